@@ -5,6 +5,7 @@ import (
 
 	gammkeeper "github.com/osmosis-labs/osmosis/v12/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/concentrated"
 	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 )
 
@@ -92,6 +93,21 @@ func (s *KeeperTestHelper) PrepareBalancerPoolWithPoolParams(poolParams balancer
 	return poolId
 }
 
+// PrepareConcentratedPoolWithCoins sets up a concentrated liquidity pool whose initial
+// price is given by the two coins, with a tick spacing of 1.
+func (s *KeeperTestHelper) PrepareConcentratedPoolWithCoins(coins ...sdk.Coin) uint64 {
+	fundCoins := sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(10000000000))).Add(coins...)
+	s.FundAcc(s.TestAccs[0], fundCoins)
+
+	msg := concentrated.NewMsgCreateConcentratedPool(s.TestAccs[0], concentrated.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}, sdk.NewCoins(coins...), 1, "")
+	poolId, err := s.App.GAMMKeeper.CreatePool(s.Ctx, msg)
+	s.NoError(err)
+	return poolId
+}
+
 // PrepareBalancerPoolWithPoolAsset sets up a Balancer pool with an array of assets.
 func (s *KeeperTestHelper) PrepareBalancerPoolWithPoolAsset(assets []balancer.PoolAsset) uint64 {
 	// Add coins for pool creation fee + coins needed to mint balances
@@ -3,6 +3,7 @@ package v13
 import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/osmosis-labs/osmosis/v12/app/upgrades"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
 	valsetpreftypes "github.com/osmosis-labs/osmosis/v12/x/valset-pref/types"
 )
 
@@ -13,7 +14,7 @@ var Upgrade = upgrades.Upgrade{
 	UpgradeName:          UpgradeName,
 	CreateUpgradeHandler: CreateUpgradeHandler,
 	StoreUpgrades: store.StoreUpgrades{
-		Added:   []string{valsetpreftypes.StoreKey},
+		Added:   []string{valsetpreftypes.StoreKey, swaproutertypes.StoreKey},
 		Deleted: []string{}, // double check bech32ibc
 	},
 }
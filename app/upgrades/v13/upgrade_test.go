@@ -1,16 +1,25 @@
 package v13_test
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/osmosis-labs/osmosis/v12/app/apptesting"
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v12/x/incentives/types"
 	ibc_hooks "github.com/osmosis-labs/osmosis/v12/x/ibc-hooks"
+	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v12/x/twap/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v12/x/txfees/types"
 )
 
 type UpgradeTestSuite struct {
@@ -52,6 +61,27 @@ func (suite *UpgradeTestSuite) TestUpgrade() {
 
 				hasAcc := suite.App.AccountKeeper.HasAccount(suite.Ctx, ibc_hooks.WasmHookModuleAccountAddr)
 				suite.Require().False(hasAcc)
+
+				// Pools created before the upgrade have no swap route.
+				poolId := suite.PrepareBalancerPool()
+				swapRouterStore := suite.Ctx.KVStore(suite.App.AppKeepers.GetKey(swaproutertypes.StoreKey))
+				swapRouterStore.Delete(swaproutertypes.FormatPoolRouteKey(poolId))
+				_, err := suite.App.SwapRouterKeeper.GetPoolRoute(suite.Ctx, poolId)
+				suite.Require().Error(err)
+
+				// Locks created before the upgrade are not indexed by start time.
+				lockOwner := suite.TestAccs[0]
+				suite.FundAcc(lockOwner, sdk.NewCoins(sdk.NewInt64Coin("foo", 10)))
+				_, err = suite.App.LockupKeeper.CreateLock(suite.Ctx, lockOwner, sdk.NewCoins(sdk.NewInt64Coin("foo", 10)), time.Hour)
+				suite.Require().NoError(err)
+				lockupStore := suite.Ctx.KVStore(suite.App.AppKeepers.GetKey(lockuptypes.StoreKey))
+				startTimeRefPrefix := bytes.Join([][]byte{lockuptypes.KeyPrefixNotUnlocking, lockuptypes.KeyPrefixDenomLockStartTime}, lockuptypes.KeyIndexSeparator)
+				iter := sdk.KVStorePrefixIterator(lockupStore, startTimeRefPrefix)
+				for ; iter.Valid(); iter.Next() {
+					lockupStore.Delete(iter.Key())
+				}
+				iter.Close()
+				suite.Require().Empty(suite.App.LockupKeeper.GetLocksStartedBeforeTimeDenom(suite.Ctx, "foo", suite.Ctx.BlockTime()))
 			},
 			func() {
 				suite.Ctx = suite.Ctx.WithBlockHeight(dummyUpgradeHeight - 1)
@@ -70,6 +100,32 @@ func (suite *UpgradeTestSuite) TestUpgrade() {
 			func() {
 				hasAcc := suite.App.AccountKeeper.HasAccount(suite.Ctx, ibc_hooks.WasmHookModuleAccountAddr)
 				suite.Require().True(hasAcc)
+
+				tokenfactoryParams := suite.App.TokenFactoryKeeper.GetParams(suite.Ctx)
+				suite.Require().False(tokenfactoryParams.EnableForceTransferAndBurnFrom)
+
+				txfeesParams := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+				suite.Require().Equal(txfeestypes.DefaultParams(), txfeesParams)
+
+				twapParams := suite.App.TwapKeeper.GetParams(suite.Ctx)
+				suite.Require().Equal(twaptypes.DefaultHistoricalRetentionTiers(), twapParams.HistoricalRetentionTiers)
+
+				gammParams := suite.App.GAMMKeeper.GetParams(suite.Ctx)
+				suite.Require().Equal(gammtypes.DefaultParams().StableswapMaxScalingFactorChange, gammParams.StableswapMaxScalingFactorChange)
+				suite.Require().Equal(gammtypes.DefaultParams().ProtocolRevenueShare, gammParams.ProtocolRevenueShare)
+				suite.Require().Equal(gammtypes.DefaultParams().ProtocolRevenueRecipient, gammParams.ProtocolRevenueRecipient)
+				suite.Require().Equal(gammtypes.DefaultParams().StableswapScalingFactorChangeWindow, gammParams.StableswapScalingFactorChangeWindow)
+
+				incentivesParams := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
+				suite.Require().Equal(incentivestypes.DefaultParams().RewardHistoryRetentionEpochs, incentivesParams.RewardHistoryRetentionEpochs)
+				suite.Require().False(incentivesParams.LazyDistribution)
+
+				route, err := suite.App.SwapRouterKeeper.GetPoolRoute(suite.Ctx, 1)
+				suite.Require().NoError(err)
+				suite.Require().Equal(gammtypes.ModuleName, route.ModuleName)
+
+				locks := suite.App.LockupKeeper.GetLocksStartedBeforeTimeDenom(suite.Ctx, "foo", suite.Ctx.BlockTime())
+				suite.Require().Len(locks, 1)
 			},
 		},
 	}
@@ -7,7 +7,12 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v12/app/keepers"
 	"github.com/osmosis-labs/osmosis/v12/app/upgrades"
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v12/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
+	tokenfactorytypes "github.com/osmosis-labs/osmosis/v12/x/tokenfactory/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v12/x/twap/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v12/x/txfees/types"
 )
 
 func CreateUpgradeHandler(
@@ -18,6 +23,42 @@ func CreateUpgradeHandler(
 ) upgradetypes.UpgradeHandler {
 	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		keepers.LockupKeeper.SetParams(ctx, lockuptypes.DefaultParams())
+		// Force transfers and burns from arbitrary accounts are disabled by default.
+		keepers.GetSubspace(tokenfactorytypes.ModuleName).Set(ctx, tokenfactorytypes.KeyEnableForceTransferAndBurnFrom, false)
+		keepers.TxFeesKeeper.SetParams(ctx, txfeestypes.DefaultParams())
+		keepers.GetSubspace(twaptypes.ModuleName).Set(ctx, twaptypes.KeyHistoricalRetentionTiers, twaptypes.DefaultHistoricalRetentionTiers())
+		keepers.GetSubspace(gammtypes.ModuleName).Set(ctx, gammtypes.KeyStableswapMaxScalingFactorChange, gammtypes.DefaultParams().StableswapMaxScalingFactorChange)
+		keepers.GetSubspace(gammtypes.ModuleName).Set(ctx, gammtypes.KeyProtocolRevenueShare, gammtypes.DefaultParams().ProtocolRevenueShare)
+		keepers.GetSubspace(gammtypes.ModuleName).Set(ctx, gammtypes.KeyProtocolRevenueRecipient, gammtypes.DefaultParams().ProtocolRevenueRecipient)
+		keepers.GetSubspace(gammtypes.ModuleName).Set(ctx, gammtypes.KeyStableswapScalingFactorChangeWindow, gammtypes.DefaultParams().StableswapScalingFactorChangeWindow)
+		keepers.GetSubspace(incentivestypes.ModuleName).Set(ctx, incentivestypes.KeyRewardHistoryRetentionEpochs, incentivestypes.DefaultParams().RewardHistoryRetentionEpochs)
+		// Rewards keep being pushed to locks until governance enables lazy distribution.
+		keepers.GetSubspace(incentivestypes.ModuleName).Set(ctx, incentivestypes.KeyLazyDistribution, false)
+		if err := keepers.TwapKeeper.MigrateExistingGeometricTwapAccumulators(ctx); err != nil {
+			return nil, err
+		}
+		if err := migrateGammPoolRoutes(ctx, keepers); err != nil {
+			return nil, err
+		}
+		// Index existing locks by their start time, for gauges distributing by lock start time.
+		if err := keepers.LockupKeeper.AddStartTimeLockRefs(ctx); err != nil {
+			return nil, err
+		}
 		return mm.RunMigrations(ctx, configurator, fromVM)
 	}
 }
+
+// migrateGammPoolRoutes routes swaps on every existing gamm pool through the gamm module,
+// as x/swaprouter only learns about pools created after it was added.
+func migrateGammPoolRoutes(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	pools, err := keepers.GAMMKeeper.GetPoolsAndPoke(ctx)
+	if err != nil {
+		return err
+	}
+	for _, pool := range pools {
+		if err := keepers.SwapRouterKeeper.SetPoolRoute(ctx, pool.GetId(), gammtypes.ModuleName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
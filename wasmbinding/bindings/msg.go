@@ -13,8 +13,12 @@ type OsmosisMsg struct {
 	MintTokens *MintTokens `json:"mint_tokens,omitempty"`
 	/// Contracts can burn native tokens for an existing factory denom
 	/// that they are the admin of.
-	/// Currently, the burn from address must be the admin contract.
+	/// Burning from an address other than the admin contract requires
+	/// force transfers and burns to be enabled in the tokenfactory params.
 	BurnTokens *BurnTokens `json:"burn_tokens,omitempty"`
+	/// Contracts can force transfer native tokens for an existing factory denom
+	/// that they are the admin of, if enabled in the tokenfactory params.
+	ForceTransfer *ForceTransfer `json:"force_transfer,omitempty"`
 	/// Swap over one or more pools
 	Swap *SwapMsg `json:"swap,omitempty"`
 }
@@ -45,10 +49,17 @@ type MintTokens struct {
 type BurnTokens struct {
 	Denom  string  `json:"denom"`
 	Amount sdk.Int `json:"amount"`
-	// BurnFromAddress must be set to "" for now.
+	// BurnFromAddress defaults to the admin contract if set to "".
 	BurnFromAddress string `json:"burn_from_address"`
 }
 
+type ForceTransfer struct {
+	Denom       string  `json:"denom"`
+	Amount      sdk.Int `json:"amount"`
+	FromAddress string  `json:"from_address"`
+	ToAddress   string  `json:"to_address"`
+}
+
 type SwapMsg struct {
 	First  Swap                `json:"first"`
 	Route  []Step              `json:"route"`
@@ -21,6 +21,10 @@ type OsmosisQuery struct {
 	EstimateSwap *EstimateSwap `json:"estimate_swap,omitempty"`
 	/// Returns the admin of a denom, if the denom is a Token Factory denom.
 	DenomAdmin *DenomAdmin `json:"denom_admin,omitempty"`
+	/// For a given pool ID, returns the cumulative amounts of tokens swapped into it.
+	PoolVolume *PoolVolume `json:"pool_volume,omitempty"`
+	/// For a given pool ID, returns the cumulative swap fees it charged.
+	PoolFeesCollected *PoolFeesCollected `json:"pool_fees_collected,omitempty"`
 }
 
 type FullDenom struct {
@@ -40,6 +44,14 @@ type PoolState struct {
 	PoolId uint64 `json:"id"`
 }
 
+type PoolVolume struct {
+	PoolId uint64 `json:"id"`
+}
+
+type PoolFeesCollected struct {
+	PoolId uint64 `json:"id"`
+}
+
 type SpotPrice struct {
 	Swap        Swap `json:"swap"`
 	WithSwapFee bool `json:"with_swap_fee"`
@@ -89,6 +101,22 @@ type PoolStateResponse struct {
 	Shares wasmvmtypes.Coin `json:"shares"`
 }
 
+type PoolVolumeResponse struct {
+	/// The cumulative amounts of tokens swapped into the pool, by denom.
+	Volume []wasmvmtypes.Coin `json:"volume"`
+}
+
+type PoolFeesCollectedResponse struct {
+	/// The cumulative swap fees charged by the pool, by denom.
+	FeesCollected []DecCoin `json:"fees_collected"`
+}
+
+// DecCoin is a coin with a decimal amount, which wasmvm has no type for.
+type DecCoin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
 type SpotPriceResponse struct {
 	/// How many output we would get for 1 input
 	Price string `json:"price"`
@@ -59,6 +59,9 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if contractMsg.BurnTokens != nil {
 			return m.burnTokens(ctx, contractAddr, contractMsg.BurnTokens)
 		}
+		if contractMsg.ForceTransfer != nil {
+			return m.forceTransfer(ctx, contractAddr, contractMsg.ForceTransfer)
+		}
 		if contractMsg.Swap != nil {
 			return m.swapTokens(ctx, contractAddr, contractMsg.Swap)
 		}
@@ -184,12 +187,14 @@ func PerformBurn(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk
 	if burn == nil {
 		return wasmvmtypes.InvalidRequest{Err: "burn token null mint"}
 	}
-	if burn.BurnFromAddress != "" && burn.BurnFromAddress != contractAddr.String() {
-		return wasmvmtypes.InvalidRequest{Err: "BurnFromAddress must be \"\""}
+	if burn.BurnFromAddress != "" {
+		if _, err := parseAddress(burn.BurnFromAddress); err != nil {
+			return err
+		}
 	}
 
 	coin := sdk.Coin{Denom: burn.Denom, Amount: burn.Amount}
-	sdkMsg := tokenfactorytypes.NewMsgBurn(contractAddr.String(), coin)
+	sdkMsg := tokenfactorytypes.NewMsgBurnFrom(contractAddr.String(), coin, burn.BurnFromAddress)
 	if err := sdkMsg.ValidateBasic(); err != nil {
 		return err
 	}
@@ -203,6 +208,44 @@ func PerformBurn(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk
 	return nil
 }
 
+// forceTransfer transfers tokens between two arbitrary addresses.
+func (m *CustomMessenger) forceTransfer(ctx sdk.Context, contractAddr sdk.AccAddress, forceTransfer *bindings.ForceTransfer) ([]sdk.Event, [][]byte, error) {
+	err := PerformForceTransfer(m.tokenFactory, ctx, contractAddr, forceTransfer)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform force transfer")
+	}
+	return nil, nil, nil
+}
+
+// PerformForceTransfer performs a force transfer after validating the forceTransfer message.
+func PerformForceTransfer(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, forceTransfer *bindings.ForceTransfer) error {
+	if forceTransfer == nil {
+		return wasmvmtypes.InvalidRequest{Err: "force transfer null force transfer"}
+	}
+	fromAddr, err := parseAddress(forceTransfer.FromAddress)
+	if err != nil {
+		return err
+	}
+	toAddr, err := parseAddress(forceTransfer.ToAddress)
+	if err != nil {
+		return err
+	}
+
+	coin := sdk.Coin{Denom: forceTransfer.Denom, Amount: forceTransfer.Amount}
+	sdkMsg := tokenfactorytypes.NewMsgForceTransfer(contractAddr.String(), coin, fromAddr.String(), toAddr.String())
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Force transfer through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err = msgServer.ForceTransfer(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "force transferring coins from message")
+	}
+	return nil
+}
+
 // swapTokens swaps one denom for another.
 func (m *CustomMessenger) swapTokens(ctx sdk.Context, contractAddr sdk.AccAddress, swap *bindings.SwapMsg) ([]sdk.Event, [][]byte, error) {
 	_, err := PerformSwap(m.gammKeeper, ctx, contractAddr, swap)
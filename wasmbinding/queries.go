@@ -56,6 +56,24 @@ func (qp QueryPlugin) GetPoolState(ctx sdk.Context, poolID uint64) (*bindings.Po
 	}, nil
 }
 
+// GetPoolVolume is a query to get the cumulative amounts of tokens swapped into a pool.
+func (qp QueryPlugin) GetPoolVolume(ctx sdk.Context, poolID uint64) (sdk.Coins, error) {
+	if _, err := qp.gammKeeper.GetPoolAndPoke(ctx, poolID); err != nil {
+		return nil, sdkerrors.Wrap(err, "gamm get pool")
+	}
+
+	return qp.gammKeeper.GetPoolSwapStats(ctx, poolID).Volume, nil
+}
+
+// GetPoolFeesCollected is a query to get the cumulative swap fees charged by a pool.
+func (qp QueryPlugin) GetPoolFeesCollected(ctx sdk.Context, poolID uint64) (sdk.DecCoins, error) {
+	if _, err := qp.gammKeeper.GetPoolAndPoke(ctx, poolID); err != nil {
+		return nil, sdkerrors.Wrap(err, "gamm get pool")
+	}
+
+	return qp.gammKeeper.GetPoolSwapStats(ctx, poolID).FeesCollected, nil
+}
+
 // GetSpotPrice is a query to get spot price of denoms.
 func (qp QueryPlugin) GetSpotPrice(ctx sdk.Context, spotPrice *bindings.SpotPrice) (*sdk.Dec, error) {
 	if spotPrice == nil {
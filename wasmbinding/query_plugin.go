@@ -109,6 +109,34 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.PoolVolume != nil:
+			volume, err := qp.GetPoolVolume(ctx, contractQuery.PoolVolume.PoolId)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo pool volume query")
+			}
+
+			res := bindings.PoolVolumeResponse{Volume: ConvertSdkCoinsToWasmCoins(volume)}
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo pool volume query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.PoolFeesCollected != nil:
+			feesCollected, err := qp.GetPoolFeesCollected(ctx, contractQuery.PoolFeesCollected.PoolId)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo pool fees collected query")
+			}
+
+			res := bindings.PoolFeesCollectedResponse{FeesCollected: ConvertSdkDecCoinsToBindingDecCoins(feesCollected)}
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo pool fees collected query response")
+			}
+
+			return bz, nil
+
 		case contractQuery.SpotPrice != nil:
 			spotPrice, err := qp.GetSpotPrice(ctx, contractQuery.SpotPrice)
 			if err != nil {
@@ -181,3 +209,15 @@ func ConvertSdkCoinToWasmCoin(coin sdk.Coin) wasmvmtypes.Coin {
 		Amount: coin.Amount.String(),
 	}
 }
+
+// ConvertSdkDecCoinsToBindingDecCoins converts sdk type dec coins to binding dec coins
+func ConvertSdkDecCoinsToBindingDecCoins(coins sdk.DecCoins) []bindings.DecCoin {
+	decCoins := []bindings.DecCoin{}
+	for _, coin := range coins {
+		decCoins = append(decCoins, bindings.DecCoin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.String(),
+		})
+	}
+	return decCoins
+}
@@ -79,6 +79,8 @@ func init() {
 	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/PoolParams", &gammtypes.QueryPoolParamsResponse{})
 	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/TotalPoolLiquidity", &gammtypes.QueryTotalPoolLiquidityResponse{})
 	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/TotalShares", &gammtypes.QueryTotalSharesResponse{})
+	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/PoolVolume", &gammtypes.QueryPoolVolumeResponse{})
+	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/PoolFeesCollected", &gammtypes.QueryPoolFeesCollectedResponse{})
 	setWhitelistedQuery("/osmosis.gamm.v1beta1.Query/SpotPrice", &gammtypes.QuerySpotPriceResponse{})
 	setWhitelistedQuery("/osmosis.gamm.v2.Query/SpotPrice", &gammv2types.QuerySpotPriceResponse{})
 
@@ -120,6 +122,8 @@ func init() {
 	// twap
 	setWhitelistedQuery("/osmosis.twap.v2.Query/ArithmeticTwap", &twapv2querytypes.ArithmeticTwapResponse{})
 	setWhitelistedQuery("/osmosis.twap.v2.Query/ArithmeticTwapToNow", &twapv2querytypes.ArithmeticTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v2.Query/GeometricTwap", &twapv2querytypes.GeometricTwapResponse{})
+	setWhitelistedQuery("/osmosis.twap.v2.Query/GeometricTwapToNow", &twapv2querytypes.GeometricTwapToNowResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/Params", &twapquerytypes.ParamsResponse{})
 }
 
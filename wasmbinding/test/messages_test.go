@@ -415,6 +415,148 @@ func TestBurn(t *testing.T) {
 	}
 }
 
+func TestBurnFrom(t *testing.T) {
+	creator := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, creator)
+
+	// Fund actor with 100 base denom creation fees
+	tokenCreationFeeAmt := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, osmosis, creator, tokenCreationFeeAmt)
+
+	validDenom := bindings.CreateDenom{
+		Subdenom: "MOON",
+	}
+	err := wasmbinding.PerformCreateDenom(osmosis.TokenFactoryKeeper, osmosis.BankKeeper, ctx, creator, &validDenom)
+	require.NoError(t, err)
+
+	holder := RandomAccountAddress()
+	validDenomStr := fmt.Sprintf("factory/%s/%s", creator.String(), validDenom.Subdenom)
+	amount := sdk.NewInt(8080)
+	mintBinding := &bindings.MintTokens{
+		Denom:         validDenomStr,
+		Amount:        amount,
+		MintToAddress: holder.String(),
+	}
+	err = wasmbinding.PerformMint(osmosis.TokenFactoryKeeper, osmosis.BankKeeper, ctx, creator, mintBinding)
+	require.NoError(t, err)
+
+	burn := &bindings.BurnTokens{
+		Denom:           validDenomStr,
+		Amount:          amount,
+		BurnFromAddress: holder.String(),
+	}
+
+	// burning from other accounts is disabled by default
+	err = wasmbinding.PerformBurn(osmosis.TokenFactoryKeeper, ctx, creator, burn)
+	require.Error(t, err)
+
+	params := osmosis.TokenFactoryKeeper.GetParams(ctx)
+	params.EnableForceTransferAndBurnFrom = true
+	osmosis.TokenFactoryKeeper.SetParams(ctx, params)
+
+	err = wasmbinding.PerformBurn(osmosis.TokenFactoryKeeper, ctx, creator, burn)
+	require.NoError(t, err)
+	require.True(t, osmosis.BankKeeper.GetBalance(ctx, holder, validDenomStr).IsZero())
+}
+
+func TestForceTransfer(t *testing.T) {
+	creator := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, creator)
+
+	// Fund actor with 100 base denom creation fees
+	tokenCreationFeeAmt := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, osmosis, creator, tokenCreationFeeAmt)
+
+	validDenom := bindings.CreateDenom{
+		Subdenom: "MOON",
+	}
+	err := wasmbinding.PerformCreateDenom(osmosis.TokenFactoryKeeper, osmosis.BankKeeper, ctx, creator, &validDenom)
+	require.NoError(t, err)
+
+	holder := RandomAccountAddress()
+	lucky := RandomAccountAddress()
+	validDenomStr := fmt.Sprintf("factory/%s/%s", creator.String(), validDenom.Subdenom)
+	amount := sdk.NewInt(8080)
+	mintBinding := &bindings.MintTokens{
+		Denom:         validDenomStr,
+		Amount:        amount,
+		MintToAddress: holder.String(),
+	}
+	err = wasmbinding.PerformMint(osmosis.TokenFactoryKeeper, osmosis.BankKeeper, ctx, creator, mintBinding)
+	require.NoError(t, err)
+
+	params := osmosis.TokenFactoryKeeper.GetParams(ctx)
+	params.EnableForceTransferAndBurnFrom = true
+	osmosis.TokenFactoryKeeper.SetParams(ctx, params)
+
+	invalidSpecs := map[string]struct {
+		sender        sdk.AccAddress
+		forceTransfer *bindings.ForceTransfer
+	}{
+		"non admin sender": {
+			sender: lucky,
+			forceTransfer: &bindings.ForceTransfer{
+				Denom:       validDenomStr,
+				Amount:      amount,
+				FromAddress: holder.String(),
+				ToAddress:   lucky.String(),
+			},
+		},
+		"invalid from address": {
+			sender: creator,
+			forceTransfer: &bindings.ForceTransfer{
+				Denom:       validDenomStr,
+				Amount:      amount,
+				FromAddress: "invalid",
+				ToAddress:   lucky.String(),
+			},
+		},
+		"invalid to address": {
+			sender: creator,
+			forceTransfer: &bindings.ForceTransfer{
+				Denom:       validDenomStr,
+				Amount:      amount,
+				FromAddress: holder.String(),
+				ToAddress:   "",
+			},
+		},
+		"insufficient funds": {
+			sender: creator,
+			forceTransfer: &bindings.ForceTransfer{
+				Denom:       validDenomStr,
+				Amount:      amount.AddRaw(1),
+				FromAddress: holder.String(),
+				ToAddress:   lucky.String(),
+			},
+		},
+		"null force transfer": {
+			sender:        creator,
+			forceTransfer: nil,
+		},
+	}
+
+	for name, spec := range invalidSpecs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			gotErr := wasmbinding.PerformForceTransfer(osmosis.TokenFactoryKeeper, ctx, spec.sender, spec.forceTransfer)
+			// then
+			require.Error(t, gotErr)
+		})
+	}
+
+	// valid force transfer
+	forceTransfer := &bindings.ForceTransfer{
+		Denom:       validDenomStr,
+		Amount:      amount,
+		FromAddress: holder.String(),
+		ToAddress:   lucky.String(),
+	}
+	err = wasmbinding.PerformForceTransfer(osmosis.TokenFactoryKeeper, ctx, creator, forceTransfer)
+	require.NoError(t, err)
+	require.True(t, osmosis.BankKeeper.GetBalance(ctx, holder, validDenomStr).IsZero())
+	require.Equal(t, amount, osmosis.BankKeeper.GetBalance(ctx, lucky, validDenomStr).Amount)
+}
+
 func TestSwap(t *testing.T) {
 	actor := RandomAccountAddress()
 	osmosis, ctx := SetupCustomApp(t, actor)
@@ -11,6 +11,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v12/wasmbinding"
 	"github.com/osmosis-labs/osmosis/v12/wasmbinding/bindings"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/balancer"
 )
 
 func TestFullDenom(t *testing.T) {
@@ -170,6 +171,65 @@ func TestPoolState(t *testing.T) {
 	}
 }
 
+func TestPoolSwapStats(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	msg := balancer.NewMsgCreateBalancerPool(actor, balancer.PoolParams{
+		SwapFee: sdk.NewDecWithPrec(1, 2),
+		ExitFee: sdk.ZeroDec(),
+	}, []balancer.PoolAsset{
+		{Token: sdk.NewInt64Coin("uosmo", 12000000), Weight: sdk.NewInt(100)},
+		{Token: sdk.NewInt64Coin("ustar", 240000000), Weight: sdk.NewInt(100)},
+	}, "")
+	starPool, err := osmosis.GAMMKeeper.CreatePool(ctx, &msg)
+	require.NoError(t, err)
+	_, err = osmosis.GAMMKeeper.SwapExactAmountIn(ctx, actor, starPool, sdk.NewInt64Coin("ustar", 2000000), "uosmo", sdk.OneInt())
+	require.NoError(t, err)
+
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper)
+
+	specs := map[string]struct {
+		poolId           uint64
+		expVolume        sdk.Coins
+		expFeesCollected sdk.DecCoins
+		expErr           bool
+	}{
+		"existent pool id": {
+			poolId:           starPool,
+			expVolume:        sdk.NewCoins(sdk.NewInt64Coin("ustar", 2000000)),
+			expFeesCollected: sdk.NewDecCoins(sdk.NewInt64DecCoin("ustar", 20000)),
+		},
+		"non-existent pool id": {
+			poolId: starPool + 1,
+			expErr: true,
+		},
+		"zero pool id": {
+			poolId: 0,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			gotVolume, gotVolumeErr := queryPlugin.GetPoolVolume(ctx, spec.poolId)
+			gotFeesCollected, gotFeesErr := queryPlugin.GetPoolFeesCollected(ctx, spec.poolId)
+			// then
+			if spec.expErr {
+				require.Error(t, gotVolumeErr)
+				require.Error(t, gotFeesErr)
+				return
+			}
+			require.NoError(t, gotVolumeErr)
+			require.NoError(t, gotFeesErr)
+			assert.Equal(t, spec.expVolume, gotVolume, "exp %s but got %s", spec.expVolume, gotVolume)
+			assert.Equal(t, spec.expFeesCollected, gotFeesCollected, "exp %s but got %s", spec.expFeesCollected, gotFeesCollected)
+		})
+	}
+}
+
 func TestSpotPrice(t *testing.T) {
 	actor := RandomAccountAddress()
 	swapFee := 0. // FIXME: Set / support an actual fee
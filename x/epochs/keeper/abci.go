@@ -16,15 +16,14 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 	k.IterateEpochInfo(ctx, func(index int64, epochInfo types.EpochInfo) (stop bool) {
 		logger := k.Logger(ctx)
 
-		// If blocktime < initial epoch start time, return
-		if ctx.BlockTime().Before(epochInfo.StartTime) {
+		// If blocktime < initial epoch start time (or height < initial epoch start height), return
+		if !hasEpochStarted(ctx, epochInfo) {
 			return
 		}
 		// if epoch counting hasn't started, signal we need to start.
 		shouldInitialEpochStart := !epochInfo.EpochCountingStarted
 
-		epochEndTime := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
-		shouldEpochStart := (ctx.BlockTime().After(epochEndTime)) || shouldInitialEpochStart
+		shouldEpochStart := hasEpochEnded(ctx, epochInfo) || shouldInitialEpochStart
 
 		if !shouldEpochStart {
 			return false
@@ -35,6 +34,10 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 			epochInfo.EpochCountingStarted = true
 			epochInfo.CurrentEpoch = 1
 			epochInfo.CurrentEpochStartTime = epochInfo.StartTime
+			if epochInfo.Mode == types.HeightBased {
+				// height based epochs record the time they actually started at
+				epochInfo.CurrentEpochStartTime = ctx.BlockTime()
+			}
 			logger.Info(fmt.Sprintf("Starting new epoch with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
 		} else {
 			ctx.EventManager().EmitEvent(
@@ -45,7 +48,11 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 			)
 			k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
 			epochInfo.CurrentEpoch += 1
-			epochInfo.CurrentEpochStartTime = epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+			if epochInfo.Mode == types.HeightBased {
+				epochInfo.CurrentEpochStartTime = ctx.BlockTime()
+			} else {
+				epochInfo.CurrentEpochStartTime = epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+			}
 			logger.Info(fmt.Sprintf("Starting epoch with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
 		}
 
@@ -63,3 +70,22 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 		return false
 	})
 }
+
+// hasEpochStarted returns whether the block is at or beyond the initial start of the epoch timer.
+func hasEpochStarted(ctx sdk.Context, epochInfo types.EpochInfo) bool {
+	if epochInfo.Mode == types.HeightBased {
+		return ctx.BlockHeight() >= epochInfo.StartHeight
+	}
+	return !ctx.BlockTime().Before(epochInfo.StartTime)
+}
+
+// hasEpochEnded returns whether the current epoch of a started epoch timer is over.
+// Time based epochs end on the first block whose time is after the epoch end time,
+// height based epochs end DurationBlocks blocks after they started.
+func hasEpochEnded(ctx sdk.Context, epochInfo types.EpochInfo) bool {
+	if epochInfo.Mode == types.HeightBased {
+		return ctx.BlockHeight() >= epochInfo.CurrentEpochStartHeight+epochInfo.DurationBlocks
+	}
+	epochEndTime := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+	return ctx.BlockTime().After(epochEndTime)
+}
@@ -104,6 +104,81 @@ func (suite KeeperTestSuite) TestEpochInfoBeginBlockChanges() {
 	}
 }
 
+// This test is responsible for testing how height based epochs increment based off
+// of their initial conditions, and subsequent block heights, regardless of block times.
+func (suite *KeeperTestSuite) TestHeightBasedEpochInfoBeginBlockChanges() {
+	block1Time := time.Unix(1656907200, 0).UTC()
+	const identifier = "blocks"
+	const durationBlocks = 10
+
+	tests := map[string]struct {
+		startHeight  int64
+		blockHeights []int64
+		// blockTimeStep is the block time in between blocks, block times do not drive height based epochs
+		blockTimeStep time.Duration
+		expEpochInfo  types.EpochInfo
+	}{
+		"First block at start height sets epoch tick": {
+			startHeight:  1,
+			expEpochInfo: types.EpochInfo{StartHeight: 1, CurrentEpoch: 1, CurrentEpochStartTime: block1Time, CurrentEpochStartHeight: 1, EpochCountingStarted: true},
+		},
+		"Blocks within the epoch do not tick, even long after the start time": {
+			startHeight:   1,
+			blockHeights:  []int64{2, 3, 10},
+			blockTimeStep: 24 * time.Hour,
+			expEpochInfo:  types.EpochInfo{StartHeight: 1, CurrentEpoch: 1, CurrentEpochStartTime: block1Time, CurrentEpochStartHeight: 1, EpochCountingStarted: true},
+		},
+		"Block at duration blocks after the epoch start ticks": {
+			startHeight:   1,
+			blockHeights:  []int64{2, 11},
+			blockTimeStep: time.Second,
+			expEpochInfo:  types.EpochInfo{StartHeight: 1, CurrentEpoch: 2, CurrentEpochStartTime: block1Time.Add(2 * time.Second), CurrentEpochStartHeight: 11, EpochCountingStarted: true},
+		},
+		"Every block ticks over many epochs": {
+			startHeight:   1,
+			blockHeights:  []int64{2, 11, 12, 21, 31, 35},
+			blockTimeStep: time.Second,
+			expEpochInfo:  types.EpochInfo{StartHeight: 1, CurrentEpoch: 4, CurrentEpochStartTime: block1Time.Add(5 * time.Second), CurrentEpochStartHeight: 31, EpochCountingStarted: true},
+		},
+		"StartHeight in future won't get ticked on first block": {
+			startHeight:   5,
+			blockHeights:  []int64{4},
+			blockTimeStep: time.Hour,
+			// currentEpochStartHeight is 1 because thats when the timer was created on-chain
+			expEpochInfo: types.EpochInfo{StartHeight: 5, CurrentEpoch: 0, CurrentEpochStartTime: time.Time{}, CurrentEpochStartHeight: 1},
+		},
+		"StartHeight in future gets ticked at start height": {
+			startHeight:   5,
+			blockHeights:  []int64{4, 5, 14},
+			blockTimeStep: time.Hour,
+			expEpochInfo:  types.EpochInfo{StartHeight: 5, CurrentEpoch: 1, CurrentEpochStartTime: block1Time.Add(2 * time.Hour), CurrentEpochStartHeight: 5, EpochCountingStarted: true},
+		},
+	}
+	for name, test := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.Ctx = suite.Ctx.WithBlockHeight(1).WithBlockTime(block1Time)
+			initialEpoch := types.NewGenesisHeightEpochInfo(identifier, durationBlocks)
+			initialEpoch.StartHeight = test.startHeight
+			err := suite.App.EpochsKeeper.AddEpochInfo(suite.Ctx, initialEpoch)
+			suite.Require().NoError(err)
+			suite.App.EpochsKeeper.BeginBlocker(suite.Ctx)
+
+			for i, h := range test.blockHeights {
+				suite.Ctx = suite.Ctx.WithBlockHeight(h).WithBlockTime(block1Time.Add(time.Duration(i+1) * test.blockTimeStep))
+				suite.App.EpochsKeeper.BeginBlocker(suite.Ctx)
+			}
+			expEpoch := test.expEpochInfo
+			expEpoch.Identifier = identifier
+			expEpoch.Mode = types.HeightBased
+			expEpoch.DurationBlocks = durationBlocks
+			expEpoch.StartTime = block1Time
+			actEpoch := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, identifier)
+			suite.Require().Equal(expEpoch, actEpoch)
+		})
+	}
+}
+
 // initializeBlankEpochInfoFields set identifier, duration and epochCountingStarted if blank in epoch
 func initializeBlankEpochInfoFields(epoch types.EpochInfo, identifier string, duration time.Duration) types.EpochInfo {
 	if epoch.Identifier == "" {
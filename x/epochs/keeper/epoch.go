@@ -28,7 +28,8 @@ func (k Keeper) GetEpochInfo(ctx sdk.Context, identifier string) types.EpochInfo
 
 // AddEpochInfo adds a new epoch info. Will return an error if the epoch fails validation,
 // or re-uses an existing identifier.
-// This method also sets the start time if left unset, and sets the epoch start height.
+// This method also sets the start time (and the start height of height based epochs) if left unset,
+// and sets the epoch start height.
 func (k Keeper) AddEpochInfo(ctx sdk.Context, epoch types.EpochInfo) error {
 	err := epoch.Validate()
 	if err != nil {
@@ -43,6 +44,9 @@ func (k Keeper) AddEpochInfo(ctx sdk.Context, epoch types.EpochInfo) error {
 	if epoch.StartTime.Equal(time.Time{}) {
 		epoch.StartTime = ctx.BlockTime()
 	}
+	if epoch.Mode == types.HeightBased && epoch.StartHeight == 0 {
+		epoch.StartHeight = ctx.BlockHeight()
+	}
 	epoch.CurrentEpochStartHeight = ctx.BlockHeight()
 	k.setEpochInfo(ctx, epoch)
 	return nil
@@ -102,10 +106,14 @@ func (k Keeper) AllEpochInfos(ctx sdk.Context) []types.EpochInfo {
 // if the epoch started on block N, then calling this during block N (after BeforeEpochStart)
 // would return 0.
 // Calling it any point in block N+1 (assuming the epoch doesn't increment) would return 1.
+// Height based epochs that have not started counting yet have no epoch start, and return an error.
 func (k Keeper) NumBlocksSinceEpochStart(ctx sdk.Context, identifier string) (int64, error) {
 	epoch := k.GetEpochInfo(ctx, identifier)
 	if (epoch == types.EpochInfo{}) {
 		return 0, fmt.Errorf("epoch with identifier %s not found", identifier)
 	}
+	if epoch.Mode == types.HeightBased && !epoch.EpochCountingStarted {
+		return 0, fmt.Errorf("epoch with identifier %s starts at height %d", identifier, epoch.StartHeight)
+	}
 	return ctx.BlockHeight() - epoch.CurrentEpochStartHeight, nil
 }
@@ -49,6 +49,67 @@ func (suite *KeeperTestSuite) TestAddEpochInfo() {
 			},
 			expErr: true,
 		},
+		"height_based_add": {
+			addedEpochInfo: types.NewGenesisHeightEpochInfo(defaultIdentifier, 1000),
+			expErr:         false,
+			expEpochInfo: types.EpochInfo{
+				Identifier:              defaultIdentifier,
+				StartTime:               startBlockTime,
+				Mode:                    types.HeightBased,
+				StartHeight:             startBlockHeight,
+				DurationBlocks:          1000,
+				CurrentEpoch:            0,
+				CurrentEpochStartHeight: startBlockHeight,
+				CurrentEpochStartTime:   time.Time{},
+				EpochCountingStarted:    false,
+			},
+		},
+		"height_based_future_start_height": {
+			addedEpochInfo: types.EpochInfo{
+				Identifier:     defaultIdentifier,
+				Mode:           types.HeightBased,
+				StartHeight:    startBlockHeight + 10,
+				DurationBlocks: 1000,
+			},
+			expErr: false,
+			expEpochInfo: types.EpochInfo{
+				Identifier:              defaultIdentifier,
+				StartTime:               startBlockTime,
+				Mode:                    types.HeightBased,
+				StartHeight:             startBlockHeight + 10,
+				DurationBlocks:          1000,
+				CurrentEpochStartHeight: startBlockHeight,
+			},
+		},
+		"height_based_zero_duration_blocks": {
+			addedEpochInfo: types.NewGenesisHeightEpochInfo(defaultIdentifier, 0),
+			expErr:         true,
+		},
+		"height_based_negative_start_height": {
+			addedEpochInfo: types.EpochInfo{
+				Identifier:     defaultIdentifier,
+				Mode:           types.HeightBased,
+				StartHeight:    -1,
+				DurationBlocks: 1000,
+			},
+			expErr: true,
+		},
+		"time_based_with_duration_blocks": {
+			addedEpochInfo: types.EpochInfo{
+				Identifier:     defaultIdentifier,
+				Duration:       defaultDuration,
+				DurationBlocks: 1000,
+			},
+			expErr: true,
+		},
+		"unknown_mode": {
+			addedEpochInfo: types.EpochInfo{
+				Identifier:     defaultIdentifier,
+				Mode:           types.EpochMode(2),
+				DurationBlocks: 1000,
+			},
+			expErr: true,
+		},
 	}
 	for name, test := range tests {
 		suite.Run(name, func() {
@@ -94,3 +155,30 @@ func (suite *KeeperTestSuite) TestEpochLifeCycle() {
 	suite.Require().Equal(allEpochs[2].Identifier, "monthly")
 	suite.Require().Equal(allEpochs[3].Identifier, "week")
 }
+
+func (suite *KeeperTestSuite) TestNumBlocksSinceEpochStart() {
+	suite.SetupTest()
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+
+	epochInfo := types.NewGenesisHeightEpochInfo("blocks", 5)
+	epochInfo.StartHeight = 12
+	err := suite.App.EpochsKeeper.AddEpochInfo(suite.Ctx, epochInfo)
+	suite.Require().NoError(err)
+
+	// height based epoch that has not started counting yet
+	_, err = suite.App.EpochsKeeper.NumBlocksSinceEpochStart(suite.Ctx, "blocks")
+	suite.Require().Error(err)
+
+	for height := int64(11); height <= 20; height++ {
+		suite.Ctx = suite.Ctx.WithBlockHeight(height)
+		suite.App.EpochsKeeper.BeginBlocker(suite.Ctx)
+	}
+
+	// epoch 2 started at height 17
+	numBlocks, err := suite.App.EpochsKeeper.NumBlocksSinceEpochStart(suite.Ctx, "blocks")
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(3), numBlocks)
+
+	_, err = suite.App.EpochsKeeper.NumBlocksSinceEpochStart(suite.Ctx, "unknown")
+	suite.Require().Error(err)
+}
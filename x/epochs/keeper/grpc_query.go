@@ -49,7 +49,13 @@ func (q Querier) CurrentEpoch(c context.Context, req *types.QueryCurrentEpochReq
 		return nil, errors.New("not available identifier")
 	}
 
-	return &types.QueryCurrentEpochResponse{
-		CurrentEpoch: info.CurrentEpoch,
-	}, nil
+	res := &types.QueryCurrentEpochResponse{
+		CurrentEpoch:            info.CurrentEpoch,
+		Mode:                    info.Mode,
+		CurrentEpochStartHeight: info.CurrentEpochStartHeight,
+	}
+	if info.Mode == types.HeightBased && info.EpochCountingStarted {
+		res.NextEpochStartHeight = info.CurrentEpochStartHeight + info.DurationBlocks
+	}
+	return res, nil
 }
@@ -3,6 +3,9 @@ package keeper_test
 import (
 	gocontext "context"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/epochs/keeper"
 	"github.com/osmosis-labs/osmosis/v12/x/epochs/types"
 )
 
@@ -22,3 +25,35 @@ func (suite *KeeperTestSuite) TestQueryEpochInfos() {
 
 	suite.Require().Equal(expectedEpochs, epochInfosResponse.Epochs)
 }
+
+func (suite *KeeperTestSuite) TestQueryCurrentEpoch() {
+	suite.SetupTest()
+	queryClient := suite.queryClient
+
+	// time based epoch
+	res, err := queryClient.CurrentEpoch(gocontext.Background(), &types.QueryCurrentEpochRequest{Identifier: "day"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryCurrentEpochResponse{
+		CurrentEpoch:            0,
+		Mode:                    types.TimeBased,
+		CurrentEpochStartHeight: suite.Ctx.BlockHeight(),
+	}, res)
+
+	// height based epoch
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	err = suite.App.EpochsKeeper.AddEpochInfo(suite.Ctx, types.NewGenesisHeightEpochInfo("blocks", 100))
+	suite.Require().NoError(err)
+	suite.App.EpochsKeeper.BeginBlocker(suite.Ctx)
+
+	res, err = keeper.NewQuerier(*suite.App.EpochsKeeper).CurrentEpoch(sdk.WrapSDKContext(suite.Ctx), &types.QueryCurrentEpochRequest{Identifier: "blocks"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryCurrentEpochResponse{
+		CurrentEpoch:            1,
+		Mode:                    types.HeightBased,
+		CurrentEpochStartHeight: 10,
+		NextEpochStartHeight:    110,
+	}, res)
+
+	_, err = queryClient.CurrentEpoch(gocontext.Background(), &types.QueryCurrentEpochRequest{Identifier: "unknown"})
+	suite.Require().Error(err)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 )
 
@@ -43,8 +44,23 @@ func (epoch EpochInfo) Validate() error {
 	if epoch.Identifier == "" {
 		return errors.New("epoch identifier should NOT be empty")
 	}
-	if epoch.Duration == 0 {
-		return errors.New("epoch duration should NOT be 0")
+	switch epoch.Mode {
+	case TimeBased:
+		if epoch.Duration == 0 {
+			return errors.New("epoch duration should NOT be 0")
+		}
+		if epoch.DurationBlocks != 0 {
+			return errors.New("time based epoch DurationBlocks should be 0")
+		}
+	case HeightBased:
+		if epoch.DurationBlocks <= 0 {
+			return errors.New("height based epoch DurationBlocks must be positive")
+		}
+		if epoch.StartHeight < 0 {
+			return errors.New("height based epoch StartHeight must be non-negative")
+		}
+	default:
+		return fmt.Errorf("unknown epoch mode %s", epoch.Mode)
 	}
 	if epoch.CurrentEpoch < 0 {
 		return errors.New("epoch CurrentEpoch must be non-negative")
@@ -66,3 +82,17 @@ func NewGenesisEpochInfo(identifier string, duration time.Duration) EpochInfo {
 		EpochCountingStarted:    false,
 	}
 }
+
+// NewGenesisHeightEpochInfo returns an epoch info ticking every durationBlocks blocks.
+func NewGenesisHeightEpochInfo(identifier string, durationBlocks int64) EpochInfo {
+	return EpochInfo{
+		Identifier:              identifier,
+		Mode:                    HeightBased,
+		DurationBlocks:          durationBlocks,
+		StartHeight:             0,
+		CurrentEpoch:            0,
+		CurrentEpochStartHeight: 0,
+		CurrentEpochStartTime:   time.Time{},
+		EpochCountingStarted:    false,
+	}
+}
@@ -27,6 +27,36 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// EpochMode defines what drives the ticks of an epoch timer.
+type EpochMode int32
+
+const (
+	// TimeBased timers tick once the block time passes the current epoch start
+	// time + duration.
+	TimeBased EpochMode = 0
+	// HeightBased timers tick every duration_blocks blocks, regardless of the
+	// block time.
+	HeightBased EpochMode = 1
+)
+
+var EpochMode_name = map[int32]string{
+	0: "TimeBased",
+	1: "HeightBased",
+}
+
+var EpochMode_value = map[string]int32{
+	"TimeBased":   0,
+	"HeightBased": 1,
+}
+
+func (x EpochMode) String() string {
+	return proto.EnumName(EpochMode_name, int32(x))
+}
+
+func (EpochMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7ecf3e4d59074cbd, []int{0}
+}
+
 // EpochInfo is a struct that describes the data going into
 // a timer defined by the x/epochs module.
 type EpochInfo struct {
@@ -39,7 +69,8 @@ type EpochInfo struct {
 	// duration is the time in between epoch ticks.
 	// In order for intended behavior to be met, duration should
 	// be greater than the chains expected block time.
-	// Duration must be non-zero.
+	// Duration must be non-zero for time based timers, and is unused by height
+	// based timers.
 	Duration time.Duration `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
 	// current_epoch is the current epoch number, or in other words,
 	// how many times has the timer 'ticked'.
@@ -70,6 +101,16 @@ type EpochInfo struct {
 	// current_epoch_start_height is the block height at which the current epoch
 	// started. (The block height at which the timer last ticked)
 	CurrentEpochStartHeight int64 `protobuf:"varint,8,opt,name=current_epoch_start_height,json=currentEpochStartHeight,proto3" json:"current_epoch_start_height,omitempty"`
+	// mode is whether the timer ticks by block time or by block height.
+	Mode EpochMode `protobuf:"varint,9,opt,name=mode,proto3,enum=osmosis.epochs.v1beta1.EpochMode" json:"mode,omitempty" yaml:"mode"`
+	// start_height is the block height at which a height based timer first
+	// ticks. If start_height is in the future, the epoch will not begin until
+	// the start height. Unused by time based timers.
+	StartHeight int64 `protobuf:"varint,10,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// duration_blocks is the number of blocks in between height based epoch
+	// ticks. It must be positive for height based timers, and zero for time
+	// based timers.
+	DurationBlocks int64 `protobuf:"varint,11,opt,name=duration_blocks,json=durationBlocks,proto3" json:"duration_blocks,omitempty" yaml:"duration_blocks"`
 }
 
 func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
@@ -154,6 +195,27 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+func (m *EpochInfo) GetMode() EpochMode {
+	if m != nil {
+		return m.Mode
+	}
+	return TimeBased
+}
+
+func (m *EpochInfo) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EpochInfo) GetDurationBlocks() int64 {
+	if m != nil {
+		return m.DurationBlocks
+	}
+	return 0
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	Epochs []EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
@@ -200,6 +262,7 @@ func (m *GenesisState) GetEpochs() []EpochInfo {
 }
 
 func init() {
+	proto.RegisterEnum("osmosis.epochs.v1beta1.EpochMode", EpochMode_name, EpochMode_value)
 	proto.RegisterType((*EpochInfo)(nil), "osmosis.epochs.v1beta1.EpochInfo")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.epochs.v1beta1.GenesisState")
 }
@@ -207,37 +270,44 @@ func init() {
 func init() { proto.RegisterFile("osmosis/epochs/genesis.proto", fileDescriptor_7ecf3e4d59074cbd) }
 
 var fileDescriptor_7ecf3e4d59074cbd = []byte{
-	// 479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x3f, 0x8f, 0xd3, 0x30,
-	0x1c, 0xad, 0x69, 0x29, 0xad, 0xef, 0x10, 0x60, 0x1d, 0x47, 0xa8, 0x20, 0x09, 0x61, 0x89, 0x04,
-	0x38, 0x6a, 0x61, 0x82, 0x01, 0xa9, 0x80, 0xf8, 0x23, 0x24, 0xa4, 0x94, 0x01, 0xb1, 0x54, 0x49,
-	0xeb, 0x26, 0x96, 0x9a, 0x38, 0x8a, 0x7f, 0x39, 0xd1, 0x8d, 0x8f, 0xd0, 0x91, 0x8f, 0x74, 0xe3,
-	0x8d, 0x4c, 0x05, 0xb5, 0x1b, 0xe3, 0x7d, 0x02, 0x14, 0x3b, 0x29, 0x85, 0x3b, 0x74, 0x5b, 0xec,
-	0xf7, 0x7e, 0xef, 0xf9, 0x3d, 0xfd, 0x82, 0xef, 0x08, 0x99, 0x08, 0xc9, 0xa5, 0xc7, 0x32, 0x31,
-	0x89, 0xa5, 0x17, 0xb1, 0x94, 0x49, 0x2e, 0x69, 0x96, 0x0b, 0x10, 0xe4, 0xb0, 0x42, 0xa9, 0x46,
-	0xe9, 0x51, 0x3f, 0x64, 0x10, 0xf4, 0x7b, 0x07, 0x91, 0x88, 0x84, 0xa2, 0x78, 0xe5, 0x97, 0x66,
-	0xf7, 0xcc, 0x48, 0x88, 0x68, 0xce, 0x3c, 0x75, 0x0a, 0x8b, 0x99, 0x37, 0x2d, 0xf2, 0x00, 0xb8,
-	0x48, 0x2b, 0xdc, 0xfa, 0x17, 0x07, 0x9e, 0x30, 0x09, 0x41, 0x92, 0x69, 0x82, 0xb3, 0x6c, 0xe1,
-	0xee, 0xab, 0xd2, 0xe9, 0x6d, 0x3a, 0x13, 0xc4, 0xc4, 0x98, 0x4f, 0x59, 0x0a, 0x7c, 0xc6, 0x59,
-	0x6e, 0x20, 0x1b, 0xb9, 0x5d, 0x7f, 0xe7, 0x86, 0x7c, 0xc2, 0x58, 0x42, 0x90, 0xc3, 0xb8, 0x94,
-	0x31, 0x2e, 0xd9, 0xc8, 0xdd, 0x1b, 0xf4, 0xa8, 0xf6, 0xa0, 0xb5, 0x07, 0xfd, 0x58, 0x7b, 0x0c,
-	0xef, 0x1e, 0xaf, 0xac, 0xc6, 0xe9, 0xca, 0xba, 0xb1, 0x08, 0x92, 0xf9, 0x53, 0xe7, 0xcf, 0xac,
-	0xb3, 0xfc, 0x61, 0x21, 0xbf, 0xab, 0x2e, 0x4a, 0x3a, 0x89, 0x71, 0xa7, 0x7e, 0xba, 0xd1, 0x54,
-	0xba, 0xb7, 0xcf, 0xe8, 0xbe, 0xac, 0x08, 0xc3, 0x7e, 0x29, 0xfb, 0x6b, 0x65, 0x91, 0x7a, 0xe4,
-	0xa1, 0x48, 0x38, 0xb0, 0x24, 0x83, 0xc5, 0xe9, 0xca, 0xba, 0xa6, 0xcd, 0x6a, 0xcc, 0xf9, 0x56,
-	0x5a, 0x6d, 0xd5, 0xc9, 0x7d, 0x7c, 0x75, 0x52, 0xe4, 0x39, 0x4b, 0x61, 0xac, 0x2a, 0x36, 0x5a,
-	0x36, 0x72, 0x9b, 0xfe, 0x7e, 0x75, 0xa9, 0xca, 0x20, 0x5f, 0x11, 0x36, 0xfe, 0x62, 0x8d, 0x77,
-	0x72, 0x5f, 0xbe, 0x30, 0xf7, 0x83, 0x2a, 0xb7, 0xa5, 0x9f, 0xf2, 0x3f, 0x25, 0xdd, 0xc2, 0xcd,
-	0x5d, 0xe7, 0xd1, 0xb6, 0x91, 0x27, 0xf8, 0x50, 0xf3, 0x27, 0xa2, 0x48, 0x81, 0xa7, 0x91, 0x1e,
-	0x64, 0x53, 0xa3, 0x6d, 0x23, 0xb7, 0xe3, 0x1f, 0x28, 0xf4, 0x45, 0x05, 0x8e, 0x34, 0x46, 0x9e,
-	0xe1, 0xde, 0x79, 0x6e, 0x31, 0xe3, 0x51, 0x0c, 0x46, 0x47, 0x45, 0xbd, 0x75, 0xc6, 0xf0, 0x8d,
-	0x82, 0xdf, 0xb5, 0x3a, 0x57, 0xae, 0x77, 0x9c, 0x0f, 0x78, 0xff, 0xb5, 0x5e, 0xc9, 0x11, 0x04,
-	0xc0, 0xc8, 0x73, 0xdc, 0xd6, 0xbb, 0x68, 0x20, 0xbb, 0xe9, 0xee, 0x0d, 0xee, 0xd1, 0xf3, 0x57,
-	0x94, 0x6e, 0xf7, 0x68, 0xd8, 0x2a, 0xf3, 0xfb, 0xd5, 0xd8, 0xf0, 0xfd, 0xf1, 0xda, 0x44, 0x27,
-	0x6b, 0x13, 0xfd, 0x5c, 0x9b, 0x68, 0xb9, 0x31, 0x1b, 0x27, 0x1b, 0xb3, 0xf1, 0x7d, 0x63, 0x36,
-	0x3e, 0x0f, 0x22, 0x0e, 0x71, 0x11, 0xd2, 0x89, 0x48, 0xbc, 0x4a, 0xf4, 0xd1, 0x3c, 0x08, 0x65,
-	0x7d, 0xf0, 0x8e, 0xfa, 0x03, 0xef, 0x4b, 0xfd, 0xa3, 0xc0, 0x22, 0x63, 0x32, 0x6c, 0xab, 0xbe,
-	0x1f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x8f, 0xef, 0x94, 0x21, 0x47, 0x03, 0x00, 0x00,
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0xf5, 0xd1, 0xb4, 0xc4, 0xe7, 0xb6, 0x29, 0x47, 0x69, 0x8d, 0x05, 0xb6, 0x31, 0x8b, 0xc5,
+	0x0f, 0x5b, 0x09, 0x4c, 0x65, 0x40, 0x72, 0xf9, 0x2d, 0x10, 0x92, 0xcb, 0x80, 0x58, 0x22, 0x3b,
+	0xbe, 0x3a, 0x16, 0xb1, 0x2f, 0xf2, 0x5d, 0x2a, 0xb2, 0x31, 0x32, 0x76, 0x64, 0xe7, 0x9f, 0xe9,
+	0xd8, 0x11, 0x16, 0x83, 0x92, 0x8d, 0x31, 0x7f, 0x01, 0xf2, 0x9d, 0x1d, 0x12, 0x5a, 0xd4, 0x2d,
+	0xfe, 0xde, 0x7b, 0xdf, 0xbb, 0xf7, 0x7d, 0x77, 0x81, 0x37, 0x08, 0x4d, 0x09, 0x4d, 0xa8, 0x8b,
+	0x87, 0xa4, 0xd7, 0xa7, 0x6e, 0x8c, 0x33, 0x4c, 0x13, 0xea, 0x0c, 0x73, 0xc2, 0x08, 0xda, 0xa9,
+	0x50, 0x47, 0xa0, 0xce, 0x51, 0x3b, 0xc4, 0x2c, 0x68, 0x6b, 0xdb, 0x31, 0x89, 0x09, 0xa7, 0xb8,
+	0xe5, 0x2f, 0xc1, 0xd6, 0xf4, 0x98, 0x90, 0x78, 0x80, 0x5d, 0xfe, 0x15, 0x8e, 0x0e, 0xdd, 0x68,
+	0x94, 0x07, 0x2c, 0x21, 0x59, 0x85, 0x1b, 0xff, 0xe2, 0x2c, 0x49, 0x31, 0x65, 0x41, 0x3a, 0x14,
+	0x04, 0xeb, 0xc7, 0x2a, 0x94, 0x9f, 0x96, 0x4e, 0x2f, 0xb3, 0x43, 0x82, 0x74, 0x08, 0x93, 0x08,
+	0x67, 0x2c, 0x39, 0x4c, 0x70, 0xae, 0x02, 0x13, 0xd8, 0xb2, 0xbf, 0x50, 0x41, 0xef, 0x21, 0xa4,
+	0x2c, 0xc8, 0x59, 0xb7, 0x6c, 0xa3, 0x5e, 0x32, 0x81, 0xad, 0x74, 0x34, 0x47, 0x78, 0x38, 0xb5,
+	0x87, 0xf3, 0xae, 0xf6, 0xf0, 0x6e, 0x9e, 0x14, 0x86, 0x34, 0x2b, 0x8c, 0x2b, 0xe3, 0x20, 0x1d,
+	0xec, 0x59, 0x7f, 0xb5, 0xd6, 0xf1, 0x4f, 0x03, 0xf8, 0x32, 0x2f, 0x94, 0x74, 0xd4, 0x87, 0xcd,
+	0xfa, 0xe8, 0xea, 0x0a, 0xef, 0x7b, 0xfd, 0x4c, 0xdf, 0x27, 0x15, 0xc1, 0x6b, 0x97, 0x6d, 0x7f,
+	0x17, 0x06, 0xaa, 0x25, 0xf7, 0x48, 0x9a, 0x30, 0x9c, 0x0e, 0xd9, 0x78, 0x56, 0x18, 0x2d, 0x61,
+	0x56, 0x63, 0xd6, 0xd7, 0xd2, 0x6a, 0xde, 0x1d, 0xdd, 0x86, 0x1b, 0xbd, 0x51, 0x9e, 0xe3, 0x8c,
+	0x75, 0xf9, 0x88, 0xd5, 0x86, 0x09, 0xec, 0x15, 0x7f, 0xbd, 0x2a, 0xf2, 0x61, 0xa0, 0xcf, 0x00,
+	0xaa, 0x4b, 0xac, 0xee, 0x42, 0xee, 0xd5, 0x0b, 0x73, 0xdf, 0xad, 0x72, 0x1b, 0xe2, 0x28, 0xff,
+	0xeb, 0x24, 0xa6, 0x70, 0x6d, 0xd1, 0xf9, 0x60, 0x3e, 0x91, 0x87, 0x70, 0x47, 0xf0, 0x7b, 0x64,
+	0x94, 0xb1, 0x24, 0x8b, 0x85, 0x10, 0x47, 0xea, 0x9a, 0x09, 0xec, 0xa6, 0xbf, 0xcd, 0xd1, 0xfd,
+	0x0a, 0x3c, 0x10, 0x18, 0x7a, 0x04, 0xb5, 0xf3, 0xdc, 0xfa, 0x38, 0x89, 0xfb, 0x4c, 0x6d, 0xf2,
+	0xa8, 0xbb, 0x67, 0x0c, 0x5f, 0x70, 0x18, 0x3d, 0x83, 0x8d, 0x94, 0x44, 0x58, 0x95, 0x4d, 0x60,
+	0x6f, 0x76, 0x6e, 0x39, 0xe7, 0x5f, 0x45, 0x87, 0xeb, 0xde, 0x90, 0x08, 0x7b, 0xad, 0x59, 0x61,
+	0x28, 0x22, 0x63, 0x29, 0xb4, 0x7c, 0xae, 0x47, 0x7b, 0x70, 0x7d, 0xc9, 0x16, 0x96, 0xb6, 0xde,
+	0xee, 0xac, 0x30, 0xae, 0x2e, 0x5e, 0x04, 0x81, 0x5a, 0xbe, 0x42, 0x17, 0xce, 0xb0, 0x0f, 0x5b,
+	0xf5, 0xaa, 0xba, 0xe1, 0x80, 0xf4, 0x3e, 0x52, 0x55, 0xe1, 0x72, 0x6d, 0x56, 0x18, 0x3b, 0xcb,
+	0xab, 0xad, 0x08, 0x96, 0xbf, 0x59, 0x57, 0x3c, 0x5e, 0x78, 0xd5, 0x68, 0x5e, 0xde, 0x6a, 0x5a,
+	0x6f, 0xe1, 0xfa, 0x73, 0xf1, 0xb6, 0x0e, 0x58, 0xc0, 0x30, 0x7a, 0x0c, 0xd7, 0x44, 0x12, 0x15,
+	0x98, 0x2b, 0xb6, 0x72, 0x41, 0xc0, 0xf2, 0x41, 0x78, 0x8d, 0x72, 0x91, 0x7e, 0x25, 0xbb, 0xd3,
+	0x86, 0xf2, 0x3c, 0x3b, 0xda, 0x80, 0x72, 0xb9, 0x27, 0x2f, 0xa0, 0x38, 0xda, 0x92, 0x50, 0x0b,
+	0x2a, 0x22, 0x81, 0x28, 0x00, 0xad, 0xf1, 0xe5, 0x9b, 0x2e, 0x79, 0xaf, 0x4f, 0x26, 0x3a, 0x38,
+	0x9d, 0xe8, 0xe0, 0xd7, 0x44, 0x07, 0xc7, 0x53, 0x5d, 0x3a, 0x9d, 0xea, 0xd2, 0xf7, 0xa9, 0x2e,
+	0x7d, 0xe8, 0xc4, 0x09, 0xeb, 0x8f, 0x42, 0xa7, 0x47, 0x52, 0xb7, 0x3a, 0xc7, 0xfd, 0x41, 0x10,
+	0xd2, 0xfa, 0xc3, 0x3d, 0x6a, 0x77, 0xdc, 0x4f, 0xf5, 0x9f, 0x04, 0x1b, 0x0f, 0x31, 0x0d, 0xd7,
+	0xf8, 0x5d, 0x7b, 0xf0, 0x67, 0x00, 0xf7, 0x80, 0x56, 0xdb, 0x43, 0x04, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -260,6 +330,21 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DurationBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DurationBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Mode != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.CurrentEpochStartHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochStartHeight))
 		i--
@@ -387,6 +472,15 @@ func (m *EpochInfo) Size() (n int) {
 	if m.CurrentEpochStartHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.CurrentEpochStartHeight))
 	}
+	if m.Mode != 0 {
+		n += 1 + sovGenesis(uint64(m.Mode))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.StartHeight))
+	}
+	if m.DurationBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.DurationBlocks))
+	}
 	return n
 }
 
@@ -629,6 +723,63 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= EpochMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationBlocks", wireType)
+			}
+			m.DurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
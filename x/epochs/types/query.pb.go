@@ -156,6 +156,15 @@ func (m *QueryCurrentEpochRequest) GetIdentifier() string {
 
 type QueryCurrentEpochResponse struct {
 	CurrentEpoch int64 `protobuf:"varint,1,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	// mode is whether the epoch ticks by block time or by block height.
+	Mode EpochMode `protobuf:"varint,2,opt,name=mode,proto3,enum=osmosis.epochs.v1beta1.EpochMode" json:"mode,omitempty"`
+	// current_epoch_start_height is the block height at which the current epoch
+	// started.
+	CurrentEpochStartHeight int64 `protobuf:"varint,3,opt,name=current_epoch_start_height,json=currentEpochStartHeight,proto3" json:"current_epoch_start_height,omitempty"`
+	// next_epoch_start_height is the block height at which the next epoch
+	// starts, for height based epochs that have started counting. It is zero
+	// otherwise.
+	NextEpochStartHeight int64 `protobuf:"varint,4,opt,name=next_epoch_start_height,json=nextEpochStartHeight,proto3" json:"next_epoch_start_height,omitempty"`
 }
 
 func (m *QueryCurrentEpochResponse) Reset()         { *m = QueryCurrentEpochResponse{} }
@@ -198,6 +207,27 @@ func (m *QueryCurrentEpochResponse) GetCurrentEpoch() int64 {
 	return 0
 }
 
+func (m *QueryCurrentEpochResponse) GetMode() EpochMode {
+	if m != nil {
+		return m.Mode
+	}
+	return TimeBased
+}
+
+func (m *QueryCurrentEpochResponse) GetCurrentEpochStartHeight() int64 {
+	if m != nil {
+		return m.CurrentEpochStartHeight
+	}
+	return 0
+}
+
+func (m *QueryCurrentEpochResponse) GetNextEpochStartHeight() int64 {
+	if m != nil {
+		return m.NextEpochStartHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "osmosis.epochs.v1beta1.QueryEpochsInfoRequest")
 	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "osmosis.epochs.v1beta1.QueryEpochsInfoResponse")
@@ -208,33 +238,37 @@ func init() {
 func init() { proto.RegisterFile("osmosis/epochs/query.proto", fileDescriptor_574bd176519c765f) }
 
 var fileDescriptor_574bd176519c765f = []byte{
-	// 410 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcf, 0x6e, 0xd3, 0x30,
-	0x18, 0x8f, 0x5b, 0xa8, 0x84, 0x29, 0x17, 0x0b, 0x95, 0x10, 0x21, 0x53, 0x82, 0x80, 0x0a, 0xa9,
-	0x31, 0x09, 0x37, 0x2e, 0xa0, 0x22, 0x0e, 0x48, 0x5c, 0xc8, 0xb1, 0x17, 0x94, 0x04, 0x37, 0xb5,
-	0xd4, 0xda, 0x69, 0xec, 0x54, 0xf4, 0xca, 0x13, 0x20, 0xa1, 0xbd, 0xc0, 0x9e, 0x65, 0x87, 0x1e,
-	0x2b, 0xed, 0xb2, 0xd3, 0x34, 0xb5, 0x7b, 0x90, 0x29, 0x4e, 0x52, 0x75, 0x5d, 0x3a, 0x6d, 0xb7,
-	0xc4, 0xbf, 0xbf, 0xdf, 0x67, 0x43, 0x4b, 0xc8, 0xa9, 0x90, 0x4c, 0x12, 0x9a, 0x88, 0x68, 0x2c,
-	0xc9, 0x2c, 0xa3, 0xe9, 0xc2, 0x49, 0x52, 0xa1, 0x04, 0xea, 0x94, 0x98, 0x53, 0x60, 0xce, 0xdc,
-	0x0d, 0xa9, 0x0a, 0x5c, 0xeb, 0x69, 0x2c, 0x62, 0xa1, 0x29, 0x24, 0xff, 0x2a, 0xd8, 0xd6, 0x8b,
-	0x58, 0x88, 0x78, 0x42, 0x49, 0x90, 0x30, 0x12, 0x70, 0x2e, 0x54, 0xa0, 0x98, 0xe0, 0xb2, 0x44,
-	0xdf, 0x47, 0xda, 0x8c, 0x84, 0x81, 0xa4, 0x45, 0x08, 0x29, 0xed, 0x48, 0x12, 0xc4, 0x8c, 0x6b,
-	0x72, 0xe5, 0xb4, 0xd7, 0x29, 0xa6, 0x9c, 0xe6, 0x35, 0x34, 0x6a, 0x9b, 0xb0, 0xf3, 0x33, 0xd7,
-	0x7f, 0xd3, 0xe0, 0x77, 0x3e, 0x12, 0x3e, 0x9d, 0x65, 0x54, 0x2a, 0x7b, 0x08, 0x9f, 0xdd, 0x40,
-	0x64, 0x22, 0xb8, 0xa4, 0xe8, 0x33, 0x6c, 0x15, 0x66, 0x26, 0xe8, 0x36, 0x7b, 0x8f, 0xbd, 0x57,
-	0x4e, 0xfd, 0x6c, 0x8e, 0xd6, 0xe6, 0xd2, 0xc1, 0x83, 0xe5, 0xf9, 0x4b, 0xc3, 0x2f, 0x65, 0xf6,
-	0x27, 0x68, 0x6a, 0xef, 0xaf, 0x59, 0x9a, 0x52, 0xae, 0x34, 0xad, 0xcc, 0x45, 0x18, 0x42, 0xf6,
-	0x9b, 0x72, 0xc5, 0x46, 0x8c, 0xa6, 0x26, 0xe8, 0x82, 0xde, 0x23, 0x7f, 0xe7, 0xc4, 0xfe, 0x02,
-	0x9f, 0xd7, 0x68, 0xcb, 0x66, 0xaf, 0xe1, 0x93, 0xa8, 0x38, 0xff, 0xa5, 0xa3, 0xb4, 0xbe, 0xe9,
-	0xb7, 0xa3, 0x1d, 0xb2, 0x77, 0xd2, 0x80, 0x0f, 0xb5, 0x05, 0x3a, 0x02, 0x10, 0x6e, 0x3b, 0x4a,
-	0xe4, 0x1c, 0x9a, 0xa3, 0x7e, 0x45, 0x16, 0xb9, 0x33, 0xbf, 0xa8, 0x67, 0xbf, 0xfd, 0x7b, 0x7a,
-	0xf9, 0xbf, 0xd1, 0x45, 0x98, 0xec, 0x5d, 0x4a, 0x75, 0x7b, 0xc5, 0x2f, 0x3a, 0x06, 0xb0, 0xbd,
-	0x3b, 0x1f, 0xfa, 0x70, 0x6b, 0x52, 0xcd, 0x1a, 0x2d, 0xf7, 0x1e, 0x8a, 0xb2, 0x5d, 0x5f, 0xb7,
-	0x7b, 0x87, 0xde, 0x1c, 0x6a, 0x77, 0x6d, 0xb5, 0x83, 0x1f, 0xcb, 0x35, 0x06, 0xab, 0x35, 0x06,
-	0x17, 0x6b, 0x0c, 0xfe, 0x6d, 0xb0, 0xb1, 0xda, 0x60, 0xe3, 0x6c, 0x83, 0x8d, 0xa1, 0x17, 0x33,
-	0x35, 0xce, 0x42, 0x27, 0x12, 0xd3, 0xca, 0xaa, 0x3f, 0x09, 0x42, 0xb9, 0xf5, 0x9d, 0xbb, 0x1e,
-	0xf9, 0x53, 0xb9, 0xab, 0x45, 0x42, 0x65, 0xd8, 0xd2, 0xef, 0xf1, 0xe3, 0x55, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x55, 0xf9, 0xaf, 0x05, 0x43, 0x03, 0x00, 0x00,
+	// 472 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x6a, 0x13, 0x41,
+	0x18, 0xcf, 0x24, 0xb1, 0xe0, 0x58, 0x3d, 0x0c, 0xa5, 0x59, 0x17, 0x59, 0xe3, 0x8a, 0x1a, 0x84,
+	0xee, 0x98, 0x48, 0x2f, 0x7a, 0x10, 0x2a, 0x82, 0x82, 0x1e, 0x5c, 0x6f, 0xbd, 0x84, 0xdd, 0xcd,
+	0xd7, 0xcd, 0x40, 0x33, 0xdf, 0x76, 0x67, 0x52, 0xda, 0xab, 0x4f, 0x20, 0x88, 0x2f, 0xe0, 0xb3,
+	0x78, 0xe8, 0xb1, 0xe0, 0xc5, 0x93, 0x48, 0xe2, 0xcd, 0x97, 0x90, 0xfd, 0x76, 0x53, 0xd2, 0xb8,
+	0x55, 0x7b, 0xdb, 0x9d, 0xdf, 0xdf, 0x99, 0x6f, 0x86, 0xbb, 0x68, 0x26, 0x68, 0x94, 0x91, 0x90,
+	0x61, 0x32, 0x36, 0xf2, 0x60, 0x0a, 0xf9, 0x71, 0x90, 0xe5, 0x68, 0x51, 0x6c, 0x56, 0x58, 0x50,
+	0x62, 0xc1, 0x61, 0x3f, 0x06, 0x1b, 0xf5, 0xdd, 0x8d, 0x14, 0x53, 0x24, 0x8a, 0x2c, 0xbe, 0x4a,
+	0xb6, 0x7b, 0x2b, 0x45, 0x4c, 0xf7, 0x41, 0x46, 0x99, 0x92, 0x91, 0xd6, 0x68, 0x23, 0xab, 0x50,
+	0x9b, 0x0a, 0x7d, 0x98, 0x90, 0x99, 0x8c, 0x23, 0x03, 0x65, 0x88, 0xac, 0xec, 0x64, 0x16, 0xa5,
+	0x4a, 0x13, 0x79, 0xe1, 0xb4, 0xd2, 0x29, 0x05, 0x0d, 0x45, 0x0d, 0x42, 0x7d, 0x87, 0x6f, 0xbe,
+	0x2d, 0xf4, 0x2f, 0x08, 0x7c, 0xa5, 0xf7, 0x30, 0x84, 0x83, 0x29, 0x18, 0xeb, 0xef, 0xf2, 0xce,
+	0x1f, 0x88, 0xc9, 0x50, 0x1b, 0x10, 0xcf, 0xf8, 0x5a, 0x69, 0xe6, 0xb0, 0x6e, 0xab, 0x77, 0x6d,
+	0x70, 0x27, 0xa8, 0xdf, 0x5b, 0x40, 0xda, 0x42, 0xba, 0xd3, 0x3e, 0xf9, 0x7e, 0xbb, 0x11, 0x56,
+	0x32, 0xff, 0x09, 0x77, 0xc8, 0xfb, 0xf9, 0x34, 0xcf, 0x41, 0x5b, 0xa2, 0x55, 0xb9, 0xc2, 0xe3,
+	0x5c, 0x8d, 0x40, 0x5b, 0xb5, 0xa7, 0x20, 0x77, 0x58, 0x97, 0xf5, 0xae, 0x86, 0x4b, 0x2b, 0xfe,
+	0x2f, 0xc6, 0x6f, 0xd6, 0x88, 0xab, 0x6a, 0x77, 0xf9, 0xf5, 0xa4, 0x5c, 0x1f, 0x52, 0x16, 0x19,
+	0xb4, 0xc2, 0xf5, 0x64, 0x89, 0x2c, 0xb6, 0x79, 0x7b, 0x82, 0x23, 0x70, 0x9a, 0x5d, 0xd6, 0xbb,
+	0xf1, 0x8f, 0xf6, 0x6f, 0x70, 0x04, 0x21, 0xd1, 0xc5, 0x53, 0xee, 0x9e, 0xf3, 0x1e, 0x1a, 0x1b,
+	0xe5, 0x76, 0x38, 0x06, 0x95, 0x8e, 0xad, 0xd3, 0xa2, 0xa0, 0xce, 0x72, 0xd0, 0xbb, 0x02, 0x7f,
+	0x49, 0xb0, 0xd8, 0xe6, 0x1d, 0x0d, 0x47, 0xb5, 0xca, 0x36, 0x29, 0x37, 0x0a, 0x78, 0x55, 0x36,
+	0xf8, 0xd2, 0xe4, 0x57, 0x68, 0xb7, 0xe2, 0x13, 0xe3, 0xfc, 0xec, 0x3c, 0x8d, 0x08, 0x2e, 0x6a,
+	0x5d, 0x3f, 0x4e, 0x57, 0xfe, 0x37, 0xbf, 0x3c, 0x49, 0xff, 0xfe, 0xfb, 0xaf, 0x3f, 0x3f, 0x36,
+	0xbb, 0xc2, 0x93, 0x2b, 0x17, 0x68, 0x71, 0xd3, 0xca, 0x5f, 0xf1, 0x99, 0xf1, 0xf5, 0xe5, 0x51,
+	0x88, 0x47, 0x7f, 0x4d, 0xaa, 0x19, 0xb9, 0xdb, 0xbf, 0x84, 0xa2, 0x6a, 0xb7, 0x45, 0xed, 0x1e,
+	0x88, 0x7b, 0x17, 0xb5, 0x3b, 0x37, 0xa9, 0x9d, 0xd7, 0x27, 0x33, 0x8f, 0x9d, 0xce, 0x3c, 0xf6,
+	0x63, 0xe6, 0xb1, 0x0f, 0x73, 0xaf, 0x71, 0x3a, 0xf7, 0x1a, 0xdf, 0xe6, 0x5e, 0x63, 0x77, 0x90,
+	0x2a, 0x3b, 0x9e, 0xc6, 0x41, 0x82, 0x93, 0x85, 0xd5, 0xd6, 0x7e, 0x14, 0x9b, 0x33, 0xdf, 0xc3,
+	0xfe, 0x40, 0x1e, 0x2d, 0xdc, 0xed, 0x71, 0x06, 0x26, 0x5e, 0xa3, 0xb7, 0xf3, 0xf8, 0xf7, 0x00,
+	0x18, 0x37, 0x29, 0xbf, 0xef, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -467,6 +501,21 @@ func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.NextEpochStartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextEpochStartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CurrentEpochStartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpochStartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Mode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.CurrentEpoch != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
 		i--
@@ -532,6 +581,15 @@ func (m *QueryCurrentEpochResponse) Size() (n int) {
 	if m.CurrentEpoch != 0 {
 		n += 1 + sovQuery(uint64(m.CurrentEpoch))
 	}
+	if m.Mode != 0 {
+		n += 1 + sovQuery(uint64(m.Mode))
+	}
+	if m.CurrentEpochStartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpochStartHeight))
+	}
+	if m.NextEpochStartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextEpochStartHeight))
+	}
 	return n
 }
 
@@ -805,6 +863,63 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= EpochMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochStartHeight", wireType)
+			}
+			m.CurrentEpochStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpochStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochStartHeight", wireType)
+			}
+			m.NextEpochStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEpochStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -40,6 +40,12 @@ const (
 	FlagSwapRouteDenoms = "swap-route-denoms"
 	// FlagScalingFactors represents the flag name for the scaling factors.
 	FlagScalingFactors = "scaling-factors"
+	// FlagScalingFactorChangeDuration represents the flag name for the duration over which scaling factors change.
+	FlagScalingFactorChangeDuration = "scaling-factor-change-duration"
+	// FlagScalingFactorController represents the flag name for the scaling factor controller.
+	FlagScalingFactorController = "scaling-factor-controller"
+	// FlagRemoveOverride represents the flag name for removing the protocol revenue share override of a pool.
+	FlagRemoveOverride = "remove-override"
 )
 
 type createBalancerPoolInputs struct {
@@ -124,6 +130,7 @@ func FlagSetAdjustScalingFactors() *flag.FlagSet {
 
 	fs.Uint64(FlagPoolId, 0, "The id of pool")
 	fs.String(FlagScalingFactors, "", "The scaling factors")
+	fs.Duration(FlagScalingFactorChangeDuration, 0, "The duration over which the pool moves to the new scaling factors, zero applies them immediately")
 
 	return fs
 }
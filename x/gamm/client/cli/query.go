@@ -35,9 +35,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdTotalShares(),
 		GetCmdSpotPrice(),
 		GetCmdQueryTotalLiquidity(),
+		GetCmdQueryProtocolRevenue(),
 		GetCmdEstimateSwapExactAmountIn(),
 		GetCmdEstimateSwapExactAmountOut(),
 		GetCmdTotalPoolLiquidity(),
+		GetCmdPoolVolume(),
+		GetCmdPoolFeesCollected(),
 	)
 
 	return cmd
@@ -286,6 +289,90 @@ $ %s query gamm total-pool-liquidity 1
 	return cmd
 }
 
+// GetCmdPoolVolume returns the cumulative amounts of tokens swapped into a pool.
+func GetCmdPoolVolume() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-volume <poolID>",
+		Short: "Query the cumulative amounts of tokens swapped into a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative amounts of tokens swapped into a pool.
+Example:
+$ %s query gamm pool-volume 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolVolume(cmd.Context(), &types.QueryPoolVolumeRequest{
+				PoolId: uint64(poolID),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPoolFeesCollected returns the cumulative swap fees charged by a pool.
+func GetCmdPoolFeesCollected() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-fees-collected <poolID>",
+		Short: "Query the cumulative swap fees charged by a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative swap fees charged by a pool.
+Example:
+$ %s query gamm pool-fees-collected 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolFeesCollected(cmd.Context(), &types.QueryPoolFeesCollectedRequest{
+				PoolId: uint64(poolID),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdTotalShares return total share.
 func GetCmdTotalShares() *cobra.Command {
 	cmd := &cobra.Command{
@@ -363,6 +450,41 @@ $ %s query gamm total-liquidity
 	return cmd
 }
 
+// GetCmdQueryProtocolRevenue returns the cumulative protocol revenue from swap fees.
+func GetCmdQueryProtocolRevenue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protocol-revenue",
+		Short: "Query the cumulative protocol revenue from swap fees",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative protocol revenue from swap fees.
+Example:
+$ %s query gamm protocol-revenue
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProtocolRevenue(cmd.Context(), &types.QueryProtocolRevenueRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdSpotPrice returns spot price
 func GetCmdSpotPrice() *cobra.Command {
 	cmd := &cobra.Command{
@@ -18,6 +18,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func NewTxCmd() *cobra.Command {
@@ -40,6 +42,10 @@ func NewTxCmd() *cobra.Command {
 		NewExitSwapExternAmountOut(),
 		NewExitSwapShareAmountIn(),
 		NewStableSwapAdjustScalingFactorsCmd(),
+		NewCmdSubmitSetScalingFactorControllerProposal(),
+		NewCmdSubmitSunsetPoolProposal(),
+		NewCmdSubmitSetDynamicSwapFeePolicyProposal(),
+		NewCmdSubmitSetPoolProtocolRevenueShareProposal(),
 	)
 
 	return txCmd
@@ -353,7 +359,7 @@ func NewStableSwapAdjustScalingFactorsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "adjust-scaling-factors --pool-id=[pool-id] --scaling-factors=[scaling-factors]",
 		Short:   "adjust scaling factors",
-		Example: "osmosisd adjust-scaling-factors --pool-id=1 --scaling-factors=\"100, 100\"",
+		Example: "osmosisd adjust-scaling-factors --pool-id=1 --scaling-factors=\"100, 100\" --scaling-factor-change-duration=24h",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -378,6 +384,283 @@ func NewStableSwapAdjustScalingFactorsCmd() *cobra.Command {
 	return cmd
 }
 
+func NewCmdSubmitSetScalingFactorControllerProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-scaling-factor-controller-proposal [pool-id] [controller-address]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a proposal to replace the scaling factor controller of a stableswap pool",
+		Example: "osmosisd tx gamm set-scaling-factor-controller-proposal 1 osmo1... --title=\"title\" --description=\"description\" --deposit=1000uosmo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetScalingFactorControllerProposal(title, description, poolId, args[1])
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
+
+func NewCmdSubmitSunsetPoolProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sunset-pool-proposal [pool-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to sunset a pool, disabling swaps and joins and letting LPs withdraw their share of its liquidity",
+		Example: "osmosisd tx gamm sunset-pool-proposal 1 --title=\"title\" --description=\"description\" --deposit=1000uosmo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSunsetPoolProposal(title, description, poolId)
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
+
+func NewCmdSubmitSetDynamicSwapFeePolicyProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-dynamic-swap-fee-policy-proposal [pool-id] [min-swap-fee] [max-swap-fee] [volatility-window]",
+		Args:    cobra.ExactArgs(4),
+		Short:   "Submit a proposal to set the dynamic swap fee policy of a pool, a zero volatility window removes the policy",
+		Example: "osmosisd tx gamm set-dynamic-swap-fee-policy-proposal 1 0.001 0.01 24h --title=\"title\" --description=\"description\" --deposit=1000uosmo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			minSwapFee, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			maxSwapFee, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			volatilityWindow, err := time.ParseDuration(args[3])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetDynamicSwapFeePolicyProposal(title, description, poolId, minSwapFee, maxSwapFee, volatilityWindow)
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
+
+func NewCmdSubmitSetPoolProtocolRevenueShareProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-pool-protocol-revenue-share-proposal [pool-id] [protocol-revenue-share]",
+		Args:    cobra.RangeArgs(1, 2),
+		Short:   "Submit a proposal to override the protocol revenue share of the swap fees of a pool",
+		Long:    "Submit a proposal to override the protocol revenue share of the swap fees of a pool, or to remove the pool's override with --remove-override",
+		Example: "osmosisd tx gamm set-pool-protocol-revenue-share-proposal 1 0.1 --title=\"title\" --description=\"description\" --deposit=1000uosmo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			removeOverride, err := cmd.Flags().GetBool(FlagRemoveOverride)
+			if err != nil {
+				return err
+			}
+
+			protocolRevenueShare := sdk.ZeroDec()
+			if !removeOverride {
+				if len(args) != 2 {
+					return fmt.Errorf("protocol revenue share is required unless --%s is set", FlagRemoveOverride)
+				}
+				protocolRevenueShare, err = sdk.NewDecFromStr(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetPoolProtocolRevenueShareProposal(title, description, poolId, protocolRevenueShare, removeOverride)
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(FlagRemoveOverride, false, "remove the pool's override, sharing its swap fees by the protocol revenue share param")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
+
 func NewBuildCreateBalancerPoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
 	pool, err := parseCreateBalancerPoolFlags(fs)
 	if err != nil {
@@ -847,10 +1130,16 @@ func NewStableSwapAdjustScalingFactorsMsg(clientCtx client.Context, txf tx.Facto
 		scalingFactors[i] = scalingFactor
 	}
 
+	scalingFactorChangeDuration, err := fs.GetDuration(FlagScalingFactorChangeDuration)
+	if err != nil {
+		return txf, nil, err
+	}
+
 	msg := &stableswap.MsgStableSwapAdjustScalingFactors{
-		Sender:         clientCtx.GetFromAddress().String(),
-		PoolID:         poolID,
-		ScalingFactors: scalingFactors,
+		Sender:                      clientCtx.GetFromAddress().String(),
+		PoolID:                      poolID,
+		ScalingFactors:              scalingFactors,
+		ScalingFactorChangeDuration: scalingFactorChangeDuration,
 	}
 
 	return txf, msg, nil
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/keeper/internal/events"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// CreatePosition provides liquidity to a concentrated liquidity pool between the given ticks,
+// using at most the desired amounts of the pool's tokens. It errors if the amounts required
+// for the created liquidity are lesser than the given minimums.
+func (k Keeper) CreatePosition(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	lowerTick, upperTick int64,
+	tokenDesired0, tokenDesired1 sdk.Coin,
+	tokenMinAmount0, tokenMinAmount1 sdk.Int,
+) (amount0, amount1 sdk.Int, liquidity sdk.Dec, err error) {
+	pool, err := k.getConcentratedPool(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	if tokenDesired0.Denom != pool.Token0 || tokenDesired1.Denom != pool.Token1 {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrDenomNotFoundInPool,
+			"pool %d trades %s and %s, got %s and %s", poolId, pool.Token0, pool.Token1, tokenDesired0.Denom, tokenDesired1.Denom)
+	}
+
+	amount0, amount1, liquidity, err = pool.CreatePosition(ctx, sender.String(), lowerTick, upperTick, tokenDesired0.Amount, tokenDesired1.Amount)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	if amount0.LT(tokenMinAmount0) || amount1.LT(tokenMinAmount1) {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrLimitMinAmount,
+			"position requires %s%s and %s%s, minimum amounts specified as %s and %s",
+			amount0, pool.Token0, amount1, pool.Token1, tokenMinAmount0, tokenMinAmount1)
+	}
+
+	coinsIn := sdk.NewCoins(sdk.NewCoin(pool.Token0, amount0), sdk.NewCoin(pool.Token1, amount1))
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), coinsIn); err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	if err := k.setPool(ctx, pool); err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	events.EmitAddLiquidityEvent(ctx, sender, poolId, coinsIn)
+	k.RecordTotalLiquidityIncrease(ctx, coinsIn)
+	return amount0, amount1, liquidity, nil
+}
+
+// WithdrawPosition removes liquidity from the sender's position in a concentrated liquidity pool,
+// and sends the tokens backing it to the sender. Fees owed to the position are left to be collected.
+func (k Keeper) WithdrawPosition(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	lowerTick, upperTick int64,
+	liquidity sdk.Dec,
+) (amount0, amount1 sdk.Int, err error) {
+	pool, err := k.getConcentratedPool(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	amount0, amount1, err = pool.WithdrawPosition(ctx, sender.String(), lowerTick, upperTick, liquidity)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	coinsOut := sdk.NewCoins(sdk.NewCoin(pool.Token0, amount0), sdk.NewCoin(pool.Token1, amount1))
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, coinsOut); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	if err := k.setPool(ctx, pool); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	events.EmitRemoveLiquidityEvent(ctx, sender, poolId, coinsOut)
+	k.RecordTotalLiquidityDecrease(ctx, coinsOut)
+	return amount0, amount1, nil
+}
+
+// CollectFees sends the swap fees owed to the sender's position in a concentrated liquidity pool
+// to the sender.
+func (k Keeper) CollectFees(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	lowerTick, upperTick int64,
+) (sdk.Coins, error) {
+	pool, err := k.getConcentratedPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	fees, err := pool.CollectFees(ctx, sender.String(), lowerTick, upperTick)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, fees); err != nil {
+		return nil, err
+	}
+
+	if err := k.setPool(ctx, pool); err != nil {
+		return nil, err
+	}
+
+	events.EmitFeesCollectedEvent(ctx, sender, poolId, lowerTick, upperTick, fees)
+	k.RecordTotalLiquidityDecrease(ctx, fees)
+	return fees, nil
+}
+
+// GetActivePositions returns the positions of a concentrated liquidity pool that are in range
+// of its current tick. It errors if the pool is not a concentrated liquidity pool.
+func (k Keeper) GetActivePositions(ctx sdk.Context, poolId uint64) ([]types.Position, error) {
+	pool, err := k.getConcentratedPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	return pool.GetActivePositions(ctx), nil
+}
+
+func (k Keeper) getConcentratedPool(ctx sdk.Context, poolId uint64) (*concentrated.Pool, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	concentratedPool, ok := pool.(*concentrated.Pool)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrNotConcentratedPool, "pool id %d is of type %T", poolId, pool)
+	}
+	return concentratedPool, nil
+}
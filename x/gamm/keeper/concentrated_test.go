@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+var defaultConcentratedPoolLiquidity = sdk.NewCoins(
+	sdk.NewInt64Coin("bar", 5_000_000),
+	sdk.NewInt64Coin("foo", 5_000_000),
+)
+
+func (suite *KeeperTestSuite) prepareConcentratedPool() uint64 {
+	suite.fundAllAccountsWith(sdk.NewCoins(
+		sdk.NewInt64Coin("bar", 100_000_000),
+		sdk.NewInt64Coin("foo", 100_000_000),
+		sdk.NewInt64Coin("uosmo", 10_000_000_000),
+	))
+
+	poolParams := concentrated.PoolParams{SwapFee: sdk.NewDecWithPrec(1, 2), ExitFee: sdk.ZeroDec()}
+	poolId, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx,
+		concentrated.NewMsgCreateConcentratedPool(suite.TestAccs[0], poolParams, defaultConcentratedPoolLiquidity, 10, ""))
+	suite.Require().NoError(err)
+	return poolId
+}
+
+func (suite *KeeperTestSuite) TestConcentratedPositionLifecycle() {
+	suite.SetupTest()
+	poolId := suite.prepareConcentratedPool()
+	msgServer := keeper.NewConcentratedMsgServerImpl(suite.App.GAMMKeeper)
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	owner, swapper := suite.TestAccs[1], suite.TestAccs[2]
+
+	poolType, err := suite.App.GAMMKeeper.GetPoolType(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal("Concentrated", poolType)
+
+	createResponse, err := msgServer.CreatePosition(sdk.WrapSDKContext(ctx), &concentrated.MsgCreatePosition{
+		Sender:          owner.String(),
+		PoolID:          poolId,
+		LowerTick:       -1000,
+		UpperTick:       1000,
+		TokenDesired0:   sdk.NewInt64Coin("bar", 1_000_000),
+		TokenDesired1:   sdk.NewInt64Coin("foo", 1_000_000),
+		TokenMinAmount0: sdk.ZeroInt(),
+		TokenMinAmount1: sdk.ZeroInt(),
+	})
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, types.TypeEvtPoolJoined, 1)
+	suite.Require().Equal(sdk.NewInt(1_000_000), createResponse.Amount0)
+	suite.Require().Equal(sdk.NewInt(1_000_000), createResponse.Amount1)
+	suite.Require().Equal(sdk.NewInt(99_000_000), suite.App.BankKeeper.GetBalance(suite.Ctx, owner, "bar").Amount)
+	suite.Require().Equal(
+		defaultConcentratedPoolLiquidity.Add(sdk.NewInt64Coin("bar", 1_000_000), sdk.NewInt64Coin("foo", 1_000_000)),
+		suite.App.GAMMKeeper.GetTotalLiquidity(suite.Ctx))
+
+	// swaps pay fees to the position
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, swapper, poolId, sdk.NewInt64Coin("bar", 100_000), "foo", sdk.OneInt())
+	suite.Require().NoError(err)
+
+	collectResponse, err := msgServer.CollectFees(sdk.WrapSDKContext(ctx), &concentrated.MsgCollectFees{
+		Sender:    owner.String(),
+		PoolID:    poolId,
+		LowerTick: -1000,
+		UpperTick: 1000,
+	})
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, types.TypeEvtFeesCollected, 1)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("bar", 803)), collectResponse.CollectedFees)
+
+	withdrawResponse, err := msgServer.WithdrawPosition(sdk.WrapSDKContext(ctx), &concentrated.MsgWithdrawPosition{
+		Sender:          owner.String(),
+		PoolID:          poolId,
+		LowerTick:       -1000,
+		UpperTick:       1000,
+		LiquidityAmount: createResponse.LiquidityCreated,
+	})
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, types.TypeEvtPoolExited, 1)
+	suite.Require().True(withdrawResponse.Amount0.GT(sdk.NewInt(1_000_000)))
+	suite.Require().True(withdrawResponse.Amount1.LT(sdk.NewInt(1_000_000)))
+
+	// the emptied position is removed once its remaining fees are collected
+	_, err = suite.App.GAMMKeeper.CollectFees(suite.Ctx, owner, poolId, -1000, 1000)
+	suite.Require().NoError(err)
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Empty(pool.(*concentrated.Pool).GetPositions(suite.Ctx, owner.String()))
+
+	// the pool holds exactly the tokens it accounts for
+	poolBalances := suite.App.BankKeeper.GetAllBalances(suite.Ctx, pool.GetAddress())
+	suite.Require().Equal(pool.GetTotalPoolLiquidity(suite.Ctx), poolBalances)
+}
+
+func (suite *KeeperTestSuite) TestCreatePositionErrors() {
+	suite.SetupTest()
+	poolId := suite.prepareConcentratedPool()
+	balancerPoolId := suite.PrepareBalancerPool()
+
+	tests := map[string]struct {
+		poolId          uint64
+		tokenDesired0   sdk.Coin
+		tokenDesired1   sdk.Coin
+		tokenMinAmount0 sdk.Int
+		expectErr       error
+	}{
+		"not a concentrated pool": {
+			poolId:          balancerPoolId,
+			tokenDesired0:   sdk.NewInt64Coin("bar", 1_000_000),
+			tokenDesired1:   sdk.NewInt64Coin("foo", 1_000_000),
+			tokenMinAmount0: sdk.ZeroInt(),
+			expectErr:       types.ErrNotConcentratedPool,
+		},
+		"tokens in the wrong order": {
+			poolId:          poolId,
+			tokenDesired0:   sdk.NewInt64Coin("foo", 1_000_000),
+			tokenDesired1:   sdk.NewInt64Coin("bar", 1_000_000),
+			tokenMinAmount0: sdk.ZeroInt(),
+			expectErr:       types.ErrDenomNotFoundInPool,
+		},
+		"min amount not reached": {
+			poolId:          poolId,
+			tokenDesired0:   sdk.NewInt64Coin("bar", 1_000_000),
+			tokenDesired1:   sdk.NewInt64Coin("foo", 500_000),
+			tokenMinAmount0: sdk.NewInt(1_000_000),
+			expectErr:       types.ErrLimitMinAmount,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			_, _, _, err := suite.App.GAMMKeeper.CreatePosition(suite.Ctx, suite.TestAccs[1], tc.poolId, -1000, 1000,
+				tc.tokenDesired0, tc.tokenDesired1, tc.tokenMinAmount0, sdk.ZeroInt())
+			suite.Require().ErrorIs(err, tc.expectErr)
+		})
+	}
+}
+
+// The last LP can exit all of the shares of a concentrated liquidity pool.
+func (suite *KeeperTestSuite) TestConcentratedPoolExitAllShares() {
+	suite.SetupTest()
+	poolId := suite.prepareConcentratedPool()
+	lp := suite.TestAccs[0]
+	shareDenom := types.GetPoolShareDenom(poolId)
+	balancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, lp)
+
+	exitCoins, err := suite.App.GAMMKeeper.ExitPool(suite.Ctx, lp, poolId, types.InitPoolSharesSupply, sdk.Coins{})
+	suite.Require().NoError(err)
+	// only the rounding dust of the full range position is left in the pool
+	for _, coin := range defaultConcentratedPoolLiquidity {
+		suite.Require().True(coin.Amount.Sub(exitCoins.AmountOf(coin.Denom)).LTE(sdk.NewInt(2)), exitCoins.String())
+	}
+	balancesAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, lp)
+	suite.Require().Equal(balancesBefore.Add(exitCoins...).Sub(sdk.NewCoins(sdk.NewCoin(shareDenom, types.InitPoolSharesSupply))), balancesAfter)
+	suite.Require().True(suite.App.BankKeeper.GetSupply(suite.Ctx, shareDenom).IsZero())
+
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().True(pool.GetTotalShares().IsZero())
+	suite.Require().Equal(suite.App.BankKeeper.GetAllBalances(suite.Ctx, pool.GetAddress()), pool.GetTotalPoolLiquidity(suite.Ctx))
+	_, broken := keeper.AllInvariants(*suite.App.GAMMKeeper, suite.App.BankKeeper)(suite.Ctx)
+	suite.Require().False(broken)
+
+	// the pool has no liquidity left to be swapped against
+	tokenIn := sdk.NewInt64Coin("bar", 1_000)
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, lp, poolId, tokenIn, "foo", sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrNotEnoughLiquidity)
+}
+
+// Concentrated liquidity pools can be swapped through in multihop routes, and
+// are tracked by twap like any other pool.
+func (suite *KeeperTestSuite) TestConcentratedPoolMultihopAndTwap() {
+	suite.SetupTest()
+	balancerPoolId := suite.PrepareBalancerPool()
+	poolId := suite.prepareConcentratedPool()
+
+	_, err := suite.App.TwapKeeper.GetBeginBlockAccumulatorRecord(suite.Ctx, poolId, "bar", "foo")
+	suite.Require().NoError(err)
+
+	routes := []types.SwapAmountInRoute{
+		{PoolId: balancerPoolId, TokenOutDenom: "bar"},
+		{PoolId: poolId, TokenOutDenom: "foo"},
+	}
+	tokenOut, err := suite.App.GAMMKeeper.MultihopSwapExactAmountIn(suite.Ctx, suite.TestAccs[0], routes, sdk.NewInt64Coin("baz", 100_000), sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().True(tokenOut.IsPositive())
+
+	spotPrice, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, "bar", "foo")
+	suite.Require().NoError(err)
+	suite.Require().True(spotPrice.LT(sdk.OneDec()))
+}
+
+// The ticks and positions of concentrated liquidity pools are stored apart from
+// the pools, and are exported and imported along with them.
+func (suite *KeeperTestSuite) TestConcentratedPoolStateGenesis() {
+	suite.SetupTest()
+	poolId := suite.prepareConcentratedPool()
+	owner := suite.TestAccs[1]
+	_, _, _, err := suite.App.GAMMKeeper.CreatePosition(suite.Ctx, owner, poolId, -1000, 1000,
+		sdk.NewInt64Coin("bar", 1_000_000), sdk.NewInt64Coin("foo", 1_000_000), sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().NoError(err)
+
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	state := pool.(*concentrated.Pool).GetState(suite.Ctx)
+	// the full range position of the pool's LP shares and the created position
+	suite.Require().Len(state.Ticks, 4)
+	suite.Require().Len(state.Positions, 2)
+
+	genesis := suite.App.GAMMKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Equal([]types.ConcentratedPoolState{state}, genesis.ConcentratedPoolStates)
+	suite.Require().NoError(genesis.Validate())
+
+	suite.SetupTest()
+	suite.App.GAMMKeeper.InitGenesis(suite.Ctx, *genesis, suite.App.AppCodec())
+	pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(state, pool.(*concentrated.Pool).GetState(suite.Ctx))
+
+	// the imported pool can be swapped against and its positions looked up
+	_, err = pool.CalcOutAmtGivenIn(suite.Ctx, sdk.NewCoins(sdk.NewInt64Coin("bar", 100_000)), "foo", pool.GetSwapFee(suite.Ctx))
+	suite.Require().NoError(err)
+	suite.Require().Len(pool.(*concentrated.Pool).GetPositions(suite.Ctx, owner.String()), 1)
+}
+
+// Only the positions in range of the current tick are active.
+func (suite *KeeperTestSuite) TestGetActivePositions() {
+	suite.SetupTest()
+	poolId := suite.prepareConcentratedPool()
+	balancerPoolId := suite.PrepareBalancerPool()
+	owner := suite.TestAccs[1]
+
+	_, _, _, err := suite.App.GAMMKeeper.CreatePosition(suite.Ctx, owner, poolId, -1000, 1000,
+		sdk.NewInt64Coin("bar", 1_000_000), sdk.NewInt64Coin("foo", 1_000_000), sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().NoError(err)
+	// a range order above the current tick
+	_, _, _, err = suite.App.GAMMKeeper.CreatePosition(suite.Ctx, owner, poolId, 2000, 3000,
+		sdk.NewInt64Coin("bar", 1_000_000), sdk.NewInt64Coin("foo", 0), sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().NoError(err)
+
+	positions, err := suite.App.GAMMKeeper.GetActivePositions(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	// the full range position of the pool's LP shares and the position around the current tick
+	suite.Require().Len(positions, 2)
+	for _, position := range positions {
+		suite.Require().True(position.LowerTick < 2000)
+	}
+
+	_, err = suite.App.GAMMKeeper.GetActivePositions(suite.Ctx, balancerPoolId)
+	suite.Require().ErrorIs(err, types.ErrNotConcentratedPool)
+}
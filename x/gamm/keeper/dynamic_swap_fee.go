@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// SetDynamicSwapFeePolicy sets the dynamic swap fee policy of a pool, replacing its current policy if any.
+// The pool's swap fee is set by the policy from the next swap onwards. The swap fee the pool had before
+// its first policy is kept in the policy, to be restored when the policy is removed.
+// Errors if the policy is invalid, or the pool does not exist or does not support dynamic swap fees.
+func (k Keeper) SetDynamicSwapFeePolicy(ctx sdk.Context, policy types.DynamicSwapFeePolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	pool, err := k.GetPoolAndPoke(ctx, policy.PoolId)
+	if err != nil {
+		return err
+	}
+	if _, ok := pool.(types.SwapFeeSettablePoolExtension); !ok {
+		return fmt.Errorf("pool with id %d does not support dynamic swap fees", policy.PoolId)
+	}
+
+	policy.StaticSwapFee = pool.GetSwapFee(ctx)
+	if currentPolicy, found := k.GetDynamicSwapFeePolicy(ctx, policy.PoolId); found {
+		policy.StaticSwapFee = currentPolicy.StaticSwapFee
+	}
+	k.setDynamicSwapFeePolicy(ctx, policy)
+	return nil
+}
+
+// RemoveDynamicSwapFeePolicy removes the dynamic swap fee policy of a pool,
+// restoring the pool's swap fee to the static swap fee of the policy.
+// Errors if the pool has no dynamic swap fee policy.
+func (k Keeper) RemoveDynamicSwapFeePolicy(ctx sdk.Context, poolId uint64) error {
+	policy, found := k.GetDynamicSwapFeePolicy(ctx, poolId)
+	if !found {
+		return fmt.Errorf("pool with id %d has no dynamic swap fee policy", poolId)
+	}
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	settablePool, ok := pool.(types.SwapFeeSettablePoolExtension)
+	if !ok {
+		return fmt.Errorf("pool with id %d does not support dynamic swap fees", poolId)
+	}
+	settablePool.SetSwapFee(policy.StaticSwapFee)
+	if err := k.setPool(ctx, settablePool); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetKeyDynamicSwapFeePolicy(poolId))
+	return nil
+}
+
+// GetDynamicSwapFeePolicy returns the dynamic swap fee policy of a pool, and whether it has one.
+func (k Keeper) GetDynamicSwapFeePolicy(ctx sdk.Context, poolId uint64) (types.DynamicSwapFeePolicy, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyDynamicSwapFeePolicy(poolId))
+	if bz == nil {
+		return types.DynamicSwapFeePolicy{}, false
+	}
+	policy := types.DynamicSwapFeePolicy{}
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy, true
+}
+
+// GetAllDynamicSwapFeePolicies returns the dynamic swap fee policies of all pools, ordered by pool id.
+func (k Keeper) GetAllDynamicSwapFeePolicies(ctx sdk.Context) []types.DynamicSwapFeePolicy {
+	iter := k.iterator(ctx, types.KeyPrefixDynamicSwapFeePolicies)
+	defer iter.Close()
+
+	policies := []types.DynamicSwapFeePolicy{}
+	for ; iter.Valid(); iter.Next() {
+		policy := types.DynamicSwapFeePolicy{}
+		k.cdc.MustUnmarshal(iter.Value(), &policy)
+		policies = append(policies, policy)
+	}
+	return policies
+}
+
+func (k Keeper) setDynamicSwapFeePolicy(ctx sdk.Context, policy types.DynamicSwapFeePolicy) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyDynamicSwapFeePolicy(policy.PoolId), k.cdc.MustMarshal(&policy))
+}
+
+// pokeDynamicSwapFee sets the swap fee of a pool with a dynamic swap fee policy to the policy's current fee.
+// The fee is the policy's min swap fee if the volatility of the pool can not be computed,
+// e.g. when the pool is younger than the volatility window.
+func (k Keeper) pokeDynamicSwapFee(ctx sdk.Context, pool types.PoolI) {
+	policy, found := k.GetDynamicSwapFeePolicy(ctx, pool.GetId())
+	if !found {
+		return
+	}
+	settablePool, ok := pool.(types.SwapFeeSettablePoolExtension)
+	if !ok {
+		return
+	}
+
+	volatility, err := k.poolVolatility(ctx, pool, policy)
+	if err != nil {
+		k.Logger(ctx).Error("volatility of pool could not be computed", "pool_id", pool.GetId(), "error", err)
+		volatility = sdk.ZeroDec()
+	}
+	settablePool.SetSwapFee(policy.SwapFee(volatility))
+}
+
+// poolVolatility returns the volatility of the prices of the pool's assets over the volatility window of the policy.
+// The volatility of the price of an asset is measured as the relative gap between its arithmetic and geometric TWAPs,
+// which grows with the variance of the price, e.g. it is about half the variance of the log price for small variances.
+// Prices are quoted in the pool's first asset, and the largest volatility of the pool's prices is returned.
+func (k Keeper) poolVolatility(ctx sdk.Context, pool types.PoolI, policy types.DynamicSwapFeePolicy) (sdk.Dec, error) {
+	startTime := ctx.BlockTime().Add(-policy.VolatilityWindow)
+	liquidity := pool.GetTotalPoolLiquidity(ctx)
+	volatility := sdk.ZeroDec()
+	if len(liquidity) < 2 {
+		return volatility, nil
+	}
+	for _, coin := range liquidity[1:] {
+		arithmeticTwap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, pool.GetId(), coin.Denom, liquidity[0].Denom, startTime)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		geometricTwap, err := k.twapKeeper.GetGeometricTwapToNow(ctx, pool.GetId(), coin.Denom, liquidity[0].Denom, startTime)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		if !geometricTwap.IsPositive() {
+			return sdk.Dec{}, fmt.Errorf("non positive geometric twap of %s in %s", coin.Denom, liquidity[0].Denom)
+		}
+		volatility = sdk.MaxDec(volatility, arithmeticTwap.Quo(geometricTwap).Sub(sdk.OneDec()))
+	}
+	return volatility, nil
+}
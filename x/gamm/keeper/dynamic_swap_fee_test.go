@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) TestSetDynamicSwapFeePolicy() {
+	validPolicy := types.DynamicSwapFeePolicy{
+		MinSwapFee:       sdk.NewDecWithPrec(1, 3),
+		MaxSwapFee:       sdk.NewDecWithPrec(1, 2),
+		VolatilityWindow: time.Hour,
+	}
+
+	tests := map[string]struct {
+		concentrated bool
+		poolId       uint64
+		modify       func(policy *types.DynamicSwapFeePolicy)
+		expectErr    bool
+	}{
+		"valid policy": {},
+		"constant fee": {modify: func(policy *types.DynamicSwapFeePolicy) { policy.MaxSwapFee = policy.MinSwapFee }},
+		"min swap fee greater than max swap fee": {
+			modify:    func(policy *types.DynamicSwapFeePolicy) { policy.MinSwapFee = sdk.NewDecWithPrec(2, 2) },
+			expectErr: true,
+		},
+		"negative min swap fee": {
+			modify:    func(policy *types.DynamicSwapFeePolicy) { policy.MinSwapFee = sdk.NewDec(-1) },
+			expectErr: true,
+		},
+		"max swap fee of one": {
+			modify:    func(policy *types.DynamicSwapFeePolicy) { policy.MaxSwapFee = sdk.OneDec() },
+			expectErr: true,
+		},
+		"negative volatility window": {
+			modify:    func(policy *types.DynamicSwapFeePolicy) { policy.VolatilityWindow = -time.Hour },
+			expectErr: true,
+		},
+		"pool does not exist":                  {poolId: 10, expectErr: true},
+		"concentrated pools are not supported": {concentrated: true, expectErr: true},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			coins := sdk.NewCoins(sdk.NewInt64Coin("foo", 10000000), sdk.NewInt64Coin("bar", 10000000))
+			policy := validPolicy
+			if tc.concentrated {
+				policy.PoolId = suite.PrepareConcentratedPoolWithCoins(coins...)
+			} else {
+				policy.PoolId = suite.PrepareBalancerPoolWithCoins(coins...)
+			}
+			if tc.poolId != 0 {
+				policy.PoolId = tc.poolId
+			}
+			if tc.modify != nil {
+				tc.modify(&policy)
+			}
+
+			err := suite.App.GAMMKeeper.SetDynamicSwapFeePolicy(suite.Ctx, policy)
+			storedPolicy, found := suite.App.GAMMKeeper.GetDynamicSwapFeePolicy(suite.Ctx, policy.PoolId)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().False(found)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(found)
+			// the swap fee of the pool is kept in the policy, to be restored when it is removed
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, policy.PoolId)
+			suite.Require().NoError(err)
+			policy.StaticSwapFee = pool.GetSwapFee(suite.Ctx)
+			suite.Require().Equal(policy, storedPolicy)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDynamicSwapFee() {
+	suite.SetupTest()
+	staticSwapFee := sdk.NewDecWithPrec(2, 2)
+	poolId := suite.prepareCustomBalancerPool(sdk.NewCoins(
+		sdk.NewInt64Coin("uosmo", 10000000000),
+		sdk.NewInt64Coin("foo", 10000000),
+		sdk.NewInt64Coin("bar", 10000000),
+	), []balancer.PoolAsset{
+		{Token: sdk.NewInt64Coin("foo", 10000000), Weight: sdk.NewInt(1)},
+		{Token: sdk.NewInt64Coin("bar", 10000000), Weight: sdk.NewInt(1)},
+	}, balancer.PoolParams{SwapFee: staticSwapFee, ExitFee: sdk.ZeroDec()})
+	policy := types.DynamicSwapFeePolicy{
+		PoolId:           poolId,
+		MinSwapFee:       sdk.NewDecWithPrec(1, 3),
+		MaxSwapFee:       sdk.NewDecWithPrec(5, 1),
+		VolatilityWindow: 2 * time.Hour,
+		StaticSwapFee:    staticSwapFee,
+	}
+	proposal := types.NewSetDynamicSwapFeePolicyProposal("title", "description", poolId, policy.MinSwapFee, policy.MaxSwapFee, policy.VolatilityWindow)
+	err := gamm.NewGammProposalHandler(*suite.App.GAMMKeeper)(suite.Ctx, &proposal)
+	suite.Require().NoError(err)
+
+	assertSwapFee := func(expectedFee sdk.Dec) {
+		pool, err := suite.App.GAMMKeeper.GetPoolForSwap(suite.Ctx, poolId)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expectedFee, pool.GetSwapFee(suite.Ctx))
+
+		res, err := keeper.NewQuerier(*suite.App.GAMMKeeper).PoolParams(sdk.WrapSDKContext(suite.Ctx), &types.QueryPoolParamsRequest{PoolId: poolId})
+		suite.Require().NoError(err)
+		suite.Require().Equal(policy, *res.DynamicSwapFeePolicy)
+		var poolParams balancer.PoolParams
+		suite.Require().NoError(poolParams.Unmarshal(res.Params.Value))
+		suite.Require().Equal(expectedFee, poolParams.SwapFee)
+
+		// swaps are quoted with the dynamic swap fee
+		tokenIn := sdk.NewInt64Coin("foo", 100000)
+		expectedTokenOut, err := pool.CalcOutAmtGivenIn(suite.Ctx, sdk.NewCoins(tokenIn), "bar", expectedFee)
+		suite.Require().NoError(err)
+		cacheCtx, _ := suite.Ctx.CacheContext()
+		estimate, err := keeper.NewQuerier(*suite.App.GAMMKeeper).EstimateSwapExactAmountIn(sdk.WrapSDKContext(cacheCtx), &types.QuerySwapExactAmountInRequest{
+			Sender:  suite.TestAccs[0].String(),
+			PoolId:  poolId,
+			TokenIn: tokenIn.String(),
+			Routes:  []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "bar"}},
+		})
+		suite.Require().NoError(err)
+		suite.Require().Equal(expectedTokenOut.Amount, estimate.TokenOutAmount)
+	}
+
+	suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin("foo", 10000000), sdk.NewInt64Coin("bar", 10000000)))
+
+	// without prices over the volatility window, the fee is the min swap fee
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	assertSwapFee(policy.MinSwapFee)
+
+	// move the price away and back to record some volatility
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, sdk.NewInt64Coin("foo", 5000000), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.App.TwapKeeper.EndBlock(suite.Ctx)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	_, err = suite.App.GAMMKeeper.SwapExactAmountOut(suite.Ctx, suite.TestAccs[0], poolId, "bar", sdk.NewInt(10000000), sdk.NewInt64Coin("foo", 5000000))
+	suite.Require().NoError(err)
+	suite.App.TwapKeeper.EndBlock(suite.Ctx)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+
+	startTime := suite.Ctx.BlockTime().Add(-policy.VolatilityWindow)
+	arithmeticTwap, err := suite.App.TwapKeeper.GetArithmeticTwapToNow(suite.Ctx, poolId, "foo", "bar", startTime)
+	suite.Require().NoError(err)
+	geometricTwap, err := suite.App.TwapKeeper.GetGeometricTwapToNow(suite.Ctx, poolId, "foo", "bar", startTime)
+	suite.Require().NoError(err)
+	volatility := arithmeticTwap.Quo(geometricTwap).Sub(sdk.OneDec())
+	suite.Require().True(volatility.IsPositive())
+	assertSwapFee(policy.MinSwapFee.Add(volatility))
+
+	// the fee is capped at the max swap fee
+	policy.MaxSwapFee = policy.MinSwapFee.Add(volatility.QuoInt64(2))
+	suite.Require().NoError(suite.App.GAMMKeeper.SetDynamicSwapFeePolicy(suite.Ctx, policy))
+	assertSwapFee(policy.MaxSwapFee)
+
+	// removing the policy restores the swap fee the pool had before its first policy
+	proposal = types.NewSetDynamicSwapFeePolicyProposal("title", "description", poolId, sdk.ZeroDec(), sdk.ZeroDec(), 0)
+	err = gamm.NewGammProposalHandler(*suite.App.GAMMKeeper)(suite.Ctx, &proposal)
+	suite.Require().NoError(err)
+	_, found := suite.App.GAMMKeeper.GetDynamicSwapFeePolicy(suite.Ctx, poolId)
+	suite.Require().False(found)
+	pool, err := suite.App.GAMMKeeper.GetPoolForSwap(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(staticSwapFee, pool.GetSwapFee(suite.Ctx))
+}
@@ -4,6 +4,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/concentrated"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 )
 
@@ -22,6 +23,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 		if err != nil {
 			panic(err)
 		}
+		k.bindPoolStore(pool)
 		err = k.setPool(ctx, pool)
 		if err != nil {
 			panic(err)
@@ -33,6 +35,37 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 		}
 	}
 
+	// the liquidity of sunset pools is their remaining liquidity, not the frozen pool liquidity
+	for _, sunsetPool := range genState.SunsetPools {
+		pool, err := k.GetPoolAndPoke(ctx, sunsetPool.PoolId)
+		if err != nil {
+			panic(err)
+		}
+		liquidity = liquidity.Sub(pool.GetTotalPoolLiquidity(ctx)).Add(sunsetPool.RemainingLiquidity...)
+		k.setSunsetPool(ctx, sunsetPool)
+	}
+
+	for _, policy := range genState.DynamicSwapFeePolicies {
+		k.setDynamicSwapFeePolicy(ctx, policy)
+	}
+
+	for _, override := range genState.PoolProtocolRevenueShares {
+		k.setPoolProtocolRevenueShare(ctx, override)
+	}
+	k.setProtocolRevenue(ctx, genState.ProtocolRevenue)
+
+	for _, stats := range genState.PoolSwapStats {
+		k.setPoolSwapStats(ctx, stats)
+	}
+
+	for _, state := range genState.ConcentratedPoolStates {
+		pool, err := k.getConcentratedPool(ctx, state.PoolId)
+		if err != nil {
+			panic(err)
+		}
+		pool.SetState(ctx, state)
+	}
+
 	k.setTotalLiquidity(ctx, liquidity)
 }
 
@@ -43,16 +76,27 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 	poolAnys := []*codectypes.Any{}
+	concentratedPoolStates := []types.ConcentratedPoolState{}
 	for _, poolI := range pools {
 		any, err := codectypes.NewAnyWithValue(poolI)
 		if err != nil {
 			panic(err)
 		}
 		poolAnys = append(poolAnys, any)
+
+		if pool, ok := poolI.(*concentrated.Pool); ok {
+			concentratedPoolStates = append(concentratedPoolStates, pool.GetState(ctx))
+		}
 	}
 	return &types.GenesisState{
-		NextPoolNumber: k.GetNextPoolId(ctx),
-		Pools:          poolAnys,
-		Params:         k.GetParams(ctx),
+		NextPoolNumber:            k.GetNextPoolId(ctx),
+		Pools:                     poolAnys,
+		Params:                    k.GetParams(ctx),
+		ConcentratedPoolStates:    concentratedPoolStates,
+		SunsetPools:               k.GetAllSunsetPools(ctx),
+		DynamicSwapFeePolicies:    k.GetAllDynamicSwapFeePolicies(ctx),
+		PoolProtocolRevenueShares: k.GetAllPoolProtocolRevenueShares(ctx),
+		ProtocolRevenue:           k.GetProtocolRevenue(ctx),
+		PoolSwapStats:             k.GetAllPoolSwapStats(ctx),
 	}
 }
@@ -43,7 +43,9 @@ func TestGammInitGenesis(t *testing.T) {
 		Pools:          []*codectypes.Any{any},
 		NextPoolNumber: 2,
 		Params: types.Params{
-			PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)},
+			PoolCreationFee:                  sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)},
+			StableswapMaxScalingFactorChange: types.DefaultParams().StableswapMaxScalingFactorChange,
+			ProtocolRevenueShare:             types.DefaultParams().ProtocolRevenueShare,
 		},
 	}, app.AppCodec())
 
@@ -88,7 +90,11 @@ func TestGammExportGenesis(t *testing.T) {
 		Weight: sdk.NewInt(100),
 		Token:  sdk.NewCoin("bar", sdk.NewInt(10000)),
 	}}, "")
-	_, err = app.GAMMKeeper.CreatePool(ctx, msg)
+	firstPoolId, err := app.GAMMKeeper.CreatePool(ctx, msg)
+	require.NoError(t, err)
+	err = app.GAMMKeeper.SetPoolProtocolRevenueShare(ctx, firstPoolId, sdk.NewDecWithPrec(5, 1))
+	require.NoError(t, err)
+	_, err = app.GAMMKeeper.SwapExactAmountIn(ctx, acc1, firstPoolId, sdk.NewInt64Coin("foo", 1000), "bar", sdk.OneInt())
 	require.NoError(t, err)
 
 	msg = balancer.NewMsgCreateBalancerPool(acc1, balancer.PoolParams{
@@ -101,12 +107,23 @@ func TestGammExportGenesis(t *testing.T) {
 		Weight: sdk.NewInt(100),
 		Token:  sdk.NewCoin("bar", sdk.NewInt(10000)),
 	}}, "")
-	_, err = app.GAMMKeeper.CreatePool(ctx, msg)
+	poolId, err := app.GAMMKeeper.CreatePool(ctx, msg)
+	require.NoError(t, err)
+	err = app.GAMMKeeper.SunsetPool(ctx, poolId)
 	require.NoError(t, err)
 
 	genesis := app.GAMMKeeper.ExportGenesis(ctx)
 	require.Equal(t, genesis.NextPoolNumber, uint64(3))
 	require.Len(t, genesis.Pools, 2)
+	require.Len(t, genesis.SunsetPools, 1)
+	require.Equal(t, poolId, genesis.SunsetPools[0].PoolId)
+	require.Equal(t, []types.PoolProtocolRevenueShare{{PoolId: firstPoolId, ProtocolRevenueShare: sdk.NewDecWithPrec(5, 1)}}, genesis.PoolProtocolRevenueShares)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 5)), genesis.ProtocolRevenue)
+	require.Equal(t, []types.PoolSwapStats{{
+		PoolId:        firstPoolId,
+		Volume:        sdk.NewCoins(sdk.NewInt64Coin("foo", 1000)),
+		FeesCollected: sdk.NewDecCoins(sdk.NewInt64DecCoin("foo", 10)),
+	}}, genesis.PoolSwapStats)
 }
 
 func TestMarshalUnmarshalGenesis(t *testing.T) {
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/v2types"
 )
@@ -155,7 +156,7 @@ func (q Querier) CalcJoinPoolShares(ctx context.Context, req *types.QueryCalcJoi
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	pool, err := q.Keeper.getPoolForSwap(sdkCtx, req.PoolId)
+	pool, err := q.Keeper.GetPoolForSwap(sdkCtx, req.PoolId)
 	if err != nil {
 		return nil, err
 	}
@@ -273,6 +274,18 @@ func (q Querier) CalcExitPoolCoinsFromShares(ctx context.Context, req *types.Que
 		return nil, types.ErrPoolNotFound
 	}
 
+	if q.Keeper.IsPoolSunset(sdkCtx, req.PoolId) {
+		sunsetPool, err := q.Keeper.GetSunsetPool(sdkCtx, req.PoolId)
+		if err != nil {
+			return nil, err
+		}
+		exitCoins, err := calcSunsetPoolExitCoins(sunsetPool, req.ShareInAmount)
+		if err != nil {
+			return nil, err
+		}
+		return &types.QueryCalcExitPoolCoinsFromSharesResponse{TokensOut: exitCoins}, nil
+	}
+
 	exitFee := pool.GetExitFee(sdkCtx)
 
 	totalSharesAmount := pool.GetTotalShares()
@@ -300,6 +313,12 @@ func (q Querier) PoolParams(ctx context.Context, req *types.QueryPoolParamsReque
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	q.Keeper.pokeDynamicSwapFee(sdkCtx, pool)
+
+	var policy *types.DynamicSwapFeePolicy
+	if p, found := q.Keeper.GetDynamicSwapFeePolicy(sdkCtx, req.PoolId); found {
+		policy = &p
+	}
 
 	switch pool := pool.(type) {
 	case *balancer.Pool:
@@ -309,7 +328,19 @@ func (q Querier) PoolParams(ctx context.Context, req *types.QueryPoolParamsReque
 		}
 
 		return &types.QueryPoolParamsResponse{
-			Params: any,
+			Params:               any,
+			DynamicSwapFeePolicy: policy,
+		}, nil
+
+	case *stableswap.Pool:
+		any, err := codectypes.NewAnyWithValue(&pool.PoolParams)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.QueryPoolParamsResponse{
+			Params:               any,
+			DynamicSwapFeePolicy: policy,
 		}, nil
 
 	default:
@@ -336,6 +367,40 @@ func (q Querier) TotalPoolLiquidity(ctx context.Context, req *types.QueryTotalPo
 	}, nil
 }
 
+// PoolVolume returns the cumulative amounts of tokens swapped into the pool.
+func (q Querier) PoolVolume(ctx context.Context, req *types.QueryPoolVolumeRequest) (*types.QueryPoolVolumeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolVolumeResponse{
+		Volume: q.Keeper.GetPoolSwapStats(sdkCtx, req.PoolId).Volume,
+	}, nil
+}
+
+// PoolFeesCollected returns the cumulative swap fees charged by the pool.
+func (q Querier) PoolFeesCollected(ctx context.Context, req *types.QueryPoolFeesCollectedRequest) (*types.QueryPoolFeesCollectedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolFeesCollectedResponse{
+		FeesCollected: q.Keeper.GetPoolSwapStats(sdkCtx, req.PoolId).FeesCollected,
+	}, nil
+}
+
 // TotalShares returns total pool shares.
 func (q Querier) TotalShares(ctx context.Context, req *types.QueryTotalSharesRequest) (*types.QueryTotalSharesResponse, error) {
 	if req == nil {
@@ -416,6 +481,15 @@ func (q Querier) TotalLiquidity(ctx context.Context, _ *types.QueryTotalLiquidit
 	}, nil
 }
 
+// ProtocolRevenue returns the cumulative protocol revenue of all pools from swap fees.
+func (q Querier) ProtocolRevenue(ctx context.Context, _ *types.QueryProtocolRevenueRequest) (*types.QueryProtocolRevenueResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryProtocolRevenueResponse{
+		ProtocolRevenue: q.Keeper.GetProtocolRevenue(sdkCtx),
+	}, nil
+}
+
 // EstimateSwapExactAmountIn estimates input token amount for a swap.
 func (q Querier) EstimateSwapExactAmountIn(ctx context.Context, req *types.QuerySwapExactAmountInRequest) (*types.QuerySwapExactAmountInResponse, error) {
 	if req == nil {
@@ -68,3 +68,45 @@ func newRemoveLiquidityEvent(sender sdk.AccAddress, poolId uint64, liquidity sdk
 		sdk.NewAttribute(types.AttributeKeyTokensOut, liquidity.String()),
 	)
 }
+
+func EmitFeesCollectedEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, lowerTick, upperTick int64, fees sdk.Coins) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newFeesCollectedEvent(sender, poolId, lowerTick, upperTick, fees),
+	})
+}
+
+func newFeesCollectedEvent(sender sdk.AccAddress, poolId uint64, lowerTick, upperTick int64, fees sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtFeesCollected,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyLowerTick, strconv.FormatInt(lowerTick, 10)),
+		sdk.NewAttribute(types.AttributeKeyUpperTick, strconv.FormatInt(upperTick, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, fees.String()),
+	)
+}
+
+func EmitProtocolRevenueEvent(ctx sdk.Context, poolId uint64, recipient sdk.AccAddress, revenue sdk.Coins) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newProtocolRevenueEvent(poolId, recipient, revenue),
+	})
+}
+
+func newProtocolRevenueEvent(poolId uint64, recipient sdk.AccAddress, revenue sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtProtocolRevenue,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, revenue.String()),
+	)
+}
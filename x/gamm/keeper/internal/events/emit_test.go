@@ -193,3 +193,113 @@ func (suite *GammEventsTestSuite) TestEmitRemoveLiquidityEvent() {
 		})
 	}
 }
+
+func (suite *GammEventsTestSuite) TestEmitFeesCollectedEvent() {
+	testcases := map[string]struct {
+		ctx             sdk.Context
+		testAccountAddr sdk.AccAddress
+		poolId          uint64
+		lowerTick       int64
+		upperTick       int64
+		fees            sdk.Coins
+	}{
+		"basic valid": {
+			ctx:             suite.CreateTestContext(),
+			testAccountAddr: sdk.AccAddress([]byte(addressString)),
+			poolId:          1,
+			lowerTick:       -100,
+			upperTick:       100,
+			fees:            sdk.NewCoins(sdk.NewCoin(testDenomA, sdk.NewInt(1234))),
+		},
+		"context with no event manager": {
+			ctx: sdk.Context{},
+		},
+		"valid with fees of both tokens": {
+			ctx:             suite.CreateTestContext(),
+			testAccountAddr: sdk.AccAddress([]byte(addressString)),
+			poolId:          200,
+			lowerTick:       10,
+			upperTick:       20,
+			fees:            sdk.NewCoins(sdk.NewCoin(testDenomA, sdk.NewInt(12)), sdk.NewCoin(testDenomB, sdk.NewInt(99))),
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtFeesCollected,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					sdk.NewAttribute(sdk.AttributeKeySender, tc.testAccountAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(tc.poolId, 10)),
+					sdk.NewAttribute(types.AttributeKeyLowerTick, strconv.FormatInt(tc.lowerTick, 10)),
+					sdk.NewAttribute(types.AttributeKeyUpperTick, strconv.FormatInt(tc.upperTick, 10)),
+					sdk.NewAttribute(types.AttributeKeyTokensOut, tc.fees.String()),
+				),
+			}
+
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitFeesCollectedEvent(tc.ctx, tc.testAccountAddr, tc.poolId, tc.lowerTick, tc.upperTick, tc.fees)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
+
+func (suite *GammEventsTestSuite) TestEmitProtocolRevenueEvent() {
+	testcases := map[string]struct {
+		ctx           sdk.Context
+		poolId        uint64
+		recipientAddr sdk.AccAddress
+		revenue       sdk.Coins
+	}{
+		"basic valid": {
+			ctx:           suite.CreateTestContext(),
+			poolId:        1,
+			recipientAddr: sdk.AccAddress([]byte(addressString)),
+			revenue:       sdk.NewCoins(sdk.NewCoin(testDenomA, sdk.NewInt(1234))),
+		},
+		"context with no event manager": {
+			ctx: sdk.Context{},
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtProtocolRevenue,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(tc.poolId, 10)),
+					sdk.NewAttribute(types.AttributeKeyRecipient, tc.recipientAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyTokensOut, tc.revenue.String()),
+				),
+			}
+
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitProtocolRevenueEvent(tc.ctx, tc.poolId, tc.recipientAddr, tc.revenue)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
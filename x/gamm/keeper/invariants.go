@@ -37,6 +37,10 @@ func PoolAccountInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 
 		for _, pool := range pools {
 			expectedCoins := pool.GetTotalPoolLiquidity(ctx)
+			// the liquidity of sunset pools is withdrawn apart from the pool
+			if sunsetPool, err := keeper.GetSunsetPool(ctx, pool.GetId()); err == nil {
+				expectedCoins = sunsetPool.RemainingLiquidity
+			}
 			actualCoins := bk.GetAllBalances(ctx, pool.GetAddress())
 			if !actualCoins.IsAllGTE(expectedCoins) {
 				return sdk.FormatInvariant(types.ModuleName, poolBalanceInvariantName,
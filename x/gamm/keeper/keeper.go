@@ -3,6 +3,8 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/tendermint/tendermint/libs/log"
+
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -32,6 +34,10 @@ type Keeper struct {
 	accountKeeper       types.AccountKeeper
 	bankKeeper          types.BankKeeper
 	communityPoolKeeper types.CommunityPoolKeeper
+	swapRouter          types.SwapRouter
+	lockupKeeper        types.LockupKeeper
+	twapKeeper          types.TwapKeeper
+	superfluidKeeper    types.SuperfluidKeeper
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, communityPoolKeeper types.CommunityPoolKeeper) Keeper {
@@ -60,6 +66,11 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtyp
 	}
 }
 
+// Logger returns a logger instance.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
 // Set the gamm hooks.
 func (k *Keeper) SetHooks(gh types.GammHooks) *Keeper {
 	if k.hooks != nil {
@@ -71,6 +82,54 @@ func (k *Keeper) SetHooks(gh types.GammHooks) *Keeper {
 	return k
 }
 
+// Set the swap router, which executes multihop swaps.
+// The swap router depends on the gamm keeper, so it can only be set after the gamm keeper is created.
+func (k *Keeper) SetSwapRouter(swapRouter types.SwapRouter) *Keeper {
+	if k.swapRouter != nil {
+		panic("cannot set gamm swap router twice")
+	}
+
+	k.swapRouter = swapRouter
+
+	return k
+}
+
+// Set the lockup keeper, which force unlocks the locks of the shares of sunset pools.
+// The lockup keeper is created after the gamm keeper, so it can only be set afterwards.
+func (k *Keeper) SetLockupKeeper(lockupKeeper types.LockupKeeper) *Keeper {
+	if k.lockupKeeper != nil {
+		panic("cannot set gamm lockup keeper twice")
+	}
+
+	k.lockupKeeper = lockupKeeper
+
+	return k
+}
+
+// Set the twap keeper, which computes the volatility driving dynamic swap fees.
+// The twap keeper depends on the gamm keeper, so it can only be set after the gamm keeper is created.
+func (k *Keeper) SetTwapKeeper(twapKeeper types.TwapKeeper) *Keeper {
+	if k.twapKeeper != nil {
+		panic("cannot set gamm twap keeper twice")
+	}
+
+	k.twapKeeper = twapKeeper
+
+	return k
+}
+
+// Set the superfluid keeper, which undelegates the superfluid staked locks of the shares of sunset pools.
+// The superfluid keeper depends on the gamm keeper, so it can only be set after the gamm keeper is created.
+func (k *Keeper) SetSuperfluidKeeper(superfluidKeeper types.SuperfluidKeeper) *Keeper {
+	if k.superfluidKeeper != nil {
+		panic("cannot set gamm superfluid keeper twice")
+	}
+
+	k.superfluidKeeper = superfluidKeeper
+
+	return k
+}
+
 // GetParams returns the total set params.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v12/osmoutils"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/concentrated"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 )
@@ -20,11 +22,24 @@ func (k Keeper) MarshalPool(pool types.PoolI) ([]byte, error) {
 
 func (k Keeper) UnmarshalPool(bz []byte) (types.PoolI, error) {
 	var acc types.PoolI
-	return acc, k.cdc.UnmarshalInterface(bz, &acc)
+	if err := k.cdc.UnmarshalInterface(bz, &acc); err != nil {
+		return acc, err
+	}
+	k.bindPoolStore(acc)
+	return acc, nil
+}
+
+// bindPoolStore binds pools keeping part of their state apart from the pool,
+// i.e. concentrated liquidity pools, to the x/gamm store.
+func (k Keeper) bindPoolStore(pool types.PoolI) {
+	if concentratedPool, ok := pool.(*concentrated.Pool); ok {
+		concentratedPool.SetStoreKey(k.storeKey)
+	}
 }
 
 // GetPoolAndPoke returns a PoolI based on it's identifier if one exists. If poolId corresponds
-// to a pool with weights (e.g. balancer), the weights of the pool are updated via PokePool prior to returning.
+// to a pool with weights (e.g. balancer) or changing scaling factors (e.g. stableswap),
+// they are updated via PokePool prior to returning.
 // TODO: Consider rename to GetPool due to downstream API confusion.
 func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -40,7 +55,7 @@ func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.PoolI, err
 		return nil, err
 	}
 
-	if pokePool, ok := pool.(types.WeightedPoolExtension); ok {
+	if pokePool, ok := pool.(types.PokablePoolExtension); ok {
 		pokePool.PokePool(ctx.BlockTime())
 	}
 
@@ -48,15 +63,21 @@ func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.PoolI, err
 }
 
 // Get pool and check if the pool is active, i.e. allowed to be swapped against.
-func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
+// Sunset pools are never active. The swap fee of pools with a dynamic swap fee policy
+// is set to the policy's current fee.
+func (k Keeper) GetPoolForSwap(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
 	pool, err := k.GetPoolAndPoke(ctx, poolId)
 	if err != nil {
 		return &balancer.Pool{}, err
 	}
 
 	if !pool.IsActive(ctx) {
+		if k.IsPoolSunset(ctx, poolId) {
+			return &balancer.Pool{}, sdkerrors.Wrapf(types.ErrPoolSunset, "swap on sunset pool")
+		}
 		return &balancer.Pool{}, sdkerrors.Wrapf(types.ErrPoolLocked, "swap on inactive pool")
 	}
+	k.pokeDynamicSwapFee(ctx, pool)
 	return pool, nil
 }
 
@@ -77,7 +98,7 @@ func (k Keeper) GetPoolsAndPoke(ctx sdk.Context) (res []types.PoolI, err error)
 			return nil, err
 		}
 
-		if pokePool, ok := pool.(types.WeightedPoolExtension); ok {
+		if pokePool, ok := pool.(types.PokablePoolExtension); ok {
 			pokePool.PokePool(ctx.BlockTime())
 		}
 		res = append(res, pool)
@@ -251,6 +272,8 @@ func (k Keeper) GetPoolType(ctx sdk.Context, poolId uint64) (string, error) {
 	switch pool := pool.(type) {
 	case *balancer.Pool:
 		return "Balancer", nil
+	case *concentrated.Pool:
+		return "Concentrated", nil
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s pool type: %T", types.ModuleName, pool)
 		return "", sdkerrors.Wrap(sdkerrors.ErrUnpackAny, errMsg)
@@ -264,10 +287,30 @@ func (k Keeper) getNextPoolIdAndIncrement(ctx sdk.Context) uint64 {
 	return nextPoolId
 }
 
-// setStableSwapScalingFactors sets the stable swap scaling factors.
-// errors if the pool does not exist, the sender is not the scaling factor controller, or due to other
-// internal errors.
-func (k Keeper) setStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scalingFactors []uint64, sender string) error {
+// setStableSwapScalingFactors sets the stable swap scaling factors, moving to them over changeDuration.
+// errors if the pool does not exist, the sender is not the scaling factor controller, the change exceeds
+// the max scaling factor change param, the scaling factors changed within the scaling factor change window param,
+// or due to other internal errors.
+func (k Keeper) setStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scalingFactors []uint64, changeDuration time.Duration, sender string) error {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+	}
+	params := k.GetParams(ctx)
+	if err := stableswapPool.SetScalingFactors(ctx, scalingFactors, changeDuration, params.StableswapMaxScalingFactorChange, params.StableswapScalingFactorChangeWindow, sender); err != nil {
+		return err
+	}
+
+	return k.setPool(ctx, stableswapPool)
+}
+
+// SetStableswapScalingFactorController replaces the scaling factor controller of a stableswap pool.
+// errors if the pool does not exist, is not a stableswap pool, or the controller is not a valid address.
+func (k Keeper) SetStableswapScalingFactorController(ctx sdk.Context, poolId uint64, scalingFactorController string) error {
 	pool, err := k.GetPoolAndPoke(ctx, poolId)
 	if err != nil {
 		return err
@@ -276,7 +319,7 @@ func (k Keeper) setStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scal
 	if !ok {
 		return fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
 	}
-	if err := stableswapPool.SetScalingFactors(ctx, scalingFactors, sender); err != nil {
+	if err := stableswapPool.SetScalingFactorController(scalingFactorController); err != nil {
 		return err
 	}
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v12/osmomath"
 	"github.com/osmosis-labs/osmosis/v12/osmoutils"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/concentrated"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 )
 
@@ -134,6 +135,15 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg types.CreatePoolMsg) (uint64, er
 		return 0, err
 	}
 
+	// the initial liquidity of a concentrated liquidity pool is the full range position
+	// of its LP shares, which is stored apart from the pool.
+	k.bindPoolStore(pool)
+	if concentratedPool, ok := pool.(*concentrated.Pool); ok {
+		if err := concentratedPool.CreateFullRangePosition(ctx); err != nil {
+			return 0, err
+		}
+	}
+
 	if err := k.validateCreatedPool(ctx, initialPoolLiquidity, poolId, pool); err != nil {
 		return 0, err
 	}
@@ -215,6 +225,12 @@ func (k Keeper) JoinPoolNoSwap(
 	if err != nil {
 		return nil, sdk.ZeroInt(), err
 	}
+	if !pool.IsActive(ctx) {
+		if k.IsPoolSunset(ctx, poolId) {
+			return nil, sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrPoolSunset, "join on sunset pool")
+		}
+		return nil, sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrPoolLocked, "join on inactive pool")
+	}
 
 	// we do an abstract calculation on the lp liquidity coins needed to have
 	// the designated amount of given shares of the pool without performing swap
@@ -298,7 +314,7 @@ func (k Keeper) JoinSwapExactAmountIn(
 		}
 	}()
 
-	pool, err := k.getPoolForSwap(ctx, poolId)
+	pool, err := k.GetPoolForSwap(ctx, poolId)
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -342,7 +358,7 @@ func (k Keeper) JoinSwapShareAmountOut(
 		}
 	}()
 
-	pool, err := k.getPoolForSwap(ctx, poolId)
+	pool, err := k.GetPoolForSwap(ctx, poolId)
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -383,10 +399,14 @@ func (k Keeper) ExitPool(
 	if err != nil {
 		return sdk.Coins{}, err
 	}
+	if k.IsPoolSunset(ctx, poolId) {
+		return k.exitSunsetPool(ctx, sender, pool, shareInAmount, tokenOutMins)
+	}
 
+	// whether all of the pool's shares can be exited is up to the pool model
 	totalSharesAmount := pool.GetTotalShares()
-	if shareInAmount.GTE(totalSharesAmount) || shareInAmount.LTE(sdk.ZeroInt()) {
-		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "share ratio is zero or negative")
+	if shareInAmount.GT(totalSharesAmount) || shareInAmount.LTE(sdk.ZeroInt()) {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "share ratio is zero, negative or greater than one")
 	}
 	exitFee := pool.GetExitFee(ctx)
 	exitCoins, err = pool.ExitPool(ctx, shareInAmount, exitFee)
@@ -449,7 +469,7 @@ func (k Keeper) ExitSwapExactAmountOut(
 	tokenOut sdk.Coin,
 	shareInMaxAmount sdk.Int,
 ) (shareInAmount sdk.Int, err error) {
-	pool, err := k.getPoolForSwap(ctx, poolId)
+	pool, err := k.GetPoolForSwap(ctx, poolId)
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -271,7 +271,9 @@ func (suite *KeeperTestSuite) TestPoolCreationFee() {
 
 		// set pool creation fee
 		gammKeeper.SetParams(suite.Ctx, types.Params{
-			PoolCreationFee: test.poolCreationFee,
+			PoolCreationFee:                  test.poolCreationFee,
+			StableswapMaxScalingFactorChange: types.DefaultParams().StableswapMaxScalingFactorChange,
+			ProtocolRevenueShare:             types.DefaultParams().ProtocolRevenueShare,
 		})
 
 		// fund sender test account
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/keeper/internal/events"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// GetProtocolRevenueShare returns the fraction of the swap fees of the pool that goes to the protocol,
+// which is the pool's override if it has one, and the protocol revenue share param otherwise.
+func (k Keeper) GetProtocolRevenueShare(ctx sdk.Context, poolId uint64) sdk.Dec {
+	if override, found := k.GetPoolProtocolRevenueShare(ctx, poolId); found {
+		return override.ProtocolRevenueShare
+	}
+	return k.GetParams(ctx).ProtocolRevenueShare
+}
+
+// SetPoolProtocolRevenueShare overrides the protocol revenue share param for the swap fees of a pool.
+// Errors if the share is not between 0 and 1, or the pool does not exist or does not share its swap fees.
+func (k Keeper) SetPoolProtocolRevenueShare(ctx sdk.Context, poolId uint64, protocolRevenueShare sdk.Dec) error {
+	if err := types.ValidateProtocolRevenueShare(protocolRevenueShare); err != nil {
+		return err
+	}
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	if _, ok := pool.(types.ProtocolRevenuePoolExtension); !ok {
+		return fmt.Errorf("pool with id %d does not support protocol revenue", poolId)
+	}
+
+	k.setPoolProtocolRevenueShare(ctx, types.PoolProtocolRevenueShare{
+		PoolId:               poolId,
+		ProtocolRevenueShare: protocolRevenueShare,
+	})
+	return nil
+}
+
+// RemovePoolProtocolRevenueShare removes the protocol revenue share override of a pool,
+// so that its swap fees are shared by the protocol revenue share param again.
+// Errors if the pool has no override.
+func (k Keeper) RemovePoolProtocolRevenueShare(ctx sdk.Context, poolId uint64) error {
+	if _, found := k.GetPoolProtocolRevenueShare(ctx, poolId); !found {
+		return fmt.Errorf("pool with id %d has no protocol revenue share override", poolId)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetKeyPoolProtocolRevenueShare(poolId))
+	return nil
+}
+
+// GetPoolProtocolRevenueShare returns the protocol revenue share override of a pool, and whether it has one.
+func (k Keeper) GetPoolProtocolRevenueShare(ctx sdk.Context, poolId uint64) (types.PoolProtocolRevenueShare, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyPoolProtocolRevenueShare(poolId))
+	if bz == nil {
+		return types.PoolProtocolRevenueShare{}, false
+	}
+	override := types.PoolProtocolRevenueShare{}
+	k.cdc.MustUnmarshal(bz, &override)
+	return override, true
+}
+
+// GetAllPoolProtocolRevenueShares returns the protocol revenue share overrides of all pools, ordered by pool id.
+func (k Keeper) GetAllPoolProtocolRevenueShares(ctx sdk.Context) []types.PoolProtocolRevenueShare {
+	iter := k.iterator(ctx, types.KeyPrefixPoolProtocolRevenueShares)
+	defer iter.Close()
+
+	overrides := []types.PoolProtocolRevenueShare{}
+	for ; iter.Valid(); iter.Next() {
+		override := types.PoolProtocolRevenueShare{}
+		k.cdc.MustUnmarshal(iter.Value(), &override)
+		overrides = append(overrides, override)
+	}
+	return overrides
+}
+
+func (k Keeper) setPoolProtocolRevenueShare(ctx sdk.Context, override types.PoolProtocolRevenueShare) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyPoolProtocolRevenueShare(override.PoolId), k.cdc.MustMarshal(&override))
+}
+
+// GetProtocolRevenue returns the cumulative protocol revenue of all pools from swap fees.
+func (k Keeper) GetProtocolRevenue(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixProtocolRevenue)
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	revenue := sdk.Coins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		revenue = revenue.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+	return revenue
+}
+
+func (k Keeper) setProtocolRevenue(ctx sdk.Context, revenue sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	for _, coin := range revenue {
+		bz, err := coin.Amount.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set(types.GetKeyProtocolRevenue(coin.Denom), bz)
+	}
+}
+
+// getProtocolRevenueAmount returns the cumulative protocol revenue of the denom.
+func (k Keeper) getProtocolRevenueAmount(ctx sdk.Context, denom string) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyProtocolRevenue(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// recordProtocolRevenue adds the revenue to the cumulative protocol revenue, only touching the keys of its denoms.
+func (k Keeper) recordProtocolRevenue(ctx sdk.Context, revenue sdk.Coins) {
+	updated := make(sdk.Coins, 0, len(revenue))
+	for _, coin := range revenue {
+		updated = append(updated, sdk.NewCoin(coin.Denom, k.getProtocolRevenueAmount(ctx, coin.Denom).Add(coin.Amount)))
+	}
+	k.setProtocolRevenue(ctx, updated)
+}
+
+// deductProtocolRevenue removes the protocol's share of the swap fee paid on tokenIn from the pool's liquidity,
+// and returns it. The swap fee of a swap is tokenIn times the swap fee, both for exact in and exact out swaps.
+// No revenue is deducted from pools that do not share their swap fees.
+func (k Keeper) deductProtocolRevenue(ctx sdk.Context, pool types.PoolI, tokenIn sdk.Coin, swapFee sdk.Dec) (sdk.Coins, error) {
+	revenuePool, ok := pool.(types.ProtocolRevenuePoolExtension)
+	if !ok {
+		return sdk.Coins{}, nil
+	}
+	share := k.GetProtocolRevenueShare(ctx, pool.GetId())
+	amount := tokenIn.Amount.ToDec().Mul(swapFee).Mul(share).TruncateInt()
+	if !amount.IsPositive() {
+		return sdk.Coins{}, nil
+	}
+
+	revenue := sdk.NewCoins(sdk.NewCoin(tokenIn.Denom, amount))
+	if err := revenuePool.RemoveLiquidity(revenue); err != nil {
+		return sdk.Coins{}, err
+	}
+	return revenue, nil
+}
+
+// sendProtocolRevenue sends the protocol revenue deducted from the pool to the protocol revenue recipient,
+// or to the community pool if no recipient is set.
+func (k Keeper) sendProtocolRevenue(ctx sdk.Context, pool types.PoolI, revenue sdk.Coins) error {
+	if revenue.IsZero() {
+		return nil
+	}
+
+	var recipient sdk.AccAddress
+	if recipientParam := k.GetParams(ctx).ProtocolRevenueRecipient; recipientParam == "" {
+		if err := k.communityPoolKeeper.FundCommunityPool(ctx, revenue, pool.GetAddress()); err != nil {
+			return err
+		}
+		recipient = authtypes.NewModuleAddress(distrtypes.ModuleName)
+	} else {
+		var err error
+		recipient, err = sdk.AccAddressFromBech32(recipientParam)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), recipient, revenue); err != nil {
+			return err
+		}
+	}
+
+	k.recordProtocolRevenue(ctx, revenue)
+	events.EmitProtocolRevenueEvent(ctx, pool.GetId(), recipient, revenue)
+	return nil
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) TestSetPoolProtocolRevenueShare() {
+	tests := map[string]struct {
+		concentrated bool
+		poolId       uint64
+		share        sdk.Dec
+		expectErr    bool
+	}{
+		"valid share":                          {share: sdk.NewDecWithPrec(1, 1)},
+		"zero share":                           {share: sdk.ZeroDec()},
+		"full share":                           {share: sdk.OneDec()},
+		"share greater than one":               {share: sdk.NewDecWithPrec(11, 1), expectErr: true},
+		"negative share":                       {share: sdk.NewDec(-1), expectErr: true},
+		"pool does not exist":                  {share: sdk.NewDecWithPrec(1, 1), poolId: 10, expectErr: true},
+		"concentrated pools are not supported": {share: sdk.NewDecWithPrec(1, 1), concentrated: true, expectErr: true},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			coins := sdk.NewCoins(sdk.NewInt64Coin("foo", 10000000), sdk.NewInt64Coin("bar", 10000000))
+			var poolId uint64
+			if tc.concentrated {
+				poolId = suite.PrepareConcentratedPoolWithCoins(coins...)
+			} else {
+				poolId = suite.PrepareBalancerPoolWithCoins(coins...)
+			}
+			if tc.poolId != 0 {
+				poolId = tc.poolId
+			}
+
+			err := suite.App.GAMMKeeper.SetPoolProtocolRevenueShare(suite.Ctx, poolId, tc.share)
+			_, found := suite.App.GAMMKeeper.GetPoolProtocolRevenueShare(suite.Ctx, poolId)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().False(found)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.share, suite.App.GAMMKeeper.GetProtocolRevenueShare(suite.Ctx, poolId))
+
+			err = suite.App.GAMMKeeper.RemovePoolProtocolRevenueShare(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.DefaultParams().ProtocolRevenueShare, suite.App.GAMMKeeper.GetProtocolRevenueShare(suite.Ctx, poolId))
+			err = suite.App.GAMMKeeper.RemovePoolProtocolRevenueShare(suite.Ctx, poolId)
+			suite.Require().Error(err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestProtocolRevenue() {
+	swapFee := sdk.NewDecWithPrec(1, 2)
+	balances := sdk.NewCoins(
+		sdk.NewInt64Coin("uosmo", 10000000000),
+		sdk.NewInt64Coin("foo", 100000000),
+		sdk.NewInt64Coin("bar", 100000000),
+	)
+	liquidity := sdk.NewCoins(sdk.NewInt64Coin("foo", 10000000), sdk.NewInt64Coin("bar", 10000000))
+
+	tests := map[string]struct {
+		createPool func() uint64
+	}{
+		"balancer pool": {
+			createPool: func() uint64 {
+				return suite.prepareCustomBalancerPool(balances, []balancer.PoolAsset{
+					{Token: liquidity[0], Weight: sdk.NewInt(1)},
+					{Token: liquidity[1], Weight: sdk.NewInt(1)},
+				}, balancer.PoolParams{SwapFee: swapFee, ExitFee: sdk.ZeroDec()})
+			},
+		},
+		"stableswap pool": {
+			createPool: func() uint64 {
+				return suite.prepareCustomStableswapPool(balances, stableswap.PoolParams{SwapFee: swapFee, ExitFee: sdk.ZeroDec()}, liquidity, []uint64{1, 1})
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			poolId := tc.createPool()
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			params := suite.App.GAMMKeeper.GetParams(suite.Ctx)
+			params.ProtocolRevenueShare = sdk.NewDecWithPrec(5, 1)
+			suite.App.GAMMKeeper.SetParams(suite.Ctx, params)
+			communityPoolAddr := authtypes.NewModuleAddress(distrtypes.ModuleName)
+			recipient := suite.TestAccs[2]
+
+			// assertSwap swaps tokenIn for bar, and checks that the expected revenue was taken out of
+			// the swap fee, leaving the pool's liquidity in sync with its balances.
+			assertSwap := func(tokenIn sdk.Coin, exactOut bool, revenueRecipient sdk.AccAddress, expectedRevenue func(tokenIn sdk.Coin) sdk.Coins) {
+				recipientBalance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, revenueRecipient)
+				totalRevenue := suite.App.GAMMKeeper.GetProtocolRevenue(suite.Ctx)
+				suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+				if exactOut {
+					tokenOut, err := pool.CalcOutAmtGivenIn(suite.Ctx, sdk.NewCoins(tokenIn), "bar", swapFee)
+					suite.Require().NoError(err)
+					tokenInAmount, err := suite.App.GAMMKeeper.SwapExactAmountOut(suite.Ctx, suite.TestAccs[0], poolId, "foo", tokenIn.Amount.MulRaw(2), tokenOut)
+					suite.Require().NoError(err)
+					tokenIn.Amount = tokenInAmount
+				} else {
+					_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, tokenIn, "bar", sdk.OneInt())
+					suite.Require().NoError(err)
+				}
+				receivedRevenue := suite.App.BankKeeper.GetAllBalances(suite.Ctx, revenueRecipient).Sub(recipientBalance)
+				suite.Require().True(expectedRevenue(tokenIn).IsEqual(receivedRevenue), "expected %s, got %s", expectedRevenue(tokenIn), receivedRevenue)
+				suite.Require().Equal(totalRevenue.Add(expectedRevenue(tokenIn)...), suite.App.GAMMKeeper.GetProtocolRevenue(suite.Ctx))
+				if expectedRevenue(tokenIn).IsZero() {
+					suite.AssertEventEmitted(suite.Ctx, types.TypeEvtProtocolRevenue, 0)
+				} else {
+					suite.AssertEventEmitted(suite.Ctx, types.TypeEvtProtocolRevenue, 1)
+				}
+
+				pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+				suite.Require().NoError(err)
+				suite.Require().Equal(suite.App.BankKeeper.GetAllBalances(suite.Ctx, pool.GetAddress()), pool.GetTotalPoolLiquidity(suite.Ctx))
+				_, broken := keeper.AllInvariants(*suite.App.GAMMKeeper, suite.App.BankKeeper)(suite.Ctx)
+				suite.Require().False(broken)
+			}
+			shareOfSwapFee := func(share sdk.Dec) func(tokenIn sdk.Coin) sdk.Coins {
+				return func(tokenIn sdk.Coin) sdk.Coins {
+					amount := tokenIn.Amount.ToDec().Mul(swapFee).Mul(share).TruncateInt()
+					return sdk.NewCoins(sdk.NewCoin(tokenIn.Denom, amount))
+				}
+			}
+
+			// half of the swap fee funds the community pool
+			assertSwap(sdk.NewInt64Coin("foo", 100000), false, communityPoolAddr, shareOfSwapFee(params.ProtocolRevenueShare))
+			assertSwap(sdk.NewInt64Coin("foo", 100000), true, communityPoolAddr, shareOfSwapFee(params.ProtocolRevenueShare))
+
+			// revenue rounding down to zero is not taken
+			assertSwap(sdk.NewInt64Coin("foo", 100), false, communityPoolAddr, shareOfSwapFee(sdk.ZeroDec()))
+
+			// the pool's override takes precedence over the param
+			proposal := types.NewSetPoolProtocolRevenueShareProposal("title", "description", poolId, sdk.ZeroDec(), false)
+			err = gamm.NewGammProposalHandler(*suite.App.GAMMKeeper)(suite.Ctx, &proposal)
+			suite.Require().NoError(err)
+			assertSwap(sdk.NewInt64Coin("foo", 100000), false, communityPoolAddr, shareOfSwapFee(sdk.ZeroDec()))
+
+			// removing the override shares the swap fees by the param again, sent to the recipient
+			params.ProtocolRevenueRecipient = recipient.String()
+			suite.App.GAMMKeeper.SetParams(suite.Ctx, params)
+			proposal = types.NewSetPoolProtocolRevenueShareProposal("title", "description", poolId, sdk.ZeroDec(), true)
+			err = gamm.NewGammProposalHandler(*suite.App.GAMMKeeper)(suite.Ctx, &proposal)
+			suite.Require().NoError(err)
+			assertSwap(sdk.NewInt64Coin("foo", 100000), false, recipient, shareOfSwapFee(params.ProtocolRevenueShare))
+
+			res, err := suite.queryClient.ProtocolRevenue(sdk.WrapSDKContext(suite.Ctx), &types.QueryProtocolRevenueRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Equal(suite.App.GAMMKeeper.GetProtocolRevenue(suite.Ctx), res.ProtocolRevenue)
+			suite.Require().True(res.ProtocolRevenue.AmountOf("foo").IsPositive())
+		})
+	}
+}
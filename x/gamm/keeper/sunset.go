@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/osmoutils"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/keeper/internal/events"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// SunsetPool retires the pool with the given id. The pool is marked inactive, disabling swaps and joins,
+// and the pool's liquidity is frozen for the LPs to withdraw pro rata to their shares by exiting the pool.
+// The locks of its shares are force unlocked in batches at the end of the following blocks.
+// Errors if the pool does not exist, cannot be sunset, e.g. concentrated liquidity pools, or is already sunset.
+func (k Keeper) SunsetPool(ctx sdk.Context, poolId uint64) error {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	sunsettablePool, ok := pool.(types.SunsettablePoolExtension)
+	if !ok {
+		return fmt.Errorf("sunsetting pool %d of type %T is not supported", poolId, pool)
+	}
+	if k.IsPoolSunset(ctx, poolId) {
+		return sdkerrors.Wrapf(types.ErrPoolSunset, "pool %d is already sunset", poolId)
+	}
+
+	sunsettablePool.SetSunset()
+	if err := k.setPool(ctx, sunsettablePool); err != nil {
+		return err
+	}
+
+	k.setSunsetPool(ctx, types.SunsetPool{
+		PoolId:              poolId,
+		RemainingShares:     pool.GetTotalShares(),
+		RemainingLiquidity:  pool.GetTotalPoolLiquidity(ctx),
+		ForceUnlocksPending: true,
+	})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPoolSunset,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+	))
+	return nil
+}
+
+// ForceUnlockSunsetPoolLocks force unlocks the locks of the shares of sunset pools, processing at most
+// MaxSunsetPoolForceUnlocksPerBlock locks and resuming from where the previous call stopped.
+// Superfluid staked locks are instantly undelegated by the superfluid module before being force unlocked,
+// so that no delegation is left backed by shares that have been unlocked. Locks that fail to be force
+// unlocked are skipped.
+func (k Keeper) ForceUnlockSunsetPoolLocks(ctx sdk.Context) {
+	limit := uint64(types.MaxSunsetPoolForceUnlocksPerBlock)
+	for _, sunsetPool := range k.GetAllSunsetPools(ctx) {
+		if limit == 0 {
+			return
+		}
+		if !sunsetPool.ForceUnlocksPending {
+			continue
+		}
+
+		shareDenom := types.GetPoolShareDenom(sunsetPool.PoolId)
+		locks, nextKey, err := k.lockupKeeper.GetLocksDenomPage(ctx, shareDenom, sunsetPool.NextLockKey, limit)
+		if err != nil {
+			k.Logger(ctx).Error("failed to get the locks of sunset pool shares", "pool_id", sunsetPool.PoolId, "error", err)
+			continue
+		}
+
+		numSkipped := 0
+		for _, lock := range locks {
+			lock := lock
+			err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+				if k.lockupKeeper.HasAnySyntheticLockups(cacheCtx, lock.ID) {
+					return k.superfluidKeeper.ForceUndelegateAndUnlock(cacheCtx, lock.ID)
+				}
+				return k.lockupKeeper.ForceUnlock(cacheCtx, lock)
+			})
+			if err != nil {
+				k.Logger(ctx).Error("failed to force unlock sunset pool shares", "pool_id", sunsetPool.PoolId, "lock_id", lock.ID, "error", err)
+				numSkipped++
+			}
+		}
+		limit -= uint64(len(locks))
+
+		sunsetPool.NextLockKey = nextKey
+		sunsetPool.ForceUnlocksPending = nextKey != nil
+		k.setSunsetPool(ctx, sunsetPool)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtSunsetPoolLocksUnlocked,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(sunsetPool.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyNumLocks, strconv.Itoa(len(locks)-numSkipped)),
+			sdk.NewAttribute(types.AttributeKeyNumSkipped, strconv.Itoa(numSkipped)),
+		))
+	}
+}
+
+// IsPoolSunset returns true if the pool with the given id has been sunset.
+func (k Keeper) IsPoolSunset(ctx sdk.Context, poolId uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetKeySunsetPool(poolId))
+}
+
+// GetSunsetPool returns the withdrawal state of the sunset pool with the given id.
+func (k Keeper) GetSunsetPool(ctx sdk.Context, poolId uint64) (types.SunsetPool, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeySunsetPool(poolId))
+	if bz == nil {
+		return types.SunsetPool{}, sdkerrors.Wrapf(types.ErrPoolNotSunset, "pool %d", poolId)
+	}
+	sunsetPool := types.SunsetPool{}
+	k.cdc.MustUnmarshal(bz, &sunsetPool)
+	return sunsetPool, nil
+}
+
+// GetAllSunsetPools returns the withdrawal states of all the sunset pools.
+func (k Keeper) GetAllSunsetPools(ctx sdk.Context) []types.SunsetPool {
+	iter := k.iterator(ctx, types.KeyPrefixSunsetPools)
+	defer iter.Close()
+
+	sunsetPools := []types.SunsetPool{}
+	for ; iter.Valid(); iter.Next() {
+		sunsetPool := types.SunsetPool{}
+		k.cdc.MustUnmarshal(iter.Value(), &sunsetPool)
+		sunsetPools = append(sunsetPools, sunsetPool)
+	}
+	return sunsetPools
+}
+
+func (k Keeper) setSunsetPool(ctx sdk.Context, sunsetPool types.SunsetPool) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeySunsetPool(sunsetPool.PoolId), k.cdc.MustMarshal(&sunsetPool))
+}
+
+// calcSunsetPoolExitCoins returns the share of the remaining liquidity of the sunset pool
+// that exiting shareInAmount shares withdraws. The last shares withdraw all the remaining liquidity,
+// so that no dust is left in the pool.
+func calcSunsetPoolExitCoins(sunsetPool types.SunsetPool, shareInAmount sdk.Int) (sdk.Coins, error) {
+	if shareInAmount.GT(sunsetPool.RemainingShares) || shareInAmount.LTE(sdk.ZeroInt()) {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "share ratio is zero, negative or greater than one")
+	}
+	if shareInAmount.Equal(sunsetPool.RemainingShares) {
+		return sunsetPool.RemainingLiquidity, nil
+	}
+
+	exitCoins := sdk.Coins{}
+	for _, coin := range sunsetPool.RemainingLiquidity {
+		amount := coin.Amount.Mul(shareInAmount).Quo(sunsetPool.RemainingShares)
+		if amount.IsPositive() {
+			exitCoins = append(exitCoins, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return exitCoins, nil
+}
+
+// exitSunsetPool burns shareInAmount shares of the sunset pool from the sender and sends
+// them their share of the pool's remaining liquidity. The pool itself is left untouched,
+// the withdrawal is only recorded in the pool's sunset state.
+func (k Keeper) exitSunsetPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool types.PoolI,
+	shareInAmount sdk.Int,
+	tokenOutMins sdk.Coins,
+) (exitCoins sdk.Coins, err error) {
+	sunsetPool, err := k.GetSunsetPool(ctx, pool.GetId())
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	exitCoins, err = calcSunsetPoolExitCoins(sunsetPool, shareInAmount)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	if !tokenOutMins.DenomsSubsetOf(exitCoins) || tokenOutMins.IsAnyGT(exitCoins) {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrLimitMinAmount,
+			"Exit pool returned %s , minimum tokens out specified as %s",
+			exitCoins, tokenOutMins)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, exitCoins); err != nil {
+		return sdk.Coins{}, err
+	}
+	if err := k.BurnPoolShareFromAccount(ctx, pool, sender, shareInAmount); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	sunsetPool.RemainingShares = sunsetPool.RemainingShares.Sub(shareInAmount)
+	sunsetPool.RemainingLiquidity = sunsetPool.RemainingLiquidity.Sub(exitCoins)
+	k.setSunsetPool(ctx, sunsetPool)
+
+	events.EmitRemoveLiquidityEvent(ctx, sender, pool.GetId(), exitCoins)
+	k.hooks.AfterExitPool(ctx, sender, pool.GetId(), shareInAmount, exitCoins)
+	k.RecordTotalLiquidityDecrease(ctx, exitCoins)
+	return exitCoins, nil
+}
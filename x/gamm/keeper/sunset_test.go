@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) TestSunsetPool() {
+	tests := map[string]struct {
+		concentrated    bool
+		alreadySunset   bool
+		syntheticLockup bool
+		poolId          uint64
+		expectErr       bool
+	}{
+		"sunset balancer pool":                 {},
+		"pool does not exist":                  {poolId: 10, expectErr: true},
+		"pool is already sunset":               {alreadySunset: true, expectErr: true},
+		"concentrated pools are not supported": {concentrated: true, expectErr: true},
+		"superfluid staked locks are unlocked": {syntheticLockup: true},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			coins := sdk.NewCoins(sdk.NewInt64Coin("foo", 10000000), sdk.NewInt64Coin("bar", 20000000))
+			if tc.concentrated {
+				poolId := suite.PrepareConcentratedPoolWithCoins(coins...)
+				err := suite.App.GAMMKeeper.SunsetPool(suite.Ctx, poolId)
+				suite.Require().Error(err)
+				suite.Require().False(suite.App.GAMMKeeper.IsPoolSunset(suite.Ctx, poolId))
+				return
+			}
+			poolId := suite.PrepareBalancerPoolWithCoins(coins...)
+			shareDenom := types.GetPoolShareDenom(poolId)
+
+			// lock part of the shares of a second LP
+			lpShares := sdk.NewCoins(sdk.NewCoin(shareDenom, types.OneShare.MulRaw(10)))
+			err := suite.App.BankKeeper.SendCoins(suite.Ctx, suite.TestAccs[0], suite.TestAccs[1], lpShares)
+			suite.Require().NoError(err)
+			lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, suite.TestAccs[1], sdk.NewCoins(sdk.NewCoin(shareDenom, types.OneShare.MulRaw(4))), time.Hour)
+			suite.Require().NoError(err)
+			lockId := lock.ID
+			if tc.syntheticLockup {
+				err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, lockId, shareDenom+"/superbonding", time.Hour, false)
+				suite.Require().NoError(err)
+			}
+			if tc.alreadySunset {
+				suite.Require().NoError(suite.App.GAMMKeeper.SunsetPool(suite.Ctx, poolId))
+			}
+			if tc.poolId != 0 {
+				poolId = tc.poolId
+			}
+
+			err = suite.App.GAMMKeeper.SunsetPool(suite.Ctx, poolId)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().Equal(tc.alreadySunset, suite.App.GAMMKeeper.IsPoolSunset(suite.Ctx, poolId))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtPoolSunset, 1)
+
+			// the share locks are force unlocked at the end of the block, including superfluid staked ones
+			_, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockId)
+			suite.Require().NoError(err)
+			suite.App.GAMMKeeper.ForceUnlockSunsetPoolLocks(suite.Ctx)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtSunsetPoolLocksUnlocked, 1)
+			_, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockId)
+			suite.Require().Error(err)
+			suite.Require().Empty(suite.App.LockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, lockId))
+			suite.Require().Equal(lpShares, suite.App.BankKeeper.GetAllBalances(suite.Ctx, suite.TestAccs[1]).FilterDenoms([]string{shareDenom}))
+
+			// the pool is inactive, and its liquidity is frozen in the sunset state
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().False(pool.IsActive(suite.Ctx))
+			sunsetPool, err := suite.App.GAMMKeeper.GetSunsetPool(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(pool.GetTotalShares(), sunsetPool.RemainingShares)
+			suite.Require().Equal(coins, sunsetPool.RemainingLiquidity)
+			suite.Require().False(sunsetPool.ForceUnlocksPending)
+
+			// swaps and joins are disabled
+			tokenIn := sdk.NewInt64Coin("foo", 1000)
+			suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(tokenIn))
+			_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, tokenIn, "bar", sdk.OneInt())
+			suite.Require().ErrorIs(err, types.ErrPoolSunset)
+			_, err = suite.App.GAMMKeeper.JoinSwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, sdk.NewCoins(tokenIn), sdk.OneInt())
+			suite.Require().ErrorIs(err, types.ErrPoolSunset)
+			_, _, err = suite.App.GAMMKeeper.JoinPoolNoSwap(suite.Ctx, suite.TestAccs[0], poolId, types.OneShare, sdk.Coins{})
+			suite.Require().ErrorIs(err, types.ErrPoolSunset)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestForceUnlockSunsetPoolLocks() {
+	suite.SetupTest()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foo", 10000000), sdk.NewInt64Coin("bar", 20000000))
+	poolIds := []uint64{suite.PrepareBalancerPoolWithCoins(coins...), suite.PrepareBalancerPoolWithCoins(coins...)}
+
+	// lock the shares of the first pool in as many locks as are force unlocked in a block,
+	// and the shares of the second pool in a single lock
+	lockIds := [][]uint64{{}, {}}
+	for i, poolId := range poolIds {
+		numLocks := types.MaxSunsetPoolForceUnlocksPerBlock
+		if i == 1 {
+			numLocks = 1
+		}
+		for j := 0; j < numLocks; j++ {
+			lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, suite.TestAccs[0], sdk.NewCoins(sdk.NewCoin(types.GetPoolShareDenom(poolId), types.OneShare)), time.Hour)
+			suite.Require().NoError(err)
+			lockIds[i] = append(lockIds[i], lock.ID)
+		}
+		suite.Require().NoError(suite.App.GAMMKeeper.SunsetPool(suite.Ctx, poolId))
+	}
+
+	// the locks of the first pool use up the limit of the block
+	suite.App.GAMMKeeper.ForceUnlockSunsetPoolLocks(suite.Ctx)
+	for _, lockId := range lockIds[0] {
+		_, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockId)
+		suite.Require().Error(err)
+	}
+	_, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockIds[1][0])
+	suite.Require().NoError(err)
+	sunsetPool, err := suite.App.GAMMKeeper.GetSunsetPool(suite.Ctx, poolIds[0])
+	suite.Require().NoError(err)
+	suite.Require().False(sunsetPool.ForceUnlocksPending)
+	sunsetPool, err = suite.App.GAMMKeeper.GetSunsetPool(suite.Ctx, poolIds[1])
+	suite.Require().NoError(err)
+	suite.Require().True(sunsetPool.ForceUnlocksPending)
+
+	// the locks of the second pool are force unlocked in the next block
+	suite.App.GAMMKeeper.ForceUnlockSunsetPoolLocks(suite.Ctx)
+	_, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockIds[1][0])
+	suite.Require().Error(err)
+	sunsetPool, err = suite.App.GAMMKeeper.GetSunsetPool(suite.Ctx, poolIds[1])
+	suite.Require().NoError(err)
+	suite.Require().False(sunsetPool.ForceUnlocksPending)
+}
+
+func (suite *KeeperTestSuite) TestExitSunsetPool() {
+	suite.SetupTest()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foo", 10000000), sdk.NewInt64Coin("bar", 20000001))
+	poolId := suite.PrepareBalancerPoolWithCoins(coins...)
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	shareDenom := types.GetPoolShareDenom(poolId)
+
+	// the second LP holds a third of the shares
+	totalShares := pool.GetTotalShares()
+	lpShares := totalShares.QuoRaw(3)
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, suite.TestAccs[0], suite.TestAccs[1], sdk.NewCoins(sdk.NewCoin(shareDenom, lpShares)))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.App.GAMMKeeper.SunsetPool(suite.Ctx, poolId))
+
+	// exiting pays out the pro rata share of the remaining liquidity, rounded down
+	expectedCoins := sdk.NewCoins(
+		sdk.NewCoin("foo", sdk.NewInt(10000000).Mul(lpShares).Quo(totalShares)),
+		sdk.NewCoin("bar", sdk.NewInt(20000001).Mul(lpShares).Quo(totalShares)),
+	)
+	res, err := suite.queryClient.CalcExitPoolCoinsFromShares(sdk.WrapSDKContext(suite.Ctx), &types.QueryCalcExitPoolCoinsFromSharesRequest{
+		PoolId:        poolId,
+		ShareInAmount: lpShares,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedCoins, res.TokensOut)
+
+	_, err = suite.App.GAMMKeeper.ExitPool(suite.Ctx, suite.TestAccs[1], poolId, lpShares, expectedCoins.Add(sdk.NewInt64Coin("foo", 1)))
+	suite.Require().ErrorIs(err, types.ErrLimitMinAmount)
+	_, err = suite.App.GAMMKeeper.ExitPool(suite.Ctx, suite.TestAccs[1], poolId, totalShares.AddRaw(1), sdk.Coins{})
+	suite.Require().Error(err)
+
+	exitCoins, err := suite.App.GAMMKeeper.ExitPool(suite.Ctx, suite.TestAccs[1], poolId, lpShares, expectedCoins)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedCoins, exitCoins)
+	suite.Require().Equal(expectedCoins, suite.App.BankKeeper.GetAllBalances(suite.Ctx, suite.TestAccs[1]))
+
+	sunsetPool, err := suite.App.GAMMKeeper.GetSunsetPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(totalShares.Sub(lpShares), sunsetPool.RemainingShares)
+	suite.Require().Equal(coins.Sub(expectedCoins), sunsetPool.RemainingLiquidity)
+	_, broken := keeper.AllInvariants(*suite.App.GAMMKeeper, suite.App.BankKeeper)(suite.Ctx)
+	suite.Require().False(broken)
+
+	// the last LP withdraws all the remaining liquidity, even the last shares of the pool
+	lastShares := totalShares.Sub(lpShares)
+	suite.Require().Equal(lastShares, suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[0], shareDenom).Amount)
+	exitCoins, err = suite.App.GAMMKeeper.ExitPool(suite.Ctx, suite.TestAccs[0], poolId, lastShares, sdk.Coins{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins.Sub(expectedCoins), exitCoins)
+
+	pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, pool.GetAddress()).IsZero())
+	suite.Require().True(suite.App.BankKeeper.GetSupply(suite.Ctx, shareDenom).IsZero())
+	suite.Require().True(suite.App.GAMMKeeper.GetTotalLiquidity(suite.Ctx).IsZero())
+	_, broken = keeper.AllInvariants(*suite.App.GAMMKeeper, suite.App.BankKeeper)(suite.Ctx)
+	suite.Require().False(broken)
+}
@@ -24,7 +24,7 @@ func (k Keeper) SwapExactAmountIn(
 	tokenOutDenom string,
 	tokenOutMinAmount sdk.Int,
 ) (sdk.Int, error) {
-	pool, err := k.getPoolForSwap(ctx, poolId)
+	pool, err := k.GetPoolForSwap(ctx, poolId)
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -77,7 +77,7 @@ func (k Keeper) swapExactAmountIn(
 
 	// Settles balances between the tx sender and the pool to match the swap that was executed earlier.
 	// Also emits swap event and updates related liquidity metrics
-	if err := k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOutCoin); err != nil {
+	if err := k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOutCoin, swapFee); err != nil {
 		return sdk.Int{}, err
 	}
 
@@ -92,7 +92,7 @@ func (k Keeper) SwapExactAmountOut(
 	tokenInMaxAmount sdk.Int,
 	tokenOut sdk.Coin,
 ) (tokenInAmount sdk.Int, err error) {
-	pool, err := k.getPoolForSwap(ctx, poolId)
+	pool, err := k.GetPoolForSwap(ctx, poolId)
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -144,27 +144,62 @@ func (k Keeper) swapExactAmountOut(
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "Swap requires %s, which is greater than the amount %s", tokenIn, tokenInMaxAmount)
 	}
 
-	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut)
+	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut, swapFee)
 	if err != nil {
 		return sdk.Int{}, err
 	}
 	return tokenInAmount, nil
 }
 
+// RouteExactAmountIn swaps an exact amount of tokenIn through the given pool,
+// using the provided swapFee. It allows x/swaprouter to route swaps to gamm pools.
+func (k Keeper) RouteExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool types.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+	swapFee sdk.Dec,
+) (sdk.Int, error) {
+	return k.swapExactAmountIn(ctx, sender, pool, tokenIn, tokenOutDenom, tokenOutMinAmount, swapFee)
+}
+
+// RouteExactAmountOut swaps for an exact amount of tokenOut through the given pool,
+// using the provided swapFee. It allows x/swaprouter to route swaps to gamm pools.
+func (k Keeper) RouteExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool types.PoolI,
+	tokenInDenom string,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
+) (sdk.Int, error) {
+	return k.swapExactAmountOut(ctx, sender, pool, tokenInDenom, tokenInMaxAmount, tokenOut, swapFee)
+}
+
 // updatePoolForSwap takes a pool, sender, and tokenIn, tokenOut amounts
 // It then updates the pool's balances to the new reserve amounts, and
 // sends the in tokens from the sender to the pool, and the out tokens from the pool to the sender.
+// The protocol's share of the swap fee paid on tokenIn is taken out of the pool and sent to the protocol.
 func (k Keeper) updatePoolForSwap(
 	ctx sdk.Context,
 	pool types.PoolI,
 	sender sdk.AccAddress,
 	tokenIn sdk.Coin,
 	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
 ) error {
 	tokensIn := sdk.Coins{tokenIn}
 	tokensOut := sdk.Coins{tokenOut}
 
-	err := k.setPool(ctx, pool)
+	protocolRevenue, err := k.deductProtocolRevenue(ctx, pool, tokenIn, swapFee)
+	if err != nil {
+		return err
+	}
+
+	err = k.setPool(ctx, pool)
 	if err != nil {
 		return err
 	}
@@ -183,10 +218,16 @@ func (k Keeper) updatePoolForSwap(
 		return err
 	}
 
+	err = k.sendProtocolRevenue(ctx, pool, protocolRevenue)
+	if err != nil {
+		return err
+	}
+
 	events.EmitSwapEvent(ctx, sender, pool.GetId(), tokensIn, tokensOut)
 	k.hooks.AfterSwap(ctx, sender, pool.GetId(), tokensIn, tokensOut)
-	k.RecordTotalLiquidityIncrease(ctx, tokensIn)
+	k.RecordTotalLiquidityIncrease(ctx, tokensIn.Sub(protocolRevenue))
 	k.RecordTotalLiquidityDecrease(ctx, tokensOut)
+	k.recordSwapStats(ctx, pool.GetId(), tokenIn, swapFee)
 
 	return err
 }
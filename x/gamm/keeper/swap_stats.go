@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// GetPoolSwapStats returns the cumulative swap volume and swap fees of a pool.
+// The stats of a pool without any swap are empty.
+func (k Keeper) GetPoolSwapStats(ctx sdk.Context, poolId uint64) types.PoolSwapStats {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyPoolSwapStats(poolId))
+	if bz == nil {
+		return types.PoolSwapStats{
+			PoolId:        poolId,
+			Volume:        sdk.Coins{},
+			FeesCollected: sdk.DecCoins{},
+		}
+	}
+	stats := types.PoolSwapStats{}
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats
+}
+
+// GetAllPoolSwapStats returns the swap stats of all pools with at least one swap, ordered by pool id.
+func (k Keeper) GetAllPoolSwapStats(ctx sdk.Context) []types.PoolSwapStats {
+	iter := k.iterator(ctx, types.KeyPrefixPoolSwapStats)
+	defer iter.Close()
+
+	allStats := []types.PoolSwapStats{}
+	for ; iter.Valid(); iter.Next() {
+		stats := types.PoolSwapStats{}
+		k.cdc.MustUnmarshal(iter.Value(), &stats)
+		allStats = append(allStats, stats)
+	}
+	return allStats
+}
+
+func (k Keeper) setPoolSwapStats(ctx sdk.Context, stats types.PoolSwapStats) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyPoolSwapStats(stats.PoolId), k.cdc.MustMarshal(&stats))
+}
+
+// recordSwapStats adds a swap of tokenIn charged with the given swap fee to the pool's swap stats.
+func (k Keeper) recordSwapStats(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, swapFee sdk.Dec) {
+	stats := k.GetPoolSwapStats(ctx, poolId)
+	stats.Volume = stats.Volume.Add(tokenIn)
+	stats.FeesCollected = stats.FeesCollected.Add(sdk.NewDecCoinFromDec(tokenIn.Denom, tokenIn.Amount.ToDec().Mul(swapFee)))
+	k.setPoolSwapStats(ctx, stats)
+}
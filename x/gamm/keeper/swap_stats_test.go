@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) TestPoolSwapStats() {
+	suite.SetupTest()
+	swapFee := sdk.NewDecWithPrec(1, 2)
+	balances := sdk.NewCoins(
+		sdk.NewInt64Coin("uosmo", 10000000000),
+		sdk.NewInt64Coin("foo", 100000000),
+		sdk.NewInt64Coin("bar", 100000000),
+	)
+	poolId := suite.prepareCustomBalancerPool(balances, []balancer.PoolAsset{
+		{Token: sdk.NewInt64Coin("foo", 10000000), Weight: sdk.NewInt(1)},
+		{Token: sdk.NewInt64Coin("bar", 10000000), Weight: sdk.NewInt(1)},
+	}, balancer.PoolParams{SwapFee: swapFee, ExitFee: sdk.ZeroDec()})
+
+	// a pool without swaps has empty stats
+	stats := suite.App.GAMMKeeper.GetPoolSwapStats(suite.Ctx, poolId)
+	suite.Require().Equal(poolId, stats.PoolId)
+	suite.Require().True(stats.Volume.Empty())
+	suite.Require().True(stats.FeesCollected.Empty())
+
+	_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, sdk.NewInt64Coin("foo", 100000), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	tokenInAmount, err := suite.App.GAMMKeeper.SwapExactAmountOut(suite.Ctx, suite.TestAccs[0], poolId, "bar", sdk.NewInt(1000000), sdk.NewInt64Coin("foo", 50000))
+	suite.Require().NoError(err)
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, sdk.NewInt64Coin("foo", 20000), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+
+	expectedVolume := sdk.NewCoins(sdk.NewInt64Coin("foo", 120000), sdk.NewCoin("bar", tokenInAmount))
+	expectedFees := sdk.NewDecCoinsFromCoins(expectedVolume...).MulDec(swapFee)
+	stats = suite.App.GAMMKeeper.GetPoolSwapStats(suite.Ctx, poolId)
+	suite.Require().Equal(expectedVolume, stats.Volume)
+	suite.Require().Equal(expectedFees, stats.FeesCollected)
+	suite.Require().Equal([]types.PoolSwapStats{stats}, suite.App.GAMMKeeper.GetAllPoolSwapStats(suite.Ctx))
+
+	volumeRes, err := suite.queryClient.PoolVolume(sdk.WrapSDKContext(suite.Ctx), &types.QueryPoolVolumeRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedVolume, volumeRes.Volume)
+	feesRes, err := suite.queryClient.PoolFeesCollected(sdk.WrapSDKContext(suite.Ctx), &types.QueryPoolFeesCollectedRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedFees, feesRes.FeesCollected)
+
+	// the stats of nonexistent pools can not be queried
+	_, err = suite.queryClient.PoolVolume(sdk.WrapSDKContext(suite.Ctx), &types.QueryPoolVolumeRequest{PoolId: poolId + 1})
+	suite.Require().Error(err)
+	_, err = suite.queryClient.PoolFeesCollected(sdk.WrapSDKContext(suite.Ctx), &types.QueryPoolFeesCollectedRequest{PoolId: poolId + 1})
+	suite.Require().Error(err)
+}
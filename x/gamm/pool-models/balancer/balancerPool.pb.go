@@ -252,6 +252,8 @@ type Pool struct {
 	PoolAssets []PoolAsset `protobuf:"bytes,6,rep,name=pool_assets,json=poolAssets,proto3" json:"pool_assets" yaml:"pool_assets"`
 	// sum of all non-normalized pool weights
 	TotalWeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_weight" yaml:"total_weight"`
+	// they only allow LPs to withdraw their share of the remaining liquidity.
+	Sunset bool `protobuf:"varint,8,opt,name=sunset,proto3" json:"sunset,omitempty" yaml:"sunset"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -298,59 +300,61 @@ func init() {
 }
 
 var fileDescriptor_7e991f749f68c2a4 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x4f, 0xe3, 0x46,
-	0x14, 0x8f, 0x93, 0x40, 0x96, 0xc9, 0x76, 0x2b, 0x66, 0x73, 0x30, 0x41, 0x8d, 0xd1, 0x54, 0xaa,
-	0x56, 0xd5, 0xc6, 0x56, 0x68, 0x4f, 0x7b, 0x59, 0x6d, 0x16, 0x5a, 0x71, 0xa3, 0xa6, 0x12, 0xa5,
-	0x42, 0xb2, 0x26, 0xc9, 0xc4, 0xb6, 0xb0, 0x3d, 0x96, 0x67, 0x12, 0xe0, 0x1b, 0xf4, 0xc8, 0x91,
-	0xde, 0xb8, 0xf7, 0xda, 0x7e, 0x07, 0xd4, 0x5e, 0x38, 0x56, 0x3d, 0xb8, 0x15, 0xf4, 0xd4, 0x63,
-	0x3e, 0x41, 0x35, 0x7f, 0x9c, 0x04, 0x9a, 0xa8, 0xa0, 0x3d, 0x65, 0xde, 0x9b, 0xf7, 0x7e, 0xef,
-	0xf7, 0xde, 0xfb, 0x8d, 0x03, 0xbe, 0xa4, 0x2c, 0xa6, 0x2c, 0x64, 0x8e, 0x8f, 0xe3, 0xd8, 0x49,
-	0x29, 0x8d, 0xda, 0x31, 0x1d, 0x90, 0x88, 0x39, 0x3d, 0x1c, 0xe1, 0xa4, 0x4f, 0xb2, 0xe9, 0x61,
-	0x9f, 0xd2, 0xc8, 0x4e, 0x33, 0xca, 0x29, 0x6c, 0xe8, 0x2c, 0x5b, 0x64, 0xd9, 0xe3, 0x4e, 0x8f,
-	0x70, 0xdc, 0x69, 0x6e, 0xf4, 0xa5, 0xdb, 0x93, 0x31, 0x8e, 0x32, 0x54, 0x42, 0xb3, 0xe1, 0x53,
-	0x9f, 0x2a, 0xbf, 0x38, 0x69, 0x6f, 0xcb, 0xa7, 0xd4, 0x8f, 0x88, 0x23, 0xad, 0xde, 0x68, 0xe8,
-	0x0c, 0x46, 0x19, 0xe6, 0x21, 0x4d, 0xf4, 0xbd, 0xf5, 0xf0, 0x9e, 0x87, 0x31, 0x61, 0x1c, 0xc7,
-	0x69, 0x01, 0xa0, 0x8a, 0x38, 0x78, 0xc4, 0x03, 0x47, 0xd3, 0x90, 0xc6, 0x83, 0xfb, 0x1e, 0x66,
-	0x64, 0x7a, 0xdf, 0xa7, 0xa1, 0x2e, 0x80, 0x7e, 0xab, 0x00, 0xf3, 0x20, 0xa6, 0x94, 0x07, 0x87,
-	0x24, 0xf4, 0x03, 0xfe, 0x3e, 0xc0, 0x89, 0x4f, 0xf6, 0x71, 0x86, 0x63, 0x06, 0xbf, 0x03, 0x80,
-	0x71, 0x9c, 0x71, 0x4f, 0x54, 0x35, 0x8d, 0x2d, 0xe3, 0x55, 0x7d, 0xbb, 0x69, 0x2b, 0x4a, 0x76,
-	0x41, 0xc9, 0xfe, 0xb6, 0xa0, 0xd4, 0xfd, 0xe4, 0x3a, 0xb7, 0x4a, 0x93, 0xdc, 0x5a, 0x3f, 0xc7,
-	0x71, 0xf4, 0x06, 0xcd, 0x72, 0xd1, 0xc5, 0x9f, 0x96, 0xe1, 0xae, 0x49, 0x87, 0x08, 0x87, 0x01,
-	0x78, 0x56, 0x74, 0x6a, 0x96, 0x25, 0xee, 0xc6, 0x7f, 0x70, 0x77, 0x74, 0x40, 0xb7, 0x23, 0x60,
-	0xff, 0xc9, 0x2d, 0x58, 0xa4, 0xbc, 0xa6, 0x71, 0xc8, 0x49, 0x9c, 0xf2, 0xf3, 0x49, 0x6e, 0x7d,
-	0xac, 0x8a, 0x15, 0x77, 0xe8, 0x52, 0x94, 0x9a, 0xa2, 0xc3, 0x31, 0x68, 0x84, 0x49, 0xc8, 0x43,
-	0x1c, 0x79, 0x62, 0xb7, 0xde, 0xa9, 0x6c, 0x93, 0x99, 0x95, 0xad, 0xca, 0xab, 0xfa, 0xb6, 0x65,
-	0x2f, 0xda, 0xa3, 0x2d, 0x16, 0xfd, 0x8e, 0x31, 0xc2, 0xbb, 0x9f, 0xea, 0x96, 0x36, 0x55, 0x95,
-	0x45, 0x50, 0xc8, 0x85, 0xda, 0x2d, 0xd2, 0xd4, 0x18, 0x19, 0x64, 0xe0, 0x25, 0xc7, 0x99, 0x4f,
-	0xf8, 0xfd, 0xb2, 0xd5, 0xc7, 0x95, 0x45, 0xba, 0x6c, 0x53, 0x95, 0x5d, 0x80, 0x84, 0xdc, 0x75,
-	0xe5, 0x9d, 0x2b, 0x8a, 0xfe, 0x2e, 0x03, 0x20, 0x6c, 0xbd, 0xbf, 0x63, 0xf0, 0x8c, 0x9d, 0xe2,
-	0xd4, 0x1b, 0x12, 0xb5, 0xbd, 0xb5, 0xee, 0x3b, 0x81, 0xfb, 0x47, 0x6e, 0x7d, 0xe6, 0x87, 0x3c,
-	0x18, 0xf5, 0xec, 0x3e, 0x8d, 0xb5, 0x4c, 0xf5, 0x4f, 0x9b, 0x0d, 0x4e, 0x1c, 0x7e, 0x9e, 0x12,
-	0x66, 0xef, 0x90, 0xfe, 0x6c, 0xbc, 0x05, 0x0e, 0x72, 0x6b, 0xe2, 0xf8, 0x15, 0x21, 0x02, 0x9d,
-	0x9c, 0x85, 0x5c, 0xa2, 0x97, 0x3f, 0x0c, 0xbd, 0xc0, 0x41, 0x6e, 0x4d, 0x1c, 0x05, 0xfa, 0x8f,
-	0x06, 0xd8, 0x64, 0x52, 0x98, 0xba, 0x63, 0xaf, 0x2f, 0xa5, 0xe9, 0xa5, 0xb2, 0x37, 0xb3, 0x22,
-	0x55, 0x63, 0x2f, 0x1e, 0xe4, 0x32, 0x45, 0x77, 0x3f, 0xbf, 0xce, 0x2d, 0x63, 0x92, 0x5b, 0x48,
-	0x77, 0xb5, 0xbc, 0x00, 0x72, 0x4d, 0xb6, 0x04, 0x05, 0xfd, 0x64, 0x80, 0xb5, 0xe9, 0xae, 0xe0,
-	0x2e, 0x58, 0xe1, 0xf4, 0x84, 0x24, 0xfa, 0x81, 0x6c, 0xd8, 0xfa, 0xdd, 0x8b, 0x27, 0x37, 0x65,
-	0xf4, 0x9e, 0x86, 0x49, 0xb7, 0xa1, 0xb7, 0xfa, 0x5c, 0x6f, 0x55, 0x64, 0x21, 0x57, 0x65, 0xc3,
-	0x43, 0xb0, 0xaa, 0x78, 0xe8, 0x61, 0xbe, 0x7d, 0xc2, 0x30, 0xf7, 0x12, 0x3e, 0xc9, 0xad, 0x8f,
-	0x14, 0xac, 0x42, 0x41, 0xae, 0x86, 0x43, 0xbf, 0x54, 0x41, 0x55, 0xb0, 0x85, 0xaf, 0x41, 0x0d,
-	0x0f, 0x06, 0x19, 0x61, 0x4c, 0xab, 0x01, 0x4e, 0x72, 0xeb, 0x85, 0x4a, 0xd2, 0x17, 0xc8, 0x2d,
-	0x42, 0xe0, 0x0b, 0x50, 0x0e, 0x07, 0x92, 0x4b, 0xd5, 0x2d, 0x87, 0x03, 0x38, 0x04, 0x75, 0xa9,
-	0xbf, 0x7b, 0xf3, 0xdf, 0x5a, 0x2e, 0x64, 0x3d, 0xf1, 0x07, 0x0f, 0xa8, 0xf8, 0x94, 0x7a, 0x73,
-	0x58, 0xc8, 0x05, 0xe9, 0x4c, 0xb4, 0xdf, 0x80, 0xc6, 0x70, 0xc4, 0x47, 0x19, 0x51, 0x21, 0x3e,
-	0x1d, 0x93, 0x2c, 0xa1, 0x99, 0x59, 0x95, 0x94, 0xad, 0x19, 0xd4, 0xa2, 0x28, 0xe4, 0x42, 0xe5,
-	0x16, 0x0c, 0xbe, 0xd6, 0x4e, 0x78, 0x04, 0x9e, 0x73, 0xca, 0x71, 0xe4, 0xb1, 0x00, 0x67, 0x84,
-	0x99, 0x2b, 0xff, 0xb7, 0xa8, 0x4d, 0x4d, 0xfa, 0x65, 0xb1, 0xa8, 0x59, 0x32, 0x72, 0xeb, 0xd2,
-	0x3c, 0x90, 0x16, 0x3c, 0xd6, 0x53, 0xc1, 0x42, 0x0a, 0xcc, 0x5c, 0x7d, 0xdc, 0xf3, 0x6e, 0x6a,
-	0x7c, 0xa8, 0xf0, 0xe7, 0x10, 0xf4, 0x2c, 0x64, 0x18, 0x83, 0x41, 0x41, 0x5c, 0x2b, 0xa3, 0x26,
-	0x67, 0xb0, 0xfb, 0x64, 0x65, 0xdc, 0xeb, 0xa3, 0xd0, 0x87, 0xea, 0x43, 0xc9, 0xfb, 0xcd, 0xfa,
-	0x0f, 0x57, 0x56, 0xe9, 0xf2, 0xca, 0x2a, 0xfd, 0xfa, 0x73, 0x7b, 0x45, 0x10, 0xdd, 0xeb, 0x1e,
-	0x5d, 0xdf, 0xb6, 0x8c, 0x9b, 0xdb, 0x96, 0xf1, 0xd7, 0x6d, 0xcb, 0xb8, 0xb8, 0x6b, 0x95, 0x6e,
-	0xee, 0x5a, 0xa5, 0xdf, 0xef, 0x5a, 0xa5, 0xef, 0xdf, 0xce, 0x15, 0xd6, 0x9d, 0xb6, 0x23, 0xdc,
-	0x63, 0x85, 0xe1, 0x8c, 0x3b, 0xdb, 0xce, 0xd9, 0xf2, 0x3f, 0xd4, 0xde, 0xaa, 0xfc, 0xc8, 0x7f,
-	0xf1, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82, 0xad, 0xdb, 0x39, 0x7c, 0x07, 0x00, 0x00,
+	// 850 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x8f, 0x93, 0x6c, 0x92, 0x9d, 0x94, 0xa2, 0x4c, 0x73, 0xf0, 0x66, 0x45, 0x1c, 0x0d, 0x12,
+	0x5a, 0x50, 0x63, 0x2b, 0x0b, 0xa7, 0x5e, 0xaa, 0xba, 0x2d, 0xa8, 0xb7, 0xe2, 0x22, 0x95, 0xa2,
+	0x4a, 0xd6, 0x24, 0x99, 0xd8, 0x56, 0x6d, 0x8f, 0xe5, 0x99, 0xa4, 0xdd, 0x6f, 0xc0, 0xb1, 0xc7,
+	0x72, 0xeb, 0x9d, 0x2b, 0x1f, 0x62, 0x05, 0x97, 0x1e, 0x11, 0x07, 0xb3, 0xda, 0xe5, 0xc4, 0x31,
+	0x9f, 0x00, 0xcd, 0x1f, 0x27, 0xd9, 0x25, 0x11, 0xbb, 0xe2, 0x94, 0x79, 0x6f, 0xde, 0xfb, 0xbd,
+	0xdf, 0x7b, 0xef, 0x37, 0x31, 0xf8, 0x8a, 0xb2, 0x84, 0xb2, 0x88, 0x39, 0x01, 0x4e, 0x12, 0x27,
+	0xa3, 0x34, 0x1e, 0x26, 0x74, 0x4a, 0x62, 0xe6, 0x8c, 0x71, 0x8c, 0xd3, 0x09, 0xc9, 0x57, 0x87,
+	0xa7, 0x94, 0xc6, 0x76, 0x96, 0x53, 0x4e, 0x61, 0x57, 0x67, 0xd9, 0x22, 0xcb, 0x5e, 0x8c, 0xc6,
+	0x84, 0xe3, 0x51, 0xef, 0x60, 0x22, 0xdd, 0xbe, 0x8c, 0x71, 0x94, 0xa1, 0x12, 0x7a, 0xdd, 0x80,
+	0x06, 0x54, 0xf9, 0xc5, 0x49, 0x7b, 0xfb, 0x01, 0xa5, 0x41, 0x4c, 0x1c, 0x69, 0x8d, 0xe7, 0x33,
+	0x67, 0x3a, 0xcf, 0x31, 0x8f, 0x68, 0xaa, 0xef, 0xad, 0xab, 0xf7, 0x3c, 0x4a, 0x08, 0xe3, 0x38,
+	0xc9, 0x4a, 0x00, 0x55, 0xc4, 0xc1, 0x73, 0x1e, 0x3a, 0x9a, 0x86, 0x34, 0xae, 0xdc, 0x8f, 0x31,
+	0x23, 0xab, 0xfb, 0x09, 0x8d, 0x74, 0x01, 0xf4, 0x5b, 0x0d, 0x98, 0xcf, 0x12, 0x4a, 0x79, 0xf8,
+	0x9c, 0x44, 0x41, 0xc8, 0x1f, 0x86, 0x38, 0x0d, 0xc8, 0x53, 0x9c, 0xe3, 0x84, 0xc1, 0xef, 0x01,
+	0x60, 0x1c, 0xe7, 0xdc, 0x17, 0x55, 0x4d, 0x63, 0x60, 0x1c, 0xb5, 0x8f, 0x7b, 0xb6, 0xa2, 0x64,
+	0x97, 0x94, 0xec, 0xef, 0x4a, 0x4a, 0xee, 0x27, 0xa7, 0x85, 0x55, 0x59, 0x16, 0x56, 0xe7, 0x04,
+	0x27, 0xf1, 0x3d, 0xb4, 0xce, 0x45, 0x6f, 0xff, 0xb4, 0x0c, 0x6f, 0x5f, 0x3a, 0x44, 0x38, 0x0c,
+	0x41, 0xab, 0xec, 0xd4, 0xac, 0x4a, 0xdc, 0x83, 0x7f, 0xe1, 0x3e, 0xd2, 0x01, 0xee, 0x48, 0xc0,
+	0xfe, 0x5d, 0x58, 0xb0, 0x4c, 0xb9, 0x4b, 0x93, 0x88, 0x93, 0x24, 0xe3, 0x27, 0xcb, 0xc2, 0xfa,
+	0x58, 0x15, 0x2b, 0xef, 0xd0, 0x3b, 0x51, 0x6a, 0x85, 0x0e, 0x17, 0xa0, 0x1b, 0xa5, 0x11, 0x8f,
+	0x70, 0xec, 0x8b, 0xdd, 0xfa, 0xaf, 0x65, 0x9b, 0xcc, 0xac, 0x0d, 0x6a, 0x47, 0xed, 0x63, 0xcb,
+	0xde, 0xb6, 0x47, 0x5b, 0x2c, 0xfa, 0x01, 0x63, 0x84, 0xbb, 0x9f, 0xea, 0x96, 0x0e, 0x55, 0x95,
+	0x6d, 0x50, 0xc8, 0x83, 0xda, 0x2d, 0xd2, 0xd4, 0x18, 0x19, 0x64, 0xe0, 0x0e, 0xc7, 0x79, 0x40,
+	0xf8, 0xe5, 0xb2, 0xf5, 0xeb, 0x95, 0x45, 0xba, 0x6c, 0x4f, 0x95, 0xdd, 0x82, 0x84, 0xbc, 0x8e,
+	0xf2, 0x6e, 0x14, 0x45, 0x7f, 0x55, 0x01, 0x10, 0xb6, 0xde, 0xdf, 0x4b, 0xd0, 0x62, 0xaf, 0x71,
+	0xe6, 0xcf, 0x88, 0xda, 0xde, 0xbe, 0xfb, 0x40, 0xe0, 0xfe, 0x51, 0x58, 0x9f, 0x05, 0x11, 0x0f,
+	0xe7, 0x63, 0x7b, 0x42, 0x13, 0x2d, 0x53, 0xfd, 0x33, 0x64, 0xd3, 0x57, 0x0e, 0x3f, 0xc9, 0x08,
+	0xb3, 0x1f, 0x91, 0xc9, 0x7a, 0xbc, 0x25, 0x0e, 0xf2, 0x9a, 0xe2, 0xf8, 0x35, 0x21, 0x02, 0x9d,
+	0xbc, 0x89, 0xb8, 0x44, 0xaf, 0xfe, 0x3f, 0xf4, 0x12, 0x07, 0x79, 0x4d, 0x71, 0x14, 0xe8, 0x3f,
+	0x19, 0xe0, 0x90, 0x49, 0x61, 0xea, 0x8e, 0xfd, 0x89, 0x94, 0xa6, 0x9f, 0xc9, 0xde, 0xcc, 0x9a,
+	0x54, 0x8d, 0xbd, 0x7d, 0x90, 0xbb, 0x14, 0xed, 0x7e, 0x71, 0x5a, 0x58, 0xc6, 0xb2, 0xb0, 0x90,
+	0xee, 0x6a, 0x77, 0x01, 0xe4, 0x99, 0x6c, 0x07, 0x0a, 0xfa, 0xd9, 0x00, 0xfb, 0xab, 0x5d, 0xc1,
+	0xc7, 0x60, 0x8f, 0xd3, 0x57, 0x24, 0xd5, 0x0f, 0xe4, 0xc0, 0xd6, 0xef, 0x5e, 0x3c, 0xb9, 0x15,
+	0xa3, 0x87, 0x34, 0x4a, 0xdd, 0xae, 0xde, 0xea, 0x2d, 0xbd, 0x55, 0x91, 0x85, 0x3c, 0x95, 0x0d,
+	0x9f, 0x83, 0x86, 0xe2, 0xa1, 0x87, 0x79, 0xff, 0x06, 0xc3, 0x7c, 0x92, 0xf2, 0x65, 0x61, 0x7d,
+	0xa4, 0x60, 0x15, 0x0a, 0xf2, 0x34, 0x1c, 0x3a, 0xab, 0x83, 0xba, 0x60, 0x0b, 0xef, 0x82, 0x26,
+	0x9e, 0x4e, 0x73, 0xc2, 0x98, 0x56, 0x03, 0x5c, 0x16, 0xd6, 0x6d, 0x95, 0xa4, 0x2f, 0x90, 0x57,
+	0x86, 0xc0, 0xdb, 0xa0, 0x1a, 0x4d, 0x25, 0x97, 0xba, 0x57, 0x8d, 0xa6, 0x70, 0x06, 0xda, 0x52,
+	0x7f, 0x97, 0xe6, 0x3f, 0xd8, 0x2d, 0x64, 0x3d, 0xf1, 0x2b, 0x0f, 0xa8, 0xfc, 0x2b, 0xf5, 0x37,
+	0xb0, 0x90, 0x07, 0xb2, 0xb5, 0x68, 0xbf, 0x05, 0xdd, 0xd9, 0x9c, 0xcf, 0x73, 0xa2, 0x42, 0x02,
+	0xba, 0x20, 0x79, 0x4a, 0x73, 0xb3, 0x2e, 0x29, 0x5b, 0x6b, 0xa8, 0x6d, 0x51, 0xc8, 0x83, 0xca,
+	0x2d, 0x18, 0x7c, 0xa3, 0x9d, 0xf0, 0x05, 0xb8, 0xc5, 0x29, 0xc7, 0xb1, 0xcf, 0x42, 0x9c, 0x13,
+	0x66, 0xee, 0xfd, 0xd7, 0xa2, 0x0e, 0x35, 0xe9, 0x3b, 0xe5, 0xa2, 0xd6, 0xc9, 0xc8, 0x6b, 0x4b,
+	0xf3, 0x99, 0xb4, 0xe0, 0x4b, 0x3d, 0x15, 0x2c, 0xa4, 0xc0, 0xcc, 0xc6, 0xf5, 0x9e, 0x77, 0x4f,
+	0xe3, 0x43, 0x85, 0xbf, 0x81, 0xa0, 0x67, 0x21, 0xc3, 0x18, 0x0c, 0x4b, 0xe2, 0x5a, 0x19, 0x4d,
+	0x39, 0x83, 0xc7, 0x37, 0x56, 0xc6, 0xa5, 0x3e, 0x4a, 0x7d, 0xa8, 0x3e, 0x94, 0xbc, 0xe1, 0xe7,
+	0xa0, 0xc1, 0xe6, 0x29, 0x23, 0xdc, 0x6c, 0x0d, 0x8c, 0xa3, 0x96, 0xdb, 0x59, 0xeb, 0x49, 0xf9,
+	0x91, 0xa7, 0x03, 0xee, 0x75, 0x7e, 0x7c, 0x6f, 0x55, 0xde, 0xbd, 0xb7, 0x2a, 0xbf, 0xfe, 0x32,
+	0xdc, 0x13, 0x3d, 0x3d, 0x71, 0x5f, 0x9c, 0x9e, 0xf7, 0x8d, 0x0f, 0xe7, 0x7d, 0xe3, 0xec, 0xbc,
+	0x6f, 0xbc, 0xbd, 0xe8, 0x57, 0x3e, 0x5c, 0xf4, 0x2b, 0xbf, 0x5f, 0xf4, 0x2b, 0x3f, 0xdc, 0xdf,
+	0xe0, 0xa8, 0x87, 0x32, 0x8c, 0xf1, 0x98, 0x95, 0x86, 0xb3, 0x18, 0x1d, 0x3b, 0x6f, 0x76, 0x7f,
+	0x7b, 0xc7, 0x0d, 0xf9, 0x3d, 0xf8, 0xf2, 0x9f, 0x01, 0x00, 0x94, 0xbe, 0x56, 0xc9, 0xa7, 0x07,
+	0x00, 0x00,
 }
 
 func (m *SmoothWeightChangeParams) Marshal() (dAtA []byte, err error) {
@@ -538,6 +542,16 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Sunset {
+		i--
+		if m.Sunset {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size := m.TotalWeight.Size()
 		i -= size
@@ -699,6 +713,9 @@ func (m *Pool) Size() (n int) {
 	}
 	l = m.TotalWeight.Size()
 	n += 1 + l + sovBalancerPool(uint64(l))
+	if m.Sunset {
+		n += 2
+	}
 	return n
 }
 
@@ -1409,6 +1426,26 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sunset", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sunset = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBalancerPool(dAtA[iNdEx:])
@@ -14,6 +14,7 @@ type balancerPoolPretty struct {
 	TotalWeight        sdk.Dec        `json:"total_weight" yaml:"total_weight"`
 	TotalShares        sdk.Coin       `json:"total_shares" yaml:"total_shares"`
 	PoolAssets         []PoolAsset    `json:"pool_assets" yaml:"pool_assets"`
+	Sunset             bool           `json:"sunset,omitempty" yaml:"sunset,omitempty"`
 }
 
 func (p Pool) String() string {
@@ -41,6 +42,7 @@ func (p Pool) MarshalJSON() ([]byte, error) {
 		TotalWeight:        decTotalWeight,
 		TotalShares:        p.TotalShares,
 		PoolAssets:         p.PoolAssets,
+		Sunset:             p.Sunset,
 	})
 }
 
@@ -58,6 +60,7 @@ func (p *Pool) UnmarshalJSON(bz []byte) error {
 	p.TotalWeight = alias.TotalWeight.RoundInt()
 	p.TotalShares = alias.TotalShares
 	p.PoolAssets = alias.PoolAssets
+	p.Sunset = alias.Sunset
 
 	return nil
 }
@@ -27,9 +27,11 @@ const (
 )
 
 var (
-	_ types.PoolI                  = &Pool{}
-	_ types.PoolAmountOutExtension = &Pool{}
-	_ types.WeightedPoolExtension  = &Pool{}
+	_ types.PoolI                        = &Pool{}
+	_ types.PoolAmountOutExtension       = &Pool{}
+	_ types.WeightedPoolExtension        = &Pool{}
+	_ types.SwapFeeSettablePoolExtension = &Pool{}
+	_ types.ProtocolRevenuePoolExtension = &Pool{}
 )
 
 // NewPool returns a weighted CPMM pool with the provided parameters, and initial assets.
@@ -90,6 +92,10 @@ func (p Pool) GetSwapFee(_ sdk.Context) sdk.Dec {
 	return p.PoolParams.SwapFee
 }
 
+func (p *Pool) SetSwapFee(swapFee sdk.Dec) {
+	p.PoolParams.SwapFee = swapFee
+}
+
 func (p Pool) GetTotalPoolLiquidity(_ sdk.Context) sdk.Coins {
 	return poolAssetsCoins(p.PoolAssets)
 }
@@ -340,6 +346,23 @@ func (p *Pool) addToPoolAssetBalances(coins sdk.Coins) error {
 	return nil
 }
 
+// RemoveLiquidity removes coins from the pool's assets without burning any shares.
+// Errors if a coin is not in the pool, or the pool would be left without any of it.
+func (p *Pool) RemoveLiquidity(coins sdk.Coins) error {
+	for _, coin := range coins {
+		i, poolAsset, err := p.getPoolAssetAndIndex(coin.Denom)
+		if err != nil {
+			return err
+		}
+		if coin.Amount.GTE(poolAsset.Token.Amount) {
+			return fmt.Errorf("can't remove all the pool's liquidity of %s", coin.Denom)
+		}
+		poolAsset.Token.Amount = poolAsset.Token.Amount.Sub(coin.Amount)
+		p.PoolAssets[i] = poolAsset
+	}
+	return nil
+}
+
 func (p Pool) GetPoolAssets(denoms ...string) ([]PoolAsset, error) {
 	result := make([]PoolAsset, 0, len(denoms))
 
@@ -476,8 +499,14 @@ func (p Pool) NumAssets() int {
 	return len(p.PoolAssets)
 }
 
+// IsActive returns false once the pool has been sunset.
 func (p Pool) IsActive(ctx sdk.Context) bool {
-	return true
+	return !p.Sunset
+}
+
+// SetSunset marks the pool as sunset, disabling swaps and joins on it.
+func (p *Pool) SetSunset() {
+	p.Sunset = true
 }
 
 // CalcOutAmtGivenIn calculates tokens to be swapped out given the provided
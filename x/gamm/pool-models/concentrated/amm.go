@@ -0,0 +1,338 @@
+package concentrated
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// swapStep is the result of swapping within the liquidity of a single range
+// of ticks.
+type swapStep struct {
+	sqrtPriceNext sdk.Dec
+	amountIn      sdk.Dec
+	amountOut     sdk.Dec
+	// crossed is true if the step moved the price to the next initialized tick.
+	crossed bool
+}
+
+// SwapOutAmtGivenIn swaps tokenIn against the pool's liquidity, crossing as many
+// ticks as needed, and returns the amount of tokenOutDenom swapped out.
+func (p *Pool) SwapOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	if len(tokenIn) != 1 {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrNotSingleSwapToken, "concentrated liquidity pools swap one token in at a time, got %s", tokenIn)
+	}
+	zeroForOne, err := p.swapDirection(tokenIn[0].Denom, tokenOutDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	amountRemaining := tokenIn[0].Amount.ToDec()
+	amountOut := sdk.ZeroDec()
+	for amountRemaining.IsPositive() {
+		sqrtPriceTarget, liquidity, err := p.nextStepTarget(ctx, zeroForOne)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		// the fee is charged on the amount in of every step, and only the remainder
+		// moves the price.
+		amountAvailable := amountRemaining.Mul(sdk.OneDec().Sub(swapFee))
+		step := computeSwapStepExactIn(p.CurrentSqrtPrice, sqrtPriceTarget, liquidity, amountAvailable, zeroForOne)
+
+		feeAmount := amountRemaining.Sub(amountAvailable)
+		if step.crossed {
+			feeAmount = step.amountIn.Mul(swapFee).Quo(sdk.OneDec().Sub(swapFee))
+		}
+		amountRemaining = sdk.MaxDec(amountRemaining.Sub(step.amountIn).Sub(feeAmount), sdk.ZeroDec())
+		amountOut = amountOut.Add(step.amountOut)
+		p.applySwapStep(ctx, step, feeAmount, zeroForOne)
+	}
+
+	tokenOut = sdk.NewCoin(tokenOutDenom, amountOut.TruncateInt())
+	if !tokenOut.Amount.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
+	}
+
+	p.PoolLiquidity = p.PoolLiquidity.Add(tokenIn...)
+	newLiquidity, negative := p.PoolLiquidity.SafeSub(sdk.NewCoins(tokenOut))
+	if negative {
+		return sdk.Coin{}, types.ErrTooManyTokensOut
+	}
+	p.PoolLiquidity = newLiquidity
+	return tokenOut, nil
+}
+
+// CalcOutAmtGivenIn returns the amount SwapOutAmtGivenIn would swap out,
+// without mutating the pool.
+func (p Pool) CalcOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	cacheCtx, _ := ctx.CacheContext()
+	pool := p.clone()
+	return pool.SwapOutAmtGivenIn(cacheCtx, tokenIn, tokenOutDenom, swapFee)
+}
+
+// SwapInAmtGivenOut swaps exactly enough of tokenInDenom against the pool's
+// liquidity to get tokenOut out, and returns the amount swapped in.
+func (p *Pool) SwapInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	if len(tokenOut) != 1 {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrNotSingleSwapToken, "concentrated liquidity pools swap one token out at a time, got %s", tokenOut)
+	}
+	zeroForOne, err := p.swapDirection(tokenInDenom, tokenOut[0].Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if tokenOut[0].Amount.GTE(p.PoolLiquidity.AmountOf(tokenOut[0].Denom)) {
+		return sdk.Coin{}, types.ErrTooManyTokensOut
+	}
+
+	amountRemaining := tokenOut[0].Amount.ToDec()
+	amountIn := sdk.ZeroDec()
+	for amountRemaining.IsPositive() {
+		sqrtPriceTarget, liquidity, err := p.nextStepTarget(ctx, zeroForOne)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		step := computeSwapStepExactOut(p.CurrentSqrtPrice, sqrtPriceTarget, liquidity, amountRemaining, zeroForOne)
+		feeAmount := step.amountIn.Mul(swapFee).Quo(sdk.OneDec().Sub(swapFee))
+		amountRemaining = sdk.MaxDec(amountRemaining.Sub(step.amountOut), sdk.ZeroDec())
+		amountIn = amountIn.Add(step.amountIn).Add(feeAmount)
+		p.applySwapStep(ctx, step, feeAmount, zeroForOne)
+	}
+
+	tokenIn = sdk.NewCoin(tokenInDenom, amountIn.Ceil().TruncateInt())
+	if !tokenIn.Amount.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
+	}
+
+	p.PoolLiquidity = p.PoolLiquidity.Add(tokenIn).Sub(tokenOut)
+	return tokenIn, nil
+}
+
+// CalcInAmtGivenOut returns the amount SwapInAmtGivenOut would swap in,
+// without mutating the pool.
+func (p Pool) CalcInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	cacheCtx, _ := ctx.CacheContext()
+	pool := p.clone()
+	return pool.SwapInAmtGivenOut(cacheCtx, tokenOut, tokenInDenom, swapFee)
+}
+
+// swapDirection returns whether token0 is swapped for token1.
+func (p Pool) swapDirection(tokenInDenom, tokenOutDenom string) (bool, error) {
+	switch {
+	case tokenInDenom == p.Token0 && tokenOutDenom == p.Token1:
+		return true, nil
+	case tokenInDenom == p.Token1 && tokenOutDenom == p.Token0:
+		return false, nil
+	default:
+		return false, sdkerrors.Wrapf(types.ErrDenomNotFoundInPool,
+			"pool %d does not trade %s for %s", p.Id, tokenInDenom, tokenOutDenom)
+	}
+}
+
+// nextStepTarget returns the square root price of the next initialized tick in
+// the swap direction, and the liquidity active until that tick.
+func (p Pool) nextStepTarget(ctx sdk.Context, zeroForOne bool) (sdk.Dec, sdk.Dec, error) {
+	nextTick, found := p.nextInitializedTick(ctx, zeroForOne)
+	if !found {
+		return sdk.Dec{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrNotEnoughLiquidity, "pool %d", p.Id)
+	}
+	return mustTickToSqrtPrice(nextTick), p.Liquidity, nil
+}
+
+// applySwapStep moves the pool's price as computed by the step, credits the
+// step's fee to the active liquidity and crosses the next tick if reached.
+func (p *Pool) applySwapStep(ctx sdk.Context, step swapStep, feeAmount sdk.Dec, zeroForOne bool) {
+	if p.Liquidity.IsPositive() {
+		feeGrowth := feeAmount.QuoTruncate(p.Liquidity)
+		if zeroForOne {
+			p.FeeGrowthGlobal0 = p.FeeGrowthGlobal0.Add(feeGrowth)
+		} else {
+			p.FeeGrowthGlobal1 = p.FeeGrowthGlobal1.Add(feeGrowth)
+		}
+	}
+
+	nextTick, _ := p.nextInitializedTick(ctx, zeroForOne)
+	p.CurrentSqrtPrice = step.sqrtPriceNext
+	switch {
+	case step.crossed && zeroForOne:
+		p.Liquidity = p.Liquidity.Sub(p.crossTick(ctx, nextTick))
+		p.CurrentTick = nextTick - 1
+	case step.crossed:
+		p.Liquidity = p.Liquidity.Add(p.crossTick(ctx, nextTick))
+		p.CurrentTick = nextTick
+	default:
+		// the price stays within the current range, so the tick is kept in it
+		// regardless of rounding.
+		tick, err := SqrtPriceToTick(step.sqrtPriceNext)
+		if err != nil {
+			tick = p.CurrentTick
+		}
+		if zeroForOne {
+			p.CurrentTick = maxInt64(tick, nextTick)
+		} else {
+			p.CurrentTick = minInt64(tick, nextTick-1)
+		}
+	}
+}
+
+// computeSwapStepExactIn swaps up to amountAvailable within the liquidity, not
+// moving the price past sqrtPriceTarget.
+func computeSwapStepExactIn(sqrtPrice, sqrtPriceTarget, liquidity, amountAvailable sdk.Dec, zeroForOne bool) swapStep {
+	if zeroForOne {
+		amountToTarget := calcAmount0Delta(liquidity, sqrtPriceTarget, sqrtPrice, true)
+		if amountAvailable.GTE(amountToTarget) {
+			return swapStep{
+				sqrtPriceNext: sqrtPriceTarget,
+				amountIn:      amountToTarget,
+				amountOut:     calcAmount1Delta(liquidity, sqrtPriceTarget, sqrtPrice, false),
+				crossed:       true,
+			}
+		}
+		sqrtPriceNext := nextSqrtPriceFromAmount0In(sqrtPrice, liquidity, amountAvailable)
+		return swapStep{
+			sqrtPriceNext: sqrtPriceNext,
+			amountIn:      amountAvailable,
+			amountOut:     calcAmount1Delta(liquidity, sqrtPriceNext, sqrtPrice, false),
+		}
+	}
+
+	amountToTarget := calcAmount1Delta(liquidity, sqrtPrice, sqrtPriceTarget, true)
+	if amountAvailable.GTE(amountToTarget) {
+		return swapStep{
+			sqrtPriceNext: sqrtPriceTarget,
+			amountIn:      amountToTarget,
+			amountOut:     calcAmount0Delta(liquidity, sqrtPrice, sqrtPriceTarget, false),
+			crossed:       true,
+		}
+	}
+	sqrtPriceNext := nextSqrtPriceFromAmount1In(sqrtPrice, liquidity, amountAvailable)
+	return swapStep{
+		sqrtPriceNext: sqrtPriceNext,
+		amountIn:      amountAvailable,
+		amountOut:     calcAmount0Delta(liquidity, sqrtPrice, sqrtPriceNext, false),
+	}
+}
+
+// computeSwapStepExactOut swaps up to amountRemaining out of the liquidity, not
+// moving the price past sqrtPriceTarget.
+func computeSwapStepExactOut(sqrtPrice, sqrtPriceTarget, liquidity, amountRemaining sdk.Dec, zeroForOne bool) swapStep {
+	if zeroForOne {
+		amountToTarget := calcAmount1Delta(liquidity, sqrtPriceTarget, sqrtPrice, false)
+		if amountRemaining.GTE(amountToTarget) {
+			return swapStep{
+				sqrtPriceNext: sqrtPriceTarget,
+				amountIn:      calcAmount0Delta(liquidity, sqrtPriceTarget, sqrtPrice, true),
+				amountOut:     amountToTarget,
+				crossed:       true,
+			}
+		}
+		sqrtPriceNext := nextSqrtPriceFromAmount1Out(sqrtPrice, liquidity, amountRemaining)
+		return swapStep{
+			sqrtPriceNext: sqrtPriceNext,
+			amountIn:      calcAmount0Delta(liquidity, sqrtPriceNext, sqrtPrice, true),
+			amountOut:     amountRemaining,
+		}
+	}
+
+	amountToTarget := calcAmount0Delta(liquidity, sqrtPrice, sqrtPriceTarget, false)
+	if amountRemaining.GTE(amountToTarget) {
+		return swapStep{
+			sqrtPriceNext: sqrtPriceTarget,
+			amountIn:      calcAmount1Delta(liquidity, sqrtPrice, sqrtPriceTarget, true),
+			amountOut:     amountToTarget,
+			crossed:       true,
+		}
+	}
+	sqrtPriceNext := nextSqrtPriceFromAmount0Out(sqrtPrice, liquidity, amountRemaining)
+	return swapStep{
+		sqrtPriceNext: sqrtPriceNext,
+		amountIn:      calcAmount1Delta(liquidity, sqrtPrice, sqrtPriceNext, true),
+		amountOut:     amountRemaining,
+	}
+}
+
+// JoinPool is not supported, liquidity is provided through positions instead.
+func (p *Pool) JoinPool(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, err error) {
+	return sdk.Int{}, sdkerrors.Wrap(types.ErrNotImplemented, "create a position to provide liquidity to a concentrated liquidity pool")
+}
+
+// JoinPoolNoSwap is not supported, liquidity is provided through positions instead.
+func (p *Pool) JoinPoolNoSwap(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, err error) {
+	return sdk.Int{}, sdkerrors.Wrap(types.ErrNotImplemented, "create a position to provide liquidity to a concentrated liquidity pool")
+}
+
+// CalcJoinPoolShares is not supported, liquidity is provided through positions instead.
+func (p Pool) CalcJoinPoolShares(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, newLiquidity sdk.Coins, err error) {
+	return sdk.Int{}, nil, sdkerrors.Wrap(types.ErrNotImplemented, "create a position to provide liquidity to a concentrated liquidity pool")
+}
+
+// CalcJoinPoolNoSwapShares is not supported, liquidity is provided through positions instead.
+func (p Pool) CalcJoinPoolNoSwapShares(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, newLiquidity sdk.Coins, err error) {
+	return sdk.Int{}, nil, sdkerrors.Wrap(types.ErrNotImplemented, "create a position to provide liquidity to a concentrated liquidity pool")
+}
+
+// ExitPool exits LP shares from the full range position created with the pool,
+// returning the shares' part of its liquidity and of its uncollected fees.
+// The last shares exit the whole position without an exit fee, as there is no LP
+// left to be paid the fee.
+func (p *Pool) ExitPool(ctx sdk.Context, exitingShares sdk.Int, exitFee sdk.Dec) (exitingCoins sdk.Coins, err error) {
+	if exitingShares.GT(p.TotalShares.Amount) {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "cannot exit more shares than the pool has")
+	}
+
+	lowerTick, upperTick := fullRangeTicks(p.TickSpacing)
+	position, found := p.getPosition(ctx, p.Address, lowerTick, upperTick)
+	if !found {
+		return sdk.Coins{}, positionNotFoundError(p.Address, lowerTick, upperTick)
+	}
+
+	shareRatio := sdk.OneDec()
+	if exitingShares.LT(p.TotalShares.Amount) {
+		refundedShares := exitingShares.ToDec().Mul(sdk.OneDec().Sub(exitFee))
+		shareRatio = refundedShares.QuoTruncate(p.TotalShares.Amount.ToDec())
+	}
+
+	p.modifyPosition(ctx, p.Address, lowerTick, upperTick, sdk.ZeroDec())
+	position, _ = p.getPosition(ctx, p.Address, lowerTick, upperTick)
+	fees, _ := position.FeesOwed.MulDecTruncate(shareRatio).TruncateDecimal()
+	position.FeesOwed = position.FeesOwed.Sub(sdk.NewDecCoinsFromCoins(fees...))
+	p.setPosition(ctx, position)
+	p.PoolLiquidity = p.PoolLiquidity.Sub(fees)
+
+	exitingCoins = fees
+	liquidity := position.Liquidity.MulTruncate(shareRatio)
+	if liquidity.IsPositive() {
+		amount0, amount1, err := p.WithdrawPosition(ctx, p.Address, lowerTick, upperTick, liquidity)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+		exitingCoins = exitingCoins.Add(p.tokenCoins(amount0, amount1)...)
+	}
+
+	p.TotalShares.Amount = p.TotalShares.Amount.Sub(exitingShares)
+	return exitingCoins, nil
+}
+
+// CalcExitPoolCoinsFromShares returns the coins ExitPool would return, without
+// mutating the pool.
+func (p Pool) CalcExitPoolCoinsFromShares(ctx sdk.Context, exitingShares sdk.Int, exitFee sdk.Dec) (exitedCoins sdk.Coins, err error) {
+	cacheCtx, _ := ctx.CacheContext()
+	pool := p.clone()
+	return pool.ExitPool(cacheCtx, exitingShares, exitFee)
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
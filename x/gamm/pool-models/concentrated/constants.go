@@ -0,0 +1,19 @@
+package concentrated
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MinTick and MaxTick bound the ticks positions can be created at. Every tick
+	// is a 0.01% price move, so the pool's price can range from roughly 2e-9 to 5e8.
+	MinTick int64 = -200_000
+	MaxTick int64 = 200_000
+
+	// MaxTickSpacing is the largest tick spacing a pool can be created with.
+	MaxTickSpacing uint64 = 10_000
+)
+
+// sqrtTickBase is sqrt(1.0001), the square root price ratio between two
+// consecutive ticks.
+var sqrtTickBase = sdk.MustNewDecFromStr("1.000049998750062496")
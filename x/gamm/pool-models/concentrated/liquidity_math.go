@@ -0,0 +1,98 @@
+package concentrated
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The functions below implement the liquidity math of a position between
+// sqrtPriceA and sqrtPriceB, with sqrtPriceA < sqrtPriceB:
+//
+//	amount0 = liquidity * (sqrtPriceB - sqrtPriceA) / (sqrtPriceA * sqrtPriceB)
+//	amount1 = liquidity * (sqrtPriceB - sqrtPriceA)
+//
+// Products of square root prices are never computed on their own, to keep the
+// precision of the intermediate results for small prices.
+
+// liquidity0 returns the liquidity provided by amount0 of token0 between the
+// two square root prices.
+func liquidity0(amount0 sdk.Dec, sqrtPriceA, sqrtPriceB sdk.Dec) sdk.Dec {
+	return amount0.MulTruncate(sqrtPriceA).MulTruncate(sqrtPriceB).QuoTruncate(sqrtPriceB.Sub(sqrtPriceA))
+}
+
+// liquidity1 returns the liquidity provided by amount1 of token1 between the
+// two square root prices.
+func liquidity1(amount1 sdk.Dec, sqrtPriceA, sqrtPriceB sdk.Dec) sdk.Dec {
+	return amount1.QuoTruncate(sqrtPriceB.Sub(sqrtPriceA))
+}
+
+// calcAmount0Delta returns the amount of token0 backing the liquidity between
+// the two square root prices.
+func calcAmount0Delta(liquidity, sqrtPriceA, sqrtPriceB sdk.Dec, roundUp bool) sdk.Dec {
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	if roundUp {
+		return liquidity.Mul(diff).QuoRoundUp(sqrtPriceB).QuoRoundUp(sqrtPriceA)
+	}
+	return liquidity.MulTruncate(diff).QuoTruncate(sqrtPriceB).QuoTruncate(sqrtPriceA)
+}
+
+// calcAmount1Delta returns the amount of token1 backing the liquidity between
+// the two square root prices.
+func calcAmount1Delta(liquidity, sqrtPriceA, sqrtPriceB sdk.Dec, roundUp bool) sdk.Dec {
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	if roundUp {
+		return liquidity.Mul(diff)
+	}
+	return liquidity.MulTruncate(diff)
+}
+
+// liquidityForAmounts returns the maximal liquidity the given amounts can
+// provide between sqrtPriceA and sqrtPriceB, at the current square root price.
+func liquidityForAmounts(sqrtPrice, sqrtPriceA, sqrtPriceB, amount0, amount1 sdk.Dec) sdk.Dec {
+	switch {
+	case sqrtPrice.LTE(sqrtPriceA):
+		return liquidity0(amount0, sqrtPriceA, sqrtPriceB)
+	case sqrtPrice.GTE(sqrtPriceB):
+		return liquidity1(amount1, sqrtPriceA, sqrtPriceB)
+	default:
+		return sdk.MinDec(liquidity0(amount0, sqrtPrice, sqrtPriceB), liquidity1(amount1, sqrtPriceA, sqrtPrice))
+	}
+}
+
+// amountsForLiquidity returns the amounts of token0 and token1 backing the
+// liquidity between sqrtPriceA and sqrtPriceB, at the current square root price.
+func amountsForLiquidity(sqrtPrice, sqrtPriceA, sqrtPriceB, liquidity sdk.Dec, roundUp bool) (sdk.Dec, sdk.Dec) {
+	switch {
+	case sqrtPrice.LTE(sqrtPriceA):
+		return calcAmount0Delta(liquidity, sqrtPriceA, sqrtPriceB, roundUp), sdk.ZeroDec()
+	case sqrtPrice.GTE(sqrtPriceB):
+		return sdk.ZeroDec(), calcAmount1Delta(liquidity, sqrtPriceA, sqrtPriceB, roundUp)
+	default:
+		return calcAmount0Delta(liquidity, sqrtPrice, sqrtPriceB, roundUp), calcAmount1Delta(liquidity, sqrtPriceA, sqrtPrice, roundUp)
+	}
+}
+
+// nextSqrtPriceFromAmount0In returns the square root price after swapping
+// amount0 of token0 in, which moves the price down.
+func nextSqrtPriceFromAmount0In(sqrtPrice, liquidity, amount0 sdk.Dec) sdk.Dec {
+	// liquidity * sqrtPrice / (liquidity + amount0 * sqrtPrice), rounded up to not
+	// move the price further than the amount in pays for.
+	return liquidity.Mul(sqrtPrice).QuoRoundUp(liquidity.Add(amount0.Mul(sqrtPrice)))
+}
+
+// nextSqrtPriceFromAmount1In returns the square root price after swapping
+// amount1 of token1 in, which moves the price up.
+func nextSqrtPriceFromAmount1In(sqrtPrice, liquidity, amount1 sdk.Dec) sdk.Dec {
+	return sqrtPrice.Add(amount1.QuoTruncate(liquidity))
+}
+
+// nextSqrtPriceFromAmount0Out returns the square root price after swapping
+// amount0 of token0 out, which moves the price up.
+func nextSqrtPriceFromAmount0Out(sqrtPrice, liquidity, amount0 sdk.Dec) sdk.Dec {
+	return liquidity.Mul(sqrtPrice).QuoRoundUp(liquidity.Sub(amount0.Mul(sqrtPrice)))
+}
+
+// nextSqrtPriceFromAmount1Out returns the square root price after swapping
+// amount1 of token1 out, which moves the price down.
+func nextSqrtPriceFromAmount1Out(sqrtPrice, liquidity, amount1 sdk.Dec) sdk.Dec {
+	return sqrtPrice.Sub(amount1.QuoRoundUp(liquidity))
+}
@@ -0,0 +1,221 @@
+package concentrated
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+const (
+	TypeMsgCreateConcentratedPool = "create_concentrated_pool"
+	TypeMsgCreatePosition         = "create_position"
+	TypeMsgWithdrawPosition       = "withdraw_position"
+	TypeMsgCollectFees            = "collect_fees"
+)
+
+var (
+	_ sdk.Msg             = &MsgCreateConcentratedPool{}
+	_ types.CreatePoolMsg = &MsgCreateConcentratedPool{}
+)
+
+func NewMsgCreateConcentratedPool(
+	sender sdk.AccAddress,
+	poolParams PoolParams,
+	initialLiquidity sdk.Coins,
+	tickSpacing uint64,
+	futurePoolGovernor string,
+) MsgCreateConcentratedPool {
+	return MsgCreateConcentratedPool{
+		Sender:               sender.String(),
+		PoolParams:           &poolParams,
+		InitialPoolLiquidity: initialLiquidity,
+		TickSpacing:          tickSpacing,
+		FuturePoolGovernor:   futurePoolGovernor,
+	}
+}
+
+func (msg MsgCreateConcentratedPool) Route() string { return types.RouterKey }
+func (msg MsgCreateConcentratedPool) Type() string  { return TypeMsgCreateConcentratedPool }
+func (msg MsgCreateConcentratedPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.PoolParams == nil {
+		return fmt.Errorf("pool params must be set")
+	}
+	if err = msg.PoolParams.Validate(); err != nil {
+		return err
+	}
+
+	if err = validateTickSpacing(msg.TickSpacing); err != nil {
+		return err
+	}
+
+	// The message's pool liquidity must have exactly 2 positive assets, which
+	// set the initial price of the pool.
+	if err = validatePoolLiquidity(msg.InitialPoolLiquidity); err != nil {
+		return err
+	}
+
+	if err = types.ValidateFutureGovernor(msg.FuturePoolGovernor); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (msg MsgCreateConcentratedPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateConcentratedPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+/// Implement the CreatePoolMsg interface
+
+func (msg MsgCreateConcentratedPool) PoolCreator() sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return sender
+}
+
+func (msg MsgCreateConcentratedPool) Validate(ctx sdk.Context) error {
+	return msg.ValidateBasic()
+}
+
+func (msg MsgCreateConcentratedPool) InitialLiquidity() sdk.Coins {
+	return msg.InitialPoolLiquidity
+}
+
+func (msg MsgCreateConcentratedPool) CreatePool(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
+	concentratedPool, err := NewConcentratedPool(poolId, *msg.PoolParams, msg.InitialPoolLiquidity,
+		msg.TickSpacing, msg.FuturePoolGovernor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &concentratedPool, nil
+}
+
+var _ sdk.Msg = &MsgCreatePosition{}
+
+func (msg MsgCreatePosition) Route() string { return types.RouterKey }
+func (msg MsgCreatePosition) Type() string  { return TypeMsgCreatePosition }
+func (msg MsgCreatePosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err = validatePositionTicks(msg.LowerTick, msg.UpperTick); err != nil {
+		return err
+	}
+
+	if !msg.TokenDesired0.IsValid() || !msg.TokenDesired1.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid desired tokens %s, %s", msg.TokenDesired0, msg.TokenDesired1)
+	}
+	if msg.TokenDesired0.Denom == msg.TokenDesired1.Denom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "desired tokens must have different denoms, got %s", msg.TokenDesired0.Denom)
+	}
+	if msg.TokenDesired0.IsZero() && msg.TokenDesired1.IsZero() {
+		return sdkerrors.Wrap(types.ErrInvalidPositionAmount, "desired tokens must not both be zero")
+	}
+
+	if msg.TokenMinAmount0.IsNil() || msg.TokenMinAmount0.IsNegative() ||
+		msg.TokenMinAmount1.IsNil() || msg.TokenMinAmount1.IsNegative() {
+		return sdkerrors.Wrap(types.ErrNotPositiveRequireAmount, "token min amounts must not be negative")
+	}
+
+	return nil
+}
+
+func (msg MsgCreatePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreatePosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgWithdrawPosition{}
+
+func (msg MsgWithdrawPosition) Route() string { return types.RouterKey }
+func (msg MsgWithdrawPosition) Type() string  { return TypeMsgWithdrawPosition }
+func (msg MsgWithdrawPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err = validatePositionTicks(msg.LowerTick, msg.UpperTick); err != nil {
+		return err
+	}
+
+	if msg.LiquidityAmount.IsNil() || !msg.LiquidityAmount.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrInvalidPositionAmount, "liquidity to withdraw must be positive, got %s", msg.LiquidityAmount)
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCollectFees{}
+
+func (msg MsgCollectFees) Route() string { return types.RouterKey }
+func (msg MsgCollectFees) Type() string  { return TypeMsgCollectFees }
+func (msg MsgCollectFees) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return validatePositionTicks(msg.LowerTick, msg.UpperTick)
+}
+
+func (msg MsgCollectFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCollectFees) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// validatePositionTicks checks the ticks of a position independently of the
+// pool's tick spacing, which is checked against the pool.
+func validatePositionTicks(lowerTick, upperTick int64) error {
+	if err := validateTicks(lowerTick, upperTick, 1); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidPositionTicks, err.Error())
+	}
+	return nil
+}
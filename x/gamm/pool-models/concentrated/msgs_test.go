@@ -0,0 +1,180 @@
+package concentrated_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appParams "github.com/osmosis-labs/osmosis/v12/app/params"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+func TestMsgCreateConcentratedPoolValidateBasic(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	baseMsg := func() concentrated.MsgCreateConcentratedPool {
+		return concentrated.NewMsgCreateConcentratedPool(addr1, concentrated.PoolParams{
+			SwapFee: sdk.NewDecWithPrec(1, 2),
+			ExitFee: sdk.ZeroDec(),
+		}, sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("osmo", 100)), 10, "")
+	}
+
+	msg := baseMsg()
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, "create_concentrated_pool", msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+
+	tests := []struct {
+		name       string
+		update     func(msg *concentrated.MsgCreateConcentratedPool)
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			update:     func(msg *concentrated.MsgCreateConcentratedPool) {},
+			expectPass: true,
+		},
+		{
+			name:   "invalid sender",
+			update: func(msg *concentrated.MsgCreateConcentratedPool) { msg.Sender = "invalid" },
+		},
+		{
+			name:   "nil pool params",
+			update: func(msg *concentrated.MsgCreateConcentratedPool) { msg.PoolParams = nil },
+		},
+		{
+			name: "swap fee of 100%",
+			update: func(msg *concentrated.MsgCreateConcentratedPool) {
+				msg.PoolParams.SwapFee = sdk.OneDec()
+			},
+		},
+		{
+			name:   "zero tick spacing",
+			update: func(msg *concentrated.MsgCreateConcentratedPool) { msg.TickSpacing = 0 },
+		},
+		{
+			name: "one asset",
+			update: func(msg *concentrated.MsgCreateConcentratedPool) {
+				msg.InitialPoolLiquidity = sdk.NewCoins(sdk.NewInt64Coin("osmo", 100))
+			},
+		},
+		{
+			name: "three assets",
+			update: func(msg *concentrated.MsgCreateConcentratedPool) {
+				msg.InitialPoolLiquidity = msg.InitialPoolLiquidity.Add(sdk.NewInt64Coin("juno", 100))
+			},
+		},
+		{
+			name:   "invalid future governor",
+			update: func(msg *concentrated.MsgCreateConcentratedPool) { msg.FuturePoolGovernor = "invalid_cosmos_address" },
+		},
+	}
+
+	for _, test := range tests {
+		msg := baseMsg()
+		test.update(&msg)
+		if test.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgCreatePositionValidateBasic(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	baseMsg := func() concentrated.MsgCreatePosition {
+		return concentrated.MsgCreatePosition{
+			Sender:          addr1.String(),
+			PoolID:          1,
+			LowerTick:       -100,
+			UpperTick:       100,
+			TokenDesired0:   sdk.NewInt64Coin("atom", 100),
+			TokenDesired1:   sdk.NewInt64Coin("osmo", 100),
+			TokenMinAmount0: sdk.ZeroInt(),
+			TokenMinAmount1: sdk.ZeroInt(),
+		}
+	}
+
+	tests := []struct {
+		name       string
+		update     func(msg *concentrated.MsgCreatePosition)
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			update:     func(msg *concentrated.MsgCreatePosition) {},
+			expectPass: true,
+		},
+		{
+			name: "single sided range order",
+			update: func(msg *concentrated.MsgCreatePosition) {
+				msg.TokenDesired1 = sdk.NewInt64Coin("osmo", 0)
+			},
+			expectPass: true,
+		},
+		{
+			name:   "invalid sender",
+			update: func(msg *concentrated.MsgCreatePosition) { msg.Sender = "invalid" },
+		},
+		{
+			name:   "lower tick above upper tick",
+			update: func(msg *concentrated.MsgCreatePosition) { msg.LowerTick = 200 },
+		},
+		{
+			name:   "upper tick out of range",
+			update: func(msg *concentrated.MsgCreatePosition) { msg.UpperTick = concentrated.MaxTick + 1 },
+		},
+		{
+			name: "same denoms",
+			update: func(msg *concentrated.MsgCreatePosition) {
+				msg.TokenDesired1 = sdk.NewInt64Coin("atom", 100)
+			},
+		},
+		{
+			name: "no tokens desired",
+			update: func(msg *concentrated.MsgCreatePosition) {
+				msg.TokenDesired0 = sdk.NewInt64Coin("atom", 0)
+				msg.TokenDesired1 = sdk.NewInt64Coin("osmo", 0)
+			},
+		},
+		{
+			name:   "negative min amount",
+			update: func(msg *concentrated.MsgCreatePosition) { msg.TokenMinAmount0 = sdk.NewInt(-1) },
+		},
+	}
+
+	for _, test := range tests {
+		msg := baseMsg()
+		test.update(&msg)
+		if test.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgWithdrawPositionValidateBasic(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	msg := concentrated.MsgWithdrawPosition{Sender: addr1.String(), PoolID: 1, LowerTick: -100, UpperTick: 100, LiquidityAmount: sdk.OneDec()}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.LiquidityAmount = sdk.ZeroDec()
+	require.Error(t, msg.ValidateBasic())
+
+	collectMsg := concentrated.MsgCollectFees{Sender: addr1.String(), PoolID: 1, LowerTick: -100, UpperTick: 100}
+	require.NoError(t, collectMsg.ValidateBasic())
+
+	collectMsg.UpperTick = -200
+	require.Error(t, collectMsg.ValidateBasic())
+}
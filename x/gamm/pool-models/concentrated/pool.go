@@ -0,0 +1,210 @@
+package concentrated
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+var _ types.PoolI = &Pool{}
+
+// Pool is the concentrated liquidity Pool struct. Only the pool's current state
+// is part of the pool, its ticks and positions are kept in the store the pool is
+// bound to.
+type Pool struct {
+	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Id         uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	PoolParams PoolParams `protobuf:"bytes,3,opt,name=pool_params,json=poolParams,proto3" json:"pool_params" yaml:"concentrated_pool_params"`
+	// This string specifies who will govern the pool in the future.
+	// Valid forms of this are:
+	// {token name},{duration}
+	// {duration}
+	// where {token name} if specified is the token which determines the
+	// governor, and if not specified is the LP token for this pool.duration is
+	// a time specified as 0w,1w,2w, etc. which specifies how long the token
+	// would need to be locked up to count in governance. 0w means no lockup.
+	FuturePoolGovernor string `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
+	// sum of all LP shares, which represent the full range position created
+	// with the initial pool liquidity
+	TotalShares sdk.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+	// assets in the pool, owed to all positions
+	PoolLiquidity sdk.Coins `protobuf:"bytes,6,rep,name=pool_liquidity,json=poolLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_liquidity"`
+	// the pool's denoms, in sorted order. The price of the pool is the price of
+	// token0 in terms of token1.
+	Token0 string `protobuf:"bytes,7,opt,name=token0,proto3" json:"token0,omitempty" yaml:"token0"`
+	Token1 string `protobuf:"bytes,8,opt,name=token1,proto3" json:"token1,omitempty" yaml:"token1"`
+	// positions must start and end at a multiple of the tick spacing
+	TickSpacing      uint64  `protobuf:"varint,9,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty" yaml:"tick_spacing"`
+	CurrentTick      int64   `protobuf:"varint,10,opt,name=current_tick,json=currentTick,proto3" json:"current_tick,omitempty" yaml:"current_tick"`
+	CurrentSqrtPrice sdk.Dec `protobuf:"bytes,11,opt,name=current_sqrt_price,json=currentSqrtPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_sqrt_price" yaml:"current_sqrt_price"`
+	// liquidity of all positions in range of the current tick
+	Liquidity sdk.Dec `protobuf:"bytes,12,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity" yaml:"liquidity"`
+	// fees collected per unit of liquidity over the lifetime of the pool
+	FeeGrowthGlobal0 sdk.Dec `protobuf:"bytes,13,opt,name=fee_growth_global0,json=feeGrowthGlobal0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_global0" yaml:"fee_growth_global0"`
+	FeeGrowthGlobal1 sdk.Dec `protobuf:"bytes,14,opt,name=fee_growth_global1,json=feeGrowthGlobal1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_global1" yaml:"fee_growth_global1"`
+
+	// storeKey is the key of the store the pool's ticks and positions are kept in.
+	storeKey sdk.StoreKey
+}
+
+// NewConcentratedPool returns a concentrated liquidity pool.
+// The initial liquidity sets the pool's price, and is provided as a full range
+// position owned by the pool's LP shares, created by CreateFullRangePosition once
+// the pool is bound to a store.
+// Invariants that are assumed to be satisfied and not checked:
+// * poolID doesn't already exist
+func NewConcentratedPool(poolId uint64,
+	poolParams PoolParams, initialLiquidity sdk.Coins,
+	tickSpacing uint64, futureGovernor string,
+) (Pool, error) {
+	if err := poolParams.Validate(); err != nil {
+		return Pool{}, err
+	}
+
+	if err := validateTickSpacing(tickSpacing); err != nil {
+		return Pool{}, err
+	}
+
+	if err := validatePoolLiquidity(initialLiquidity); err != nil {
+		return Pool{}, err
+	}
+
+	if err := types.ValidateFutureGovernor(futureGovernor); err != nil {
+		return Pool{}, err
+	}
+
+	// the initial price of token0 in terms of token1
+	token0, token1 := initialLiquidity[0], initialLiquidity[1]
+	sqrtPrice, err := token1.Amount.ToDec().Quo(token0.Amount.ToDec()).ApproxSqrt()
+	if err != nil {
+		return Pool{}, err
+	}
+	currentTick, err := SqrtPriceToTick(sqrtPrice)
+	if err != nil {
+		return Pool{}, sdkerrors.Wrap(types.ErrInvalidPool, err.Error())
+	}
+
+	pool := Pool{
+		Address:            types.NewPoolAddress(poolId).String(),
+		Id:                 poolId,
+		PoolParams:         poolParams,
+		FuturePoolGovernor: futureGovernor,
+		TotalShares:        sdk.NewCoin(types.GetPoolShareDenom(poolId), types.InitPoolSharesSupply),
+		PoolLiquidity:      initialLiquidity,
+		Token0:             token0.Denom,
+		Token1:             token1.Denom,
+		TickSpacing:        tickSpacing,
+		CurrentTick:        currentTick,
+		CurrentSqrtPrice:   sqrtPrice,
+		Liquidity:          sdk.ZeroDec(),
+		FeeGrowthGlobal0:   sdk.ZeroDec(),
+		FeeGrowthGlobal1:   sdk.ZeroDec(),
+	}
+
+	return pool, nil
+}
+
+// CreateFullRangePosition provides the pool's initial liquidity as the full range
+// position of its LP shares. It must be called once, when the pool is created.
+func (p *Pool) CreateFullRangePosition(ctx sdk.Context) error {
+	initialLiquidity := p.PoolLiquidity
+	lowerTick, upperTick := fullRangeTicks(p.TickSpacing)
+	if _, _, _, err := p.CreatePosition(ctx, p.Address, lowerTick, upperTick,
+		initialLiquidity.AmountOf(p.Token0), initialLiquidity.AmountOf(p.Token1)); err != nil {
+		return err
+	}
+	// The pool holds all of the initial liquidity, any rounding dust left over by
+	// the full range position is owed to LP share holders.
+	p.PoolLiquidity = initialLiquidity
+	return nil
+}
+
+func (p Pool) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		panic(fmt.Sprintf("could not bech32 decode address of pool with id: %d", p.GetId()))
+	}
+	return addr
+}
+
+func (p Pool) String() string {
+	out, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return string(out)
+}
+
+func (p Pool) GetId() uint64 {
+	return p.Id
+}
+
+func (p Pool) GetSwapFee(ctx sdk.Context) sdk.Dec {
+	return p.PoolParams.SwapFee
+}
+
+func (p Pool) GetExitFee(ctx sdk.Context) sdk.Dec {
+	return p.PoolParams.ExitFee
+}
+
+// IsActive returns true, concentrated liquidity pools are always open to swaps.
+// Swaps that run out of liquidity to swap against fail with ErrNotEnoughLiquidity.
+func (p Pool) IsActive(ctx sdk.Context) bool {
+	return true
+}
+
+// Returns the coins in the pool owned by all positions, including the fees
+// they have not collected yet.
+func (p Pool) GetTotalPoolLiquidity(ctx sdk.Context) sdk.Coins {
+	return p.PoolLiquidity
+}
+
+func (p Pool) GetTotalShares() sdk.Int {
+	return p.TotalShares.Amount
+}
+
+// SpotPrice returns the spot price of the base asset in terms of the quote
+// asset, given by the pool's current square root price.
+func (p Pool) SpotPrice(ctx sdk.Context, baseAssetDenom string, quoteAssetDenom string) (sdk.Dec, error) {
+	price := p.CurrentSqrtPrice.Mul(p.CurrentSqrtPrice)
+	switch {
+	case baseAssetDenom == p.Token0 && quoteAssetDenom == p.Token1:
+		return price, nil
+	case baseAssetDenom == p.Token1 && quoteAssetDenom == p.Token0:
+		return sdk.OneDec().Quo(price), nil
+	default:
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrDenomNotFoundInPool,
+			"pool %d does not trade %s for %s", p.Id, baseAssetDenom, quoteAssetDenom)
+	}
+}
+
+// clone returns a copy of the pool that can be mutated without affecting the
+// original pool. The ticks and positions of the copy are the same as the
+// pool's, so mutating them must be done in a cache context.
+func (p Pool) clone() Pool {
+	cloned := p
+	cloned.PoolLiquidity = sdk.NewCoins(p.PoolLiquidity...)
+	return cloned
+}
+
+func validateTickSpacing(tickSpacing uint64) error {
+	if tickSpacing == 0 || tickSpacing > MaxTickSpacing {
+		return sdkerrors.Wrapf(types.ErrInvalidTickSpacing, "tick spacing must be in [1, %d], got %d", MaxTickSpacing, tickSpacing)
+	}
+	return nil
+}
+
+// validatePoolLiquidity checks that the pool has exactly two positive assets.
+func validatePoolLiquidity(liquidity sdk.Coins) error {
+	if len(liquidity) != 2 {
+		return sdkerrors.Wrapf(types.ErrInvalidPool, "concentrated liquidity pools must have exactly 2 assets, got %d", len(liquidity))
+	}
+	if !liquidity.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid initial pool liquidity %s", liquidity)
+	}
+	return nil
+}
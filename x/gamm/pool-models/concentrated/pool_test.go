@@ -0,0 +1,411 @@
+package concentrated
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+var (
+	defaultSwapFee    = sdk.MustNewDecFromStr("0.003")
+	defaultPoolId     = uint64(1)
+	defaultPoolParams = PoolParams{
+		SwapFee: defaultSwapFee,
+		ExitFee: sdk.ZeroDec(),
+	}
+	defaultTickSpacing = uint64(10)
+	defaultOwner       = "owner"
+
+	testStoreKey = sdk.NewKVStoreKey(types.StoreKey)
+
+	// bar is token0 and foo is token1, with an initial price of 1
+	defaultPoolLiquidity = sdk.NewCoins(
+		sdk.NewInt64Coin("bar", 1_000_000_000),
+		sdk.NewInt64Coin("foo", 1_000_000_000),
+	)
+)
+
+// createTestPool returns a pool with the given initial liquidity, bound to the
+// store of the returned context.
+func createTestPool(t *testing.T, poolLiquidity sdk.Coins) (sdk.Context, *Pool) {
+	ctx := testutil.DefaultContext(testStoreKey, sdk.NewTransientStoreKey("transient_test"))
+	pool, err := NewConcentratedPool(defaultPoolId, defaultPoolParams, poolLiquidity, defaultTickSpacing, "")
+	require.NoError(t, err)
+	pool.SetStoreKey(testStoreKey)
+	require.NoError(t, pool.CreateFullRangePosition(ctx))
+	return ctx, &pool
+}
+
+func TestNewConcentratedPool(t *testing.T) {
+	tests := map[string]struct {
+		poolLiquidity     sdk.Coins
+		tickSpacing       uint64
+		expectedTick      int64
+		expectedSpotPrice sdk.Dec
+		expectErr         bool
+	}{
+		"price of 1": {
+			poolLiquidity:     defaultPoolLiquidity,
+			tickSpacing:       defaultTickSpacing,
+			expectedTick:      0,
+			expectedSpotPrice: sdk.OneDec(),
+		},
+		"price of 4": {
+			poolLiquidity:     sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000_000), sdk.NewInt64Coin("foo", 4_000_000)),
+			tickSpacing:       1,
+			expectedTick:      13863,
+			expectedSpotPrice: sdk.NewDec(4),
+		},
+		"price of 0.25": {
+			poolLiquidity:     sdk.NewCoins(sdk.NewInt64Coin("bar", 4_000_000), sdk.NewInt64Coin("foo", 1_000_000)),
+			tickSpacing:       1,
+			expectedTick:      -13864,
+			expectedSpotPrice: sdk.MustNewDecFromStr("0.25"),
+		},
+		"three assets": {
+			poolLiquidity: sdk.NewCoins(sdk.NewInt64Coin("bar", 1), sdk.NewInt64Coin("baz", 1), sdk.NewInt64Coin("foo", 1)),
+			tickSpacing:   defaultTickSpacing,
+			expectErr:     true,
+		},
+		"zero tick spacing": {
+			poolLiquidity: defaultPoolLiquidity,
+			tickSpacing:   0,
+			expectErr:     true,
+		},
+		"tick spacing too large": {
+			poolLiquidity: defaultPoolLiquidity,
+			tickSpacing:   MaxTickSpacing + 1,
+			expectErr:     true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := testutil.DefaultContext(testStoreKey, sdk.NewTransientStoreKey("transient_test"))
+			pool, err := NewConcentratedPool(defaultPoolId, defaultPoolParams, tc.poolLiquidity, tc.tickSpacing, "")
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			pool.SetStoreKey(testStoreKey)
+			require.NoError(t, pool.CreateFullRangePosition(ctx))
+
+			require.Equal(t, tc.expectedTick, pool.CurrentTick)
+			spotPrice, err := pool.SpotPrice(ctx, "bar", "foo")
+			require.NoError(t, err)
+			require.True(t, tc.expectedSpotPrice.Sub(spotPrice).Abs().LTE(sdk.NewDecWithPrec(1, 12)))
+
+			// the initial liquidity is held by a full range position of the pool's LP shares.
+			lowerTick, upperTick := fullRangeTicks(tc.tickSpacing)
+			position, found := pool.getPosition(ctx, pool.Address, lowerTick, upperTick)
+			require.True(t, found)
+			require.Equal(t, pool.Liquidity, position.Liquidity)
+			require.Equal(t, tc.poolLiquidity, pool.GetTotalPoolLiquidity(ctx))
+			require.Equal(t, types.InitPoolSharesSupply, pool.GetTotalShares())
+			require.Len(t, pool.GetState(ctx).Ticks, 2)
+		})
+	}
+}
+
+func TestIsActive(t *testing.T) {
+	ctx := testutil.DefaultContext(testStoreKey, sdk.NewTransientStoreKey("transient_test"))
+	pool, err := NewConcentratedPool(defaultPoolId, defaultPoolParams, defaultPoolLiquidity, defaultTickSpacing, "")
+	require.NoError(t, err)
+	pool.SetStoreKey(testStoreKey)
+
+	// a pool without ticks is active, but has no liquidity to swap against
+	require.Empty(t, pool.GetState(ctx).Ticks)
+	require.True(t, pool.IsActive(ctx))
+	_, err = pool.CalcOutAmtGivenIn(ctx, sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000_000)), "foo", defaultSwapFee)
+	require.ErrorIs(t, err, types.ErrNotEnoughLiquidity)
+	_, err = pool.CalcInAmtGivenOut(ctx, sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000_000)), "foo", defaultSwapFee)
+	require.ErrorIs(t, err, types.ErrNotEnoughLiquidity)
+
+	require.NoError(t, pool.CreateFullRangePosition(ctx))
+	require.True(t, pool.IsActive(ctx))
+}
+
+func TestSwapNotSingleToken(t *testing.T) {
+	ctx, pool := createTestPool(t, defaultPoolLiquidity)
+
+	_, err := pool.CalcOutAmtGivenIn(ctx, defaultPoolLiquidity, "foo", defaultSwapFee)
+	require.ErrorIs(t, err, types.ErrNotSingleSwapToken)
+	_, err = pool.SwapOutAmtGivenIn(ctx, sdk.Coins{}, "foo", defaultSwapFee)
+	require.ErrorIs(t, err, types.ErrNotSingleSwapToken)
+	_, err = pool.CalcInAmtGivenOut(ctx, defaultPoolLiquidity, "foo", defaultSwapFee)
+	require.ErrorIs(t, err, types.ErrNotSingleSwapToken)
+	_, err = pool.SwapInAmtGivenOut(ctx, sdk.Coins{}, "foo", defaultSwapFee)
+	require.ErrorIs(t, err, types.ErrNotSingleSwapToken)
+}
+
+func TestSpotPrice(t *testing.T) {
+	ctx, pool := createTestPool(t, sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000_000), sdk.NewInt64Coin("foo", 4_000_000)))
+
+	price, err := pool.SpotPrice(ctx, "bar", "foo")
+	require.NoError(t, err)
+	inversePrice, err := pool.SpotPrice(ctx, "foo", "bar")
+	require.NoError(t, err)
+	require.True(t, price.Mul(inversePrice).Sub(sdk.OneDec()).Abs().LTE(sdk.NewDecWithPrec(1, 12)))
+
+	_, err = pool.SpotPrice(ctx, "bar", "baz")
+	require.ErrorIs(t, err, types.ErrDenomNotFoundInPool)
+}
+
+func TestSwapOutAmtGivenIn(t *testing.T) {
+	tests := map[string]struct {
+		tokenIn          sdk.Coin
+		tokenOutDenom    string
+		expectedTokenOut sdk.Coin
+		expectErr        error
+	}{
+		"swap token0 for token1": {
+			tokenIn:          sdk.NewInt64Coin("bar", 1_000_000),
+			tokenOutDenom:    "foo",
+			expectedTokenOut: sdk.NewInt64Coin("foo", 996_007),
+		},
+		"swap token1 for token0": {
+			tokenIn:          sdk.NewInt64Coin("foo", 1_000_000),
+			tokenOutDenom:    "bar",
+			expectedTokenOut: sdk.NewInt64Coin("bar", 996_007),
+		},
+		"denom not in pool": {
+			tokenIn:       sdk.NewInt64Coin("baz", 1_000_000),
+			tokenOutDenom: "bar",
+			expectErr:     types.ErrDenomNotFoundInPool,
+		},
+		"swap past the last tick": {
+			tokenIn:       sdk.NewCoin("bar", sdk.NewInt(10).ToDec().Power(30).TruncateInt()),
+			tokenOutDenom: "foo",
+			expectErr:     types.ErrNotEnoughLiquidity,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, pool := createTestPool(t, defaultPoolLiquidity)
+			initialPrice, err := pool.SpotPrice(ctx, "bar", "foo")
+			require.NoError(t, err)
+			initialState := pool.GetState(ctx)
+
+			calcTokenOut, err := pool.CalcOutAmtGivenIn(ctx, sdk.NewCoins(tc.tokenIn), tc.tokenOutDenom, defaultSwapFee)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTokenOut, calcTokenOut)
+
+			// calculating the swap doesn't mutate the pool
+			_, initialPool := createTestPool(t, defaultPoolLiquidity)
+			require.Equal(t, *initialPool, *pool)
+			require.Equal(t, initialState, pool.GetState(ctx))
+
+			tokenOut, err := pool.SwapOutAmtGivenIn(ctx, sdk.NewCoins(tc.tokenIn), tc.tokenOutDenom, defaultSwapFee)
+			require.NoError(t, err)
+			require.Equal(t, calcTokenOut, tokenOut)
+			require.Equal(t, defaultPoolLiquidity.Add(tc.tokenIn).Sub(sdk.NewCoins(tokenOut)), pool.PoolLiquidity)
+
+			// the price of the token swapped in goes down
+			newPrice, err := pool.SpotPrice(ctx, "bar", "foo")
+			require.NoError(t, err)
+			if tc.tokenIn.Denom == "bar" {
+				require.True(t, newPrice.LT(initialPrice))
+			} else {
+				require.True(t, newPrice.GT(initialPrice))
+			}
+		})
+	}
+}
+
+func TestSwapInAmtGivenOut(t *testing.T) {
+	tests := map[string]struct {
+		tokenOut        sdk.Coin
+		tokenInDenom    string
+		expectedTokenIn sdk.Coin
+		expectErr       error
+	}{
+		"swap token0 for token1": {
+			tokenOut:        sdk.NewInt64Coin("foo", 996_006),
+			tokenInDenom:    "bar",
+			expectedTokenIn: sdk.NewInt64Coin("bar", 999_999),
+		},
+		"swap token1 for token0": {
+			tokenOut:        sdk.NewInt64Coin("bar", 996_006),
+			tokenInDenom:    "foo",
+			expectedTokenIn: sdk.NewInt64Coin("foo", 999_999),
+		},
+		"more tokens out than the pool holds": {
+			tokenOut:     sdk.NewInt64Coin("bar", 1_000_000_000),
+			tokenInDenom: "foo",
+			expectErr:    types.ErrTooManyTokensOut,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, pool := createTestPool(t, defaultPoolLiquidity)
+			initialState := pool.GetState(ctx)
+
+			calcTokenIn, err := pool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tc.tokenOut), tc.tokenInDenom, defaultSwapFee)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTokenIn, calcTokenIn)
+			_, initialPool := createTestPool(t, defaultPoolLiquidity)
+			require.Equal(t, *initialPool, *pool)
+			require.Equal(t, initialState, pool.GetState(ctx))
+
+			tokenIn, err := pool.SwapInAmtGivenOut(ctx, sdk.NewCoins(tc.tokenOut), tc.tokenInDenom, defaultSwapFee)
+			require.NoError(t, err)
+			require.Equal(t, calcTokenIn, tokenIn)
+			require.Equal(t, defaultPoolLiquidity.Add(tokenIn).Sub(sdk.NewCoins(tc.tokenOut)), pool.PoolLiquidity)
+		})
+	}
+}
+
+// A position above the current price is a range order selling token0, which is
+// entirely filled once the price crosses its upper tick.
+func TestRangeOrder(t *testing.T) {
+	ctx, pool := createTestPool(t, defaultPoolLiquidity)
+	activeLiquidity := pool.Liquidity
+
+	amount0, amount1, liquidity, err := pool.CreatePosition(ctx, defaultOwner, 100, 200, sdk.NewInt(1_000_000), sdk.NewInt(1_000_000))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1_000_000), amount0)
+	require.Equal(t, sdk.ZeroInt(), amount1)
+	require.True(t, liquidity.IsPositive())
+	require.Len(t, pool.GetState(ctx).Ticks, 4)
+
+	// the order is out of range, so it doesn't add to the active liquidity
+	require.Equal(t, activeLiquidity, pool.Liquidity)
+
+	// push the price past the upper tick of the order
+	_, err = pool.SwapOutAmtGivenIn(ctx, sdk.NewCoins(sdk.NewInt64Coin("foo", 30_000_000)), "bar", defaultSwapFee)
+	require.NoError(t, err)
+	require.Greater(t, pool.CurrentTick, int64(200))
+	require.Equal(t, activeLiquidity, pool.Liquidity)
+
+	amount0, amount1, err = pool.WithdrawPosition(ctx, defaultOwner, 100, 200, liquidity)
+	require.NoError(t, err)
+	require.Equal(t, sdk.ZeroInt(), amount0)
+	// the order sold token0 at an average price of ~1.0151
+	require.Equal(t, sdk.NewInt(1_015_112), amount1)
+
+	// the order earned the swap fees paid while the price crossed it
+	fees, err := pool.CollectFees(ctx, defaultOwner, 100, 200)
+	require.NoError(t, err)
+	require.Equal(t, "foo", fees[0].Denom)
+	require.True(t, fees.AmountOf("foo").IsPositive())
+	require.Len(t, pool.GetPositions(ctx, defaultOwner), 0)
+	require.Len(t, pool.GetState(ctx).Ticks, 2)
+}
+
+func TestPositionFees(t *testing.T) {
+	ctx, pool := createTestPool(t, defaultPoolLiquidity)
+
+	// a position with as much liquidity as the initial full range position, in
+	// a narrower range, earns the same fees while it is in range.
+	_, _, liquidity, err := pool.CreatePosition(ctx, defaultOwner, -1000, 1000, sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000))
+	require.NoError(t, err)
+	require.Equal(t, pool.Liquidity, liquidity.Add(pool.GetPositions(ctx, pool.Address)[0].Liquidity))
+
+	tokenIn := sdk.NewInt64Coin("bar", 10_000_000)
+	_, err = pool.SwapOutAmtGivenIn(ctx, sdk.NewCoins(tokenIn), "foo", defaultSwapFee)
+	require.NoError(t, err)
+
+	totalFee := tokenIn.Amount.ToDec().Mul(defaultSwapFee)
+	expectedFee := totalFee.Mul(liquidity).Quo(pool.Liquidity).TruncateInt()
+	fees, err := pool.CollectFees(ctx, defaultOwner, -1000, 1000)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("bar", expectedFee)), fees)
+
+	// fees are only collected once
+	fees, err = pool.CollectFees(ctx, defaultOwner, -1000, 1000)
+	require.NoError(t, err)
+	require.True(t, fees.IsZero())
+
+	_, err = pool.CollectFees(ctx, "other", -1000, 1000)
+	require.ErrorIs(t, err, types.ErrPositionNotFound)
+}
+
+func TestCreatePositionInvalid(t *testing.T) {
+	ctx, pool := createTestPool(t, defaultPoolLiquidity)
+
+	_, _, _, err := pool.CreatePosition(ctx, defaultOwner, -105, 100, sdk.NewInt(100), sdk.NewInt(100))
+	require.ErrorIs(t, err, types.ErrInvalidPositionTicks)
+
+	// a range order below the current price only takes token1
+	_, _, _, err = pool.CreatePosition(ctx, defaultOwner, -200, -100, sdk.NewInt(100), sdk.ZeroInt())
+	require.ErrorIs(t, err, types.ErrInvalidPositionAmount)
+
+	_, _, err = pool.WithdrawPosition(ctx, defaultOwner, -200, -100, sdk.OneDec())
+	require.ErrorIs(t, err, types.ErrPositionNotFound)
+}
+
+func TestWithdrawPosition(t *testing.T) {
+	ctx, pool := createTestPool(t, defaultPoolLiquidity)
+	amount0, amount1, liquidity, err := pool.CreatePosition(ctx, defaultOwner, -100, 100, sdk.NewInt(1_000_000), sdk.NewInt(1_000_000))
+	require.NoError(t, err)
+
+	_, _, err = pool.WithdrawPosition(ctx, defaultOwner, -100, 100, liquidity.Add(sdk.OneDec()))
+	require.ErrorIs(t, err, types.ErrInvalidPositionAmount)
+
+	withdrawn0, withdrawn1, err := pool.WithdrawPosition(ctx, defaultOwner, -100, 100, liquidity)
+	require.NoError(t, err)
+	// withdrawals round down in favor of the pool
+	require.True(t, amount0.Sub(withdrawn0).LTE(sdk.OneInt()))
+	require.True(t, amount1.Sub(withdrawn1).LTE(sdk.OneInt()))
+
+	// the pool is left as it was, up to the rounding dust
+	initialCtx, initialPool := createTestPool(t, defaultPoolLiquidity)
+	require.Equal(t, initialPool.GetState(initialCtx), pool.GetState(ctx))
+	require.Equal(t, initialPool.Liquidity, pool.Liquidity)
+}
+
+func TestExitPool(t *testing.T) {
+	ctx, pool := createTestPool(t, defaultPoolLiquidity)
+
+	initialState := pool.GetState(ctx)
+	exitedCoins, err := pool.CalcExitPoolCoinsFromShares(ctx, types.InitPoolSharesSupply.QuoRaw(2), sdk.ZeroDec())
+	require.NoError(t, err)
+	_, initialPool := createTestPool(t, defaultPoolLiquidity)
+	require.Equal(t, *initialPool, *pool)
+	require.Equal(t, initialState, pool.GetState(ctx))
+
+	actualExitedCoins, err := pool.ExitPool(ctx, types.InitPoolSharesSupply.QuoRaw(2), sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, exitedCoins, actualExitedCoins)
+	for _, coin := range exitedCoins {
+		require.True(t, sdk.NewInt(500_000_000).Sub(coin.Amount).LTE(sdk.OneInt()), coin.String())
+	}
+	require.Equal(t, types.InitPoolSharesSupply.QuoRaw(2), pool.GetTotalShares())
+
+	_, err = pool.ExitPool(ctx, pool.GetTotalShares().AddRaw(1), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrLimitMaxAmount)
+
+	// the last shares exit the whole position, even with an exit fee
+	actualExitedCoins, err = pool.ExitPool(ctx, pool.GetTotalShares(), sdk.NewDecWithPrec(1, 2))
+	require.NoError(t, err)
+	for _, coin := range actualExitedCoins {
+		require.True(t, sdk.NewInt(500_000_000).Sub(coin.Amount).LTE(sdk.NewInt(2)), coin.String())
+	}
+	require.True(t, pool.GetTotalShares().IsZero())
+	require.True(t, pool.Liquidity.IsZero())
+	require.Empty(t, pool.GetState(ctx).Ticks)
+	require.True(t, pool.IsActive(ctx))
+}
+
+func TestJoinPoolNotImplemented(t *testing.T) {
+	ctx, pool := createTestPool(t, defaultPoolLiquidity)
+	_, err := pool.JoinPool(ctx, defaultPoolLiquidity, defaultSwapFee)
+	require.ErrorIs(t, err, types.ErrNotImplemented)
+	_, _, err = pool.CalcJoinPoolNoSwapShares(ctx, defaultPoolLiquidity, defaultSwapFee)
+	require.ErrorIs(t, err, types.ErrNotImplemented)
+}
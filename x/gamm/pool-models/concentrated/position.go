@@ -0,0 +1,234 @@
+package concentrated
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// CreatePosition adds the maximal liquidity the desired amounts can provide
+// between the given ticks to the owner's position, creating the position if it
+// doesn't exist yet. It returns the amounts of token0 and token1 that back the
+// created liquidity, rounded up in favor of the pool. It errors if they exceed
+// the desired amounts.
+// When the range doesn't contain the current tick, the position is a range order
+// funded with a single token.
+func (p *Pool) CreatePosition(ctx sdk.Context, owner string, lowerTick, upperTick int64, amount0Desired, amount1Desired sdk.Int) (
+	amount0, amount1 sdk.Int, liquidity sdk.Dec, err error,
+) {
+	if err := validateTicks(lowerTick, upperTick, p.TickSpacing); err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdkerrors.Wrap(types.ErrInvalidPositionTicks, err.Error())
+	}
+
+	sqrtPriceA, sqrtPriceB := mustTickToSqrtPrice(lowerTick), mustTickToSqrtPrice(upperTick)
+	liquidity = liquidityForAmounts(p.CurrentSqrtPrice, sqrtPriceA, sqrtPriceB, amount0Desired.ToDec(), amount1Desired.ToDec())
+	if !liquidity.IsPositive() {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidPositionAmount,
+			"amounts %s%s and %s%s provide no liquidity in [%d, %d]", amount0Desired, p.Token0, amount1Desired, p.Token1, lowerTick, upperTick)
+	}
+
+	// amounts are rounded up in favor of the pool. The position is rejected rather
+	// than underfunded if rounding makes it require more than what was desired.
+	amount0Dec, amount1Dec := amountsForLiquidity(p.CurrentSqrtPrice, sqrtPriceA, sqrtPriceB, liquidity, true)
+	amount0, amount1 = amount0Dec.Ceil().TruncateInt(), amount1Dec.Ceil().TruncateInt()
+	if amount0.GT(amount0Desired) || amount1.GT(amount1Desired) {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidPositionAmount,
+			"liquidity %s in [%d, %d] requires %s%s and %s%s, more than the desired %s%s and %s%s",
+			liquidity, lowerTick, upperTick, amount0, p.Token0, amount1, p.Token1, amount0Desired, p.Token0, amount1Desired, p.Token1)
+	}
+
+	p.modifyPosition(ctx, owner, lowerTick, upperTick, liquidity)
+	p.PoolLiquidity = p.PoolLiquidity.Add(p.tokenCoins(amount0, amount1)...)
+	return amount0, amount1, liquidity, nil
+}
+
+// WithdrawPosition removes liquidity from the owner's position, returning the
+// amounts of token0 and token1 backing it. Fees owed to the position are kept
+// until they are collected.
+func (p *Pool) WithdrawPosition(ctx sdk.Context, owner string, lowerTick, upperTick int64, liquidity sdk.Dec) (amount0, amount1 sdk.Int, err error) {
+	position, found := p.getPosition(ctx, owner, lowerTick, upperTick)
+	if !found {
+		return sdk.Int{}, sdk.Int{}, positionNotFoundError(owner, lowerTick, upperTick)
+	}
+	if !liquidity.IsPositive() || liquidity.GT(position.Liquidity) {
+		return sdk.Int{}, sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidPositionAmount,
+			"liquidity to withdraw must be in (0, %s], got %s", position.Liquidity, liquidity)
+	}
+
+	sqrtPriceA, sqrtPriceB := mustTickToSqrtPrice(lowerTick), mustTickToSqrtPrice(upperTick)
+	amount0Dec, amount1Dec := amountsForLiquidity(p.CurrentSqrtPrice, sqrtPriceA, sqrtPriceB, liquidity, false)
+	amount0, amount1 = amount0Dec.TruncateInt(), amount1Dec.TruncateInt()
+
+	newLiquidity, negative := p.PoolLiquidity.SafeSub(p.tokenCoins(amount0, amount1))
+	if negative {
+		return sdk.Int{}, sdk.Int{}, sdkerrors.Wrapf(types.ErrTooManyTokensOut,
+			"pool %d does not hold enough liquidity to withdraw %s%s and %s%s", p.Id, amount0, p.Token0, amount1, p.Token1)
+	}
+
+	p.modifyPosition(ctx, owner, lowerTick, upperTick, liquidity.Neg())
+	p.PoolLiquidity = newLiquidity
+	return amount0, amount1, nil
+}
+
+// CollectFees returns the swap fees owed to the owner's position. Positions with
+// no liquidity left are removed once their fees are collected, dropping any fee
+// dust smaller than a unit.
+func (p *Pool) CollectFees(ctx sdk.Context, owner string, lowerTick, upperTick int64) (sdk.Coins, error) {
+	position, found := p.getPosition(ctx, owner, lowerTick, upperTick)
+	if !found {
+		return nil, positionNotFoundError(owner, lowerTick, upperTick)
+	}
+
+	if position.Liquidity.IsPositive() {
+		p.modifyPosition(ctx, owner, lowerTick, upperTick, sdk.ZeroDec())
+		position, _ = p.getPosition(ctx, owner, lowerTick, upperTick)
+	}
+
+	collected, remainder := position.FeesOwed.TruncateDecimal()
+	newLiquidity, negative := p.PoolLiquidity.SafeSub(collected)
+	if negative {
+		return nil, sdkerrors.Wrapf(types.ErrTooManyTokensOut,
+			"pool %d does not hold enough liquidity to pay fees %s", p.Id, collected)
+	}
+	p.PoolLiquidity = newLiquidity
+
+	position.FeesOwed = remainder
+	p.setPosition(ctx, position)
+	if position.Liquidity.IsZero() {
+		p.removePosition(ctx, owner, lowerTick, upperTick)
+	}
+	return collected, nil
+}
+
+// modifyPosition changes the liquidity of the owner's position by liquidityDelta,
+// updating its ticks and accruing the fees it earned since it was last touched.
+func (p *Pool) modifyPosition(ctx sdk.Context, owner string, lowerTick, upperTick int64, liquidityDelta sdk.Dec) {
+	if !liquidityDelta.IsZero() {
+		p.updateTick(ctx, lowerTick, liquidityDelta, false)
+		p.updateTick(ctx, upperTick, liquidityDelta, true)
+	}
+
+	feeGrowthInside0, feeGrowthInside1 := p.feeGrowthInside(ctx, lowerTick, upperTick)
+	position, found := p.getPosition(ctx, owner, lowerTick, upperTick)
+	if !found {
+		position = types.Position{
+			Owner:                owner,
+			LowerTick:            lowerTick,
+			UpperTick:            upperTick,
+			Liquidity:            sdk.ZeroDec(),
+			FeeGrowthInside0Last: feeGrowthInside0,
+			FeeGrowthInside1Last: feeGrowthInside1,
+			FeesOwed:             sdk.NewDecCoins(),
+		}
+	}
+
+	position.FeesOwed = position.FeesOwed.Add(
+		p.accruedFee(p.Token0, position.Liquidity, feeGrowthInside0.Sub(position.FeeGrowthInside0Last)),
+		p.accruedFee(p.Token1, position.Liquidity, feeGrowthInside1.Sub(position.FeeGrowthInside1Last)),
+	)
+	position.FeeGrowthInside0Last = feeGrowthInside0
+	position.FeeGrowthInside1Last = feeGrowthInside1
+	position.Liquidity = position.Liquidity.Add(liquidityDelta)
+	p.setPosition(ctx, position)
+
+	if lowerTick <= p.CurrentTick && p.CurrentTick < upperTick {
+		p.Liquidity = p.Liquidity.Add(liquidityDelta)
+	}
+
+	if liquidityDelta.IsNegative() {
+		p.removeTickIfUnused(ctx, lowerTick)
+		p.removeTickIfUnused(ctx, upperTick)
+		if position.Liquidity.IsZero() && position.FeesOwed.IsZero() {
+			p.removePosition(ctx, owner, lowerTick, upperTick)
+		}
+	}
+}
+
+// accruedFee returns the fee earned by the liquidity over the given fee growth.
+// The fee growth may be marginally negative due to rounding, which earns nothing.
+func (p Pool) accruedFee(denom string, liquidity, feeGrowth sdk.Dec) sdk.DecCoin {
+	fee := liquidity.MulTruncate(feeGrowth)
+	if !fee.IsPositive() {
+		fee = sdk.ZeroDec()
+	}
+	return sdk.NewDecCoinFromDec(denom, fee)
+}
+
+// feeGrowthInside returns the fee growth per unit of liquidity of both tokens
+// within the given ticks, which must be initialized.
+func (p Pool) feeGrowthInside(ctx sdk.Context, lowerTick, upperTick int64) (sdk.Dec, sdk.Dec) {
+	lower, _ := p.getTick(ctx, lowerTick)
+	upper, _ := p.getTick(ctx, upperTick)
+
+	// fee growth below the lower tick
+	below0, below1 := lower.FeeGrowthOutside0, lower.FeeGrowthOutside1
+	if p.CurrentTick < lowerTick {
+		below0, below1 = p.FeeGrowthGlobal0.Sub(below0), p.FeeGrowthGlobal1.Sub(below1)
+	}
+
+	// fee growth above the upper tick
+	above0, above1 := upper.FeeGrowthOutside0, upper.FeeGrowthOutside1
+	if p.CurrentTick >= upperTick {
+		above0, above1 = p.FeeGrowthGlobal0.Sub(above0), p.FeeGrowthGlobal1.Sub(above1)
+	}
+
+	return p.FeeGrowthGlobal0.Sub(below0).Sub(above0), p.FeeGrowthGlobal1.Sub(below1).Sub(above1)
+}
+
+// updateTick changes the liquidity referencing the tick, initializing it if needed.
+// By convention, all fee growth is assumed to have happened below the tick when
+// it is initialized at or below the current tick.
+func (p *Pool) updateTick(ctx sdk.Context, index int64, liquidityDelta sdk.Dec, upper bool) {
+	tick, found := p.getTick(ctx, index)
+	if !found {
+		tick = types.TickInfo{
+			Index:             index,
+			LiquidityGross:    sdk.ZeroDec(),
+			LiquidityNet:      sdk.ZeroDec(),
+			FeeGrowthOutside0: sdk.ZeroDec(),
+			FeeGrowthOutside1: sdk.ZeroDec(),
+		}
+		if index <= p.CurrentTick {
+			tick.FeeGrowthOutside0 = p.FeeGrowthGlobal0
+			tick.FeeGrowthOutside1 = p.FeeGrowthGlobal1
+		}
+	}
+
+	tick.LiquidityGross = tick.LiquidityGross.Add(liquidityDelta)
+	if upper {
+		tick.LiquidityNet = tick.LiquidityNet.Sub(liquidityDelta)
+	} else {
+		tick.LiquidityNet = tick.LiquidityNet.Add(liquidityDelta)
+	}
+	p.setTick(ctx, tick)
+}
+
+// removeTickIfUnused removes the tick once no position references it anymore.
+func (p *Pool) removeTickIfUnused(ctx sdk.Context, index int64) {
+	tick, found := p.getTick(ctx, index)
+	if found && tick.LiquidityGross.IsZero() {
+		p.removeTick(ctx, index)
+	}
+}
+
+// crossTick flips the fee growth outside of the tick as the current price moves
+// across it, and returns the tick's net liquidity.
+func (p *Pool) crossTick(ctx sdk.Context, index int64) sdk.Dec {
+	tick, _ := p.getTick(ctx, index)
+	tick.FeeGrowthOutside0 = p.FeeGrowthGlobal0.Sub(tick.FeeGrowthOutside0)
+	tick.FeeGrowthOutside1 = p.FeeGrowthGlobal1.Sub(tick.FeeGrowthOutside1)
+	p.setTick(ctx, tick)
+	return tick.LiquidityNet
+}
+
+// tokenCoins returns the amounts of token0 and token1 as coins.
+func (p Pool) tokenCoins(amount0, amount1 sdk.Int) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(p.Token0, amount0), sdk.NewCoin(p.Token1, amount1))
+}
+
+func positionNotFoundError(owner string, lowerTick, upperTick int64) error {
+	return sdkerrors.Wrap(types.ErrPositionNotFound, fmt.Sprintf("no position of %s in [%d, %d]", owner, lowerTick, upperTick))
+}
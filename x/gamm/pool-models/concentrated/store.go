@@ -0,0 +1,150 @@
+package concentrated
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/osmoutils"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// The initialized ticks and the positions of a pool are stored apart from the
+// pool, keyed by pool and tick and by pool, owner and ticks respectively, so that
+// only the ones a swap or a position update touches are read and written.
+
+// SetStoreKey binds the pool to the store its ticks and positions are kept in.
+// x/gamm binds every concentrated liquidity pool it creates or loads to its store.
+func (p *Pool) SetStoreKey(storeKey sdk.StoreKey) {
+	p.storeKey = storeKey
+}
+
+func (p Pool) kvStore(ctx sdk.Context) sdk.KVStore {
+	if p.storeKey == nil {
+		panic(fmt.Sprintf("concentrated liquidity pool %d is not bound to a store", p.Id))
+	}
+	return ctx.KVStore(p.storeKey)
+}
+
+// GetState returns the initialized ticks and the positions of the pool.
+func (p Pool) GetState(ctx sdk.Context) types.ConcentratedPoolState {
+	store := p.kvStore(ctx)
+	ticks, err := osmoutils.GatherValuesFromStorePrefix(store, types.GetKeyPrefixTicks(p.Id), parseTick)
+	if err != nil {
+		panic(err)
+	}
+	positions, err := osmoutils.GatherValuesFromStorePrefix(store, types.GetKeyPrefixPositions(p.Id), parsePosition)
+	if err != nil {
+		panic(err)
+	}
+	return types.ConcentratedPoolState{PoolId: p.Id, Ticks: ticks, Positions: positions}
+}
+
+// SetState stores the initialized ticks and the positions of the pool, as
+// exported by GetState.
+func (p Pool) SetState(ctx sdk.Context, state types.ConcentratedPoolState) {
+	for _, tick := range state.Ticks {
+		p.setTick(ctx, tick)
+	}
+	for _, position := range state.Positions {
+		p.setPosition(ctx, position)
+	}
+}
+
+// GetPositions returns all positions of the given owner.
+func (p Pool) GetPositions(ctx sdk.Context, owner string) []types.Position {
+	positions, err := osmoutils.GatherValuesFromStorePrefix(p.kvStore(ctx), types.GetKeyPrefixOwnerPositions(p.Id, owner), parsePosition)
+	if err != nil {
+		panic(err)
+	}
+	return positions
+}
+
+// GetActivePositions returns the positions in range of the current tick, whose
+// liquidity makes up the pool's active liquidity.
+func (p Pool) GetActivePositions(ctx sdk.Context) []types.Position {
+	positions, err := osmoutils.GatherValuesFromStorePrefix(p.kvStore(ctx), types.GetKeyPrefixPositions(p.Id), parsePosition)
+	if err != nil {
+		panic(err)
+	}
+	activePositions := []types.Position{}
+	for _, position := range positions {
+		if position.LowerTick <= p.CurrentTick && p.CurrentTick < position.UpperTick && position.Liquidity.IsPositive() {
+			activePositions = append(activePositions, position)
+		}
+	}
+	return activePositions
+}
+
+func (p Pool) getTick(ctx sdk.Context, index int64) (types.TickInfo, bool) {
+	store := p.kvStore(ctx)
+	key := types.GetKeyTick(p.Id, index)
+	if !store.Has(key) {
+		return types.TickInfo{}, false
+	}
+	tick := types.TickInfo{}
+	osmoutils.MustGet(store, key, &tick)
+	return tick, true
+}
+
+func (p Pool) setTick(ctx sdk.Context, tick types.TickInfo) {
+	osmoutils.MustSet(p.kvStore(ctx), types.GetKeyTick(p.Id, tick.Index), &tick)
+}
+
+func (p Pool) removeTick(ctx sdk.Context, index int64) {
+	p.kvStore(ctx).Delete(types.GetKeyTick(p.Id, index))
+}
+
+// nextInitializedTick returns the next initialized tick the price crosses when
+// moving in the given direction. Moving down, this is the current tick if it is
+// initialized.
+func (p Pool) nextInitializedTick(ctx sdk.Context, zeroForOne bool) (int64, bool) {
+	store := p.kvStore(ctx)
+	prefix := types.GetKeyPrefixTicks(p.Id)
+	aboveCurrentTick := types.GetKeyTick(p.Id, p.CurrentTick+1)
+
+	var iter sdk.Iterator
+	if zeroForOne {
+		iter = store.ReverseIterator(prefix, aboveCurrentTick)
+	} else {
+		iter = store.Iterator(aboveCurrentTick, sdk.PrefixEndBytes(prefix))
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, false
+	}
+	return types.BytesToTick(iter.Key()[len(prefix):]), true
+}
+
+func (p Pool) getPosition(ctx sdk.Context, owner string, lowerTick, upperTick int64) (types.Position, bool) {
+	store := p.kvStore(ctx)
+	key := types.GetKeyPosition(p.Id, owner, lowerTick, upperTick)
+	if !store.Has(key) {
+		return types.Position{}, false
+	}
+	position := types.Position{}
+	osmoutils.MustGet(store, key, &position)
+	return position, true
+}
+
+func (p Pool) setPosition(ctx sdk.Context, position types.Position) {
+	key := types.GetKeyPosition(p.Id, position.Owner, position.LowerTick, position.UpperTick)
+	osmoutils.MustSet(p.kvStore(ctx), key, &position)
+}
+
+func (p Pool) removePosition(ctx sdk.Context, owner string, lowerTick, upperTick int64) {
+	p.kvStore(ctx).Delete(types.GetKeyPosition(p.Id, owner, lowerTick, upperTick))
+}
+
+func parseTick(bz []byte) (types.TickInfo, error) {
+	tick := types.TickInfo{}
+	err := tick.Unmarshal(bz)
+	return tick, err
+}
+
+func parsePosition(bz []byte) (types.Position, error) {
+	position := types.Position{}
+	err := position.Unmarshal(bz)
+	return position, err
+}
@@ -0,0 +1,68 @@
+package concentrated
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TickToSqrtPrice returns the square root of the price at the given tick,
+// sqrt(1.0001^tick).
+func TickToSqrtPrice(tick int64) (sdk.Dec, error) {
+	if tick < MinTick || tick > MaxTick {
+		return sdk.Dec{}, fmt.Errorf("tick %d is out of range [%d, %d]", tick, MinTick, MaxTick)
+	}
+
+	if tick < 0 {
+		return sdk.OneDec().Quo(sqrtTickBase.Power(uint64(-tick))), nil
+	}
+	return sqrtTickBase.Power(uint64(tick)), nil
+}
+
+// mustTickToSqrtPrice is TickToSqrtPrice, for ticks that have already been
+// validated to be in range.
+func mustTickToSqrtPrice(tick int64) sdk.Dec {
+	sqrtPrice, err := TickToSqrtPrice(tick)
+	if err != nil {
+		panic(err)
+	}
+	return sqrtPrice
+}
+
+// SqrtPriceToTick returns the largest tick whose square root price is lesser
+// than or equal to the given square root price.
+func SqrtPriceToTick(sqrtPrice sdk.Dec) (int64, error) {
+	if sqrtPrice.LT(mustTickToSqrtPrice(MinTick)) || sqrtPrice.GT(mustTickToSqrtPrice(MaxTick)) {
+		return 0, fmt.Errorf("square root price %s is out of the range of valid ticks", sqrtPrice)
+	}
+
+	// the first tick past the one we're looking for, in [MinTick + 1, MaxTick + 1].
+	numTicks := int(MaxTick - MinTick)
+	offset := sort.Search(numTicks, func(i int) bool {
+		return mustTickToSqrtPrice(MinTick + 1 + int64(i)).GT(sqrtPrice)
+	})
+	return MinTick + int64(offset), nil
+}
+
+// validateTicks checks that a position's ticks are in range, ordered and a
+// multiple of the tick spacing.
+func validateTicks(lowerTick, upperTick int64, tickSpacing uint64) error {
+	if lowerTick >= upperTick {
+		return fmt.Errorf("lower tick %d must be lesser than upper tick %d", lowerTick, upperTick)
+	}
+	if lowerTick < MinTick || upperTick > MaxTick {
+		return fmt.Errorf("ticks [%d, %d] are out of range [%d, %d]", lowerTick, upperTick, MinTick, MaxTick)
+	}
+	spacing := int64(tickSpacing)
+	if lowerTick%spacing != 0 || upperTick%spacing != 0 {
+		return fmt.Errorf("ticks [%d, %d] must be multiples of the tick spacing %d", lowerTick, upperTick, spacing)
+	}
+	return nil
+}
+
+// fullRangeTicks returns the widest position range allowed by the tick spacing.
+func fullRangeTicks(tickSpacing uint64) (int64, int64) {
+	spacing := int64(tickSpacing)
+	return MinTick / spacing * spacing, MaxTick / spacing * spacing
+}
@@ -0,0 +1,84 @@
+package concentrated
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTickToSqrtPrice(t *testing.T) {
+	tests := map[string]struct {
+		tick              int64
+		expectedSqrtPrice sdk.Dec
+		expectErr         bool
+	}{
+		"tick zero":           {tick: 0, expectedSqrtPrice: sdk.OneDec()},
+		"tick one":            {tick: 1, expectedSqrtPrice: sqrtTickBase},
+		"price 1.0001^10000":  {tick: 20000, expectedSqrtPrice: sdk.MustNewDecFromStr("2.718145926825224864")},
+		"negative tick":       {tick: -20000, expectedSqrtPrice: sdk.MustNewDecFromStr("0.367897834377123709")},
+		"tick above max tick": {tick: MaxTick + 1, expectErr: true},
+		"tick below min tick": {tick: MinTick - 1, expectErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			sqrtPrice, err := TickToSqrtPrice(tc.tick)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tc.expectedSqrtPrice.Sub(sqrtPrice).Abs().LTE(sdk.NewDecWithPrec(1, 12)),
+				"expected %s, got %s", tc.expectedSqrtPrice, sqrtPrice)
+		})
+	}
+}
+
+func TestSqrtPriceToTick(t *testing.T) {
+	for _, tick := range []int64{MinTick, -123456, -1, 0, 1, 7, 99999, MaxTick} {
+		sqrtPrice := mustTickToSqrtPrice(tick)
+		actualTick, err := SqrtPriceToTick(sqrtPrice)
+		require.NoError(t, err)
+		require.Equal(t, tick, actualTick)
+
+		// prices between two ticks round down to the lower tick
+		if tick < MaxTick {
+			between := sqrtPrice.Add(mustTickToSqrtPrice(tick + 1)).QuoInt64(2)
+			actualTick, err = SqrtPriceToTick(between)
+			require.NoError(t, err)
+			require.Equal(t, tick, actualTick)
+		}
+	}
+
+	_, err := SqrtPriceToTick(mustTickToSqrtPrice(MaxTick).Add(sdk.OneDec()))
+	require.Error(t, err)
+	_, err = SqrtPriceToTick(sdk.ZeroDec())
+	require.Error(t, err)
+}
+
+func TestValidateTicks(t *testing.T) {
+	tests := map[string]struct {
+		lowerTick, upperTick int64
+		tickSpacing          uint64
+		expectErr            bool
+	}{
+		"valid":                   {lowerTick: -100, upperTick: 100, tickSpacing: 10},
+		"full range":              {lowerTick: MinTick, upperTick: MaxTick, tickSpacing: 1},
+		"lower tick equals upper": {lowerTick: 100, upperTick: 100, tickSpacing: 1, expectErr: true},
+		"lower tick above upper":  {lowerTick: 200, upperTick: 100, tickSpacing: 1, expectErr: true},
+		"upper tick out of range": {lowerTick: 0, upperTick: MaxTick + 1, tickSpacing: 1, expectErr: true},
+		"not multiple of spacing": {lowerTick: -100, upperTick: 105, tickSpacing: 10, expectErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateTicks(tc.lowerTick, tc.upperTick, tc.tickSpacing)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -2,17 +2,21 @@
 // We expect tests for:
 // * MsgCreatePool creating correct pool as expected
 // * MsgStableSwapAdjustScalingFactors works as expected
+// * SetScalingFactorControllerProposal works as expected
 package stableswap_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/osmosis-labs/osmosis/v12/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm"
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 )
 
 type TestSuite struct {
@@ -34,14 +38,16 @@ func (s *TestSuite) TestSetScalingFactors() {
 	nextPoolId := s.App.GAMMKeeper.GetNextPoolId(s.Ctx)
 	defaultCreatePoolMsg := *baseCreatePoolMsgGen(addr1)
 	defaultCreatePoolMsg.ScalingFactorController = defaultCreatePoolMsg.Sender
-	defaultAdjustSFMsg := stableswap.NewMsgStableSwapAdjustScalingFactors(defaultCreatePoolMsg.Sender, nextPoolId, []uint64{1, 1})
+	defaultAdjustSFMsg := stableswap.NewMsgStableSwapAdjustScalingFactors(defaultCreatePoolMsg.Sender, nextPoolId, []uint64{1, 1}, 0)
+	tooLargeAdjustSFMsg := stableswap.NewMsgStableSwapAdjustScalingFactors(defaultCreatePoolMsg.Sender, nextPoolId, []uint64{2, 1}, 0)
 
 	tests := map[string]struct {
 		createMsg  stableswap.MsgCreateStableswapPool
 		setMsg     stableswap.MsgStableSwapAdjustScalingFactors
 		expectPass bool
 	}{
-		"valid_msg": {defaultCreatePoolMsg, defaultAdjustSFMsg, true},
+		"valid_msg":           {defaultCreatePoolMsg, defaultAdjustSFMsg, true},
+		"change_is_too_large": {defaultCreatePoolMsg, tooLargeAdjustSFMsg, false},
 	}
 
 	for name, tc := range tests {
@@ -53,7 +59,130 @@ func (s *TestSuite) TestSetScalingFactors() {
 			_, err := s.RunMsg(&tc.createMsg)
 			s.Require().NoError(err)
 			_, err = s.RunMsg(&tc.setMsg)
+			if tc.expectPass {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestSetScalingFactorsOverTime() {
+	s.SetupTest()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	createPoolMsg := *baseCreatePoolMsgGen(addr1)
+	createPoolMsg.ScalingFactorController = createPoolMsg.Sender
+	createPoolMsg.ScalingFactors = []uint64{20, 20}
+	s.FundAcc(addr1, s.App.GAMMKeeper.GetParams(s.Ctx).PoolCreationFee)
+	s.FundAcc(addr1, createPoolMsg.InitialPoolLiquidity.Sort())
+	poolId := s.App.GAMMKeeper.GetNextPoolId(s.Ctx)
+	_, err := s.RunMsg(&createPoolMsg)
+	s.Require().NoError(err)
+
+	adjustMsg := stableswap.NewMsgStableSwapAdjustScalingFactors(createPoolMsg.Sender, poolId, []uint64{22, 18}, time.Hour)
+	_, err = s.RunMsg(&adjustMsg)
+	s.Require().NoError(err)
+
+	assertScalingFactors := func(expected []uint64) {
+		pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, pool.(*stableswap.Pool).GetScalingFactors())
+	}
+
+	assertScalingFactors([]uint64{20, 20})
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(30 * time.Minute))
+	assertScalingFactors([]uint64{21, 19})
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(30 * time.Minute))
+	assertScalingFactors([]uint64{22, 18})
+}
+
+// TestSetScalingFactorsRateLimit tests that back-to-back scaling factor changes can not
+// compound past the max scaling factor change within the scaling factor change window.
+func (s *TestSuite) TestSetScalingFactorsRateLimit() {
+	changeWindow := s.App.GAMMKeeper.GetParams(s.Ctx).StableswapScalingFactorChangeWindow
+
+	tests := map[string]struct {
+		changeDuration time.Duration
+	}{
+		"instant changes": {0},
+		"smooth changes":  {2 * changeWindow},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+			createPoolMsg := *baseCreatePoolMsgGen(addr1)
+			createPoolMsg.ScalingFactorController = createPoolMsg.Sender
+			createPoolMsg.ScalingFactors = []uint64{10, 10}
+			s.FundAcc(addr1, s.App.GAMMKeeper.GetParams(s.Ctx).PoolCreationFee)
+			s.FundAcc(addr1, createPoolMsg.InitialPoolLiquidity.Sort())
+			poolId := s.App.GAMMKeeper.GetNextPoolId(s.Ctx)
+			_, err := s.RunMsg(&createPoolMsg)
+			s.Require().NoError(err)
+
+			firstMsg := stableswap.NewMsgStableSwapAdjustScalingFactors(createPoolMsg.Sender, poolId, []uint64{11, 10}, tc.changeDuration)
+			secondMsg := stableswap.NewMsgStableSwapAdjustScalingFactors(createPoolMsg.Sender, poolId, []uint64{12, 10}, tc.changeDuration)
+			_, err = s.RunMsg(&firstMsg)
+			s.Require().NoError(err)
+
+			// the second change is rejected in the same block, and until the first change is over and the window elapsed
+			_, err = s.RunMsg(&secondMsg)
+			s.Require().ErrorIs(err, types.ErrScalingFactorChangeTooFrequent)
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(changeWindow - time.Second))
+			_, err = s.RunMsg(&secondMsg)
+			s.Require().ErrorIs(err, types.ErrScalingFactorChangeTooFrequent)
+			if tc.changeDuration > 0 {
+				s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second))
+				_, err = s.RunMsg(&secondMsg)
+				s.Require().ErrorIs(err, types.ErrScalingFactorChangeTooFrequent)
+			}
+
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(tc.changeDuration + time.Second))
+			_, err = s.RunMsg(&secondMsg)
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *TestSuite) TestSetScalingFactorControllerProposal() {
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	newController := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	tests := map[string]struct {
+		controller string
+		expectPass bool
+	}{
+		"replace controller": {newController.String(), true},
+		"remove controller":  {"", true},
+		"invalid controller": {"invalid", false},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			createPoolMsg := *baseCreatePoolMsgGen(addr1)
+			createPoolMsg.ScalingFactorController = createPoolMsg.Sender
+			s.FundAcc(addr1, s.App.GAMMKeeper.GetParams(s.Ctx).PoolCreationFee)
+			s.FundAcc(addr1, createPoolMsg.InitialPoolLiquidity.Sort())
+			poolId := s.App.GAMMKeeper.GetNextPoolId(s.Ctx)
+			_, err := s.RunMsg(&createPoolMsg)
 			s.Require().NoError(err)
+
+			proposal := types.NewSetScalingFactorControllerProposal("title", "description", poolId, tc.controller)
+			err = gamm.NewGammProposalHandler(*s.App.GAMMKeeper)(s.Ctx, &proposal)
+
+			pool, poolErr := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+			s.Require().NoError(poolErr)
+			controller := pool.(*stableswap.Pool).ScalingFactorController
+			if tc.expectPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.controller, controller)
+			} else {
+				s.Require().Error(err)
+				s.Require().Equal(createPoolMsg.Sender, controller)
+			}
 		})
 	}
 }
@@ -1,6 +1,8 @@
 package stableswap
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -120,11 +122,13 @@ func NewMsgStableSwapAdjustScalingFactors(
 	sender string,
 	poolID uint64,
 	scalingFactors []uint64,
+	scalingFactorChangeDuration time.Duration,
 ) MsgStableSwapAdjustScalingFactors {
 	return MsgStableSwapAdjustScalingFactors{
-		Sender:         sender,
-		PoolID:         poolID,
-		ScalingFactors: scalingFactors,
+		Sender:                      sender,
+		PoolID:                      poolID,
+		ScalingFactors:              scalingFactors,
+		ScalingFactorChangeDuration: scalingFactorChangeDuration,
 	}
 }
 
@@ -143,6 +147,10 @@ func (msg MsgStableSwapAdjustScalingFactors) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
 	}
 
+	if msg.ScalingFactorChangeDuration < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "scaling factor change duration must be non-negative, got %s", msg.ScalingFactorChangeDuration)
+	}
+
 	return nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -13,7 +14,12 @@ import (
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 )
 
-var _ types.PoolI = &Pool{}
+var (
+	_ types.PoolI                        = &Pool{}
+	_ types.PokablePoolExtension         = &Pool{}
+	_ types.SwapFeeSettablePoolExtension = &Pool{}
+	_ types.ProtocolRevenuePoolExtension = &Pool{}
+)
 
 // NewStableswapPool returns a stableswap pool
 // Invariants that are assumed to be satisfied and not checked:
@@ -82,12 +88,22 @@ func (p Pool) GetSwapFee(ctx sdk.Context) sdk.Dec {
 	return p.PoolParams.SwapFee
 }
 
+func (p *Pool) SetSwapFee(swapFee sdk.Dec) {
+	p.PoolParams.SwapFee = swapFee
+}
+
 func (p Pool) GetExitFee(ctx sdk.Context) sdk.Dec {
 	return p.PoolParams.ExitFee
 }
 
+// IsActive returns false once the pool has been sunset.
 func (p Pool) IsActive(ctx sdk.Context) bool {
-	return true
+	return !p.Sunset
+}
+
+// SetSunset marks the pool as sunset, disabling swaps and joins on it.
+func (p *Pool) SetSunset() {
+	p.Sunset = true
 }
 
 // Returns the coins in the pool owned by all LP shareholders
@@ -214,6 +230,19 @@ func (p *Pool) updatePoolLiquidityForExit(tokensOut sdk.Coins) {
 	p.updatePoolLiquidityForSwap(sdk.Coins{}, tokensOut)
 }
 
+// RemoveLiquidity removes coins from the pool liquidity without burning any shares.
+// Errors if a coin is not in the pool, or the pool would be left without any of it.
+func (p *Pool) RemoveLiquidity(coins sdk.Coins) error {
+	if !coins.DenomsSubsetOf(p.PoolLiquidity) {
+		return fmt.Errorf("can't remove %s from the pool's liquidity %s", coins, p.PoolLiquidity)
+	}
+	if coins.IsAnyGTE(p.PoolLiquidity) {
+		return fmt.Errorf("can't remove all the pool's liquidity of any denom, removing %s from %s", coins, p.PoolLiquidity)
+	}
+	p.updatePoolLiquidityForExit(coins)
+	return nil
+}
+
 func (p *Pool) updatePoolForJoin(tokensIn sdk.Coins, newShares sdk.Int) {
 	numTokens := p.NumAssets()
 	p.PoolLiquidity = p.PoolLiquidity.Add(tokensIn...)
@@ -373,13 +402,33 @@ func (p Pool) CalcExitPoolCoinsFromShares(ctx sdk.Context, exitingShares sdk.Int
 }
 
 // SetScalingFactors sets scaling factors for pool to the given amount
-// It should only be able to be successfully called by the pool's ScalingFactorGovernor
+// It should only be able to be successfully called by the pool's ScalingFactorGovernor.
+// Each scaling factor may change by at most maxChange relative to its current value.
+// If changeDuration is zero the new scaling factors are applied immediately, otherwise
+// the pool linearly moves from its current scaling factors to the new ones over changeDuration,
+// starting at the current block time.
+// To rate limit the changes, the scaling factors can not change while a change is in progress,
+// nor within changeWindow of the start of the previous change.
 // TODO: move commented test for this function from x/gamm/keeper/pool_service_test.go once a pool_test.go file has been created for stableswap
-func (p *Pool) SetScalingFactors(ctx sdk.Context, scalingFactors []uint64, sender string) error {
+func (p *Pool) SetScalingFactors(ctx sdk.Context, scalingFactors []uint64, changeDuration time.Duration, maxChange sdk.Dec, changeWindow time.Duration, sender string) error {
 	if sender != p.ScalingFactorController {
 		return types.ErrNotScalingFactorGovernor
 	}
 
+	if changeDuration < 0 {
+		return fmt.Errorf("scaling factor change duration must be non-negative, got %s", changeDuration)
+	}
+
+	p.PokePool(ctx.BlockTime())
+	if p.ScalingFactorChangeParams != nil {
+		return sdkerrors.Wrapf(types.ErrScalingFactorChangeTooFrequent,
+			"scaling factor change started at %s is in progress", p.ScalingFactorChangeParams.StartTime)
+	}
+	if !p.LastScalingFactorChangeTime.IsZero() && ctx.BlockTime().Before(p.LastScalingFactorChangeTime.Add(changeWindow)) {
+		return sdkerrors.Wrapf(types.ErrScalingFactorChangeTooFrequent,
+			"scaling factors changed at %s, next change allowed at %s", p.LastScalingFactorChangeTime, p.LastScalingFactorChangeTime.Add(changeWindow))
+	}
+
 	scalingFactors = applyScalingFactorMultiplier(scalingFactors)
 
 	if err := validateScalingFactors(scalingFactors, p.PoolLiquidity.Len()); err != nil {
@@ -390,7 +439,93 @@ func (p *Pool) SetScalingFactors(ctx sdk.Context, scalingFactors []uint64, sende
 		return err
 	}
 
-	p.ScalingFactors = scalingFactors
+	if err := validateScalingFactorChange(p.ScalingFactors, scalingFactors, maxChange); err != nil {
+		return err
+	}
+
+	p.LastScalingFactorChangeTime = ctx.BlockTime()
+	if changeDuration == 0 {
+		p.ScalingFactors = scalingFactors
+		return nil
+	}
+
+	initialScalingFactors := make([]uint64, len(p.ScalingFactors))
+	copy(initialScalingFactors, p.ScalingFactors)
+	p.ScalingFactorChangeParams = &ScalingFactorChangeParams{
+		StartTime:             ctx.BlockTime(),
+		Duration:              changeDuration,
+		InitialScalingFactors: initialScalingFactors,
+		TargetScalingFactors:  scalingFactors,
+	}
+	return nil
+}
+
+// SetScalingFactorController replaces the pool's scaling factor controller.
+// An empty controller disables scaling factor changes for the pool.
+func (p *Pool) SetScalingFactorController(scalingFactorController string) error {
+	if err := validateScalingFactorController(scalingFactorController); err != nil {
+		return err
+	}
+
+	p.ScalingFactorController = scalingFactorController
+	return nil
+}
+
+// PokePool checks to see if the pool's scaling factors need to be updated, and
+// if so, does so. Only does anything while a scaling factor change is in progress.
+func (p *Pool) PokePool(blockTime time.Time) {
+	if p.ScalingFactorChangeParams == nil {
+		return
+	}
+
+	params := *p.ScalingFactorChangeParams
+
+	// The scaling factors s(t) for the pool at time `t` are defined as:
+	//
+	// 1. t <= start_time: s(t) = current scaling factors
+	//
+	// 2. start_time < t < start_time + duration:
+	//     s(t) = initial_scaling_factors + (t - start_time) *
+	//       (target_scaling_factors - initial_scaling_factors) / (duration)
+	//
+	// 3. t >= start_time + duration: s(t) = target_scaling_factors
+	switch {
+	case !blockTime.After(params.StartTime):
+		return
+
+	case !blockTime.Before(params.StartTime.Add(params.Duration)):
+		p.ScalingFactors = params.TargetScalingFactors
+		p.ScalingFactorChangeParams = nil
+		return
+
+	default:
+		elapsed := sdk.NewInt(blockTime.Sub(params.StartTime).Milliseconds())
+		duration := sdk.NewInt(params.Duration.Milliseconds())
+
+		updatedScalingFactors := make([]uint64, len(params.TargetScalingFactors))
+		for i := range params.TargetScalingFactors {
+			initial := sdk.NewIntFromUint64(params.InitialScalingFactors[i])
+			target := sdk.NewIntFromUint64(params.TargetScalingFactors[i])
+			// integer division truncates towards zero, so the factor never overshoots the target.
+			delta := target.Sub(initial).Mul(elapsed).Quo(duration)
+			updatedScalingFactors[i] = initial.Add(delta).Uint64()
+		}
+		p.ScalingFactors = updatedScalingFactors
+	}
+}
+
+// validateScalingFactorChange returns an error if any of the new scaling factors
+// differs from the corresponding current scaling factor by more than maxChange, relative
+// to the current scaling factor.
+func validateScalingFactorChange(current []uint64, updated []uint64, maxChange sdk.Dec) error {
+	for i := range updated {
+		currentFactor := sdk.NewIntFromUint64(current[i])
+		change := sdk.NewIntFromUint64(updated[i]).Sub(currentFactor).Abs()
+		if sdk.NewDecFromInt(change).GT(maxChange.MulInt(currentFactor)) {
+			return sdkerrors.Wrapf(types.ErrScalingFactorChangeTooLarge,
+				"scaling factor %d changes from %d to %d, max change is %s", i, current[i], updated[i], maxChange)
+		}
+	}
 	return nil
 }
 
@@ -3,6 +3,7 @@ package stableswap
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -991,3 +992,166 @@ func TestExitPool(t *testing.T) {
 		})
 	}
 }
+
+func TestSetScalingFactors(t *testing.T) {
+	controller := "osmo1cyyzpxplxdzkeea7kwsydadg87357qnahakaks"
+	startTime := time.Unix(1_000_000, 0).UTC()
+	maxChange := sdk.NewDecWithPrec(1, 1)
+	changeWindow := 24 * time.Hour
+	tests := map[string]struct {
+		// lastChangeTime and changeParams are the pool's previous change, if any
+		lastChangeTime         time.Time
+		changeParams           *ScalingFactorChangeParams
+		scalingFactors         []uint64
+		changeDuration         time.Duration
+		sender                 string
+		expectedScalingFactors []uint64
+		expectedChangeParams   *ScalingFactorChangeParams
+		expectedErr            error
+	}{
+		"instant change": {
+			scalingFactors:         []uint64{110, 90},
+			sender:                 controller,
+			expectedScalingFactors: []uint64{110, 90},
+		},
+		"smooth change": {
+			scalingFactors:         []uint64{110, 90},
+			changeDuration:         time.Hour,
+			sender:                 controller,
+			expectedScalingFactors: []uint64{100, 100},
+			expectedChangeParams: &ScalingFactorChangeParams{
+				StartTime:             startTime,
+				Duration:              time.Hour,
+				InitialScalingFactors: []uint64{100, 100},
+				TargetScalingFactors:  []uint64{110, 90},
+			},
+		},
+		"change after the change window": {
+			lastChangeTime:         startTime.Add(-changeWindow),
+			scalingFactors:         []uint64{110, 90},
+			sender:                 controller,
+			expectedScalingFactors: []uint64{110, 90},
+		},
+		"change within the change window": {
+			lastChangeTime: startTime.Add(-changeWindow + time.Second),
+			scalingFactors: []uint64{110, 90},
+			sender:         controller,
+			expectedErr:    types.ErrScalingFactorChangeTooFrequent,
+		},
+		"change while a change is in progress": {
+			lastChangeTime: startTime.Add(-2 * changeWindow),
+			changeParams: &ScalingFactorChangeParams{
+				StartTime:             startTime.Add(-2 * changeWindow),
+				Duration:              3 * changeWindow,
+				InitialScalingFactors: applyScalingFactorMultiplier([]uint64{100, 100}),
+				TargetScalingFactors:  applyScalingFactorMultiplier([]uint64{100, 100}),
+			},
+			scalingFactors: []uint64{110, 90},
+			sender:         controller,
+			expectedErr:    types.ErrScalingFactorChangeTooFrequent,
+		},
+		"change larger than max change": {
+			scalingFactors: []uint64{111, 100},
+			sender:         controller,
+			expectedErr:    types.ErrScalingFactorChangeTooLarge,
+		},
+		"decrease larger than max change": {
+			scalingFactors: []uint64{100, 89},
+			changeDuration: time.Hour,
+			sender:         controller,
+			expectedErr:    types.ErrScalingFactorChangeTooLarge,
+		},
+		"sender is not the controller": {
+			scalingFactors: []uint64{110, 90},
+			sender:         "osmo1k8c2m5cn322akk5wy8lpt87dd2f4yh9afcd7af",
+			expectedErr:    types.ErrNotScalingFactorGovernor,
+		},
+		"wrong number of scaling factors": {
+			scalingFactors: []uint64{100},
+			sender:         controller,
+			expectedErr:    types.ErrInvalidStableswapScalingFactors,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithBlockTime(startTime)
+			p := poolStructFromAssets(twoEvenStablePoolAssets, []uint64{100, 100})
+			p.ScalingFactorController = controller
+			p.LastScalingFactorChangeTime = tc.lastChangeTime
+			p.ScalingFactorChangeParams = tc.changeParams
+
+			err := p.SetScalingFactors(ctx, tc.scalingFactors, tc.changeDuration, maxChange, changeWindow, tc.sender)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Equal(t, applyScalingFactorMultiplier([]uint64{100, 100}), p.ScalingFactors)
+				require.Equal(t, tc.changeParams, p.ScalingFactorChangeParams)
+				require.Equal(t, tc.lastChangeTime, p.LastScalingFactorChangeTime)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, applyScalingFactorMultiplier(tc.expectedScalingFactors), p.ScalingFactors)
+			require.Equal(t, tc.expectedChangeParams, p.ScalingFactorChangeParams)
+			require.Equal(t, startTime, p.LastScalingFactorChangeTime)
+		})
+	}
+}
+
+func TestPokePool(t *testing.T) {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	changeParams := ScalingFactorChangeParams{
+		StartTime:             startTime,
+		Duration:              time.Hour,
+		InitialScalingFactors: []uint64{100, 100},
+		TargetScalingFactors:  []uint64{110, 90},
+	}
+	tests := map[string]struct {
+		blockTime              time.Time
+		expectedScalingFactors []uint64
+		expectChangeCleared    bool
+	}{
+		"before start time": {
+			blockTime:              startTime.Add(-time.Minute),
+			expectedScalingFactors: []uint64{100, 100},
+		},
+		"at start time": {
+			blockTime:              startTime,
+			expectedScalingFactors: []uint64{100, 100},
+		},
+		"quarter of the way through": {
+			blockTime:              startTime.Add(15 * time.Minute),
+			expectedScalingFactors: []uint64{102, 98},
+		},
+		"half way through": {
+			blockTime:              startTime.Add(30 * time.Minute),
+			expectedScalingFactors: []uint64{105, 95},
+		},
+		"at end time": {
+			blockTime:              startTime.Add(time.Hour),
+			expectedScalingFactors: []uint64{110, 90},
+			expectChangeCleared:    true,
+		},
+		"after end time": {
+			blockTime:              startTime.Add(2 * time.Hour),
+			expectedScalingFactors: []uint64{110, 90},
+			expectChangeCleared:    true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := poolStructFromAssets(twoEvenStablePoolAssets, []uint64{100, 100})
+			params := changeParams
+			p.ScalingFactorChangeParams = &params
+
+			p.PokePool(tc.blockTime)
+
+			require.Equal(t, tc.expectedScalingFactors, p.ScalingFactors)
+			if tc.expectChangeCleared {
+				require.Nil(t, p.ScalingFactorChangeParams)
+			} else {
+				require.Equal(t, &changeParams, p.ScalingFactorChangeParams)
+			}
+		})
+	}
+}
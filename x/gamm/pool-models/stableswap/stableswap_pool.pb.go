@@ -7,20 +7,23 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -70,6 +73,82 @@ func (m *PoolParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PoolParams proto.InternalMessageInfo
 
+// ScalingFactorChangeParams defines a change of the pool's scaling factors
+// over time, similarly to the smooth weight change of balancer pools.
+type ScalingFactorChangeParams struct {
+	// The start time for beginning the scaling factor change.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// Duration for the scaling factors to change over
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+	// The initial scaling factors. These are copied from the pool's scaling
+	// factors at the time of scaling factor change instantiation.
+	InitialScalingFactors []uint64 `protobuf:"varint,3,rep,packed,name=initial_scaling_factors,json=initialScalingFactors,proto3" json:"initial_scaling_factors,omitempty" yaml:"initial_scaling_factors"`
+	// The target scaling factors. The pool scaling factors will change linearly
+	// with respect to time between start_time, and start_time + duration.
+	TargetScalingFactors []uint64 `protobuf:"varint,4,rep,packed,name=target_scaling_factors,json=targetScalingFactors,proto3" json:"target_scaling_factors,omitempty" yaml:"target_scaling_factors"`
+}
+
+func (m *ScalingFactorChangeParams) Reset()         { *m = ScalingFactorChangeParams{} }
+func (m *ScalingFactorChangeParams) String() string { return proto.CompactTextString(m) }
+func (*ScalingFactorChangeParams) ProtoMessage()    {}
+func (*ScalingFactorChangeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae0f054436f9999a, []int{1}
+}
+func (m *ScalingFactorChangeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScalingFactorChangeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScalingFactorChangeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScalingFactorChangeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalingFactorChangeParams.Merge(m, src)
+}
+func (m *ScalingFactorChangeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScalingFactorChangeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalingFactorChangeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalingFactorChangeParams proto.InternalMessageInfo
+
+func (m *ScalingFactorChangeParams) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *ScalingFactorChangeParams) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *ScalingFactorChangeParams) GetInitialScalingFactors() []uint64 {
+	if m != nil {
+		return m.InitialScalingFactors
+	}
+	return nil
+}
+
+func (m *ScalingFactorChangeParams) GetTargetScalingFactors() []uint64 {
+	if m != nil {
+		return m.TargetScalingFactors
+	}
+	return nil
+}
+
 // Pool is the stableswap Pool struct
 type Pool struct {
 	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
@@ -85,19 +164,27 @@ type Pool struct {
 	// would need to be locked up to count in governance. 0w means no lockup.
 	FuturePoolGovernor string `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
 	// sum of all LP shares
-	TotalShares types.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+	TotalShares types1.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
 	// assets in the pool
 	PoolLiquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=pool_liquidity,json=poolLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_liquidity"`
 	// for calculation amognst assets with different precisions
 	ScalingFactors []uint64 `protobuf:"varint,7,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factors"`
 	// scaling_factor_controller is the address can adjust pool scaling factors
 	ScalingFactorController string `protobuf:"bytes,8,opt,name=scaling_factor_controller,json=scalingFactorController,proto3" json:"scaling_factor_controller,omitempty" yaml:"scaling_factor_controller"`
+	// scaling_factor_change_params is the ongoing change of the scaling
+	// factors, if any.
+	ScalingFactorChangeParams *ScalingFactorChangeParams `protobuf:"bytes,9,opt,name=scaling_factor_change_params,json=scalingFactorChangeParams,proto3" json:"scaling_factor_change_params,omitempty" yaml:"scaling_factor_change_params"`
+	// last_scaling_factor_change_time is the start time of the latest
+	// adjustment of the scaling factors, if any.
+	LastScalingFactorChangeTime time.Time `protobuf:"bytes,10,opt,name=last_scaling_factor_change_time,json=lastScalingFactorChangeTime,proto3,stdtime" json:"last_scaling_factor_change_time" yaml:"last_scaling_factor_change_time"`
+	// they only allow LPs to withdraw their share of the remaining liquidity.
+	Sunset bool `protobuf:"varint,11,opt,name=sunset,proto3" json:"sunset,omitempty" yaml:"sunset"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
 func (*Pool) ProtoMessage() {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae0f054436f9999a, []int{1}
+	return fileDescriptor_ae0f054436f9999a, []int{2}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -128,6 +215,7 @@ var xxx_messageInfo_Pool proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*PoolParams)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.PoolParams")
+	proto.RegisterType((*ScalingFactorChangeParams)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.ScalingFactorChangeParams")
 	proto.RegisterType((*Pool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.Pool")
 }
 
@@ -136,48 +224,63 @@ func init() {
 }
 
 var fileDescriptor_ae0f054436f9999a = []byte{
-	// 642 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0xdb, 0xb4, 0x29, 0x5b, 0x48, 0x85, 0xa9, 0x84, 0xdb, 0x0a, 0x6f, 0xb0, 0x28, 0x8a,
-	0x10, 0xb1, 0x69, 0x91, 0x90, 0xe8, 0xad, 0x29, 0x2a, 0x42, 0x42, 0xa8, 0x98, 0x13, 0x3f, 0x52,
-	0x58, 0xdb, 0x1b, 0x77, 0x85, 0x9d, 0x35, 0xbb, 0xeb, 0xd2, 0x5e, 0x38, 0x73, 0xe4, 0xc8, 0xb1,
-	0x67, 0xb8, 0xf2, 0x10, 0x15, 0xa7, 0x1e, 0x11, 0x07, 0x83, 0xda, 0x37, 0xc8, 0x13, 0xa0, 0x5d,
-	0xaf, 0xfb, 0x13, 0xa0, 0xaa, 0xc4, 0x29, 0x3b, 0x33, 0xdf, 0x7c, 0x33, 0xdf, 0xcc, 0xc4, 0xe0,
-	0x3e, 0xe5, 0x29, 0xe5, 0x84, 0x7b, 0x31, 0x4a, 0x53, 0x2f, 0xa3, 0x34, 0xe9, 0xa4, 0x34, 0xc2,
-	0x09, 0xf7, 0xb8, 0x40, 0x41, 0x82, 0xf9, 0x3b, 0x94, 0x9d, 0x78, 0xf6, 0x24, 0xc2, 0xcd, 0x18,
-	0x15, 0xd4, 0xbc, 0xa5, 0x53, 0x5d, 0x99, 0xea, 0xca, 0x40, 0x99, 0xe9, 0x1e, 0xc3, 0xdd, 0xad,
-	0xa5, 0x00, 0x0b, 0xb4, 0x34, 0x3f, 0x17, 0x2a, 0x70, 0x4f, 0x65, 0x7a, 0xa5, 0x51, 0xd2, 0xcc,
-	0xcf, 0xc6, 0x34, 0xa6, 0xa5, 0x5f, 0xbe, 0xb4, 0xd7, 0x8e, 0x29, 0x8d, 0x13, 0xec, 0x29, 0x2b,
-	0xc8, 0xfb, 0x5e, 0x94, 0x33, 0x24, 0x08, 0x1d, 0xe8, 0x38, 0x1c, 0x8d, 0x0b, 0x92, 0x62, 0x2e,
-	0x50, 0x9a, 0x55, 0x04, 0x65, 0x11, 0x0f, 0xe5, 0x62, 0xd3, 0xd3, 0x6d, 0x28, 0x63, 0x24, 0x1e,
-	0x20, 0x8e, 0x8f, 0xe2, 0x21, 0x25, 0xba, 0x80, 0xb3, 0x67, 0x00, 0xb0, 0x41, 0x69, 0xb2, 0x81,
-	0x18, 0x4a, 0xb9, 0xf9, 0x0a, 0x4c, 0x29, 0xfd, 0x7d, 0x8c, 0x2d, 0xa3, 0x65, 0xb4, 0x2f, 0x74,
-	0x57, 0xf7, 0x0a, 0x58, 0xfb, 0x51, 0xc0, 0x9b, 0x31, 0x11, 0x9b, 0x79, 0xe0, 0x86, 0x34, 0xd5,
-	0xc2, 0xf4, 0x4f, 0x87, 0x47, 0x6f, 0x3c, 0xb1, 0x93, 0x61, 0xee, 0x3e, 0xc0, 0xe1, 0xb0, 0x80,
-	0x33, 0x3b, 0x28, 0x4d, 0x56, 0x9c, 0x8a, 0xc7, 0xf1, 0x1b, 0xf2, 0xb9, 0x8e, 0xb1, 0x64, 0xc7,
-	0xdb, 0x44, 0x28, 0xf6, 0xb1, 0xff, 0x63, 0xaf, 0x78, 0x1c, 0xbf, 0x21, 0x9f, 0xeb, 0x18, 0x3b,
-	0x5f, 0x26, 0x40, 0x5d, 0x4a, 0x31, 0x6f, 0x83, 0x06, 0x8a, 0x22, 0x86, 0x39, 0xd7, 0x1a, 0xcc,
-	0x61, 0x01, 0x9b, 0x65, 0x9e, 0x0e, 0x38, 0x7e, 0x05, 0x31, 0x9b, 0x60, 0x8c, 0x44, 0xaa, 0x9d,
-	0xba, 0x3f, 0x46, 0x22, 0xf3, 0x3d, 0x98, 0x96, 0x4b, 0xee, 0x65, 0x6a, 0x22, 0xd6, 0x78, 0xcb,
-	0x68, 0x4f, 0x2f, 0xdf, 0x73, 0xcf, 0x7f, 0x05, 0xee, 0xf1, 0x3c, 0xbb, 0x8b, 0x52, 0xdf, 0xb0,
-	0x80, 0xd7, 0xf4, 0x4c, 0x4e, 0x5f, 0x98, 0xae, 0xe1, 0xf8, 0x20, 0x3b, 0x5e, 0xc1, 0x53, 0x30,
-	0xdb, 0xcf, 0x45, 0xce, 0x70, 0x09, 0x89, 0xe9, 0x16, 0x66, 0x03, 0xca, 0xac, 0xba, 0x92, 0x02,
-	0x87, 0x05, 0x5c, 0x28, 0xc9, 0xfe, 0x86, 0x72, 0x7c, 0xb3, 0x74, 0xcb, 0x1e, 0x1e, 0x6a, 0xa7,
-	0xf9, 0x1c, 0x5c, 0x14, 0x54, 0xa0, 0xa4, 0xc7, 0x37, 0x11, 0xc3, 0xdc, 0x9a, 0x50, 0x9a, 0xe6,
-	0x5c, 0x7d, 0xa0, 0xf2, 0x36, 0x8e, 0x9a, 0x5f, 0xa3, 0x64, 0xd0, 0x5d, 0xd0, 0x6d, 0x5f, 0x29,
-	0x2b, 0x9d, 0x4c, 0x76, 0xfc, 0x69, 0x65, 0x3e, 0x53, 0x96, 0xc9, 0x40, 0x53, 0x35, 0x90, 0x90,
-	0xb7, 0x39, 0x89, 0x88, 0xd8, 0xb1, 0x26, 0x5b, 0xe3, 0x67, 0x93, 0xdf, 0x91, 0xe4, 0x9f, 0x7f,
-	0xc2, 0xf6, 0x39, 0x76, 0x2e, 0x13, 0xb8, 0x7f, 0x49, 0x96, 0x78, 0x5c, 0x55, 0x30, 0x9f, 0x80,
-	0x19, 0x1e, 0xa2, 0x84, 0x0c, 0xe2, 0x5e, 0x1f, 0x85, 0x82, 0x32, 0x6e, 0x35, 0x5a, 0xe3, 0xed,
-	0x7a, 0x77, 0x71, 0x58, 0xc0, 0xeb, 0x7f, 0x4c, 0x7a, 0x04, 0xeb, 0xf8, 0x4d, 0xed, 0x59, 0x2f,
-	0x1d, 0xe6, 0x6b, 0x30, 0x77, 0x1a, 0xd3, 0x0b, 0xe9, 0x40, 0x30, 0x9a, 0x24, 0x98, 0x59, 0x53,
-	0x6a, 0xec, 0x37, 0x86, 0x05, 0x6c, 0x69, 0xe6, 0x7f, 0x41, 0x1d, 0xff, 0xea, 0x29, 0xe2, 0xb5,
-	0xa3, 0xc8, 0xca, 0xe5, 0x0f, 0xbb, 0xb0, 0xf6, 0x69, 0x17, 0xd6, 0xbe, 0x7d, 0xed, 0x4c, 0xc8,
-	0xd5, 0x3c, 0xea, 0xbe, 0xdc, 0x3b, 0xb0, 0x8d, 0xfd, 0x03, 0xdb, 0xf8, 0x75, 0x60, 0x1b, 0x1f,
-	0x0f, 0xed, 0xda, 0xfe, 0xa1, 0x5d, 0xfb, 0x7e, 0x68, 0xd7, 0x5e, 0xac, 0x9e, 0x98, 0x8b, 0xbe,
-	0xba, 0x4e, 0x82, 0x02, 0x5e, 0x19, 0xde, 0xd6, 0xd2, 0xb2, 0xb7, 0x7d, 0xd6, 0x97, 0x2c, 0x98,
-	0x54, 0x7f, 0xee, 0xbb, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x42, 0xfc, 0xdb, 0xf6, 0xf7, 0x04,
-	0x00, 0x00,
+	// 896 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc6, 0x6e, 0xe2, 0x8c, 0x69, 0xaa, 0x2c, 0x81, 0xda, 0x09, 0xf1, 0xb8, 0x03, 0xad,
+	0x02, 0x34, 0xbb, 0x24, 0x48, 0x48, 0xf4, 0xd6, 0x4d, 0x09, 0x42, 0x42, 0xa8, 0x6c, 0x91, 0x80,
+	0x82, 0x64, 0xc6, 0xde, 0xc9, 0x7a, 0xc4, 0xee, 0xce, 0xb2, 0x33, 0x1b, 0x9a, 0x0b, 0x67, 0x8e,
+	0x3d, 0xf4, 0xd0, 0x63, 0x25, 0x6e, 0x5c, 0xe1, 0x8f, 0x88, 0x38, 0xf5, 0x88, 0x38, 0x6c, 0x51,
+	0x72, 0xe3, 0xb8, 0x7f, 0x01, 0x9a, 0x1f, 0xeb, 0xd8, 0x89, 0xdd, 0x16, 0x71, 0xf2, 0xce, 0xbc,
+	0xef, 0x7d, 0xf3, 0xbe, 0xf7, 0xcb, 0xe0, 0x43, 0xc6, 0x63, 0xc6, 0x29, 0x77, 0x43, 0x1c, 0xc7,
+	0x6e, 0xca, 0x58, 0xb4, 0x1d, 0xb3, 0x80, 0x44, 0xdc, 0xe5, 0x02, 0x0f, 0x22, 0xc2, 0x7f, 0xc4,
+	0xe9, 0xc4, 0x67, 0x5f, 0x22, 0x9c, 0x34, 0x63, 0x82, 0xd9, 0xef, 0x18, 0x57, 0x47, 0xba, 0x3a,
+	0xd2, 0xa0, 0x3d, 0x9d, 0x33, 0xb8, 0x73, 0xb8, 0x33, 0x20, 0x02, 0xef, 0xac, 0x77, 0x86, 0x0a,
+	0xdc, 0x57, 0x9e, 0xae, 0x3e, 0x68, 0x9a, 0xf5, 0xb5, 0x90, 0x85, 0x4c, 0xdf, 0xcb, 0x2f, 0x73,
+	0xdb, 0x0d, 0x19, 0x0b, 0x23, 0xe2, 0xaa, 0xd3, 0x20, 0x3f, 0x70, 0x83, 0x3c, 0xc3, 0x82, 0xb2,
+	0xc4, 0xd8, 0xe1, 0x79, 0xbb, 0xa0, 0x31, 0xe1, 0x02, 0xc7, 0x69, 0x45, 0xa0, 0x1f, 0x71, 0x71,
+	0x2e, 0x46, 0xae, 0x09, 0x43, 0x1d, 0xce, 0xd9, 0x07, 0x98, 0x93, 0xb1, 0x7d, 0xc8, 0xa8, 0x79,
+	0x00, 0x1d, 0x5b, 0x00, 0xdc, 0x65, 0x2c, 0xba, 0x8b, 0x33, 0x1c, 0x73, 0xfb, 0x5b, 0xd0, 0x54,
+	0xfa, 0x0f, 0x08, 0x69, 0x5b, 0x3d, 0x6b, 0x6b, 0xd9, 0xbb, 0x7d, 0x5c, 0xc0, 0xda, 0x5f, 0x05,
+	0xbc, 0x11, 0x52, 0x31, 0xca, 0x07, 0xce, 0x90, 0xc5, 0x46, 0x98, 0xf9, 0xd9, 0xe6, 0xc1, 0xf7,
+	0xae, 0x38, 0x4a, 0x09, 0x77, 0xee, 0x90, 0x61, 0x59, 0xc0, 0x2b, 0x47, 0x38, 0x8e, 0x6e, 0xa1,
+	0x8a, 0x07, 0xf9, 0x4b, 0xf2, 0x73, 0x9f, 0x10, 0xc9, 0x4e, 0x1e, 0x50, 0xa1, 0xd8, 0x17, 0xfe,
+	0x1f, 0x7b, 0xc5, 0x83, 0xfc, 0x25, 0xf9, 0xb9, 0x4f, 0x08, 0x7a, 0x54, 0x07, 0x9d, 0x7b, 0x43,
+	0x1c, 0xd1, 0x24, 0xdc, 0xc7, 0x43, 0xc1, 0xb2, 0xbd, 0x11, 0x4e, 0x42, 0x62, 0x94, 0x7d, 0x05,
+	0x00, 0x17, 0x38, 0x13, 0x7d, 0x99, 0x41, 0xa5, 0xad, 0xb5, 0xbb, 0xee, 0xe8, 0xf4, 0x3a, 0x55,
+	0x7a, 0x9d, 0x2f, 0xaa, 0xf4, 0x7a, 0x9b, 0x32, 0xb2, 0xb2, 0x80, 0xab, 0x46, 0xcd, 0xd8, 0x17,
+	0x3d, 0x7c, 0x06, 0x2d, 0x7f, 0x59, 0x5d, 0x48, 0xb8, 0x3d, 0x02, 0xcd, 0xaa, 0x6a, 0x4a, 0x55,
+	0x6b, 0xb7, 0x73, 0x81, 0xf7, 0x8e, 0x01, 0x78, 0x3b, 0x92, 0xf6, 0x9f, 0x02, 0xda, 0x95, 0xcb,
+	0x4d, 0x16, 0x53, 0x41, 0xe2, 0x54, 0x1c, 0x9d, 0x89, 0xab, 0x6c, 0xe8, 0xb1, 0x7c, 0x6a, 0xcc,
+	0x6e, 0xdf, 0x07, 0x57, 0x69, 0x42, 0x05, 0xc5, 0x51, 0x9f, 0x6b, 0xa1, 0xfd, 0x03, 0xa5, 0x94,
+	0xb7, 0xeb, 0xbd, 0xfa, 0x56, 0xc3, 0x43, 0x65, 0x01, 0xbb, 0x9a, 0x63, 0x0e, 0x10, 0xf9, 0xaf,
+	0x19, 0xcb, 0x54, 0xaa, 0xb8, 0xfd, 0x25, 0x78, 0x5d, 0xe0, 0x2c, 0x24, 0xe2, 0x02, 0x75, 0x43,
+	0x51, 0x5f, 0x2b, 0x0b, 0xb8, 0xa9, 0xa9, 0x67, 0xe3, 0x90, 0xbf, 0xa6, 0x0d, 0xd3, 0xc4, 0xe8,
+	0x97, 0x26, 0x68, 0xc8, 0x0e, 0xb3, 0x6f, 0x82, 0x25, 0x1c, 0x04, 0x19, 0xe1, 0xdc, 0xb4, 0x96,
+	0x5d, 0x16, 0x70, 0x45, 0x53, 0x1a, 0x03, 0xf2, 0x2b, 0x88, 0xbd, 0x02, 0x16, 0x68, 0xa0, 0xf2,
+	0xd9, 0xf0, 0x17, 0x68, 0x60, 0xff, 0x04, 0x5a, 0x72, 0xf6, 0xfa, 0xa9, 0x2a, 0x67, 0xbb, 0xae,
+	0x12, 0xfd, 0x81, 0xf3, 0xf2, 0xc3, 0xe9, 0x9c, 0xb5, 0xb9, 0x77, 0xdd, 0x14, 0x77, 0x73, 0x5c,
+	0xdc, 0xc9, 0xc1, 0x37, 0x6f, 0x20, 0x1f, 0xa4, 0x67, 0x93, 0xf1, 0x39, 0x58, 0x3b, 0xc8, 0x45,
+	0x9e, 0x11, 0x0d, 0x09, 0xd9, 0x21, 0xc9, 0x12, 0x96, 0xb5, 0x1b, 0x4a, 0x0a, 0x2c, 0x0b, 0xb8,
+	0xa1, 0xc9, 0x66, 0xa1, 0x90, 0x6f, 0xeb, 0x6b, 0x19, 0xc3, 0xc7, 0xe6, 0xd2, 0xfe, 0x1a, 0xbc,
+	0x22, 0x98, 0x90, 0x35, 0x1a, 0xe1, 0x8c, 0xf0, 0xf6, 0x25, 0xd3, 0x3c, 0x66, 0x6f, 0xc8, 0x91,
+	0x1d, 0x07, 0xbf, 0xc7, 0x68, 0xe2, 0x6d, 0x98, 0xb0, 0x5f, 0x35, 0x75, 0x98, 0x70, 0x46, 0x7e,
+	0x4b, 0x1d, 0xef, 0xa9, 0x93, 0x9d, 0x81, 0x15, 0x15, 0x40, 0x44, 0x7f, 0xc8, 0x69, 0x40, 0xc5,
+	0x51, 0x7b, 0xb1, 0x57, 0x7f, 0x3e, 0xf9, 0x7b, 0x92, 0xfc, 0xd7, 0x67, 0x70, 0xeb, 0x25, 0x46,
+	0x51, 0x3a, 0x70, 0xff, 0xb2, 0x7c, 0xe2, 0xd3, 0xea, 0x05, 0xfb, 0x33, 0x70, 0xe5, 0x7c, 0xeb,
+	0x2c, 0xa9, 0xd6, 0xb9, 0x5e, 0x16, 0xf0, 0xda, 0x85, 0x4c, 0x5f, 0x68, 0x9f, 0x15, 0x3e, 0xdd,
+	0x91, 0xdf, 0x81, 0xce, 0x34, 0xa6, 0x3f, 0x64, 0x89, 0xc8, 0x58, 0x14, 0x91, 0xac, 0xdd, 0x54,
+	0x69, 0x7f, 0xab, 0x2c, 0x60, 0xcf, 0x30, 0xcf, 0x83, 0x22, 0xff, 0xea, 0x14, 0xf1, 0xde, 0xd8,
+	0x62, 0xff, 0x66, 0x81, 0x37, 0xce, 0xfb, 0xa9, 0x9d, 0x51, 0x75, 0xd9, 0xb2, 0xaa, 0xc8, 0x47,
+	0xff, 0xa5, 0xcb, 0xe6, 0x6e, 0x20, 0xef, 0xdd, 0xe3, 0x02, 0x5a, 0x65, 0x01, 0xdf, 0x9c, 0x1d,
+	0xf0, 0xe4, 0xc3, 0xc8, 0xef, 0xf0, 0xb9, 0x9b, 0xec, 0x91, 0x05, 0x60, 0x84, 0xb9, 0xe8, 0xcf,
+	0x66, 0x50, 0xfb, 0x0d, 0xbc, 0x70, 0xbf, 0xed, 0x9a, 0x5e, 0xba, 0xa1, 0xa3, 0x79, 0x01, 0xa1,
+	0x5e, 0x7a, 0x1b, 0x12, 0x35, 0x43, 0xa0, 0x5a, 0x83, 0x6f, 0x83, 0x45, 0x9e, 0x27, 0x9c, 0x88,
+	0x76, 0xab, 0x67, 0x6d, 0x35, 0xbd, 0xd5, 0xb2, 0x80, 0x97, 0x8d, 0x54, 0x75, 0x8f, 0x7c, 0x03,
+	0xb8, 0xb5, 0xfa, 0xf3, 0x13, 0x58, 0x7b, 0xfc, 0x04, 0xd6, 0xfe, 0xf8, 0x7d, 0xfb, 0x92, 0x1c,
+	0x89, 0x4f, 0xbc, 0x6f, 0x8e, 0x4f, 0xba, 0xd6, 0xd3, 0x93, 0xae, 0xf5, 0xf7, 0x49, 0xd7, 0x7a,
+	0x78, 0xda, 0xad, 0x3d, 0x3d, 0xed, 0xd6, 0xfe, 0x3c, 0xed, 0xd6, 0xee, 0xdf, 0x9e, 0xe8, 0x47,
+	0x53, 0x87, 0xed, 0x08, 0x0f, 0x78, 0x75, 0x70, 0x0f, 0x77, 0x76, 0xdd, 0x07, 0xcf, 0xfb, 0x63,
+	0x1f, 0x2c, 0x2a, 0xfd, 0xef, 0xff, 0x3b, 0x00, 0x55, 0x23, 0xaa, 0x8f, 0x06, 0x08, 0x00, 0x00,
 }
 
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
@@ -223,6 +326,81 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScalingFactorChangeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalingFactorChangeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScalingFactorChangeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetScalingFactors) > 0 {
+		dAtA2 := make([]byte, len(m.TargetScalingFactors)*10)
+		var j1 int
+		for _, num := range m.TargetScalingFactors {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InitialScalingFactors) > 0 {
+		dAtA4 := make([]byte, len(m.InitialScalingFactors)*10)
+		var j3 int
+		for _, num := range m.InitialScalingFactors {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Pool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -243,6 +421,36 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Sunset {
+		i--
+		if m.Sunset {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastScalingFactorChangeTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastScalingFactorChangeTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x52
+	if m.ScalingFactorChangeParams != nil {
+		{
+			size, err := m.ScalingFactorChangeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.ScalingFactorController) > 0 {
 		i -= len(m.ScalingFactorController)
 		copy(dAtA[i:], m.ScalingFactorController)
@@ -251,20 +459,20 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if len(m.ScalingFactors) > 0 {
-		dAtA2 := make([]byte, len(m.ScalingFactors)*10)
-		var j1 int
+		dAtA10 := make([]byte, len(m.ScalingFactors)*10)
+		var j9 int
 		for _, num := range m.ScalingFactors {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j9++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA10[j9] = uint8(num)
+			j9++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintStableswapPool(dAtA, i, uint64(j1))
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j9))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -348,6 +556,33 @@ func (m *PoolParams) Size() (n int) {
 	return n
 }
 
+func (m *ScalingFactorChangeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	if len(m.InitialScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.InitialScalingFactors {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
+	if len(m.TargetScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.TargetScalingFactors {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *Pool) Size() (n int) {
 	if m == nil {
 		return 0
@@ -386,6 +621,15 @@ func (m *Pool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStableswapPool(uint64(l))
 	}
+	if m.ScalingFactorChangeParams != nil {
+		l = m.ScalingFactorChangeParams.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastScalingFactorChangeTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	if m.Sunset {
+		n += 2
+	}
 	return n
 }
 
@@ -513,6 +757,274 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScalingFactorChangeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScalingFactorChangeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScalingFactorChangeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.InitialScalingFactors = append(m.InitialScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.InitialScalingFactors) == 0 {
+					m.InitialScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStableswapPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.InitialScalingFactors = append(m.InitialScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialScalingFactors", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TargetScalingFactors) == 0 {
+					m.TargetScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStableswapPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetScalingFactors", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Pool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -720,7 +1232,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolLiquidity = append(m.PoolLiquidity, types.Coin{})
+			m.PoolLiquidity = append(m.PoolLiquidity, types1.Coin{})
 			if err := m.PoolLiquidity[len(m.PoolLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -833,6 +1345,95 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			}
 			m.ScalingFactorController = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorChangeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScalingFactorChangeParams == nil {
+				m.ScalingFactorChangeParams = &ScalingFactorChangeParams{}
+			}
+			if err := m.ScalingFactorChangeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScalingFactorChangeTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastScalingFactorChangeTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sunset", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sunset = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
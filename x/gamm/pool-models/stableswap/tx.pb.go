@@ -11,18 +11,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -161,11 +165,13 @@ func (m *MsgCreateStableswapPoolResponse) GetPoolID() uint64 {
 }
 
 // Sender must be the pool's scaling_factor_governor in order for the tx to
-// succeed. Adjusts stableswap scaling factors.
+// succeed. Adjusts stableswap scaling factors, linearly over
+// scaling_factor_change_duration, or at once if it is zero.
 type MsgStableSwapAdjustScalingFactors struct {
-	Sender         string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	PoolID         uint64   `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	ScalingFactors []uint64 `protobuf:"varint,3,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factor"`
+	Sender                      string        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID                      uint64        `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	ScalingFactors              []uint64      `protobuf:"varint,3,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factor"`
+	ScalingFactorChangeDuration time.Duration `protobuf:"bytes,4,opt,name=scaling_factor_change_duration,json=scalingFactorChangeDuration,proto3,stdduration" json:"scaling_factor_change_duration" yaml:"scaling_factor_change_duration"`
 }
 
 func (m *MsgStableSwapAdjustScalingFactors) Reset()         { *m = MsgStableSwapAdjustScalingFactors{} }
@@ -222,6 +228,13 @@ func (m *MsgStableSwapAdjustScalingFactors) GetScalingFactors() []uint64 {
 	return nil
 }
 
+func (m *MsgStableSwapAdjustScalingFactors) GetScalingFactorChangeDuration() time.Duration {
+	if m != nil {
+		return m.ScalingFactorChangeDuration
+	}
+	return 0
+}
+
 type MsgStableSwapAdjustScalingFactorsResponse struct {
 }
 
@@ -274,46 +287,51 @@ func init() {
 }
 
 var fileDescriptor_46b7c8a0f24de97c = []byte{
-	// 623 bytes of a gzipped FileDescriptorProto
+	// 691 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x9b, 0x7c, 0xf9, 0xc4, 0x54, 0x80, 0xb0, 0xa2, 0xd6, 0x0d, 0x92, 0x1d, 0x0c, 0x8b,
-	0x14, 0xa8, 0x87, 0x06, 0x09, 0x09, 0x76, 0x75, 0x50, 0x51, 0x05, 0x91, 0x8a, 0x2b, 0x36, 0xb0,
-	0x08, 0x63, 0x7b, 0x6a, 0x06, 0x6c, 0x8f, 0xf1, 0x4c, 0xd2, 0x66, 0xc9, 0x1b, 0xf0, 0x18, 0x88,
-	0x77, 0x60, 0xc3, 0x02, 0x75, 0xd9, 0x25, 0x2b, 0x83, 0x92, 0x37, 0xc8, 0x8e, 0x1d, 0xb2, 0xc7,
-	0xf9, 0x93, 0x92, 0xb6, 0x41, 0x5d, 0x79, 0x7c, 0xe7, 0xdc, 0x73, 0xee, 0x3d, 0x77, 0x66, 0xc0,
-	0x7d, 0xca, 0x02, 0xca, 0x08, 0x83, 0x1e, 0x0a, 0x02, 0x18, 0x51, 0xea, 0x6f, 0x05, 0xd4, 0xc5,
-	0x3e, 0x83, 0x8c, 0x23, 0xdb, 0xc7, 0xec, 0x08, 0x45, 0x90, 0x1f, 0x1b, 0x51, 0x4c, 0x39, 0x95,
-	0xef, 0xe6, 0x68, 0x23, 0x45, 0x1b, 0x29, 0x5a, 0x80, 0x8d, 0x09, 0xd8, 0xe8, 0x6e, 0xdb, 0x98,
-	0xa3, 0xed, 0xaa, 0xea, 0x64, 0x60, 0x68, 0x23, 0x86, 0x61, 0x1e, 0x84, 0x0e, 0x25, 0xa1, 0xe0,
-	0xaa, 0x56, 0x3c, 0xea, 0xd1, 0x6c, 0x09, 0xd3, 0x55, 0x1e, 0x7d, 0x7c, 0x91, 0x7a, 0x26, 0xcb,
-	0x76, 0x8a, 0x10, 0xa9, 0xfa, 0xb7, 0x12, 0x58, 0x6f, 0x31, 0xaf, 0x19, 0x63, 0xc4, 0xf1, 0xc1,
-	0x18, 0xb2, 0x4f, 0xa9, 0x2f, 0x6f, 0x82, 0x32, 0xc3, 0xa1, 0x8b, 0x63, 0x45, 0xaa, 0x49, 0xf5,
-	0x2b, 0xe6, 0x8d, 0x61, 0xa2, 0x5d, 0xed, 0xa1, 0xc0, 0x7f, 0xa2, 0x8b, 0xb8, 0x6e, 0xe5, 0x00,
-	0x99, 0x82, 0xd5, 0x94, 0xb4, 0x1d, 0xa1, 0x18, 0x05, 0x4c, 0x59, 0xa9, 0x49, 0xf5, 0xd5, 0xc6,
-	0x23, 0xe3, 0xe2, 0x9d, 0x1b, 0xa9, 0xe2, 0x7e, 0x96, 0x6d, 0xae, 0x0d, 0x13, 0x4d, 0x16, 0x3a,
-	0x53, 0xa4, 0xba, 0x05, 0xa2, 0x31, 0x46, 0xfe, 0x24, 0x81, 0x35, 0x12, 0x12, 0x4e, 0x90, 0x9f,
-	0xb5, 0xd3, 0xf6, 0xc9, 0xc7, 0x0e, 0x71, 0x09, 0xef, 0x29, 0xc5, 0x5a, 0xb1, 0xbe, 0xda, 0xd8,
-	0x30, 0x84, 0x95, 0x46, 0x6a, 0xe5, 0x58, 0xa5, 0x49, 0x49, 0x68, 0x3e, 0x38, 0x49, 0xb4, 0xc2,
-	0xd7, 0x5f, 0x5a, 0xdd, 0x23, 0xfc, 0x5d, 0xc7, 0x36, 0x1c, 0x1a, 0xc0, 0xdc, 0x77, 0xf1, 0xd9,
-	0x62, 0xee, 0x07, 0xc8, 0x7b, 0x11, 0x66, 0x59, 0x02, 0xb3, 0x2a, 0xb9, 0x54, 0x5a, 0xe4, 0x8b,
-	0x91, 0x90, 0xdc, 0x02, 0xd7, 0x99, 0x83, 0x7c, 0x12, 0x7a, 0xed, 0x43, 0xe4, 0x70, 0x1a, 0x33,
-	0xa5, 0x54, 0x2b, 0xd6, 0x4b, 0xe6, 0x9d, 0x61, 0xa2, 0xd5, 0x72, 0xa3, 0x26, 0xae, 0xcf, 0x62,
-	0x75, 0xeb, 0x5a, 0x1e, 0xd8, 0x15, 0xb9, 0xf2, 0x4b, 0x50, 0x39, 0xec, 0xf0, 0x4e, 0x8c, 0x45,
-	0x43, 0x1e, 0xed, 0xe2, 0x38, 0xa4, 0xb1, 0xf2, 0x5f, 0x66, 0xbe, 0x36, 0x4c, 0xb4, 0x9b, 0x82,
-	0x73, 0x1e, 0x4a, 0xb7, 0x64, 0x11, 0x4e, 0x4b, 0x7c, 0x96, 0x07, 0xe5, 0xb7, 0x60, 0x63, 0x56,
-	0xb5, 0xed, 0xd0, 0x90, 0xc7, 0xd4, 0xf7, 0x71, 0xac, 0x94, 0x33, 0xde, 0xe9, 0x5a, 0x17, 0x41,
-	0x75, 0x6b, 0x7d, 0xa6, 0xd6, 0xe6, 0x64, 0x67, 0x17, 0x68, 0x0b, 0x8e, 0x8f, 0x85, 0x59, 0x44,
-	0x43, 0x86, 0xe5, 0xdb, 0xe0, 0xff, 0xac, 0x54, 0xe2, 0x66, 0xe7, 0xa8, 0x64, 0x82, 0x7e, 0xa2,
-	0x95, 0x53, 0xc8, 0xde, 0x53, 0xab, 0x9c, 0x6e, 0xed, 0xb9, 0xfa, 0x77, 0x09, 0xdc, 0x6a, 0x31,
-	0x4f, 0x50, 0x1c, 0x1c, 0xa1, 0x68, 0xc7, 0x7d, 0xdf, 0x61, 0xfc, 0x60, 0xd6, 0xa2, 0x25, 0x4e,
-	0xe4, 0x94, 0xea, 0xca, 0x22, 0xd5, 0x79, 0x13, 0x2c, 0xfe, 0xfb, 0x04, 0xf5, 0x7b, 0x60, 0xf3,
-	0xdc, 0x1e, 0x46, 0xb6, 0x34, 0xfe, 0xac, 0x80, 0x62, 0x8b, 0x79, 0xf2, 0x17, 0x09, 0x54, 0xe6,
-	0x5e, 0xbf, 0xe6, 0x32, 0xd7, 0x67, 0xc1, 0x10, 0xaa, 0xcf, 0x2f, 0x81, 0x64, 0x3c, 0xc9, 0x1f,
-	0x12, 0x50, 0xcf, 0x99, 0x50, 0x6b, 0x49, 0xbd, 0xb3, 0xe9, 0xaa, 0xaf, 0x2e, 0x95, 0x6e, 0xd4,
-	0x88, 0xf9, 0xe6, 0xa4, 0xaf, 0x4a, 0xa7, 0x7d, 0x55, 0xfa, 0xdd, 0x57, 0xa5, 0xcf, 0x03, 0xb5,
-	0x70, 0x3a, 0x50, 0x0b, 0x3f, 0x07, 0x6a, 0xe1, 0xf5, 0xce, 0xd4, 0x9b, 0x90, 0x4b, 0x6f, 0xf9,
-	0xc8, 0x66, 0xa3, 0x1f, 0xd8, 0xdd, 0x6e, 0xc0, 0xe3, 0xb3, 0x1e, 0x5a, 0xbb, 0x9c, 0xbd, 0xac,
-	0x0f, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x50, 0xb6, 0xbb, 0x26, 0x06, 0x00, 0x00,
+	0x14, 0x8d, 0x93, 0x7c, 0xf9, 0xc4, 0x54, 0x80, 0xb0, 0xa2, 0xd6, 0x4d, 0x25, 0x3b, 0x18, 0x90,
+	0x52, 0xa0, 0x1e, 0x12, 0x24, 0x24, 0xd8, 0xd5, 0xa9, 0x8a, 0x2a, 0x88, 0x54, 0x5c, 0xb1, 0x81,
+	0x45, 0x18, 0xc7, 0x53, 0xd7, 0x60, 0x7b, 0x8c, 0x67, 0xdc, 0x9f, 0x25, 0x6f, 0x00, 0x3b, 0x1e,
+	0x01, 0xf1, 0x0e, 0x6c, 0x51, 0x97, 0x5d, 0x21, 0x56, 0x2e, 0x4a, 0xdf, 0x20, 0x3b, 0x76, 0xc8,
+	0x1e, 0x3b, 0x3f, 0x28, 0xe9, 0x8f, 0xe8, 0x2a, 0x93, 0xeb, 0x73, 0xcf, 0xb9, 0x73, 0xee, 0xbd,
+	0x03, 0xee, 0x13, 0xea, 0x11, 0xea, 0x50, 0x68, 0x23, 0xcf, 0x83, 0x01, 0x21, 0xee, 0x8a, 0x47,
+	0x2c, 0xec, 0x52, 0x48, 0x19, 0x32, 0x5d, 0x4c, 0xf7, 0x50, 0x00, 0xd9, 0xbe, 0x16, 0x84, 0x84,
+	0x11, 0xf1, 0x6e, 0x86, 0xd6, 0x12, 0xb4, 0x96, 0xa0, 0x39, 0x58, 0x1b, 0x81, 0xb5, 0xdd, 0xa6,
+	0x89, 0x19, 0x6a, 0xd6, 0xe4, 0x5e, 0x0a, 0x86, 0x26, 0xa2, 0x18, 0x66, 0x41, 0xd8, 0x23, 0x8e,
+	0xcf, 0xb9, 0x6a, 0x55, 0x9b, 0xd8, 0x24, 0x3d, 0xc2, 0xe4, 0x94, 0x45, 0x65, 0x9b, 0x10, 0xdb,
+	0xc5, 0x30, 0xfd, 0x67, 0x46, 0xdb, 0xd0, 0x8a, 0x42, 0xc4, 0x1c, 0x92, 0x67, 0x3d, 0x3e, 0x4f,
+	0xbd, 0xa3, 0x63, 0x37, 0x41, 0xf0, 0x54, 0xf5, 0x5b, 0x19, 0x2c, 0x74, 0xa8, 0xdd, 0x0e, 0x31,
+	0x62, 0x78, 0x6b, 0x08, 0xd9, 0x24, 0xc4, 0x15, 0x97, 0x41, 0x85, 0x62, 0xdf, 0xc2, 0xa1, 0x24,
+	0xd4, 0x85, 0xc6, 0x15, 0xfd, 0xc6, 0x20, 0x56, 0xae, 0x1e, 0x20, 0xcf, 0x7d, 0xa2, 0xf2, 0xb8,
+	0x6a, 0x64, 0x00, 0x91, 0x80, 0xb9, 0x84, 0xb4, 0x1b, 0xa0, 0x10, 0x79, 0x54, 0x2a, 0xd6, 0x85,
+	0xc6, 0x5c, 0xeb, 0x91, 0x76, 0x7e, 0x67, 0xb4, 0x44, 0x71, 0x33, 0xcd, 0xd6, 0xe7, 0x07, 0xb1,
+	0x22, 0x72, 0x9d, 0x31, 0x52, 0xd5, 0x00, 0xc1, 0x10, 0x23, 0x7e, 0x10, 0xc0, 0xbc, 0xe3, 0x3b,
+	0xcc, 0x41, 0x6e, 0x7a, 0x9d, 0xae, 0xeb, 0xbc, 0x8f, 0x1c, 0xcb, 0x61, 0x07, 0x52, 0xa9, 0x5e,
+	0x6a, 0xcc, 0xb5, 0x16, 0x35, 0x6e, 0xb5, 0x96, 0x58, 0x3d, 0x54, 0x69, 0x13, 0xc7, 0xd7, 0x1f,
+	0x1c, 0xc6, 0x4a, 0xe1, 0xeb, 0xb1, 0xd2, 0xb0, 0x1d, 0xb6, 0x13, 0x99, 0x5a, 0x8f, 0x78, 0x30,
+	0xeb, 0x0b, 0xff, 0x59, 0xa1, 0xd6, 0x3b, 0xc8, 0x0e, 0x02, 0x4c, 0xd3, 0x04, 0x6a, 0x54, 0x33,
+	0xa9, 0xa4, 0xc8, 0xe7, 0xb9, 0x90, 0xd8, 0x01, 0xd7, 0x69, 0x0f, 0xb9, 0x8e, 0x6f, 0x77, 0xb7,
+	0x51, 0x8f, 0x91, 0x90, 0x4a, 0xe5, 0x7a, 0xa9, 0x51, 0xd6, 0x6f, 0x0f, 0x62, 0xa5, 0x9e, 0x19,
+	0x35, 0x72, 0x7d, 0x12, 0xab, 0x1a, 0xd7, 0xb2, 0xc0, 0x3a, 0xcf, 0x15, 0x5f, 0x80, 0xea, 0x76,
+	0xc4, 0xa2, 0x10, 0xf3, 0x0b, 0xd9, 0x64, 0x17, 0x87, 0x3e, 0x09, 0xa5, 0xff, 0x52, 0xf3, 0x95,
+	0x41, 0xac, 0x2c, 0x71, 0xce, 0x69, 0x28, 0xd5, 0x10, 0x79, 0x38, 0x29, 0xf1, 0x69, 0x16, 0x14,
+	0xdf, 0x80, 0xc5, 0x49, 0xd5, 0x6e, 0x8f, 0xf8, 0x2c, 0x24, 0xae, 0x8b, 0x43, 0xa9, 0x92, 0xf2,
+	0x8e, 0xd7, 0x3a, 0x0b, 0xaa, 0x1a, 0x0b, 0x13, 0xb5, 0xb6, 0x47, 0x5f, 0xd6, 0x81, 0x32, 0x63,
+	0x7c, 0x0c, 0x4c, 0x03, 0xe2, 0x53, 0x2c, 0xde, 0x02, 0xff, 0xa7, 0xa5, 0x3a, 0x56, 0x3a, 0x47,
+	0x65, 0x1d, 0xf4, 0x63, 0xa5, 0x92, 0x40, 0x36, 0xd6, 0x8c, 0x4a, 0xf2, 0x69, 0xc3, 0x52, 0x7f,
+	0x14, 0xc1, 0xcd, 0x0e, 0xb5, 0x39, 0xc5, 0xd6, 0x1e, 0x0a, 0x56, 0xad, 0xb7, 0x11, 0x65, 0x5b,
+	0x93, 0x16, 0x5d, 0x60, 0x22, 0xc7, 0x54, 0x8b, 0xb3, 0x54, 0xa7, 0x75, 0xb0, 0xf4, 0x0f, 0x1d,
+	0xfc, 0x24, 0x00, 0xf9, 0x6f, 0x13, 0x77, 0x90, 0x6f, 0xe3, 0x6e, 0xbe, 0xb0, 0x52, 0x39, 0xdd,
+	0x8c, 0x45, 0x8d, 0x6f, 0xb4, 0x96, 0x6f, 0xb4, 0xb6, 0x96, 0x01, 0xf4, 0x66, 0x32, 0x9c, 0x83,
+	0x58, 0xb9, 0x33, 0xbd, 0x27, 0x93, 0x74, 0xea, 0xe7, 0x63, 0x45, 0x30, 0x96, 0x26, 0x9b, 0x93,
+	0x42, 0x72, 0x3e, 0xf5, 0x1e, 0x58, 0x3e, 0xd3, 0xd7, 0xbc, 0x55, 0xad, 0xdf, 0x45, 0x50, 0xea,
+	0x50, 0x5b, 0xfc, 0x22, 0x80, 0xea, 0xd4, 0x27, 0xa1, 0x7d, 0x91, 0x95, 0x9e, 0x31, 0x18, 0xb5,
+	0x67, 0x97, 0x40, 0x32, 0x9c, 0xae, 0xef, 0x02, 0x90, 0xcf, 0x98, 0x9a, 0xce, 0x05, 0xf5, 0x4e,
+	0xa7, 0xab, 0xbd, 0xbc, 0x54, 0xba, 0xfc, 0x22, 0xfa, 0xeb, 0xc3, 0xbe, 0x2c, 0x1c, 0xf5, 0x65,
+	0xe1, 0x57, 0x5f, 0x16, 0x3e, 0x9e, 0xc8, 0x85, 0xa3, 0x13, 0xb9, 0xf0, 0xf3, 0x44, 0x2e, 0xbc,
+	0x5a, 0x1d, 0x7b, 0xa7, 0x32, 0xe9, 0x15, 0x17, 0x99, 0x34, 0xff, 0x03, 0x77, 0x9b, 0x2d, 0xb8,
+	0x7f, 0xda, 0xe3, 0x6f, 0x56, 0xd2, 0x41, 0x7b, 0xf8, 0x67, 0x00, 0x3b, 0x32, 0xcc, 0x49, 0xda,
+	0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -568,21 +586,29 @@ func (m *MsgStableSwapAdjustScalingFactors) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ScalingFactorChangeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ScalingFactorChangeDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
 	if len(m.ScalingFactors) > 0 {
-		dAtA5 := make([]byte, len(m.ScalingFactors)*10)
-		var j4 int
+		dAtA6 := make([]byte, len(m.ScalingFactors)*10)
+		var j5 int
 		for _, num := range m.ScalingFactors {
 			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j4++
+				j5++
 			}
-			dAtA5[j4] = uint8(num)
-			j4++
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintTx(dAtA, i, uint64(j4))
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -705,6 +731,8 @@ func (m *MsgStableSwapAdjustScalingFactors) Size() (n int) {
 		}
 		n += 1 + sovTx(uint64(l)) + l
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ScalingFactorChangeDuration)
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1240,6 +1268,39 @@ func (m *MsgStableSwapAdjustScalingFactors) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactors", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorChangeDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ScalingFactorChangeDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
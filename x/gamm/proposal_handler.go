@@ -0,0 +1,49 @@
+package gamm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+func NewGammProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetScalingFactorControllerProposal:
+			return handleSetScalingFactorControllerProposal(ctx, k, c)
+		case *types.SunsetPoolProposal:
+			return handleSunsetPoolProposal(ctx, k, c)
+		case *types.SetDynamicSwapFeePolicyProposal:
+			return handleSetDynamicSwapFeePolicyProposal(ctx, k, c)
+		case *types.SetPoolProtocolRevenueShareProposal:
+			return handleSetPoolProtocolRevenueShareProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gamm proposal content type: %T", c)
+		}
+	}
+}
+
+func handleSetScalingFactorControllerProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetScalingFactorControllerProposal) error {
+	return k.SetStableswapScalingFactorController(ctx, p.PoolId, p.ControllerAddress)
+}
+
+func handleSunsetPoolProposal(ctx sdk.Context, k keeper.Keeper, p *types.SunsetPoolProposal) error {
+	return k.SunsetPool(ctx, p.PoolId)
+}
+
+func handleSetDynamicSwapFeePolicyProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetDynamicSwapFeePolicyProposal) error {
+	if p.VolatilityWindow == 0 {
+		return k.RemoveDynamicSwapFeePolicy(ctx, p.PoolId)
+	}
+	return k.SetDynamicSwapFeePolicy(ctx, p.Policy())
+}
+
+func handleSetPoolProtocolRevenueShareProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetPoolProtocolRevenueShareProposal) error {
+	if p.RemoveOverride {
+		return k.RemovePoolProtocolRevenueShare(ctx, p.PoolId)
+	}
+	return k.SetPoolProtocolRevenueShare(ctx, p.PoolId, p.ProtocolRevenueShare)
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/gamm interfaces and concrete types
@@ -20,6 +21,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgJoinSwapShareAmountOut{}, "osmosis/gamm/join-swap-share-amount-out", nil)
 	cdc.RegisterConcrete(&MsgExitSwapExternAmountOut{}, "osmosis/gamm/exit-swap-extern-amount-out", nil)
 	cdc.RegisterConcrete(&MsgExitSwapShareAmountIn{}, "osmosis/gamm/exit-swap-share-amount-in", nil)
+	cdc.RegisterConcrete(&SetScalingFactorControllerProposal{}, "osmosis/SetScalingFactorControllerProposal", nil)
+	cdc.RegisterConcrete(&SunsetPoolProposal{}, "osmosis/SunsetPoolProposal", nil)
+	cdc.RegisterConcrete(&SetDynamicSwapFeePolicyProposal{}, "osmosis/SetDynamicSwapFeePolicyProposal", nil)
+	cdc.RegisterConcrete(&SetPoolProtocolRevenueShareProposal{}, "osmosis/SetPoolProtocolRevenueShareProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -39,6 +44,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgExitSwapExternAmountOut{},
 		&MsgExitSwapShareAmountIn{},
 	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&SetScalingFactorControllerProposal{},
+		&SunsetPoolProposal{},
+		&SetDynamicSwapFeePolicyProposal{},
+		&SetPoolProtocolRevenueShareProposal{},
+	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
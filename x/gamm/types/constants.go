@@ -17,6 +17,10 @@ const (
 	StableswapMinScaledAmtPerAsset = 1
 	// We keep this multiplier at 1, but can increase if needed in the unlikely scenario where default scaling factors of 1 cannot accommodate enough assets
 	ScalingFactorMultiplier = 1
+
+	// MaxSunsetPoolForceUnlocksPerBlock is the maximum number of locks of sunset pool shares
+	// processed at the end of a block.
+	MaxSunsetPoolForceUnlocksPerBlock = 100
 )
 
 var (
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate returns an error if the swap fee bounds are not within [0, 1) and ordered,
+// the volatility window is not positive, or the static swap fee, if set, is not within [0, 1).
+func (p DynamicSwapFeePolicy) Validate() error {
+	if p.MinSwapFee.IsNil() || p.MinSwapFee.IsNegative() {
+		return ErrNegativeSwapFee
+	}
+	if p.MaxSwapFee.IsNil() || p.MaxSwapFee.GTE(sdk.OneDec()) {
+		return ErrTooMuchSwapFee
+	}
+	if p.MinSwapFee.GT(p.MaxSwapFee) {
+		return fmt.Errorf("min swap fee %s is greater than max swap fee %s", p.MinSwapFee, p.MaxSwapFee)
+	}
+	if p.VolatilityWindow <= 0 {
+		return fmt.Errorf("volatility window must be positive, got %s", p.VolatilityWindow)
+	}
+	if !p.StaticSwapFee.IsNil() {
+		if p.StaticSwapFee.IsNegative() {
+			return ErrNegativeSwapFee
+		}
+		if p.StaticSwapFee.GTE(sdk.OneDec()) {
+			return ErrTooMuchSwapFee
+		}
+	}
+	return nil
+}
+
+// SwapFee returns the swap fee of the policy given the volatility of the pool's prices,
+// min swap fee plus the volatility, capped at max swap fee.
+func (p DynamicSwapFeePolicy) SwapFee(volatility sdk.Dec) sdk.Dec {
+	if volatility.IsNegative() {
+		volatility = sdk.ZeroDec()
+	}
+	return sdk.MinDec(p.MinSwapFee.Add(volatility), p.MaxSwapFee)
+}
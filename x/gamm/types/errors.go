@@ -54,4 +54,17 @@ var (
 	ErrInvalidScalingFactors           = sdkerrors.Register(ModuleName, 64, "invalid scaling factor")
 	ErrHitMaxScaledAssets              = sdkerrors.Register(ModuleName, 65, "post-scaled pool assets can not exceed 10e34")
 	ErrHitMinScaledAssets              = sdkerrors.Register(ModuleName, 66, "post-scaled pool assets can not be less than 1")
+	ErrScalingFactorChangeTooLarge     = sdkerrors.Register(ModuleName, 67, "scaling factor change exceeds the max scaling factor change")
+	ErrScalingFactorChangeTooFrequent  = sdkerrors.Register(ModuleName, 68, "scaling factors were changed within the scaling factor change window")
+
+	ErrNotConcentratedPool   = sdkerrors.Register(ModuleName, 70, "not concentrated liquidity pool")
+	ErrInvalidTickSpacing    = sdkerrors.Register(ModuleName, 71, "invalid tick spacing")
+	ErrInvalidPositionTicks  = sdkerrors.Register(ModuleName, 72, "invalid position ticks")
+	ErrPositionNotFound      = sdkerrors.Register(ModuleName, 73, "position not found")
+	ErrNotEnoughLiquidity    = sdkerrors.Register(ModuleName, 74, "not enough liquidity in the pool to complete the swap")
+	ErrInvalidPositionAmount = sdkerrors.Register(ModuleName, 75, "invalid position liquidity amount")
+	ErrNotSingleSwapToken    = sdkerrors.Register(ModuleName, 76, "swaps must be of exactly one token")
+
+	ErrPoolSunset    = sdkerrors.Register(ModuleName, 80, "pool is sunset")
+	ErrPoolNotSunset = sdkerrors.Register(ModuleName, 81, "pool is not sunset")
 )
@@ -1,14 +1,24 @@
 package types
 
 const (
-	TypeEvtPoolJoined   = "pool_joined"
-	TypeEvtPoolExited   = "pool_exited"
-	TypeEvtPoolCreated  = "pool_created"
-	TypeEvtTokenSwapped = "token_swapped"
+	TypeEvtPoolJoined      = "pool_joined"
+	TypeEvtPoolExited      = "pool_exited"
+	TypeEvtPoolCreated     = "pool_created"
+	TypeEvtTokenSwapped    = "token_swapped"
+	TypeEvtFeesCollected   = "fees_collected"
+	TypeEvtPoolSunset      = "pool_sunset"
+	TypeEvtProtocolRevenue = "protocol_revenue"
+
+	TypeEvtSunsetPoolLocksUnlocked = "sunset_pool_locks_unlocked"
 
 	AttributeValueCategory = ModuleName
 	AttributeKeyPoolId     = "pool_id"
 	AttributeKeySwapFee    = "swap_fee"
 	AttributeKeyTokensIn   = "tokens_in"
 	AttributeKeyTokensOut  = "tokens_out"
+	AttributeKeyNumLocks   = "num_locks"
+	AttributeKeyNumSkipped = "num_skipped"
+	AttributeKeyRecipient  = "recipient"
+	AttributeKeyLowerTick  = "lower_tick"
+	AttributeKeyUpperTick  = "upper_tick"
 )
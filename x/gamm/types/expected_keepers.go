@@ -1,9 +1,13 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
 )
 
 // AccountKeeper defines the account contract that must be fulfilled when
@@ -41,3 +45,28 @@ type BankKeeper interface {
 type CommunityPoolKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+// SwapRouter defines the contract needed to execute multihop swaps, routing every hop
+// to the module owning its pool.
+type SwapRouter interface {
+	MultihopSwapExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, routes []SwapAmountInRoute, tokenIn sdk.Coin, tokenOutMinAmount sdk.Int) (sdk.Int, error)
+	MultihopSwapExactAmountOut(ctx sdk.Context, sender sdk.AccAddress, routes []SwapAmountOutRoute, tokenInMaxAmount sdk.Int, tokenOut sdk.Coin) (sdk.Int, error)
+}
+
+// LockupKeeper defines the contract needed to force unlock the locks of a pool's shares.
+type LockupKeeper interface {
+	GetLocksDenomPage(ctx sdk.Context, denom string, key []byte, limit uint64) ([]lockuptypes.PeriodLock, []byte, error)
+	HasAnySyntheticLockups(ctx sdk.Context, lockID uint64) bool
+	ForceUnlock(ctx sdk.Context, lock lockuptypes.PeriodLock) error
+}
+
+// SuperfluidKeeper defines the contract needed to force unlock superfluid staked locks of a pool's shares.
+type SuperfluidKeeper interface {
+	ForceUndelegateAndUnlock(ctx sdk.Context, lockID uint64) error
+}
+
+// TwapKeeper defines the contract needed to compute the volatility of pool prices from their TWAPs.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (sdk.Dec, error)
+	GetGeometricTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (sdk.Dec, error)
+}
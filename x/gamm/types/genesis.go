@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 )
 
@@ -19,5 +21,67 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	concentratedPoolIds := map[uint64]bool{}
+	for _, state := range gs.ConcentratedPoolStates {
+		if concentratedPoolIds[state.PoolId] {
+			return fmt.Errorf("duplicate ticks and positions for pool %d", state.PoolId)
+		}
+		concentratedPoolIds[state.PoolId] = true
+	}
+	sunsetPoolIds := map[uint64]bool{}
+	for _, sunsetPool := range gs.SunsetPools {
+		if sunsetPoolIds[sunsetPool.PoolId] {
+			return fmt.Errorf("duplicate sunset state for pool %d", sunsetPool.PoolId)
+		}
+		sunsetPoolIds[sunsetPool.PoolId] = true
+		if sunsetPool.RemainingShares.IsNil() || sunsetPool.RemainingShares.IsNegative() {
+			return fmt.Errorf("invalid remaining shares of sunset pool %d", sunsetPool.PoolId)
+		}
+		if err := sunsetPool.RemainingLiquidity.Validate(); err != nil {
+			return fmt.Errorf("invalid remaining liquidity of sunset pool %d: %w", sunsetPool.PoolId, err)
+		}
+		if !sunsetPool.ForceUnlocksPending && len(sunsetPool.NextLockKey) != 0 {
+			return fmt.Errorf("next lock key of sunset pool %d without pending force unlocks", sunsetPool.PoolId)
+		}
+	}
+	dynamicSwapFeePoolIds := map[uint64]bool{}
+	for _, policy := range gs.DynamicSwapFeePolicies {
+		if dynamicSwapFeePoolIds[policy.PoolId] {
+			return fmt.Errorf("duplicate dynamic swap fee policy for pool %d", policy.PoolId)
+		}
+		dynamicSwapFeePoolIds[policy.PoolId] = true
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+		if policy.StaticSwapFee.IsNil() {
+			return fmt.Errorf("missing static swap fee of dynamic swap fee policy for pool %d", policy.PoolId)
+		}
+	}
+	protocolRevenueSharePoolIds := map[uint64]bool{}
+	for _, override := range gs.PoolProtocolRevenueShares {
+		if protocolRevenueSharePoolIds[override.PoolId] {
+			return fmt.Errorf("duplicate protocol revenue share override for pool %d", override.PoolId)
+		}
+		protocolRevenueSharePoolIds[override.PoolId] = true
+		if err := ValidateProtocolRevenueShare(override.ProtocolRevenueShare); err != nil {
+			return err
+		}
+	}
+	if err := gs.ProtocolRevenue.Validate(); err != nil {
+		return fmt.Errorf("invalid protocol revenue: %w", err)
+	}
+	swapStatsPoolIds := map[uint64]bool{}
+	for _, stats := range gs.PoolSwapStats {
+		if swapStatsPoolIds[stats.PoolId] {
+			return fmt.Errorf("duplicate swap stats for pool %d", stats.PoolId)
+		}
+		swapStatsPoolIds[stats.PoolId] = true
+		if err := stats.Volume.Validate(); err != nil {
+			return fmt.Errorf("invalid swap volume of pool %d: %w", stats.PoolId, err)
+		}
+		if err := stats.FeesCollected.Validate(); err != nil {
+			return fmt.Errorf("invalid swap fees collected by pool %d: %w", stats.PoolId, err)
+		}
+	}
 	return nil
 }
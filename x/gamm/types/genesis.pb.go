@@ -6,20 +6,24 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	types2 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,6 +34,22 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params holds parameters for the incentives module
 type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+	// stableswap_max_scaling_factor_change is the maximum fraction by which a
+	// stableswap scaling factor can change relative to its current value in a
+	// single scaling factor adjustment. As a pool's scaling factors can only be
+	// adjusted once per stableswap_scaling_factor_change_window, this bounds
+	// how much they can change within the window.
+	StableswapMaxScalingFactorChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=stableswap_max_scaling_factor_change,json=stableswapMaxScalingFactorChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stableswap_max_scaling_factor_change" yaml:"stableswap_max_scaling_factor_change"`
+	// protocol_revenue_share is the fraction of the swap fees of balancer and
+	// stableswap pools that goes to the protocol instead of the pool's LPs,
+	// unless the pool overrides it.
+	ProtocolRevenueShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=protocol_revenue_share,json=protocolRevenueShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_revenue_share" yaml:"protocol_revenue_share"`
+	// protocol_revenue_recipient is the address receiving the protocol revenue.
+	// If empty, the protocol revenue funds the community pool.
+	ProtocolRevenueRecipient string `protobuf:"bytes,4,opt,name=protocol_revenue_recipient,json=protocolRevenueRecipient,proto3" json:"protocol_revenue_recipient,omitempty" yaml:"protocol_revenue_recipient"`
+	// stableswap_scaling_factor_change_window is the minimum time between the
+	// starts of two scaling factor adjustments of a stableswap pool.
+	StableswapScalingFactorChangeWindow time.Duration `protobuf:"bytes,5,opt,name=stableswap_scaling_factor_change_window,json=stableswapScalingFactorChangeWindow,proto3,stdduration" json:"stableswap_scaling_factor_change_window" yaml:"stableswap_scaling_factor_change_window"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -72,12 +92,33 @@ func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Params) GetProtocolRevenueRecipient() string {
+	if m != nil {
+		return m.ProtocolRevenueRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetStableswapScalingFactorChangeWindow() time.Duration {
+	if m != nil {
+		return m.StableswapScalingFactorChangeWindow
+	}
+	return 0
+}
+
 // GenesisState defines the gamm module's genesis state.
 type GenesisState struct {
-	Pools []*types1.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	Pools []*types2.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
 	// will be renamed to next_pool_id in an upcoming version
-	NextPoolNumber uint64 `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
-	Params         Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	NextPoolNumber            uint64                     `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
+	Params                    Params                     `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	ConcentratedPoolStates    []ConcentratedPoolState    `protobuf:"bytes,4,rep,name=concentrated_pool_states,json=concentratedPoolStates,proto3" json:"concentrated_pool_states"`
+	SunsetPools               []SunsetPool               `protobuf:"bytes,5,rep,name=sunset_pools,json=sunsetPools,proto3" json:"sunset_pools"`
+	DynamicSwapFeePolicies    []DynamicSwapFeePolicy     `protobuf:"bytes,6,rep,name=dynamic_swap_fee_policies,json=dynamicSwapFeePolicies,proto3" json:"dynamic_swap_fee_policies"`
+	PoolProtocolRevenueShares []PoolProtocolRevenueShare `protobuf:"bytes,7,rep,name=pool_protocol_revenue_shares,json=poolProtocolRevenueShares,proto3" json:"pool_protocol_revenue_shares"`
+	// cumulative protocol revenue of all pools
+	ProtocolRevenue github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=protocol_revenue,json=protocolRevenue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"protocol_revenue" yaml:"protocol_revenue"`
+	PoolSwapStats   []PoolSwapStats                          `protobuf:"bytes,9,rep,name=pool_swap_stats,json=poolSwapStats,proto3" json:"pool_swap_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -113,7 +154,7 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
-func (m *GenesisState) GetPools() []*types1.Any {
+func (m *GenesisState) GetPools() []*types2.Any {
 	if m != nil {
 		return m.Pools
 	}
@@ -134,187 +175,2856 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "osmosis.gamm.v1beta1.Params")
-	proto.RegisterType((*GenesisState)(nil), "osmosis.gamm.v1beta1.GenesisState")
+func (m *GenesisState) GetConcentratedPoolStates() []ConcentratedPoolState {
+	if m != nil {
+		return m.ConcentratedPoolStates
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("osmosis/gamm/v1beta1/genesis.proto", fileDescriptor_5a324eb7f1dd793e)
+func (m *GenesisState) GetSunsetPools() []SunsetPool {
+	if m != nil {
+		return m.SunsetPools
+	}
+	return nil
 }
 
-var fileDescriptor_5a324eb7f1dd793e = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x18, 0xc5, 0x33, 0xde, 0x7b, 0x0b, 0xe6, 0x8a, 0x7f, 0x42, 0x17, 0xb9, 0x17, 0x49, 0x4b, 0x56,
-	0xd9, 0x74, 0xc6, 0x56, 0xdc, 0x74, 0x67, 0x0a, 0x8a, 0x22, 0x52, 0xe2, 0xce, 0x4d, 0x98, 0xc4,
-	0x69, 0x0c, 0x26, 0xf3, 0x85, 0xcc, 0xb4, 0x34, 0x6f, 0x21, 0xb8, 0xf7, 0x01, 0x74, 0xeb, 0x43,
-	0x14, 0x57, 0x5d, 0xba, 0xaa, 0xd2, 0xbe, 0x81, 0x4f, 0x20, 0xf3, 0x27, 0x22, 0x78, 0x57, 0xc9,
-	0x37, 0xdf, 0xef, 0x1c, 0xce, 0x9c, 0xc4, 0x0d, 0x41, 0xd4, 0x20, 0x4a, 0x41, 0x0a, 0x5a, 0xd7,
-	0x64, 0x33, 0xcd, 0x98, 0xa4, 0x53, 0x52, 0x30, 0xce, 0x44, 0x29, 0x70, 0xd3, 0x82, 0x04, 0x6f,
-	0x68, 0x19, 0xac, 0x18, 0x6c, 0x99, 0xeb, 0x61, 0x01, 0x05, 0x68, 0x80, 0xa8, 0x37, 0xc3, 0x5e,
-	0x5f, 0x15, 0x00, 0x45, 0xc5, 0x88, 0x9e, 0xb2, 0xf5, 0x8a, 0x50, 0xde, 0xf5, 0xab, 0x5c, 0xfb,
-	0xa4, 0x46, 0x63, 0x06, 0xbb, 0x0a, 0xcc, 0x44, 0x32, 0x2a, 0xd8, 0xdf, 0x10, 0x39, 0x94, 0xdc,
-	0xec, 0xc3, 0xcf, 0xc8, 0x1d, 0x2c, 0x69, 0x4b, 0x6b, 0xe1, 0x7d, 0x42, 0xee, 0x83, 0x06, 0xa0,
-	0x4a, 0xf3, 0x96, 0x51, 0x59, 0x02, 0x4f, 0x57, 0x8c, 0xf9, 0x68, 0x7c, 0x16, 0x5d, 0xce, 0xae,
-	0xb0, 0x75, 0x55, 0x3e, 0x7d, 0x50, 0xbc, 0x80, 0x92, 0xc7, 0xaf, 0x76, 0x87, 0x91, 0xf3, 0xfb,
-	0x30, 0xf2, 0x3b, 0x5a, 0x57, 0xf3, 0xf0, 0x3f, 0x87, 0xf0, 0xcb, 0xcf, 0x51, 0x54, 0x94, 0xf2,
-	0xfd, 0x3a, 0xc3, 0x39, 0xd4, 0x36, 0x9e, 0x7d, 0x4c, 0xc4, 0xbb, 0x0f, 0x44, 0x76, 0x0d, 0x13,
-	0xda, 0x4c, 0x24, 0xf7, 0x94, 0x7e, 0x61, 0xe5, 0xcf, 0x18, 0x0b, 0xbf, 0x22, 0xf7, 0xce, 0x73,
-	0x53, 0xda, 0x1b, 0x49, 0x25, 0xf3, 0x9e, 0xb8, 0x17, 0x8a, 0x11, 0x36, 0xd9, 0x10, 0x9b, 0x5e,
-	0x70, 0xdf, 0x0b, 0x7e, 0xca, 0xbb, 0xf8, 0xf6, 0xf7, 0x6f, 0x93, 0x8b, 0x25, 0x40, 0xf5, 0x22,
-	0x31, 0xb4, 0x17, 0xb9, 0xf7, 0x39, 0xdb, 0xca, 0x54, 0xe7, 0xe3, 0xeb, 0x3a, 0x63, 0xad, 0x7f,
-	0x6b, 0x8c, 0xa2, 0xf3, 0xe4, 0xae, 0x3a, 0x57, 0xec, 0x6b, 0x7d, 0xea, 0xcd, 0xdd, 0x41, 0xa3,
-	0x1b, 0xf1, 0xcf, 0xc6, 0x28, 0xba, 0x9c, 0x3d, 0xc4, 0x37, 0x7d, 0x25, 0x6c, 0x5a, 0x8b, 0xcf,
-	0xd5, 0xf5, 0x13, 0xab, 0x88, 0x5f, 0xee, 0x8e, 0x01, 0xda, 0x1f, 0x03, 0xf4, 0xeb, 0x18, 0xa0,
-	0x8f, 0xa7, 0xc0, 0xd9, 0x9f, 0x02, 0xe7, 0xc7, 0x29, 0x70, 0xde, 0x3e, 0xfa, 0xa7, 0x02, 0xeb,
-	0x37, 0xa9, 0x68, 0x26, 0xfa, 0x81, 0x6c, 0xa6, 0x33, 0xb2, 0x35, 0x3f, 0x8b, 0x2e, 0x24, 0x1b,
-	0xe8, 0x1b, 0x3d, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x83, 0x7c, 0xba, 0x44, 0x49, 0x02, 0x00,
-	0x00,
+func (m *GenesisState) GetDynamicSwapFeePolicies() []DynamicSwapFeePolicy {
+	if m != nil {
+		return m.DynamicSwapFeePolicies
+	}
+	return nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GenesisState) GetPoolProtocolRevenueShares() []PoolProtocolRevenueShare {
+	if m != nil {
+		return m.PoolProtocolRevenueShares
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GenesisState) GetProtocolRevenue() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProtocolRevenue
+	}
+	return nil
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PoolCreationFee) > 0 {
-		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PoolCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *GenesisState) GetPoolSwapStats() []PoolSwapStats {
+	if m != nil {
+		return m.PoolSwapStats
+	}
+	return nil
+}
+
+// TickInfo is the liquidity state of an initialized tick of a concentrated
+// liquidity pool, i.e. a tick that is the lower or upper bound of at least one
+// position.
+type TickInfo struct {
+	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty" yaml:"index"`
+	// total liquidity of the positions bounded by this tick
+	LiquidityGross github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=liquidity_gross,json=liquidityGross,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_gross" yaml:"liquidity_gross"`
+	// liquidity added to the active liquidity when the tick is crossed moving
+	// up, and removed when crossed moving down
+	LiquidityNet github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_net" yaml:"liquidity_net"`
+	// fee growth per unit of liquidity on the other side of this tick, relative
+	// to the current tick
+	FeeGrowthOutside0 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=fee_growth_outside0,json=feeGrowthOutside0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_outside0" yaml:"fee_growth_outside0"`
+	FeeGrowthOutside1 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=fee_growth_outside1,json=feeGrowthOutside1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_outside1" yaml:"fee_growth_outside1"`
+}
+
+func (m *TickInfo) Reset()         { *m = TickInfo{} }
+func (m *TickInfo) String() string { return proto.CompactTextString(m) }
+func (*TickInfo) ProtoMessage()    {}
+func (*TickInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{2}
+}
+func (m *TickInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TickInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickInfo.Merge(m, src)
+}
+func (m *TickInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickInfo.DiscardUnknown(m)
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TickInfo proto.InternalMessageInfo
+
+func (m *TickInfo) GetIndex() int64 {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Position is the liquidity provided by an owner to a concentrated liquidity
+// pool between a lower and an upper tick.
+type Position struct {
+	Owner     string                                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	LowerTick int64                                  `protobuf:"varint,2,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64                                  `protobuf:"varint,3,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	Liquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity" yaml:"liquidity"`
+	// fee growth inside the position's range as of the last update
+	FeeGrowthInside0Last github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=fee_growth_inside0_last,json=feeGrowthInside0Last,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_inside0_last" yaml:"fee_growth_inside0_last"`
+	FeeGrowthInside1Last github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=fee_growth_inside1_last,json=feeGrowthInside1Last,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_inside1_last" yaml:"fee_growth_inside1_last"`
+	// fees accrued by the position that have not been collected yet
+	FeesOwed github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,7,rep,name=fees_owed,json=feesOwed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fees_owed" yaml:"fees_owed"`
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *Position) Reset()         { *m = Position{} }
+func (m *Position) String() string { return proto.CompactTextString(m) }
+func (*Position) ProtoMessage()    {}
+func (*Position) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{3}
+}
+func (m *Position) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Position) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Position.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.NextPoolNumber != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPoolNumber))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *Position) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Position.Merge(m, src)
+}
+func (m *Position) XXX_Size() int {
+	return m.Size()
+}
+func (m *Position) XXX_DiscardUnknown() {
+	xxx_messageInfo_Position.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Position proto.InternalMessageInfo
+
+func (m *Position) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return ""
+}
+
+func (m *Position) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *Position) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func (m *Position) GetFeesOwed() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.FeesOwed
+	}
+	return nil
+}
+
+// ConcentratedPoolState holds the initialized ticks and the positions of a
+// concentrated liquidity pool, which are stored apart from the pool.
+type ConcentratedPoolState struct {
+	PoolId    uint64     `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Ticks     []TickInfo `protobuf:"bytes,2,rep,name=ticks,proto3" json:"ticks"`
+	Positions []Position `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions"`
+}
+
+func (m *ConcentratedPoolState) Reset()         { *m = ConcentratedPoolState{} }
+func (m *ConcentratedPoolState) String() string { return proto.CompactTextString(m) }
+func (*ConcentratedPoolState) ProtoMessage()    {}
+func (*ConcentratedPoolState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{4}
+}
+func (m *ConcentratedPoolState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConcentratedPoolState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConcentratedPoolState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConcentratedPoolState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConcentratedPoolState.Merge(m, src)
+}
+func (m *ConcentratedPoolState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConcentratedPoolState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConcentratedPoolState.DiscardUnknown(m)
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ConcentratedPoolState proto.InternalMessageInfo
+
+func (m *ConcentratedPoolState) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ConcentratedPoolState) GetTicks() []TickInfo {
+	if m != nil {
+		return m.Ticks
 	}
-	var l int
-	_ = l
-	if len(m.PoolCreationFee) > 0 {
-		for _, e := range m.PoolCreationFee {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+func (m *ConcentratedPoolState) GetPositions() []Position {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+// SunsetPool is the withdrawal state of a pool retired by governance. LPs exit
+// the pool pro rata to their share of the remaining shares, out of the
+// remaining liquidity, which is frozen at the time of the sunset. The locks of
+// the pool's shares are force unlocked in batches at the end of blocks.
+type SunsetPool struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// shares not withdrawn yet
+	RemainingShares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=remaining_shares,json=remainingShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_shares" yaml:"remaining_shares"`
+	// liquidity not withdrawn yet
+	RemainingLiquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=remaining_liquidity,json=remainingLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_liquidity" yaml:"remaining_liquidity"`
+	// true until the locks of the pool's shares are all force unlocked
+	ForceUnlocksPending bool `protobuf:"varint,4,opt,name=force_unlocks_pending,json=forceUnlocksPending,proto3" json:"force_unlocks_pending,omitempty" yaml:"force_unlocks_pending"`
+	// lock ref store key of the lockup module to resume force unlocking from
+	NextLockKey []byte `protobuf:"bytes,5,opt,name=next_lock_key,json=nextLockKey,proto3" json:"next_lock_key,omitempty" yaml:"next_lock_key"`
+}
+
+func (m *SunsetPool) Reset()         { *m = SunsetPool{} }
+func (m *SunsetPool) String() string { return proto.CompactTextString(m) }
+func (*SunsetPool) ProtoMessage()    {}
+func (*SunsetPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{5}
+}
+func (m *SunsetPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SunsetPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SunsetPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SunsetPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SunsetPool.Merge(m, src)
+}
+func (m *SunsetPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *SunsetPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_SunsetPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SunsetPool proto.InternalMessageInfo
+
+func (m *SunsetPool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *SunsetPool) GetRemainingLiquidity() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainingLiquidity
+	}
+	return nil
+}
+
+func (m *SunsetPool) GetForceUnlocksPending() bool {
+	if m != nil {
+		return m.ForceUnlocksPending
+	}
+	return false
+}
+
+func (m *SunsetPool) GetNextLockKey() []byte {
+	if m != nil {
+		return m.NextLockKey
+	}
+	return nil
+}
+
+// DynamicSwapFeePolicy sets the swap fee of a pool from the volatility of its
+// prices. The swap fee is min_swap_fee plus the volatility of the pool's
+// prices over the last volatility_window, capped at max_swap_fee.
+type DynamicSwapFeePolicy struct {
+	PoolId           uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	MinSwapFee       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_swap_fee,json=minSwapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_swap_fee" yaml:"min_swap_fee"`
+	MaxSwapFee       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_swap_fee,json=maxSwapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_swap_fee" yaml:"max_swap_fee"`
+	VolatilityWindow time.Duration                          `protobuf:"bytes,4,opt,name=volatility_window,json=volatilityWindow,proto3,stdduration" json:"volatility_window" yaml:"volatility_window"`
+	// swap fee of the pool before its first policy was set, restored when the
+	// policy is removed
+	StaticSwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=static_swap_fee,json=staticSwapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"static_swap_fee" yaml:"static_swap_fee"`
+}
+
+func (m *DynamicSwapFeePolicy) Reset()         { *m = DynamicSwapFeePolicy{} }
+func (m *DynamicSwapFeePolicy) String() string { return proto.CompactTextString(m) }
+func (*DynamicSwapFeePolicy) ProtoMessage()    {}
+func (*DynamicSwapFeePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{6}
+}
+func (m *DynamicSwapFeePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSwapFeePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSwapFeePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSwapFeePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSwapFeePolicy.Merge(m, src)
+}
+func (m *DynamicSwapFeePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSwapFeePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSwapFeePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSwapFeePolicy proto.InternalMessageInfo
+
+func (m *DynamicSwapFeePolicy) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *DynamicSwapFeePolicy) GetVolatilityWindow() time.Duration {
+	if m != nil {
+		return m.VolatilityWindow
+	}
+	return 0
+}
+
+// PoolProtocolRevenueShare overrides the protocol revenue share param for the
+// swap fees of a pool.
+type PoolProtocolRevenueShare struct {
+	PoolId               uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	ProtocolRevenueShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=protocol_revenue_share,json=protocolRevenueShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_revenue_share" yaml:"protocol_revenue_share"`
+}
+
+func (m *PoolProtocolRevenueShare) Reset()         { *m = PoolProtocolRevenueShare{} }
+func (m *PoolProtocolRevenueShare) String() string { return proto.CompactTextString(m) }
+func (*PoolProtocolRevenueShare) ProtoMessage()    {}
+func (*PoolProtocolRevenueShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{7}
+}
+func (m *PoolProtocolRevenueShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolProtocolRevenueShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolProtocolRevenueShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolProtocolRevenueShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolProtocolRevenueShare.Merge(m, src)
+}
+func (m *PoolProtocolRevenueShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolProtocolRevenueShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolProtocolRevenueShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolProtocolRevenueShare proto.InternalMessageInfo
+
+func (m *PoolProtocolRevenueShare) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// PoolSwapStats is the cumulative swap volume and swap fees of a pool, by
+// denom.
+type PoolSwapStats struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// cumulative amounts of tokens swapped into the pool
+	Volume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume" yaml:"volume"`
+	// cumulative swap fees charged on the tokens swapped into the pool,
+	// including the protocol revenue
+	FeesCollected github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=fees_collected,json=feesCollected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fees_collected" yaml:"fees_collected"`
+}
+
+func (m *PoolSwapStats) Reset()         { *m = PoolSwapStats{} }
+func (m *PoolSwapStats) String() string { return proto.CompactTextString(m) }
+func (*PoolSwapStats) ProtoMessage()    {}
+func (*PoolSwapStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{8}
+}
+func (m *PoolSwapStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolSwapStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolSwapStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolSwapStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolSwapStats.Merge(m, src)
+}
+func (m *PoolSwapStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolSwapStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolSwapStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolSwapStats proto.InternalMessageInfo
+
+func (m *PoolSwapStats) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolSwapStats) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *PoolSwapStats) GetFeesCollected() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.FeesCollected
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "osmosis.gamm.v1beta1.Params")
+	proto.RegisterType((*GenesisState)(nil), "osmosis.gamm.v1beta1.GenesisState")
+	proto.RegisterType((*TickInfo)(nil), "osmosis.gamm.v1beta1.TickInfo")
+	proto.RegisterType((*Position)(nil), "osmosis.gamm.v1beta1.Position")
+	proto.RegisterType((*ConcentratedPoolState)(nil), "osmosis.gamm.v1beta1.ConcentratedPoolState")
+	proto.RegisterType((*SunsetPool)(nil), "osmosis.gamm.v1beta1.SunsetPool")
+	proto.RegisterType((*DynamicSwapFeePolicy)(nil), "osmosis.gamm.v1beta1.DynamicSwapFeePolicy")
+	proto.RegisterType((*PoolProtocolRevenueShare)(nil), "osmosis.gamm.v1beta1.PoolProtocolRevenueShare")
+	proto.RegisterType((*PoolSwapStats)(nil), "osmosis.gamm.v1beta1.PoolSwapStats")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/gamm/v1beta1/genesis.proto", fileDescriptor_5a324eb7f1dd793e)
+}
+
+var fileDescriptor_5a324eb7f1dd793e = []byte{
+	// 1536 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0xb1, 0x1b, 0x4f, 0x7e, 0x6f, 0x92, 0x76, 0x13, 0xe5, 0x6b, 0xe7, 0xbb, 0x2d,
+	0x25, 0x22, 0xaa, 0x5d, 0x17, 0xb8, 0x44, 0x5c, 0xea, 0x84, 0x84, 0xd0, 0xd0, 0x86, 0x4d, 0x11,
+	0x12, 0x12, 0x5a, 0xc6, 0xeb, 0xb1, 0x33, 0xf2, 0x7a, 0x67, 0xbb, 0x33, 0x8e, 0x6d, 0x89, 0x13,
+	0x12, 0xe2, 0x86, 0x40, 0x5c, 0x80, 0x3f, 0x01, 0x04, 0x27, 0xfe, 0x04, 0x84, 0x2a, 0x2e, 0xf4,
+	0x88, 0x38, 0xb8, 0x55, 0x7b, 0xe2, 0x1a, 0x89, 0x3b, 0x9a, 0x1f, 0xbb, 0x76, 0x9c, 0x4d, 0x93,
+	0x55, 0x2b, 0x4e, 0xf1, 0xbc, 0x79, 0xef, 0xf3, 0x79, 0x3b, 0xef, 0xd7, 0x4c, 0x80, 0x49, 0x68,
+	0x93, 0x50, 0x4c, 0x8b, 0x75, 0xd8, 0x6c, 0x16, 0x8f, 0x4a, 0x15, 0xc4, 0x60, 0xa9, 0x58, 0x47,
+	0x1e, 0xa2, 0x98, 0x16, 0xfc, 0x80, 0x30, 0xa2, 0x2f, 0x28, 0x9d, 0x02, 0xd7, 0x29, 0x28, 0x9d,
+	0xe5, 0x85, 0x3a, 0xa9, 0x13, 0xa1, 0x50, 0xe4, 0xbf, 0xa4, 0xee, 0xf2, 0x52, 0x9d, 0x90, 0xba,
+	0x8b, 0x8a, 0x62, 0x55, 0x69, 0xd5, 0x8a, 0xd0, 0xeb, 0xaa, 0xad, 0xdc, 0xf0, 0x56, 0xb5, 0x15,
+	0x40, 0x86, 0x89, 0x17, 0x9a, 0x3a, 0x82, 0xc7, 0x96, 0x98, 0x72, 0x11, 0x9a, 0xca, 0x55, 0xb1,
+	0x02, 0x29, 0x8a, 0x9c, 0x74, 0x08, 0x56, 0xa6, 0xe6, 0xdf, 0x69, 0x90, 0xd9, 0x87, 0x01, 0x6c,
+	0x52, 0xfd, 0x1b, 0x0d, 0xcc, 0xf9, 0x84, 0xb8, 0xb6, 0x13, 0x20, 0x81, 0x6e, 0xd7, 0x10, 0x32,
+	0xb4, 0xd5, 0xd4, 0xda, 0xc4, 0xad, 0xa5, 0x82, 0x42, 0xe5, 0x38, 0xe1, 0x87, 0x14, 0x36, 0x09,
+	0xf6, 0xca, 0x7b, 0x0f, 0x7b, 0xf9, 0x91, 0xe3, 0x5e, 0xde, 0xe8, 0xc2, 0xa6, 0xbb, 0x61, 0x9e,
+	0x42, 0x30, 0x7f, 0x78, 0x9c, 0x5f, 0xab, 0x63, 0x76, 0xd8, 0xaa, 0x14, 0x1c, 0xd2, 0x54, 0xee,
+	0xa9, 0x3f, 0x37, 0x68, 0xb5, 0x51, 0x64, 0x5d, 0x1f, 0x51, 0x01, 0x46, 0xad, 0x19, 0x6e, 0xbf,
+	0xa9, 0xcc, 0xb7, 0x11, 0xd2, 0x7f, 0xd2, 0xc0, 0x35, 0xca, 0x60, 0xc5, 0x45, 0xb4, 0x0d, 0x7d,
+	0xbb, 0x09, 0x3b, 0x36, 0x75, 0xa0, 0x8b, 0xbd, 0xba, 0x5d, 0x83, 0x0e, 0x23, 0x81, 0xed, 0x1c,
+	0x42, 0xaf, 0x8e, 0x8c, 0xd1, 0x55, 0x6d, 0x2d, 0x5b, 0xfe, 0x98, 0x7b, 0xf3, 0x57, 0x2f, 0x7f,
+	0xfd, 0x02, 0x8c, 0x5b, 0xc8, 0x39, 0xee, 0xe5, 0xd7, 0xa5, 0xdf, 0x17, 0xe1, 0x30, 0xad, 0xd5,
+	0xbe, 0xda, 0x7b, 0xb0, 0x73, 0x20, 0x95, 0xb6, 0x85, 0xce, 0xa6, 0x50, 0xd1, 0x3f, 0xd7, 0xc0,
+	0x65, 0x71, 0xb4, 0x0e, 0x71, 0xed, 0x00, 0x1d, 0x21, 0xaf, 0x85, 0x6c, 0x7a, 0x08, 0x03, 0x64,
+	0xa4, 0x84, 0x87, 0xf7, 0x12, 0x7b, 0xf8, 0x3f, 0x75, 0xb2, 0xb1, 0xa8, 0xa6, 0xb5, 0x10, 0x6e,
+	0x58, 0x52, 0x7e, 0xc0, 0xc5, 0xba, 0x03, 0x96, 0x4f, 0x19, 0x04, 0xc8, 0xc1, 0x3e, 0x46, 0x1e,
+	0x33, 0xc6, 0x84, 0x2b, 0xaf, 0x1c, 0xf7, 0xf2, 0xff, 0x3f, 0x03, 0x3c, 0xd2, 0x35, 0x2d, 0x63,
+	0x88, 0xc0, 0x0a, 0xb7, 0xf4, 0x1f, 0x35, 0xf0, 0xea, 0xc0, 0xc1, 0xc5, 0x1e, 0x9a, 0xdd, 0xc6,
+	0x5e, 0x95, 0xb4, 0x8d, 0xf4, 0xaa, 0x26, 0x12, 0x49, 0xe6, 0x72, 0x21, 0xcc, 0xe5, 0xc2, 0x96,
+	0xca, 0xe5, 0xf2, 0x86, 0x4a, 0xa4, 0xc2, 0xa9, 0x80, 0x3c, 0x0f, 0xd7, 0xfc, 0xf6, 0x71, 0x5e,
+	0xb3, 0xae, 0xf6, 0xb5, 0x63, 0x82, 0xf2, 0xa1, 0xd4, 0xfc, 0x39, 0x03, 0x26, 0x77, 0x64, 0x7d,
+	0x1e, 0x30, 0xc8, 0x90, 0xfe, 0x26, 0x48, 0xf3, 0x74, 0xa3, 0x2a, 0xc9, 0x17, 0x4e, 0xf9, 0x76,
+	0xdb, 0xeb, 0x96, 0xb3, 0xbf, 0xff, 0x72, 0x23, 0xbd, 0x4f, 0x88, 0xbb, 0x6b, 0x49, 0x6d, 0x7d,
+	0x0d, 0xcc, 0x7a, 0xa8, 0xc3, 0x6c, 0xbe, 0xb2, 0xbd, 0x56, 0xb3, 0x82, 0x02, 0x91, 0x7d, 0x63,
+	0xd6, 0x34, 0x97, 0x73, 0xdd, 0xbb, 0x42, 0xaa, 0x6f, 0x80, 0x8c, 0x2f, 0x8a, 0x4b, 0xc4, 0x7e,
+	0xe2, 0xd6, 0x4a, 0x21, 0xae, 0x21, 0x14, 0x64, 0x01, 0x96, 0xc7, 0xf8, 0x01, 0x58, 0xca, 0x42,
+	0x6f, 0x00, 0xc3, 0x21, 0x9e, 0x83, 0x3c, 0x16, 0x40, 0x86, 0xaa, 0x92, 0x8d, 0x72, 0xbf, 0xa9,
+	0x31, 0x26, 0xfc, 0x5d, 0x8f, 0x47, 0xdb, 0x1c, 0xb0, 0xe2, 0xbe, 0x88, 0x6f, 0x55, 0xe0, 0x97,
+	0x9d, 0xb8, 0x4d, 0xaa, 0xef, 0x82, 0x49, 0xda, 0xf2, 0x28, 0x92, 0x1f, 0x45, 0x8d, 0xb4, 0x20,
+	0x58, 0x8d, 0x27, 0x38, 0x10, 0x9a, 0xdc, 0x5a, 0xa1, 0x4e, 0xd0, 0x48, 0xc2, 0xfd, 0x5e, 0xaa,
+	0x76, 0x3d, 0xd8, 0xc4, 0x8e, 0x2d, 0x82, 0x57, 0x43, 0xc8, 0xf6, 0x89, 0x8b, 0x1d, 0x8c, 0xa8,
+	0x91, 0x11, 0xb8, 0xaf, 0xc5, 0xe3, 0x6e, 0x49, 0xb3, 0x83, 0x36, 0xf4, 0xb7, 0x11, 0xda, 0xe7,
+	0x36, 0xdd, 0xd0, 0xef, 0xea, 0xe9, 0x3d, 0x8c, 0xa8, 0xde, 0x02, 0x2b, 0xe2, 0x5c, 0xe2, 0x6b,
+	0x83, 0x1a, 0x97, 0x04, 0x5f, 0xe1, 0x8c, 0x63, 0x27, 0xc4, 0xdd, 0x8f, 0xa9, 0x1d, 0xc5, 0xb9,
+	0xe4, 0x9f, 0xb1, 0x4f, 0xf5, 0xaf, 0x35, 0x30, 0x3b, 0x4c, 0x69, 0x8c, 0x9f, 0xd7, 0x29, 0xef,
+	0xa8, 0x04, 0xbf, 0x12, 0x5f, 0x72, 0x49, 0x1b, 0xe5, 0x49, 0xcf, 0xf4, 0xf7, 0xc1, 0x8c, 0x4c,
+	0x11, 0x51, 0x31, 0x0c, 0x32, 0x6a, 0x64, 0x85, 0x47, 0x57, 0xcf, 0xfe, 0x7a, 0x7e, 0x9c, 0x3c,
+	0x03, 0xc2, 0xdc, 0x9b, 0xf2, 0x07, 0x85, 0xe6, 0x97, 0x63, 0x60, 0xfc, 0x3e, 0x76, 0x1a, 0xbb,
+	0x5e, 0x8d, 0xe8, 0xd7, 0x41, 0x1a, 0x7b, 0x55, 0xd4, 0x31, 0xb4, 0x55, 0x6d, 0x2d, 0x55, 0x9e,
+	0x3d, 0xee, 0xe5, 0x27, 0xe5, 0x87, 0x08, 0xb1, 0x69, 0xc9, 0x6d, 0xfd, 0x01, 0x98, 0x71, 0xf1,
+	0x83, 0x16, 0xae, 0x62, 0xd6, 0xb5, 0xeb, 0x01, 0xa1, 0x54, 0xb5, 0xe6, 0x77, 0x12, 0x37, 0xbe,
+	0xcb, 0x12, 0x7f, 0x08, 0xce, 0xb4, 0xa6, 0x23, 0xc9, 0x0e, 0x17, 0xe8, 0x0d, 0x30, 0xd5, 0xd7,
+	0xf1, 0x10, 0x53, 0x9d, 0x76, 0x3b, 0x31, 0xe1, 0xc2, 0x30, 0xa1, 0x87, 0x98, 0x69, 0x4d, 0x46,
+	0xeb, 0xbb, 0x88, 0xe9, 0x9f, 0x82, 0x79, 0x9e, 0xd2, 0xf5, 0x80, 0xb4, 0xd9, 0xa1, 0x4d, 0x5a,
+	0x8c, 0xe2, 0x2a, 0xba, 0xa9, 0x3a, 0xea, 0x5e, 0x62, 0xca, 0x65, 0x49, 0x19, 0x03, 0x69, 0x5a,
+	0x73, 0x35, 0x84, 0x76, 0x84, 0xf0, 0x9e, 0x92, 0xc5, 0xb3, 0x97, 0x8c, 0xf4, 0xcb, 0x66, 0x2f,
+	0xc5, 0xb0, 0x97, 0xcc, 0xef, 0xd2, 0x60, 0x7c, 0x9f, 0x50, 0xcc, 0xfb, 0x35, 0x4f, 0x08, 0xd2,
+	0xf6, 0x50, 0x20, 0x12, 0x22, 0x3b, 0x98, 0x10, 0x42, 0x6c, 0x5a, 0x72, 0x5b, 0x7f, 0x03, 0x00,
+	0x97, 0xb4, 0x51, 0x60, 0x33, 0xec, 0x34, 0x44, 0x2e, 0xa4, 0xca, 0x8b, 0xc7, 0xbd, 0xfc, 0x9c,
+	0x3a, 0xec, 0x68, 0xcf, 0xb4, 0xb2, 0x62, 0xc1, 0x53, 0x8e, 0x5b, 0xb5, 0x7c, 0x3f, 0xb4, 0x4a,
+	0x0d, 0x5b, 0xf5, 0xf7, 0x4c, 0x2b, 0x2b, 0x16, 0xc2, 0xea, 0x13, 0x90, 0x8d, 0x82, 0xa5, 0x42,
+	0x52, 0x4e, 0x7c, 0x28, 0xb3, 0x43, 0x59, 0xc0, 0xfd, 0x0a, 0x7f, 0xeb, 0x5f, 0x68, 0xe0, 0xca,
+	0xc0, 0x71, 0x61, 0x4f, 0xc4, 0xc5, 0x76, 0x21, 0x65, 0x2a, 0x0a, 0xfb, 0x89, 0x09, 0x73, 0xa7,
+	0xa2, 0x30, 0x08, 0x6b, 0x5a, 0x0b, 0x51, 0x24, 0x76, 0xa5, 0x7c, 0x0f, 0x52, 0x16, 0xef, 0x49,
+	0x49, 0x7a, 0x92, 0x79, 0xc9, 0x9e, 0x94, 0xce, 0xf0, 0xa4, 0x24, 0x3c, 0xf9, 0x4c, 0x03, 0xd9,
+	0x1a, 0x42, 0xd4, 0x26, 0x6d, 0x54, 0x55, 0x3d, 0x77, 0x25, 0xb6, 0x0f, 0x6e, 0x21, 0x47, 0xb4,
+	0xc2, 0x1d, 0xd5, 0x0a, 0x67, 0x23, 0x3e, 0x69, 0xcc, 0x7b, 0xe0, 0xfa, 0xc5, 0xbc, 0x95, 0x6d,
+	0x70, 0x9c, 0x9b, 0xde, 0xe3, 0x96, 0xbf, 0x6a, 0x60, 0x31, 0x76, 0xf4, 0xe9, 0xeb, 0xe0, 0x92,
+	0xe8, 0x8c, 0xb8, 0x2a, 0x52, 0x75, 0xac, 0xac, 0x1f, 0xf7, 0xf2, 0xd3, 0x03, 0xd7, 0x55, 0x5c,
+	0x35, 0xad, 0x0c, 0xff, 0xb5, 0x5b, 0xd5, 0x37, 0x40, 0x9a, 0x67, 0x15, 0x6f, 0x5a, 0xfc, 0x33,
+	0x72, 0xf1, 0xcd, 0x33, 0xec, 0x8a, 0xaa, 0x6f, 0x4a, 0x13, 0xbd, 0x0c, 0xb2, 0xbe, 0xaa, 0x0e,
+	0x3e, 0xf1, 0x9f, 0x63, 0x1f, 0x16, 0x91, 0xb2, 0xef, 0x9b, 0x99, 0x4f, 0x52, 0x00, 0xf4, 0x07,
+	0x6c, 0x32, 0xdf, 0x19, 0x98, 0x0d, 0x50, 0x13, 0x62, 0x8f, 0x5f, 0x96, 0xd4, 0x04, 0x94, 0xbd,
+	0x77, 0x37, 0x41, 0x26, 0xec, 0x7a, 0xac, 0x3f, 0xa4, 0x86, 0xf1, 0x4c, 0x6b, 0x26, 0x12, 0xa9,
+	0x61, 0xf8, 0xbd, 0x06, 0xe6, 0xfb, 0x6a, 0xfd, 0xf2, 0x4b, 0x9d, 0x37, 0x0f, 0xef, 0xaa, 0x24,
+	0x58, 0x1e, 0xa6, 0xea, 0x57, 0x5e, 0xa2, 0x91, 0xa8, 0x47, 0x08, 0x7b, 0x51, 0xb9, 0xde, 0x07,
+	0x8b, 0x35, 0x12, 0x38, 0xc8, 0x6e, 0x79, 0x2e, 0x71, 0x1a, 0xd4, 0xf6, 0x91, 0x57, 0xc5, 0x5e,
+	0x5d, 0x34, 0x87, 0xf1, 0xf2, 0xea, 0x71, 0x2f, 0xbf, 0xa2, 0x72, 0x30, 0x4e, 0xcd, 0xb4, 0xe6,
+	0x85, 0xfc, 0x03, 0x29, 0xde, 0x97, 0x52, 0xfd, 0x2d, 0x30, 0x25, 0x6e, 0x80, 0x5c, 0x68, 0x37,
+	0x50, 0x57, 0x54, 0xfe, 0x64, 0xd9, 0xe8, 0x8f, 0x90, 0x13, 0xdb, 0xa6, 0x35, 0xc1, 0xd7, 0x7b,
+	0xc4, 0x69, 0xdc, 0x41, 0x5d, 0xf3, 0x9f, 0x14, 0x58, 0x88, 0xbb, 0xeb, 0x24, 0x0b, 0x76, 0x1d,
+	0x4c, 0x36, 0xb1, 0x17, 0xdd, 0xb1, 0x54, 0xa0, 0xdf, 0x4e, 0x5c, 0xf2, 0xf3, 0x12, 0x7f, 0x10,
+	0xcb, 0xb4, 0x40, 0x13, 0x7b, 0xca, 0x37, 0x41, 0x04, 0x3b, 0xd1, 0xa6, 0x91, 0x7a, 0x41, 0x22,
+	0xd8, 0x39, 0x41, 0x04, 0x3b, 0x21, 0x91, 0x0b, 0xe6, 0x8e, 0x88, 0x0b, 0x19, 0x76, 0xf9, 0xe8,
+	0x55, 0xcf, 0x86, 0xb1, 0xf3, 0x9e, 0x0d, 0xd7, 0x4e, 0xbe, 0x3f, 0x4f, 0x21, 0xc8, 0x07, 0xc2,
+	0x6c, 0x5f, 0x2e, 0x5f, 0x03, 0xba, 0x0f, 0x66, 0x28, 0x83, 0x6c, 0xe0, 0x9a, 0x6a, 0xa4, 0x5f,
+	0xec, 0x9e, 0x32, 0x04, 0x67, 0x5a, 0x53, 0x52, 0xa2, 0xbe, 0xcf, 0xfc, 0x43, 0x03, 0xc6, 0x59,
+	0x77, 0xce, 0x64, 0xb1, 0x7f, 0xce, 0x23, 0x73, 0xf4, 0x3f, 0x7c, 0x64, 0x9a, 0xbf, 0x8d, 0x82,
+	0xa9, 0x13, 0xf7, 0xc8, 0xa4, 0xfd, 0x2a, 0x73, 0x44, 0xdc, 0x56, 0x13, 0x19, 0xa3, 0xe7, 0xf5,
+	0x8a, 0xdb, 0x2a, 0xca, 0x53, 0x51, 0x94, 0x5b, 0xcd, 0x84, 0x37, 0x66, 0xc5, 0xc5, 0x2f, 0xef,
+	0xd3, 0x62, 0xe0, 0x38, 0xc4, 0x75, 0x91, 0xc3, 0x50, 0xd5, 0x48, 0x5d, 0x60, 0x64, 0x85, 0xff,
+	0xe7, 0x58, 0x1c, 0x18, 0x59, 0x11, 0x42, 0xe2, 0xb9, 0x35, 0xc5, 0xed, 0x37, 0x43, 0xf3, 0xf2,
+	0xbb, 0x0f, 0x9f, 0xe6, 0xb4, 0x47, 0x4f, 0x73, 0xda, 0x93, 0xa7, 0x39, 0xed, 0xab, 0x67, 0xb9,
+	0x91, 0x47, 0xcf, 0x72, 0x23, 0x7f, 0x3e, 0xcb, 0x8d, 0x7c, 0x74, 0x73, 0x00, 0x55, 0x8d, 0x92,
+	0x1b, 0x2e, 0xac, 0xd0, 0x70, 0x51, 0x3c, 0x2a, 0xdd, 0x2a, 0x76, 0xe4, 0x3f, 0xa1, 0x04, 0x47,
+	0x25, 0x23, 0x42, 0xf5, 0xfa, 0xbf, 0x03, 0x00, 0x72, 0x0d, 0xba, 0xa0, 0xa1, 0x12, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.StableswapScalingFactorChangeWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.StableswapScalingFactorChangeWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ProtocolRevenueRecipient) > 0 {
+		i -= len(m.ProtocolRevenueRecipient)
+		copy(dAtA[i:], m.ProtocolRevenueRecipient)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ProtocolRevenueRecipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.ProtocolRevenueShare.Size()
+		i -= size
+		if _, err := m.ProtocolRevenueShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StableswapMaxScalingFactorChange.Size()
+		i -= size
+		if _, err := m.StableswapMaxScalingFactorChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolCreationFee) > 0 {
+		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolSwapStats) > 0 {
+		for iNdEx := len(m.PoolSwapStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolSwapStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ProtocolRevenue) > 0 {
+		for iNdEx := len(m.ProtocolRevenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PoolProtocolRevenueShares) > 0 {
+		for iNdEx := len(m.PoolProtocolRevenueShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolProtocolRevenueShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DynamicSwapFeePolicies) > 0 {
+		for iNdEx := len(m.DynamicSwapFeePolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DynamicSwapFeePolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SunsetPools) > 0 {
+		for iNdEx := len(m.SunsetPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SunsetPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ConcentratedPoolStates) > 0 {
+		for iNdEx := len(m.ConcentratedPoolStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConcentratedPoolStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.NextPoolNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPoolNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TickInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeGrowthOutside1.Size()
+		i -= size
+		if _, err := m.FeeGrowthOutside1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FeeGrowthOutside0.Size()
+		i -= size
+		if _, err := m.FeeGrowthOutside0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LiquidityGross.Size()
+		i -= size
+		if _, err := m.LiquidityGross.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Index != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeesOwed) > 0 {
+		for iNdEx := len(m.FeesOwed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesOwed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.FeeGrowthInside1Last.Size()
+		i -= size
+		if _, err := m.FeeGrowthInside1Last.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.FeeGrowthInside0Last.Size()
+		i -= size
+		if _, err := m.FeeGrowthInside0Last.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.UpperTick != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConcentratedPoolState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConcentratedPoolState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConcentratedPoolState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Ticks) > 0 {
+		for iNdEx := len(m.Ticks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ticks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SunsetPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SunsetPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SunsetPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextLockKey) > 0 {
+		i -= len(m.NextLockKey)
+		copy(dAtA[i:], m.NextLockKey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.NextLockKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ForceUnlocksPending {
+		i--
+		if m.ForceUnlocksPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RemainingLiquidity) > 0 {
+		for iNdEx := len(m.RemainingLiquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.RemainingShares.Size()
+		i -= size
+		if _, err := m.RemainingShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicSwapFeePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSwapFeePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSwapFeePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StaticSwapFee.Size()
+		i -= size
+		if _, err := m.StaticSwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VolatilityWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VolatilityWindow):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxSwapFee.Size()
+		i -= size
+		if _, err := m.MaxSwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinSwapFee.Size()
+		i -= size
+		if _, err := m.MinSwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolProtocolRevenueShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolProtocolRevenueShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolProtocolRevenueShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ProtocolRevenueShare.Size()
+		i -= size
+		if _, err := m.ProtocolRevenueShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolSwapStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolSwapStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolSwapStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeesCollected) > 0 {
+		for iNdEx := len(m.FeesCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolCreationFee) > 0 {
+		for _, e := range m.PoolCreationFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.StableswapMaxScalingFactorChange.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.ProtocolRevenueShare.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.ProtocolRevenueRecipient)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.StableswapScalingFactorChangeWindow)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPoolNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPoolNumber))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ConcentratedPoolStates) > 0 {
+		for _, e := range m.ConcentratedPoolStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SunsetPools) > 0 {
+		for _, e := range m.SunsetPools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DynamicSwapFeePolicies) > 0 {
+		for _, e := range m.DynamicSwapFeePolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolProtocolRevenueShares) > 0 {
+		for _, e := range m.PoolProtocolRevenueShares {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProtocolRevenue) > 0 {
+		for _, e := range m.ProtocolRevenue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolSwapStats) > 0 {
+		for _, e := range m.PoolSwapStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TickInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovGenesis(uint64(m.Index))
+	}
+	l = m.LiquidityGross.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FeeGrowthOutside0.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FeeGrowthOutside1.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovGenesis(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovGenesis(uint64(m.UpperTick))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FeeGrowthInside0Last.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FeeGrowthInside1Last.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FeesOwed) > 0 {
+		for _, e := range m.FeesOwed {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConcentratedPoolState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	if len(m.Ticks) > 0 {
+		for _, e := range m.Ticks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SunsetPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = m.RemainingShares.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RemainingLiquidity) > 0 {
+		for _, e := range m.RemainingLiquidity {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ForceUnlocksPending {
+		n += 2
+	}
+	l = len(m.NextLockKey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *DynamicSwapFeePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = m.MinSwapFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxSwapFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VolatilityWindow)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.StaticSwapFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PoolProtocolRevenueShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = m.ProtocolRevenueShare.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PoolSwapStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeesCollected) > 0 {
+		for _, e := range m.FeesCollected {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
+			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableswapMaxScalingFactorChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StableswapMaxScalingFactorChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolRevenueShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolRevenueShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolRevenueRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolRevenueRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableswapScalingFactorChangeWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.StableswapScalingFactorChangeWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types2.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPoolNumber", wireType)
+			}
+			m.NextPoolNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPoolNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedPoolStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConcentratedPoolStates = append(m.ConcentratedPoolStates, ConcentratedPoolState{})
+			if err := m.ConcentratedPoolStates[len(m.ConcentratedPoolStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SunsetPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SunsetPools = append(m.SunsetPools, SunsetPool{})
+			if err := m.SunsetPools[len(m.SunsetPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSwapFeePolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DynamicSwapFeePolicies = append(m.DynamicSwapFeePolicies, DynamicSwapFeePolicy{})
+			if err := m.DynamicSwapFeePolicies[len(m.DynamicSwapFeePolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolProtocolRevenueShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolProtocolRevenueShares = append(m.PoolProtocolRevenueShares, PoolProtocolRevenueShare{})
+			if err := m.PoolProtocolRevenueShares[len(m.PoolProtocolRevenueShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolRevenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolRevenue = append(m.ProtocolRevenue, types.Coin{})
+			if err := m.ProtocolRevenue[len(m.ProtocolRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolSwapStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolSwapStats = append(m.PoolSwapStats, PoolSwapStats{})
+			if err := m.PoolSwapStats[len(m.PoolSwapStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityGross", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityGross.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthOutside0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthOutside0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthOutside1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthOutside1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Position) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthInside0Last", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthInside0Last.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthInside1Last", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthInside1Last.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesOwed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesOwed = append(m.FeesOwed, types.DecCoin{})
+			if err := m.FeesOwed[len(m.FeesOwed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConcentratedPoolState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConcentratedPoolState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConcentratedPoolState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticks = append(m.Ticks, TickInfo{})
+			if err := m.Ticks[len(m.Ticks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, Position{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SunsetPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SunsetPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SunsetPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingLiquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingLiquidity = append(m.RemainingLiquidity, types.Coin{})
+			if err := m.RemainingLiquidity[len(m.RemainingLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceUnlocksPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceUnlocksPending = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLockKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextLockKey = append(m.NextLockKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextLockKey == nil {
+				m.NextLockKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.NextPoolNumber != 0 {
-		n += 1 + sovGenesis(uint64(m.NextPoolNumber))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DynamicSwapFeePolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -337,15 +3047,102 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DynamicSwapFeePolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DynamicSwapFeePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityWindow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -372,8 +3169,41 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
-			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VolatilityWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaticSwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StaticSwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -398,7 +3228,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *PoolProtocolRevenueShare) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -421,17 +3251,36 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolProtocolRevenueShare: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolProtocolRevenueShare: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolRevenueShare", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -441,31 +3290,81 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types1.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProtocolRevenueShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolSwapStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolSwapStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolSwapStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPoolNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.NextPoolNumber = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -475,14 +3374,48 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextPoolNumber |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = append(m.Volume, types.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesCollected", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -509,7 +3442,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeesCollected = append(m.FeesCollected, types.DecCoin{})
+			if err := m.FeesCollected[len(m.FeesCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,234 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeSetScalingFactorController  = "SetScalingFactorController"
+	ProposalTypeSunsetPool                  = "SunsetPool"
+	ProposalTypeSetDynamicSwapFeePolicy     = "SetDynamicSwapFeePolicy"
+	ProposalTypeSetPoolProtocolRevenueShare = "SetPoolProtocolRevenueShare"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetScalingFactorController)
+	govtypes.RegisterProposalTypeCodec(&SetScalingFactorControllerProposal{}, "osmosis/SetScalingFactorControllerProposal")
+	govtypes.RegisterProposalType(ProposalTypeSunsetPool)
+	govtypes.RegisterProposalTypeCodec(&SunsetPoolProposal{}, "osmosis/SunsetPoolProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetDynamicSwapFeePolicy)
+	govtypes.RegisterProposalTypeCodec(&SetDynamicSwapFeePolicyProposal{}, "osmosis/SetDynamicSwapFeePolicyProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetPoolProtocolRevenueShare)
+	govtypes.RegisterProposalTypeCodec(&SetPoolProtocolRevenueShareProposal{}, "osmosis/SetPoolProtocolRevenueShareProposal")
+}
+
+var (
+	_ govtypes.Content = &SetScalingFactorControllerProposal{}
+	_ govtypes.Content = &SunsetPoolProposal{}
+	_ govtypes.Content = &SetDynamicSwapFeePolicyProposal{}
+	_ govtypes.Content = &SetPoolProtocolRevenueShareProposal{}
+)
+
+func NewSetScalingFactorControllerProposal(title, description string, poolId uint64, controllerAddress string) SetScalingFactorControllerProposal {
+	return SetScalingFactorControllerProposal{
+		Title:             title,
+		Description:       description,
+		PoolId:            poolId,
+		ControllerAddress: controllerAddress,
+	}
+}
+
+func (p *SetScalingFactorControllerProposal) GetTitle() string { return p.Title }
+
+func (p *SetScalingFactorControllerProposal) GetDescription() string { return p.Description }
+
+func (p *SetScalingFactorControllerProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetScalingFactorControllerProposal) ProposalType() string {
+	return ProposalTypeSetScalingFactorController
+}
+
+func (p *SetScalingFactorControllerProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.PoolId == 0 {
+		return sdkerrors.Wrap(ErrPoolNotFound, "pool id must be positive")
+	}
+
+	// an empty controller removes the pool's scaling factor controller.
+	if p.ControllerAddress == "" {
+		return nil
+	}
+	_, err = sdk.AccAddressFromBech32(p.ControllerAddress)
+	return err
+}
+
+func (p SetScalingFactorControllerProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Scaling Factor Controller Proposal:
+  Title:              %s
+  Description:        %s
+  Pool ID:            %d
+  Controller Address: %s
+`, p.Title, p.Description, p.PoolId, p.ControllerAddress))
+	return b.String()
+}
+
+func NewSunsetPoolProposal(title, description string, poolId uint64) SunsetPoolProposal {
+	return SunsetPoolProposal{
+		Title:       title,
+		Description: description,
+		PoolId:      poolId,
+	}
+}
+
+func (p *SunsetPoolProposal) GetTitle() string { return p.Title }
+
+func (p *SunsetPoolProposal) GetDescription() string { return p.Description }
+
+func (p *SunsetPoolProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SunsetPoolProposal) ProposalType() string { return ProposalTypeSunsetPool }
+
+func (p *SunsetPoolProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.PoolId == 0 {
+		return sdkerrors.Wrap(ErrPoolNotFound, "pool id must be positive")
+	}
+	return nil
+}
+
+func (p SunsetPoolProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Sunset Pool Proposal:
+  Title:       %s
+  Description: %s
+  Pool ID:     %d
+`, p.Title, p.Description, p.PoolId))
+	return b.String()
+}
+
+func NewSetDynamicSwapFeePolicyProposal(title, description string, poolId uint64, minSwapFee, maxSwapFee sdk.Dec, volatilityWindow time.Duration) SetDynamicSwapFeePolicyProposal {
+	return SetDynamicSwapFeePolicyProposal{
+		Title:            title,
+		Description:      description,
+		PoolId:           poolId,
+		MinSwapFee:       minSwapFee,
+		MaxSwapFee:       maxSwapFee,
+		VolatilityWindow: volatilityWindow,
+	}
+}
+
+func (p *SetDynamicSwapFeePolicyProposal) GetTitle() string { return p.Title }
+
+func (p *SetDynamicSwapFeePolicyProposal) GetDescription() string { return p.Description }
+
+func (p *SetDynamicSwapFeePolicyProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetDynamicSwapFeePolicyProposal) ProposalType() string {
+	return ProposalTypeSetDynamicSwapFeePolicy
+}
+
+func (p *SetDynamicSwapFeePolicyProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.PoolId == 0 {
+		return sdkerrors.Wrap(ErrPoolNotFound, "pool id must be positive")
+	}
+
+	// a zero volatility window removes the pool's dynamic swap fee policy.
+	if p.VolatilityWindow == 0 {
+		return nil
+	}
+	return p.Policy().Validate()
+}
+
+// Policy returns the dynamic swap fee policy set by the proposal.
+// Its static swap fee is set from the pool when the policy is set.
+func (p SetDynamicSwapFeePolicyProposal) Policy() DynamicSwapFeePolicy {
+	return DynamicSwapFeePolicy{
+		PoolId:           p.PoolId,
+		MinSwapFee:       p.MinSwapFee,
+		MaxSwapFee:       p.MaxSwapFee,
+		VolatilityWindow: p.VolatilityWindow,
+		StaticSwapFee:    sdk.ZeroDec(),
+	}
+}
+
+func (p SetDynamicSwapFeePolicyProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Dynamic Swap Fee Policy Proposal:
+  Title:             %s
+  Description:       %s
+  Pool ID:           %d
+  Min Swap Fee:      %s
+  Max Swap Fee:      %s
+  Volatility Window: %s
+`, p.Title, p.Description, p.PoolId, p.MinSwapFee, p.MaxSwapFee, p.VolatilityWindow))
+	return b.String()
+}
+
+func NewSetPoolProtocolRevenueShareProposal(title, description string, poolId uint64, protocolRevenueShare sdk.Dec, removeOverride bool) SetPoolProtocolRevenueShareProposal {
+	return SetPoolProtocolRevenueShareProposal{
+		Title:                title,
+		Description:          description,
+		PoolId:               poolId,
+		ProtocolRevenueShare: protocolRevenueShare,
+		RemoveOverride:       removeOverride,
+	}
+}
+
+func (p *SetPoolProtocolRevenueShareProposal) GetTitle() string { return p.Title }
+
+func (p *SetPoolProtocolRevenueShareProposal) GetDescription() string { return p.Description }
+
+func (p *SetPoolProtocolRevenueShareProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetPoolProtocolRevenueShareProposal) ProposalType() string {
+	return ProposalTypeSetPoolProtocolRevenueShare
+}
+
+func (p *SetPoolProtocolRevenueShareProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.PoolId == 0 {
+		return sdkerrors.Wrap(ErrPoolNotFound, "pool id must be positive")
+	}
+
+	// the protocol revenue share is ignored when removing the pool's override.
+	if p.RemoveOverride {
+		return nil
+	}
+	return ValidateProtocolRevenueShare(p.ProtocolRevenueShare)
+}
+
+func (p SetPoolProtocolRevenueShareProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Pool Protocol Revenue Share Proposal:
+  Title:                  %s
+  Description:            %s
+  Pool ID:                %d
+  Protocol Revenue Share: %s
+  Remove Override:        %t
+`, p.Title, p.Description, p.PoolId, p.ProtocolRevenueShare, p.RemoveOverride))
+	return b.String()
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -25,6 +26,20 @@ var (
 	KeyPrefixPools = []byte{0x02}
 	// KeyTotalLiquidity defines key to store total liquidity.
 	KeyTotalLiquidity = []byte{0x03}
+	// KeyPrefixTicks defines prefix to store the initialized ticks of concentrated liquidity pools by pool and tick.
+	KeyPrefixTicks = []byte{0x04}
+	// KeyPrefixPositions defines prefix to store the positions of concentrated liquidity pools by pool, owner and ticks.
+	KeyPrefixPositions = []byte{0x05}
+	// KeyPrefixSunsetPools defines prefix to store the withdrawal state of pools retired by governance.
+	KeyPrefixSunsetPools = []byte{0x06}
+	// KeyPrefixDynamicSwapFeePolicies defines prefix to store the dynamic swap fee policies of pools.
+	KeyPrefixDynamicSwapFeePolicies = []byte{0x07}
+	// KeyPrefixPoolProtocolRevenueShares defines prefix to store the protocol revenue share overrides of pools.
+	KeyPrefixPoolProtocolRevenueShares = []byte{0x08}
+	// KeyPrefixProtocolRevenue defines prefix to store the cumulative protocol revenue by denom.
+	KeyPrefixProtocolRevenue = []byte{0x09}
+	// KeyPrefixPoolSwapStats defines prefix to store the cumulative swap volume and swap fees of pools.
+	KeyPrefixPoolSwapStats = []byte{0x0A}
 )
 
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
@@ -56,3 +71,55 @@ func GetPoolShareDenom(poolId uint64) string {
 func GetKeyPrefixPools(poolId uint64) []byte {
 	return append(KeyPrefixPools, sdk.Uint64ToBigEndian(poolId)...)
 }
+
+func GetKeySunsetPool(poolId uint64) []byte {
+	return append(KeyPrefixSunsetPools, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyDynamicSwapFeePolicy(poolId uint64) []byte {
+	return append(KeyPrefixDynamicSwapFeePolicies, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyPoolProtocolRevenueShare(poolId uint64) []byte {
+	return append(KeyPrefixPoolProtocolRevenueShares, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyProtocolRevenue(denom string) []byte {
+	return append(KeyPrefixProtocolRevenue, []byte(denom)...)
+}
+
+func GetKeyPoolSwapStats(poolId uint64) []byte {
+	return append(KeyPrefixPoolSwapStats, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyPrefixTicks(poolId uint64) []byte {
+	return append(KeyPrefixTicks, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyTick(poolId uint64, tick int64) []byte {
+	return append(GetKeyPrefixTicks(poolId), TickToBytes(tick)...)
+}
+
+func GetKeyPrefixPositions(poolId uint64) []byte {
+	return append(KeyPrefixPositions, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyPrefixOwnerPositions(poolId uint64, owner string) []byte {
+	return append(GetKeyPrefixPositions(poolId), address.MustLengthPrefix([]byte(owner))...)
+}
+
+func GetKeyPosition(poolId uint64, owner string, lowerTick, upperTick int64) []byte {
+	key := append(GetKeyPrefixOwnerPositions(poolId, owner), TickToBytes(lowerTick)...)
+	return append(key, TickToBytes(upperTick)...)
+}
+
+// TickToBytes encodes a tick so that the byte order of encoded ticks is the
+// order of the ticks, by flipping the sign bit of its big endian encoding.
+func TickToBytes(tick int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(tick) ^ (1 << 63))
+}
+
+// BytesToTick decodes a tick encoded by TickToBytes.
+func BytesToTick(bz []byte) int64 {
+	return int64(sdk.BigEndianToUint64(bz) ^ (1 << 63))
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"math"
 	"testing"
 
@@ -22,3 +23,14 @@ func TestGetPoolShareDenom(t *testing.T) {
 	require.NoError(t, sdk.ValidateDenom(denom))
 	require.Equal(t, "gamm/pool/18446744073709551615", denom)
 }
+
+func TestTickToBytes(t *testing.T) {
+	ticks := []int64{math.MinInt64, -1000, -1, 0, 1, 1000, math.MaxInt64}
+	for i, tick := range ticks {
+		require.Equal(t, tick, BytesToTick(TickToBytes(tick)))
+		if i > 0 {
+			// encoded ticks sort in the order of the ticks
+			require.Equal(t, -1, bytes.Compare(TickToBytes(ticks[i-1]), TickToBytes(tick)))
+		}
+	}
+}
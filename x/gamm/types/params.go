@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	appparams "github.com/osmosis-labs/osmosis/v12/app/params"
 
@@ -11,7 +12,11 @@ import (
 
 // Parameter store keys.
 var (
-	KeyPoolCreationFee = []byte("PoolCreationFee")
+	KeyPoolCreationFee                     = []byte("PoolCreationFee")
+	KeyStableswapMaxScalingFactorChange    = []byte("StableswapMaxScalingFactorChange")
+	KeyProtocolRevenueShare                = []byte("ProtocolRevenueShare")
+	KeyProtocolRevenueRecipient            = []byte("ProtocolRevenueRecipient")
+	KeyStableswapScalingFactorChangeWindow = []byte("StableswapScalingFactorChangeWindow")
 )
 
 // ParamTable for gamm module.
@@ -19,16 +24,24 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(poolCreationFee sdk.Coins) Params {
+func NewParams(poolCreationFee sdk.Coins, stableswapMaxScalingFactorChange sdk.Dec, protocolRevenueShare sdk.Dec, protocolRevenueRecipient string, stableswapScalingFactorChangeWindow time.Duration) Params {
 	return Params{
-		PoolCreationFee: poolCreationFee,
+		PoolCreationFee:                     poolCreationFee,
+		StableswapMaxScalingFactorChange:    stableswapMaxScalingFactorChange,
+		ProtocolRevenueShare:                protocolRevenueShare,
+		ProtocolRevenueRecipient:            protocolRevenueRecipient,
+		StableswapScalingFactorChangeWindow: stableswapScalingFactorChangeWindow,
 	}
 }
 
 // default gamm module parameters.
 func DefaultParams() Params {
 	return Params{
-		PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		PoolCreationFee:                     sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		StableswapMaxScalingFactorChange:    sdk.NewDecWithPrec(1, 1),                                          // 10%
+		ProtocolRevenueShare:                sdk.ZeroDec(),
+		ProtocolRevenueRecipient:            "", // community pool
+		StableswapScalingFactorChangeWindow: 24 * time.Hour,
 	}
 }
 
@@ -38,6 +51,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateStableswapMaxScalingFactorChange(p.StableswapMaxScalingFactorChange); err != nil {
+		return err
+	}
+
+	if err := validateProtocolRevenueShare(p.ProtocolRevenueShare); err != nil {
+		return err
+	}
+
+	if err := validateProtocolRevenueRecipient(p.ProtocolRevenueRecipient); err != nil {
+		return err
+	}
+
+	if err := validateStableswapScalingFactorChangeWindow(p.StableswapScalingFactorChangeWindow); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -45,6 +74,10 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyStableswapMaxScalingFactorChange, &p.StableswapMaxScalingFactorChange, validateStableswapMaxScalingFactorChange),
+		paramtypes.NewParamSetPair(KeyProtocolRevenueShare, &p.ProtocolRevenueShare, validateProtocolRevenueShare),
+		paramtypes.NewParamSetPair(KeyProtocolRevenueRecipient, &p.ProtocolRevenueRecipient, validateProtocolRevenueRecipient),
+		paramtypes.NewParamSetPair(KeyStableswapScalingFactorChangeWindow, &p.StableswapScalingFactorChangeWindow, validateStableswapScalingFactorChangeWindow),
 	}
 }
 
@@ -60,3 +93,64 @@ func validatePoolCreationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateStableswapMaxScalingFactorChange(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("stableswap max scaling factor change must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateProtocolRevenueShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateProtocolRevenueShare(v)
+}
+
+// ValidateProtocolRevenueShare returns an error if the protocol revenue share is not a fraction between 0 and 1.
+func ValidateProtocolRevenueShare(share sdk.Dec) error {
+	if share.IsNil() || share.IsNegative() || share.GT(sdk.OneDec()) {
+		return fmt.Errorf("protocol revenue share must be between 0 and 1: %s", share)
+	}
+
+	return nil
+}
+
+func validateProtocolRevenueRecipient(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an empty recipient funds the community pool.
+	if v == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid protocol revenue recipient: %w", err)
+	}
+
+	return nil
+}
+
+func validateStableswapScalingFactorChangeWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("stableswap scaling factor change window must be non-negative: %s", v)
+	}
+
+	return nil
+}
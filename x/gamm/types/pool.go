@@ -122,14 +122,48 @@ type PoolAmountOutExtension interface {
 	IncreaseLiquidity(sharesOut sdk.Int, coinsIn sdk.Coins)
 }
 
-// WeightedPoolExtension is an extension of the PoolI interface
-// That defines an additional API for handling the pool's weights.
-type WeightedPoolExtension interface {
+// PokablePoolExtension is an extension of the PoolI interface
+// for pools whose parameters change over time, e.g. weights or scaling factors.
+type PokablePoolExtension interface {
 	PoolI
 
-	// PokePool determines if a pool's weights need to be updated and updates
-	// them if so.
+	// PokePool determines if a pool's time dependent parameters need to be updated
+	// and updates them if so.
 	PokePool(blockTime time.Time)
+}
+
+// SwapFeeSettablePoolExtension is an extension of the PoolI interface
+// for pools whose swap fee can be set by a dynamic swap fee policy.
+type SwapFeeSettablePoolExtension interface {
+	PoolI
+
+	// SetSwapFee replaces the pool's swap fee.
+	SetSwapFee(swapFee sdk.Dec)
+}
+
+// ProtocolRevenuePoolExtension is an extension of the PoolI interface
+// for pools sharing their swap fees with the protocol.
+type ProtocolRevenuePoolExtension interface {
+	PoolI
+
+	// RemoveLiquidity removes coins from the pool's liquidity without burning any shares.
+	// Errors if the pool would be left without liquidity of any of its assets.
+	RemoveLiquidity(coins sdk.Coins) error
+}
+
+// SunsettablePoolExtension is an extension of the PoolI interface
+// for pools that can be retired. Sunset pools report themselves as inactive.
+type SunsettablePoolExtension interface {
+	PoolI
+
+	// SetSunset marks the pool as sunset.
+	SetSunset()
+}
+
+// WeightedPoolExtension is an extension of the PoolI interface
+// That defines an additional API for handling the pool's weights.
+type WeightedPoolExtension interface {
+	PokablePoolExtension
 
 	// GetTokenWeight returns the weight of the specified token in the pool.
 	GetTokenWeight(denom string) (sdk.Int, error)
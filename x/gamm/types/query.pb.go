@@ -630,7 +630,10 @@ func (m *QueryPoolParamsRequest) GetPoolId() uint64 {
 }
 
 type QueryPoolParamsResponse struct {
-	Params *types.Any `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// params of the pool, with the swap fee set by the pool's dynamic swap fee
+	// policy if it has one
+	Params               *types.Any            `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	DynamicSwapFeePolicy *DynamicSwapFeePolicy `protobuf:"bytes,2,opt,name=dynamic_swap_fee_policy,json=dynamicSwapFeePolicy,proto3" json:"dynamic_swap_fee_policy,omitempty" yaml:"dynamic_swap_fee_policy"`
 }
 
 func (m *QueryPoolParamsResponse) Reset()         { *m = QueryPoolParamsResponse{} }
@@ -673,6 +676,13 @@ func (m *QueryPoolParamsResponse) GetParams() *types.Any {
 	return nil
 }
 
+func (m *QueryPoolParamsResponse) GetDynamicSwapFeePolicy() *DynamicSwapFeePolicy {
+	if m != nil {
+		return m.DynamicSwapFeePolicy
+	}
+	return nil
+}
+
 // =============================== PoolLiquidity
 type QueryTotalPoolLiquidityRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
@@ -762,6 +772,184 @@ func (m *QueryTotalPoolLiquidityResponse) GetLiquidity() github_com_cosmos_cosmo
 	return nil
 }
 
+// =============================== PoolVolume
+type QueryPoolVolumeRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryPoolVolumeRequest) Reset()         { *m = QueryPoolVolumeRequest{} }
+func (m *QueryPoolVolumeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolVolumeRequest) ProtoMessage()    {}
+func (*QueryPoolVolumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{16}
+}
+func (m *QueryPoolVolumeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolVolumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolVolumeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolVolumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolVolumeRequest.Merge(m, src)
+}
+func (m *QueryPoolVolumeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolVolumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolVolumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolVolumeRequest proto.InternalMessageInfo
+
+func (m *QueryPoolVolumeRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryPoolVolumeResponse struct {
+	Volume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume" yaml:"volume"`
+}
+
+func (m *QueryPoolVolumeResponse) Reset()         { *m = QueryPoolVolumeResponse{} }
+func (m *QueryPoolVolumeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolVolumeResponse) ProtoMessage()    {}
+func (*QueryPoolVolumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{17}
+}
+func (m *QueryPoolVolumeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolVolumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolVolumeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolVolumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolVolumeResponse.Merge(m, src)
+}
+func (m *QueryPoolVolumeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolVolumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolVolumeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolVolumeResponse proto.InternalMessageInfo
+
+func (m *QueryPoolVolumeResponse) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+// =============================== PoolFeesCollected
+type QueryPoolFeesCollectedRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryPoolFeesCollectedRequest) Reset()         { *m = QueryPoolFeesCollectedRequest{} }
+func (m *QueryPoolFeesCollectedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolFeesCollectedRequest) ProtoMessage()    {}
+func (*QueryPoolFeesCollectedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{18}
+}
+func (m *QueryPoolFeesCollectedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolFeesCollectedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolFeesCollectedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolFeesCollectedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolFeesCollectedRequest.Merge(m, src)
+}
+func (m *QueryPoolFeesCollectedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolFeesCollectedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolFeesCollectedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolFeesCollectedRequest proto.InternalMessageInfo
+
+func (m *QueryPoolFeesCollectedRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryPoolFeesCollectedResponse struct {
+	FeesCollected github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=fees_collected,json=feesCollected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fees_collected" yaml:"fees_collected"`
+}
+
+func (m *QueryPoolFeesCollectedResponse) Reset()         { *m = QueryPoolFeesCollectedResponse{} }
+func (m *QueryPoolFeesCollectedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolFeesCollectedResponse) ProtoMessage()    {}
+func (*QueryPoolFeesCollectedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{19}
+}
+func (m *QueryPoolFeesCollectedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolFeesCollectedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolFeesCollectedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolFeesCollectedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolFeesCollectedResponse.Merge(m, src)
+}
+func (m *QueryPoolFeesCollectedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolFeesCollectedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolFeesCollectedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolFeesCollectedResponse proto.InternalMessageInfo
+
+func (m *QueryPoolFeesCollectedResponse) GetFeesCollected() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.FeesCollected
+	}
+	return nil
+}
+
 // =============================== TotalShares
 type QueryTotalSharesRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
@@ -771,7 +959,7 @@ func (m *QueryTotalSharesRequest) Reset()         { *m = QueryTotalSharesRequest
 func (m *QueryTotalSharesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSharesRequest) ProtoMessage()    {}
 func (*QueryTotalSharesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{16}
+	return fileDescriptor_d9a717df9ca609ef, []int{20}
 }
 func (m *QueryTotalSharesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -815,7 +1003,7 @@ func (m *QueryTotalSharesResponse) Reset()         { *m = QueryTotalSharesRespon
 func (m *QueryTotalSharesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSharesResponse) ProtoMessage()    {}
 func (*QueryTotalSharesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{17}
+	return fileDescriptor_d9a717df9ca609ef, []int{21}
 }
 func (m *QueryTotalSharesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -863,7 +1051,7 @@ func (m *QuerySpotPriceRequest) Reset()         { *m = QuerySpotPriceRequest{} }
 func (m *QuerySpotPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySpotPriceRequest) ProtoMessage()    {}
 func (*QuerySpotPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{18}
+	return fileDescriptor_d9a717df9ca609ef, []int{22}
 }
 func (m *QuerySpotPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -922,7 +1110,7 @@ func (m *QueryPoolsWithFilterRequest) Reset()         { *m = QueryPoolsWithFilte
 func (m *QueryPoolsWithFilterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolsWithFilterRequest) ProtoMessage()    {}
 func (*QueryPoolsWithFilterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{19}
+	return fileDescriptor_d9a717df9ca609ef, []int{23}
 }
 func (m *QueryPoolsWithFilterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -973,7 +1161,7 @@ func (m *QueryPoolsWithFilterResponse) Reset()         { *m = QueryPoolsWithFilt
 func (m *QueryPoolsWithFilterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolsWithFilterResponse) ProtoMessage()    {}
 func (*QueryPoolsWithFilterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{20}
+	return fileDescriptor_d9a717df9ca609ef, []int{24}
 }
 func (m *QueryPoolsWithFilterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1020,7 +1208,7 @@ func (m *QuerySpotPriceResponse) Reset()         { *m = QuerySpotPriceResponse{}
 func (m *QuerySpotPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySpotPriceResponse) ProtoMessage()    {}
 func (*QuerySpotPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{21}
+	return fileDescriptor_d9a717df9ca609ef, []int{25}
 }
 func (m *QuerySpotPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1068,7 +1256,7 @@ func (m *QuerySwapExactAmountInRequest) Reset()         { *m = QuerySwapExactAmo
 func (m *QuerySwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountInRequest) ProtoMessage()    {}
 func (*QuerySwapExactAmountInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{22}
+	return fileDescriptor_d9a717df9ca609ef, []int{26}
 }
 func (m *QuerySwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1133,7 +1321,7 @@ func (m *QuerySwapExactAmountInResponse) Reset()         { *m = QuerySwapExactAm
 func (m *QuerySwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountInResponse) ProtoMessage()    {}
 func (*QuerySwapExactAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{23}
+	return fileDescriptor_d9a717df9ca609ef, []int{27}
 }
 func (m *QuerySwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1174,7 +1362,7 @@ func (m *QuerySwapExactAmountOutRequest) Reset()         { *m = QuerySwapExactAm
 func (m *QuerySwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountOutRequest) ProtoMessage()    {}
 func (*QuerySwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{24}
+	return fileDescriptor_d9a717df9ca609ef, []int{28}
 }
 func (m *QuerySwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1239,7 +1427,7 @@ func (m *QuerySwapExactAmountOutResponse) Reset()         { *m = QuerySwapExactA
 func (m *QuerySwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountOutResponse) ProtoMessage()    {}
 func (*QuerySwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{25}
+	return fileDescriptor_d9a717df9ca609ef, []int{29}
 }
 func (m *QuerySwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1275,7 +1463,7 @@ func (m *QueryTotalLiquidityRequest) Reset()         { *m = QueryTotalLiquidityR
 func (m *QueryTotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalLiquidityRequest) ProtoMessage()    {}
 func (*QueryTotalLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{26}
+	return fileDescriptor_d9a717df9ca609ef, []int{30}
 }
 func (m *QueryTotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1312,7 +1500,7 @@ func (m *QueryTotalLiquidityResponse) Reset()         { *m = QueryTotalLiquidity
 func (m *QueryTotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalLiquidityResponse) ProtoMessage()    {}
 func (*QueryTotalLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{27}
+	return fileDescriptor_d9a717df9ca609ef, []int{31}
 }
 func (m *QueryTotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1348,6 +1536,86 @@ func (m *QueryTotalLiquidityResponse) GetLiquidity() github_com_cosmos_cosmos_sd
 	return nil
 }
 
+type QueryProtocolRevenueRequest struct {
+}
+
+func (m *QueryProtocolRevenueRequest) Reset()         { *m = QueryProtocolRevenueRequest{} }
+func (m *QueryProtocolRevenueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolRevenueRequest) ProtoMessage()    {}
+func (*QueryProtocolRevenueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{32}
+}
+func (m *QueryProtocolRevenueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtocolRevenueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolRevenueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProtocolRevenueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolRevenueRequest.Merge(m, src)
+}
+func (m *QueryProtocolRevenueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtocolRevenueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolRevenueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProtocolRevenueRequest proto.InternalMessageInfo
+
+type QueryProtocolRevenueResponse struct {
+	ProtocolRevenue github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=protocol_revenue,json=protocolRevenue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"protocol_revenue" yaml:"protocol_revenue"`
+}
+
+func (m *QueryProtocolRevenueResponse) Reset()         { *m = QueryProtocolRevenueResponse{} }
+func (m *QueryProtocolRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolRevenueResponse) ProtoMessage()    {}
+func (*QueryProtocolRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{33}
+}
+func (m *QueryProtocolRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtocolRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolRevenueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProtocolRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolRevenueResponse.Merge(m, src)
+}
+func (m *QueryProtocolRevenueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtocolRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolRevenueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProtocolRevenueResponse proto.InternalMessageInfo
+
+func (m *QueryProtocolRevenueResponse) GetProtocolRevenue() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProtocolRevenue
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryPoolRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolRequest")
 	proto.RegisterType((*QueryPoolResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolResponse")
@@ -1365,6 +1633,10 @@ func init() {
 	proto.RegisterType((*QueryPoolParamsResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolParamsResponse")
 	proto.RegisterType((*QueryTotalPoolLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalPoolLiquidityRequest")
 	proto.RegisterType((*QueryTotalPoolLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalPoolLiquidityResponse")
+	proto.RegisterType((*QueryPoolVolumeRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolVolumeRequest")
+	proto.RegisterType((*QueryPoolVolumeResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolVolumeResponse")
+	proto.RegisterType((*QueryPoolFeesCollectedRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolFeesCollectedRequest")
+	proto.RegisterType((*QueryPoolFeesCollectedResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolFeesCollectedResponse")
 	proto.RegisterType((*QueryTotalSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesRequest")
 	proto.RegisterType((*QueryTotalSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesResponse")
 	proto.RegisterType((*QuerySpotPriceRequest)(nil), "osmosis.gamm.v1beta1.QuerySpotPriceRequest")
@@ -1377,118 +1649,138 @@ func init() {
 	proto.RegisterType((*QuerySwapExactAmountOutResponse)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountOutResponse")
 	proto.RegisterType((*QueryTotalLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityRequest")
 	proto.RegisterType((*QueryTotalLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityResponse")
+	proto.RegisterType((*QueryProtocolRevenueRequest)(nil), "osmosis.gamm.v1beta1.QueryProtocolRevenueRequest")
+	proto.RegisterType((*QueryProtocolRevenueResponse)(nil), "osmosis.gamm.v1beta1.QueryProtocolRevenueResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/query.proto", fileDescriptor_d9a717df9ca609ef) }
 
 var fileDescriptor_d9a717df9ca609ef = []byte{
-	// 1695 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xdd, 0x6b, 0x5c, 0x45,
-	0x14, 0xcf, 0x4d, 0x93, 0x34, 0x99, 0x34, 0x5f, 0xd3, 0xb4, 0x4d, 0x37, 0xe9, 0x6e, 0x1d, 0x35,
-	0x49, 0xdb, 0xe4, 0x6e, 0x93, 0xa6, 0x20, 0xc1, 0x7e, 0x24, 0x6d, 0xd2, 0x6c, 0xd0, 0x26, 0xde,
-	0xd6, 0x16, 0xf5, 0x61, 0xb9, 0x49, 0x6e, 0x37, 0xb7, 0xdd, 0xbd, 0x73, 0xb3, 0x77, 0x6e, 0x93,
-	0x20, 0x45, 0x28, 0x22, 0x3e, 0x08, 0x0a, 0xd5, 0x3e, 0x48, 0x41, 0x1f, 0x44, 0xc4, 0x67, 0xc1,
-	0x3f, 0x40, 0x0a, 0x45, 0x10, 0x2a, 0xbe, 0x88, 0x0f, 0xab, 0xb4, 0x0a, 0x3e, 0xef, 0x3f, 0xa0,
-	0xcc, 0xcc, 0xb9, 0x1f, 0xfb, 0xfd, 0x51, 0x0b, 0x3e, 0xed, 0xde, 0x39, 0x1f, 0xf3, 0x3b, 0xbf,
-	0x73, 0x66, 0xce, 0x1c, 0x74, 0x94, 0x3a, 0x19, 0xea, 0x98, 0x4e, 0x3c, 0xa5, 0x67, 0x32, 0xf1,
-	0xdb, 0x53, 0x6b, 0x06, 0xd3, 0xa7, 0xe2, 0x5b, 0xae, 0x91, 0xdd, 0x55, 0xed, 0x2c, 0x65, 0x14,
-	0x0f, 0x82, 0x86, 0xca, 0x35, 0x54, 0xd0, 0x88, 0x0c, 0xa6, 0x68, 0x8a, 0x0a, 0x85, 0x38, 0xff,
-	0x27, 0x75, 0x23, 0x47, 0xca, 0x7a, 0x63, 0x3b, 0x20, 0x8e, 0xae, 0x0b, 0x79, 0x7c, 0x4d, 0x77,
-	0x0c, 0x5f, 0xba, 0x4e, 0x4d, 0x0b, 0xe4, 0xc7, 0xc3, 0x72, 0x81, 0xc1, 0xd7, 0xb2, 0xf5, 0x94,
-	0x69, 0xe9, 0xcc, 0xa4, 0x9e, 0xee, 0x48, 0x8a, 0xd2, 0x54, 0xda, 0x88, 0xeb, 0xb6, 0x19, 0xd7,
-	0x2d, 0x8b, 0x32, 0x21, 0x74, 0x40, 0x7a, 0x18, 0xa4, 0xe2, 0x6b, 0xcd, 0xbd, 0x11, 0xd7, 0xad,
-	0x5d, 0x4f, 0x24, 0x37, 0x49, 0x4a, 0xf0, 0xf2, 0x43, 0x8a, 0xc8, 0x39, 0xd4, 0xff, 0x06, 0xdf,
-	0x75, 0x95, 0xd2, 0xb4, 0x66, 0x6c, 0xb9, 0x86, 0xc3, 0xf0, 0x09, 0xb4, 0xd7, 0xa6, 0x34, 0x9d,
-	0x34, 0x37, 0x86, 0x94, 0xa3, 0xca, 0x78, 0xdb, 0x3c, 0xce, 0xe7, 0x62, 0xbd, 0xbb, 0x7a, 0x26,
-	0x3d, 0x4b, 0x40, 0x40, 0xb4, 0x0e, 0xfe, 0x2f, 0xb1, 0x41, 0x96, 0xd0, 0x40, 0xc8, 0x81, 0x63,
-	0x53, 0xcb, 0x31, 0xf0, 0x29, 0xd4, 0xc6, 0xc5, 0xc2, 0xbc, 0x7b, 0x7a, 0x50, 0x95, 0xd0, 0x54,
-	0x0f, 0x9a, 0x3a, 0x67, 0xed, 0xce, 0x77, 0xfd, 0xf8, 0xdd, 0x64, 0x3b, 0xb7, 0x4a, 0x68, 0x42,
-	0x99, 0xbc, 0x13, 0xf2, 0xe4, 0x78, 0x58, 0x16, 0x11, 0x0a, 0x78, 0x18, 0x6a, 0x15, 0xfe, 0x46,
-	0x55, 0x08, 0x81, 0x93, 0xa6, 0xca, 0xc4, 0x01, 0x69, 0xea, 0xaa, 0x9e, 0x32, 0xc0, 0x56, 0x0b,
-	0x59, 0x92, 0x4f, 0x15, 0x84, 0xc3, 0xde, 0x01, 0xe8, 0x69, 0xd4, 0xce, 0xf7, 0x76, 0x86, 0x94,
-	0xa3, 0x7b, 0xea, 0x41, 0x2a, 0xb5, 0xf1, 0xa5, 0x32, 0xa8, 0xc6, 0x6a, 0xa2, 0x92, 0x7b, 0x16,
-	0xc0, 0x3a, 0x88, 0x06, 0x05, 0xaa, 0xcb, 0x6e, 0x26, 0x1c, 0x36, 0x59, 0x46, 0x07, 0x8a, 0xd6,
-	0x01, 0xf0, 0x14, 0xea, 0xb2, 0xdc, 0x4c, 0xd2, 0x03, 0xcd, 0xb3, 0x33, 0x98, 0xcf, 0xc5, 0xfa,
-	0x65, 0x76, 0x7c, 0x11, 0xd1, 0x3a, 0x2d, 0x30, 0x25, 0x17, 0x60, 0x0f, 0xfe, 0x75, 0x75, 0xd7,
-	0x36, 0x9a, 0x4a, 0xb3, 0x07, 0x28, 0x70, 0x12, 0x00, 0x12, 0xca, 0x6c, 0xd7, 0x36, 0x84, 0x9f,
-	0xae, 0x30, 0x20, 0x5f, 0x44, 0xb4, 0x4e, 0x1b, 0x4c, 0xc9, 0xf7, 0x0a, 0x8a, 0x0a, 0x67, 0x17,
-	0xf4, 0xf4, 0xfa, 0x32, 0x35, 0x2d, 0xee, 0xf4, 0xca, 0xa6, 0x9e, 0x35, 0x9c, 0x66, 0xb0, 0xe1,
-	0x4d, 0xd4, 0xc5, 0xe8, 0x2d, 0xc3, 0x72, 0x92, 0x26, 0x4f, 0x06, 0x4f, 0xe4, 0xe1, 0x82, 0x64,
-	0x78, 0x69, 0xb8, 0x40, 0x4d, 0x6b, 0xfe, 0xe4, 0xa3, 0x5c, 0xac, 0xe5, 0xdb, 0xdf, 0x63, 0xe3,
-	0x29, 0x93, 0x6d, 0xba, 0x6b, 0xea, 0x3a, 0xcd, 0xc0, 0x91, 0x80, 0x9f, 0x49, 0x67, 0xe3, 0x56,
-	0x9c, 0x63, 0x76, 0x84, 0x81, 0xa3, 0x75, 0x4a, 0xef, 0x09, 0x8b, 0xdc, 0x6d, 0x45, 0xb1, 0x8a,
-	0xc8, 0x81, 0x10, 0x07, 0xf5, 0x3b, 0x7c, 0x25, 0x49, 0x5d, 0x96, 0xd4, 0x33, 0xd4, 0xb5, 0x18,
-	0xf0, 0x92, 0xe0, 0x3b, 0xff, 0x96, 0x8b, 0x8d, 0xd6, 0xb1, 0x73, 0xc2, 0x62, 0xf9, 0x5c, 0xec,
-	0x90, 0x8c, 0xb8, 0xd8, 0x1f, 0xd1, 0x7a, 0xc5, 0xd2, 0x8a, 0xcb, 0xe6, 0xc4, 0x02, 0xbe, 0x89,
-	0x10, 0x50, 0x40, 0x5d, 0xf6, 0x3c, 0x38, 0x00, 0x86, 0x57, 0x5c, 0x46, 0x3e, 0x57, 0xd0, 0x98,
-	0x4f, 0xc2, 0xc2, 0x8e, 0xc9, 0x38, 0x09, 0x42, 0x6b, 0x31, 0x4b, 0x33, 0x85, 0x79, 0x3c, 0x54,
-	0x94, 0x47, 0x3f, 0x67, 0xd7, 0x50, 0x9f, 0x8c, 0xca, 0xb4, 0x3c, 0x92, 0x5a, 0x05, 0x49, 0x6a,
-	0x63, 0x24, 0x69, 0x3d, 0xc2, 0x4d, 0xc2, 0x92, 0x44, 0x90, 0xfb, 0x0a, 0x1a, 0xaf, 0x0d, 0x0e,
-	0x52, 0x55, 0xc8, 0x9a, 0xf2, 0x5c, 0x59, 0x5b, 0x40, 0x07, 0xfd, 0x03, 0xb4, 0xaa, 0x67, 0xf5,
-	0x4c, 0x53, 0xb5, 0x4e, 0x2e, 0xa1, 0x43, 0x25, 0x6e, 0x20, 0x9a, 0x09, 0xd4, 0x61, 0x8b, 0x95,
-	0x6a, 0xd7, 0xae, 0x06, 0x3a, 0xe4, 0x75, 0x38, 0x83, 0x57, 0x29, 0xd3, 0xd3, 0xdc, 0xdb, 0x6b,
-	0xe6, 0x96, 0x6b, 0x6e, 0x98, 0x6c, 0xb7, 0x29, 0x5c, 0x5f, 0x2a, 0x70, 0x32, 0xca, 0xf9, 0x03,
-	0x80, 0x77, 0x50, 0x57, 0xda, 0x5b, 0xac, 0xcd, 0xf6, 0x45, 0xce, 0x76, 0x70, 0x93, 0xf8, 0x96,
-	0xa4, 0xb1, 0x0c, 0x04, 0x76, 0x8b, 0x40, 0x9d, 0x40, 0xd8, 0xfc, 0x75, 0x43, 0x5c, 0x34, 0x54,
-	0xea, 0x07, 0x42, 0x7c, 0x0b, 0xed, 0x63, 0x7c, 0x39, 0x29, 0xaa, 0xd2, 0xcb, 0x44, 0x95, 0x28,
-	0x87, 0x21, 0xca, 0xfd, 0x72, 0xb3, 0xb0, 0x31, 0xd1, 0xba, 0x59, 0xb0, 0x05, 0xf9, 0x5b, 0x81,
-	0x2b, 0xf8, 0x8a, 0x4d, 0xd9, 0x6a, 0xd6, 0x5c, 0x6f, 0xea, 0x22, 0xc7, 0x0b, 0xa8, 0x9f, 0xa3,
-	0x48, 0xea, 0x8e, 0x63, 0xb0, 0xe4, 0x86, 0x61, 0xd1, 0x0c, 0x9c, 0xbc, 0xe1, 0xe0, 0xc2, 0x29,
-	0xd6, 0x20, 0x5a, 0x2f, 0x5f, 0x9a, 0xe3, 0x2b, 0x17, 0xf9, 0x02, 0x5e, 0x42, 0x03, 0x5b, 0x2e,
-	0x65, 0x85, 0x7e, 0xf6, 0x08, 0x3f, 0x23, 0xf9, 0x5c, 0x6c, 0x48, 0xfa, 0x29, 0x51, 0x21, 0x5a,
-	0x9f, 0x58, 0x0b, 0x3c, 0x2d, 0xb7, 0x75, 0xb6, 0xf5, 0xb7, 0x6b, 0xdd, 0xdb, 0x26, 0xdb, 0xbc,
-	0xb2, 0xad, 0xdb, 0x8b, 0x86, 0x41, 0x1e, 0x2a, 0x68, 0x38, 0x68, 0xd6, 0xd7, 0x4d, 0xb6, 0xb9,
-	0x68, 0xa6, 0x99, 0x91, 0xf5, 0x02, 0xfe, 0x50, 0x41, 0x3d, 0x19, 0xd3, 0x4a, 0x36, 0x50, 0x4d,
-	0x4b, 0xc0, 0xf3, 0xa0, 0x04, 0x56, 0x60, 0xdd, 0x58, 0x45, 0xed, 0xcb, 0x98, 0x96, 0x5f, 0xdb,
-	0x78, 0x38, 0xdc, 0xfe, 0x04, 0x8f, 0xa1, 0x46, 0xf7, 0x26, 0x1a, 0x29, 0x1f, 0xc6, 0x33, 0xbd,
-	0x3e, 0xc8, 0x65, 0xb8, 0x4a, 0x42, 0x85, 0x00, 0x0e, 0x67, 0x10, 0x72, 0x6c, 0xca, 0x92, 0x36,
-	0x5f, 0x85, 0xae, 0x73, 0x20, 0x9f, 0x8b, 0x0d, 0x40, 0x1f, 0xf1, 0x65, 0x44, 0xeb, 0x72, 0x3c,
-	0x6b, 0xf2, 0x8f, 0x82, 0x8e, 0x48, 0x87, 0xdb, 0xba, 0xbd, 0xb0, 0xa3, 0xaf, 0x43, 0x57, 0x49,
-	0x58, 0x1e, 0xe1, 0xc7, 0x50, 0x87, 0x63, 0x58, 0x1b, 0x46, 0x16, 0x7c, 0x0e, 0xe4, 0x73, 0xb1,
-	0x1e, 0xf0, 0x29, 0xd6, 0x89, 0x06, 0x0a, 0xe1, 0x62, 0x6c, 0xad, 0x59, 0x8c, 0x2a, 0x92, 0xbd,
-	0x95, 0x37, 0x6e, 0x59, 0x3c, 0xfb, 0xf3, 0xb9, 0x58, 0x9f, 0x77, 0x16, 0xa4, 0x84, 0x68, 0x7b,
-	0xc5, 0xdf, 0x84, 0x85, 0xaf, 0xa1, 0x8e, 0x2c, 0x75, 0x99, 0xe1, 0x0c, 0xb5, 0x09, 0xc6, 0xc6,
-	0xd4, 0x72, 0x2f, 0x75, 0x95, 0xc7, 0xe1, 0x87, 0xc0, 0xf5, 0xe7, 0x0f, 0x40, 0xfa, 0x01, 0xb4,
-	0x74, 0x42, 0x34, 0xf0, 0x46, 0x3e, 0xf3, 0x5e, 0x24, 0x65, 0x18, 0x08, 0xda, 0xba, 0x04, 0xf4,
-	0xdf, 0xb5, 0xf5, 0x62, 0x7f, 0x44, 0xeb, 0x15, 0x4b, 0x7e, 0x5b, 0x27, 0xef, 0xb7, 0x96, 0xc7,
-	0xb5, 0xe2, 0xb2, 0xe7, 0x9d, 0x9a, 0xeb, 0x3e, 0xd5, 0x7b, 0x04, 0xd5, 0xe3, 0xb5, 0xa8, 0xe6,
-	0x98, 0xea, 0xe0, 0x9a, 0x3f, 0x18, 0xfd, 0xc0, 0x87, 0xda, 0x8a, 0x1f, 0x8c, 0xbe, 0x88, 0xc0,
-	0xb3, 0x8b, 0xf7, 0xce, 0x7b, 0x5e, 0x73, 0x29, 0x47, 0x03, 0xe4, 0xc7, 0x46, 0x7d, 0x5e, 0xc1,
-	0x14, 0xa6, 0x67, 0xa9, 0xe1, 0xf4, 0x1c, 0x2c, 0xac, 0x3f, 0x3f, 0x3b, 0x3d, 0x50, 0x86, 0x90,
-	0x9c, 0x11, 0x14, 0x09, 0xfa, 0x40, 0x71, 0xf7, 0x24, 0x0f, 0xbc, 0x3b, 0xac, 0x58, 0xfc, 0xbf,
-	0x68, 0x86, 0xd3, 0x1f, 0xef, 0x47, 0xed, 0x02, 0x1e, 0x7e, 0x0f, 0x89, 0xdb, 0xc5, 0xc1, 0x15,
-	0x0e, 0x53, 0xc9, 0x4c, 0x16, 0x19, 0xaf, 0xad, 0x28, 0x83, 0x24, 0x2f, 0xde, 0xfd, 0xe5, 0xcf,
-	0x7b, 0xad, 0x47, 0xf0, 0x70, 0xbc, 0xec, 0x94, 0x2c, 0x87, 0xa9, 0x8f, 0x14, 0xd4, 0xe9, 0xcd,
-	0x39, 0xf8, 0x78, 0x15, 0xdf, 0x45, 0x43, 0x52, 0xe4, 0x44, 0x5d, 0xba, 0x00, 0x65, 0x4c, 0x40,
-	0x79, 0x01, 0xc7, 0xca, 0x43, 0xf1, 0x27, 0x27, 0xfc, 0x95, 0x82, 0x7a, 0x0b, 0x73, 0x86, 0x4f,
-	0x56, 0xd9, 0xa8, 0x6c, 0xf6, 0x23, 0x53, 0x0d, 0x58, 0x00, 0xc0, 0x49, 0x01, 0x70, 0x0c, 0xbf,
-	0x5c, 0x1e, 0xa0, 0x7c, 0x19, 0xf8, 0x09, 0xc4, 0x5f, 0x2b, 0xa8, 0xaf, 0xa8, 0xaf, 0xe0, 0xa9,
-	0x5a, 0x89, 0x29, 0x69, 0xa5, 0x91, 0xe9, 0x46, 0x4c, 0x00, 0xe9, 0x84, 0x40, 0x3a, 0x8a, 0x5f,
-	0x2a, 0x8f, 0xf4, 0x86, 0xd0, 0x36, 0x36, 0x80, 0xcf, 0x0f, 0x14, 0xd4, 0xc6, 0x3d, 0xe1, 0xd1,
-	0x1a, 0x5b, 0x79, 0x90, 0xc6, 0x6a, 0xea, 0xd5, 0xc7, 0x98, 0xd8, 0x3e, 0xfe, 0x2e, 0xdc, 0x6c,
-	0x77, 0xf0, 0x7d, 0x05, 0x75, 0x7a, 0xe3, 0x6b, 0xd5, 0x3a, 0x2b, 0x1a, 0x94, 0xab, 0xd6, 0x59,
-	0xf1, 0x3c, 0x4c, 0xa6, 0x04, 0xa8, 0x13, 0xf8, 0x58, 0x65, 0x50, 0xe2, 0xb1, 0x10, 0x02, 0xf6,
-	0x83, 0x82, 0x70, 0xe9, 0x40, 0x89, 0x67, 0xaa, 0x6c, 0x5b, 0x71, 0x72, 0x8e, 0x9c, 0x6e, 0xd0,
-	0x0a, 0x60, 0x9f, 0x17, 0xb0, 0x67, 0xf1, 0x2b, 0x75, 0x71, 0x19, 0xbf, 0x49, 0x4d, 0x2b, 0xe9,
-	0x6c, 0xeb, 0x76, 0xd2, 0xe0, 0xd7, 0x71, 0xd2, 0xb4, 0xf0, 0x5f, 0x0a, 0x1a, 0xae, 0x32, 0x74,
-	0xe1, 0x33, 0x35, 0x80, 0x55, 0x9f, 0x24, 0x23, 0x67, 0x9b, 0x35, 0x87, 0x00, 0x2f, 0x89, 0x00,
-	0xe7, 0xf0, 0xb9, 0xfa, 0x02, 0x34, 0x76, 0x4c, 0x26, 0x03, 0x94, 0x63, 0xaa, 0xec, 0x01, 0x3c,
-	0xce, 0x2f, 0x14, 0x84, 0x82, 0xe9, 0x0b, 0x4f, 0xd4, 0x28, 0x8e, 0x82, 0x59, 0x2f, 0x32, 0x59,
-	0xa7, 0x36, 0x80, 0x9e, 0x11, 0xa0, 0x55, 0x3c, 0x51, 0x1f, 0x68, 0x39, 0xda, 0xe1, 0x87, 0x0a,
-	0xc2, 0xa5, 0x63, 0x58, 0xd5, 0x7a, 0xaa, 0x38, 0x05, 0x56, 0xad, 0xa7, 0xca, 0xb3, 0x1e, 0x99,
-	0x17, 0xc8, 0x5f, 0xc5, 0xb3, 0xf5, 0x21, 0x97, 0xb7, 0x9b, 0xf8, 0x0c, 0xae, 0xb8, 0x6f, 0x14,
-	0xd4, 0x1d, 0x1a, 0xb2, 0xf0, 0x64, 0x2d, 0x28, 0x85, 0x15, 0xa3, 0xd6, 0xab, 0x0e, 0x90, 0x67,
-	0x05, 0xe4, 0x19, 0x3c, 0xdd, 0x08, 0x64, 0x39, 0xaa, 0xe1, 0x07, 0x0a, 0xea, 0xf2, 0x9f, 0xe3,
-	0xb8, 0xda, 0x85, 0x51, 0x3c, 0xbd, 0x45, 0x26, 0xea, 0x53, 0x6e, 0xb2, 0x22, 0xb8, 0xb1, 0x83,
-	0x7f, 0x52, 0xd0, 0xe1, 0x05, 0x87, 0x99, 0x19, 0x9d, 0x19, 0x25, 0x4f, 0x5c, 0x7c, 0xaa, 0x1a,
-	0x82, 0x0a, 0x23, 0x41, 0x64, 0xa6, 0x31, 0x23, 0x80, 0xbf, 0x20, 0xe0, 0x9f, 0xc3, 0x67, 0xca,
-	0xc3, 0x0f, 0x9d, 0x3f, 0x40, 0x1b, 0x0f, 0x5d, 0x32, 0xc1, 0x19, 0xfc, 0x59, 0x41, 0x91, 0x0a,
-	0xf1, 0xac, 0xb8, 0x0c, 0x37, 0x80, 0x2d, 0x78, 0x49, 0x57, 0xad, 0xf4, 0xca, 0x0f, 0x4f, 0xb2,
-	0x28, 0x42, 0x3a, 0x8f, 0xcf, 0x3e, 0x43, 0x48, 0xd4, 0x65, 0xf3, 0xcb, 0x8f, 0x9e, 0x44, 0x95,
-	0xc7, 0x4f, 0xa2, 0xca, 0x1f, 0x4f, 0xa2, 0xca, 0x27, 0x4f, 0xa3, 0x2d, 0x8f, 0x9f, 0x46, 0x5b,
-	0x7e, 0x7d, 0x1a, 0x6d, 0x79, 0xfb, 0x64, 0xe8, 0x81, 0x07, 0x7b, 0x4c, 0xa6, 0xf5, 0x35, 0xc7,
-	0xdf, 0xf0, 0xf6, 0xd4, 0x74, 0x7c, 0x47, 0x6e, 0x2b, 0x9e, 0x7b, 0x6b, 0x1d, 0x62, 0x82, 0x3c,
-	0xf5, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xac, 0xf5, 0xe8, 0x0d, 0xeb, 0x18, 0x00, 0x00,
+	// 1981 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xcf, 0x38, 0x8e, 0x6b, 0xdf, 0xd4, 0x5f, 0xb7, 0x4e, 0xec, 0x8c, 0x9d, 0xdd, 0x70, 0x01,
+	0xdb, 0x8d, 0xed, 0xdd, 0xd8, 0x71, 0x10, 0x8a, 0xda, 0xa6, 0x76, 0x62, 0x37, 0x0e, 0xa1, 0x31,
+	0x93, 0x92, 0x0a, 0x78, 0x18, 0x8d, 0x77, 0xaf, 0xd7, 0xd3, 0xee, 0xce, 0x9d, 0xec, 0xdc, 0x71,
+	0xbc, 0x42, 0x15, 0x52, 0x05, 0x88, 0x07, 0x24, 0x40, 0x85, 0x3e, 0x40, 0x25, 0x78, 0x40, 0x08,
+	0xf1, 0x88, 0x2a, 0xf1, 0xc2, 0x1b, 0xaa, 0x54, 0x21, 0x21, 0x8a, 0x78, 0x41, 0x3c, 0x2c, 0x28,
+	0x01, 0x89, 0x67, 0xff, 0x03, 0xa0, 0x7b, 0xef, 0x99, 0xaf, 0xf5, 0xec, 0xc7, 0x6c, 0x88, 0xd4,
+	0x27, 0x7b, 0xef, 0xf9, 0xfa, 0x9d, 0x8f, 0x39, 0xf7, 0xdc, 0x83, 0x2e, 0x31, 0xaf, 0xc6, 0x3c,
+	0xdb, 0x2b, 0x56, 0xac, 0x5a, 0xad, 0x78, 0xb8, 0xba, 0x47, 0xb9, 0xb5, 0x5a, 0x7c, 0xe8, 0xd3,
+	0x7a, 0xa3, 0xe0, 0xd6, 0x19, 0x67, 0x78, 0x0a, 0x38, 0x0a, 0x82, 0xa3, 0x00, 0x1c, 0xfa, 0x54,
+	0x85, 0x55, 0x98, 0x64, 0x28, 0x8a, 0xff, 0x14, 0xaf, 0x7e, 0x31, 0x55, 0x1b, 0x3f, 0x02, 0x32,
+	0x49, 0x25, 0x57, 0xa8, 0x43, 0x85, 0x7e, 0xc5, 0x93, 0x2b, 0x49, 0xa6, 0xe2, 0x9e, 0xe5, 0xd1,
+	0x90, 0xa5, 0xc4, 0x6c, 0x07, 0xe8, 0x97, 0xe3, 0x74, 0x89, 0x33, 0xe4, 0x72, 0xad, 0x8a, 0xed,
+	0x58, 0xdc, 0x66, 0x01, 0xef, 0x5c, 0x85, 0xb1, 0x4a, 0x95, 0x16, 0x2d, 0xd7, 0x2e, 0x5a, 0x8e,
+	0xc3, 0xb8, 0x24, 0x06, 0x96, 0x2e, 0x00, 0x55, 0xfe, 0xda, 0xf3, 0xf7, 0x8b, 0x96, 0xd3, 0x08,
+	0x48, 0xca, 0x88, 0xa9, 0x1c, 0x54, 0x3f, 0x14, 0x89, 0xdc, 0x40, 0x13, 0x5f, 0x11, 0x56, 0x77,
+	0x19, 0xab, 0x1a, 0xf4, 0xa1, 0x4f, 0x3d, 0x8e, 0x97, 0xd0, 0x73, 0x2e, 0x63, 0x55, 0xd3, 0x2e,
+	0xcf, 0x68, 0x97, 0xb4, 0xc5, 0xc1, 0x4d, 0x7c, 0xdc, 0xcc, 0x8f, 0x35, 0xac, 0x5a, 0xf5, 0x3a,
+	0x01, 0x02, 0x31, 0x86, 0xc4, 0x7f, 0x3b, 0x65, 0x72, 0x1b, 0x4d, 0xc6, 0x14, 0x78, 0x2e, 0x73,
+	0x3c, 0x8a, 0xaf, 0xa2, 0x41, 0x41, 0x96, 0xe2, 0x67, 0xd7, 0xa6, 0x0a, 0x0a, 0x5a, 0x21, 0x80,
+	0x56, 0xd8, 0x70, 0x1a, 0x9b, 0x23, 0x7f, 0xfc, 0x70, 0xe5, 0x8c, 0x90, 0xda, 0x31, 0x24, 0x33,
+	0xf9, 0x46, 0x4c, 0x93, 0x17, 0x60, 0xd9, 0x46, 0x28, 0x8a, 0xc3, 0xcc, 0x80, 0xd4, 0x37, 0x5f,
+	0x00, 0x17, 0x44, 0xd0, 0x0a, 0x2a, 0xb9, 0x10, 0xb4, 0xc2, 0xae, 0x55, 0xa1, 0x20, 0x6b, 0xc4,
+	0x24, 0xc9, 0x8f, 0x35, 0x84, 0xe3, 0xda, 0x01, 0xe8, 0x35, 0x74, 0x46, 0xd8, 0xf6, 0x66, 0xb4,
+	0x4b, 0xa7, 0x7b, 0x41, 0xaa, 0xb8, 0xf1, 0x6b, 0x29, 0xa8, 0x16, 0xba, 0xa2, 0x52, 0x36, 0x13,
+	0xb0, 0xce, 0xa3, 0x29, 0x89, 0xea, 0x75, 0xbf, 0x16, 0x77, 0x9b, 0xdc, 0x41, 0xe7, 0x5a, 0xce,
+	0x01, 0xf0, 0x2a, 0x1a, 0x71, 0xfc, 0x9a, 0x19, 0x80, 0x16, 0xd9, 0x99, 0x3a, 0x6e, 0xe6, 0x27,
+	0x54, 0x76, 0x42, 0x12, 0x31, 0x86, 0x1d, 0x10, 0x25, 0x37, 0xc1, 0x86, 0xf8, 0xf5, 0x46, 0xc3,
+	0xa5, 0x7d, 0xa5, 0x39, 0x00, 0x14, 0x29, 0x89, 0x00, 0x49, 0x66, 0xde, 0x70, 0xa9, 0xd4, 0x33,
+	0x12, 0x07, 0x14, 0x92, 0x88, 0x31, 0xec, 0x82, 0x28, 0xf9, 0x9d, 0x86, 0x72, 0x52, 0xd9, 0x4d,
+	0xab, 0x5a, 0xba, 0xc3, 0x6c, 0x47, 0x28, 0xbd, 0x7f, 0x60, 0xd5, 0xa9, 0xd7, 0x0f, 0x36, 0x7c,
+	0x80, 0x46, 0x38, 0x7b, 0x9b, 0x3a, 0x9e, 0x69, 0x8b, 0x64, 0x88, 0x44, 0x5e, 0x48, 0x24, 0x23,
+	0x48, 0xc3, 0x4d, 0x66, 0x3b, 0x9b, 0x57, 0x3e, 0x6e, 0xe6, 0x4f, 0xfd, 0xe6, 0x1f, 0xf9, 0xc5,
+	0x8a, 0xcd, 0x0f, 0xfc, 0xbd, 0x42, 0x89, 0xd5, 0xe0, 0x93, 0x80, 0x3f, 0x2b, 0x5e, 0xf9, 0xed,
+	0xa2, 0xc0, 0xec, 0x49, 0x01, 0xcf, 0x18, 0x56, 0xda, 0x77, 0x1c, 0xf2, 0xee, 0x00, 0xca, 0xb7,
+	0x45, 0x0e, 0x01, 0xf1, 0xd0, 0x84, 0x27, 0x4e, 0x4c, 0xe6, 0x73, 0xd3, 0xaa, 0x31, 0xdf, 0xe1,
+	0x10, 0x97, 0x1d, 0x61, 0xf9, 0xef, 0xcd, 0xfc, 0x7c, 0x0f, 0x96, 0x77, 0x1c, 0x7e, 0xdc, 0xcc,
+	0x4f, 0x2b, 0x8f, 0x5b, 0xf5, 0x11, 0x63, 0x4c, 0x1e, 0xdd, 0xf3, 0xf9, 0x86, 0x3c, 0xc0, 0x6f,
+	0x21, 0x04, 0x21, 0x60, 0x3e, 0x7f, 0x16, 0x31, 0x80, 0x08, 0xdf, 0xf3, 0x39, 0xf9, 0xa9, 0x86,
+	0x16, 0xc2, 0x20, 0x6c, 0x1d, 0xd9, 0x5c, 0x04, 0x41, 0x72, 0x6d, 0xd7, 0x59, 0x2d, 0x99, 0xc7,
+	0xe9, 0x96, 0x3c, 0x86, 0x39, 0x7b, 0x80, 0xc6, 0x95, 0x57, 0xb6, 0x13, 0x04, 0x69, 0x40, 0x06,
+	0xa9, 0x90, 0x2d, 0x48, 0xc6, 0xa8, 0x54, 0xb3, 0xe3, 0xa8, 0x40, 0x90, 0xf7, 0x35, 0xb4, 0xd8,
+	0x1d, 0x1c, 0xa4, 0x2a, 0x19, 0x35, 0xed, 0x99, 0x46, 0x6d, 0x0b, 0x9d, 0x0f, 0x3f, 0xa0, 0x5d,
+	0xab, 0x6e, 0xd5, 0xfa, 0xaa, 0x75, 0xf2, 0x67, 0x0d, 0x4d, 0x9f, 0xd0, 0x03, 0xee, 0x2c, 0xa3,
+	0x21, 0x57, 0x9e, 0x74, 0xea, 0xbb, 0x06, 0xf0, 0xe0, 0xef, 0x68, 0x68, 0xba, 0xdc, 0x70, 0xac,
+	0x9a, 0x5d, 0x32, 0xbd, 0x47, 0x96, 0x6b, 0xee, 0x53, 0x6a, 0xba, 0xac, 0x6a, 0x97, 0x1a, 0xd0,
+	0xd1, 0x2e, 0x17, 0xd2, 0xee, 0xca, 0xc2, 0x2d, 0x25, 0x74, 0xff, 0x91, 0xe5, 0x6e, 0x53, 0xba,
+	0x2b, 0x25, 0x36, 0xc9, 0x71, 0x33, 0x9f, 0x53, 0x98, 0xdb, 0x28, 0x25, 0xc6, 0x54, 0x39, 0x45,
+	0x92, 0x7c, 0x19, 0x9a, 0xc1, 0x1b, 0x8c, 0x5b, 0x55, 0xe1, 0xd5, 0x5d, 0xfb, 0xa1, 0x6f, 0x97,
+	0x6d, 0xde, 0xe8, 0x2b, 0x40, 0xbf, 0xd0, 0x50, 0xbe, 0xad, 0x3e, 0x08, 0xd4, 0x3b, 0x68, 0xa4,
+	0x1a, 0x1c, 0x76, 0x4f, 0xfb, 0x2d, 0x91, 0xf6, 0xa8, 0xa5, 0x85, 0x92, 0x24, 0x5b, 0x29, 0x44,
+	0x72, 0xf1, 0x52, 0x78, 0xc0, 0xaa, 0x7e, 0xad, 0xbf, 0x96, 0xfc, 0x83, 0x78, 0x29, 0x04, 0x7a,
+	0xc0, 0x43, 0x8e, 0x86, 0x0e, 0xe5, 0x49, 0x77, 0xf7, 0x36, 0xc0, 0xbd, 0x51, 0x65, 0x46, 0x89,
+	0x65, 0xf3, 0x0d, 0x6c, 0x91, 0xbb, 0xe8, 0x62, 0x08, 0x68, 0x9b, 0x52, 0xef, 0x26, 0xab, 0x56,
+	0x69, 0x89, 0xd3, 0x72, 0x5f, 0xfe, 0x7d, 0x18, 0x5c, 0x13, 0x29, 0xea, 0xc0, 0xcd, 0x1f, 0x69,
+	0x68, 0x6c, 0x9f, 0x52, 0xcf, 0x2c, 0x05, 0x24, 0xf0, 0x77, 0x2e, 0xd5, 0xdf, 0x5b, 0xb4, 0x24,
+	0x5d, 0xbe, 0x0b, 0x2e, 0x9f, 0x53, 0x96, 0x93, 0x1a, 0x84, 0xeb, 0x4b, 0x3d, 0xb8, 0x0e, 0xca,
+	0x3c, 0x63, 0x74, 0x3f, 0x8e, 0x8d, 0x6c, 0xa3, 0xe9, 0xa8, 0xfe, 0xfa, 0xbf, 0xd5, 0x88, 0x8f,
+	0x66, 0x4e, 0xea, 0x01, 0xbf, 0xbf, 0x86, 0x9e, 0xe7, 0xe2, 0xd8, 0x94, 0xcd, 0x2f, 0xf8, 0xde,
+	0x3b, 0x24, 0x79, 0x16, 0x3c, 0x7e, 0x41, 0x19, 0x8b, 0x0b, 0x13, 0xe3, 0x2c, 0x8f, 0x4c, 0x90,
+	0xff, 0x68, 0x70, 0xd3, 0xdf, 0x77, 0x19, 0xdf, 0xad, 0xdb, 0xa5, 0xbe, 0x8a, 0x13, 0x6f, 0xa1,
+	0x09, 0x81, 0xc2, 0xb4, 0x3c, 0x8f, 0x72, 0xb3, 0x4c, 0x1d, 0x56, 0x83, 0x06, 0x3f, 0x1b, 0xdd,
+	0x6b, 0xad, 0x1c, 0xc4, 0x18, 0x13, 0x47, 0x1b, 0xe2, 0xe4, 0x96, 0x38, 0xc0, 0xb7, 0xd1, 0xe4,
+	0x43, 0x9f, 0xf1, 0xa4, 0x9e, 0xd3, 0x52, 0xcf, 0xdc, 0x71, 0x33, 0x3f, 0xa3, 0xf4, 0x9c, 0x60,
+	0x21, 0xc6, 0xb8, 0x3c, 0x8b, 0x34, 0xdd, 0x19, 0x1c, 0x1e, 0x9c, 0x38, 0x63, 0x9c, 0x7d, 0x64,
+	0xf3, 0x03, 0xe8, 0x3f, 0xe4, 0x23, 0x0d, 0xcd, 0x46, 0x33, 0xe1, 0x9b, 0x36, 0x3f, 0xd8, 0xb6,
+	0xab, 0x9c, 0xd6, 0x03, 0x87, 0xbf, 0xa7, 0xa1, 0xd1, 0x9a, 0xed, 0x98, 0x19, 0x7a, 0xc5, 0x6d,
+	0x88, 0xf3, 0x94, 0x02, 0x96, 0x90, 0xce, 0xf6, 0x4d, 0x3d, 0x5f, 0xb3, 0x9d, 0xb0, 0x73, 0xe1,
+	0xd9, 0xf8, 0x94, 0x25, 0xe3, 0x18, 0x9b, 0xa7, 0xbe, 0x8a, 0xe6, 0xd2, 0xdd, 0x78, 0xaa, 0x21,
+	0x97, 0xbc, 0x8e, 0xce, 0xb7, 0x16, 0x02, 0x28, 0x5c, 0x47, 0xc8, 0x73, 0x19, 0x37, 0x5d, 0x71,
+	0x0a, 0xc3, 0xcd, 0xb9, 0xe3, 0x66, 0x7e, 0x52, 0x79, 0x1d, 0xd1, 0x88, 0x31, 0xe2, 0x05, 0xd2,
+	0xe4, 0xbf, 0x1a, 0xb4, 0x07, 0x11, 0xff, 0xad, 0x23, 0xab, 0x04, 0xc3, 0xcb, 0x8e, 0x13, 0x04,
+	0xfc, 0x45, 0x34, 0xe4, 0x51, 0xa7, 0x4c, 0xeb, 0xa0, 0x73, 0x32, 0x6a, 0x4b, 0xea, 0x9c, 0x18,
+	0xc0, 0x10, 0x2f, 0xc6, 0x81, 0xae, 0xc5, 0x58, 0x40, 0x6a, 0x84, 0x13, 0xf3, 0xa1, 0x2a, 0x9e,
+	0x17, 0x8e, 0x9b, 0xf9, 0xf1, 0xe0, 0x5b, 0x50, 0x14, 0x62, 0x3c, 0x27, 0xff, 0xdd, 0x71, 0xf0,
+	0x03, 0x34, 0x54, 0x67, 0x3e, 0xa7, 0xde, 0xcc, 0xa0, 0x8c, 0xd8, 0x42, 0xfa, 0x45, 0x28, 0xfc,
+	0x08, 0x5d, 0x10, 0xfc, 0x9b, 0xe7, 0x92, 0xbd, 0x54, 0x29, 0x21, 0x06, 0x68, 0x23, 0x3f, 0x09,
+	0x3a, 0x5a, 0x4a, 0x04, 0xa2, 0xe9, 0x51, 0x01, 0xfa, 0xff, 0x4d, 0x8f, 0xad, 0xfa, 0x88, 0x31,
+	0x26, 0x8f, 0xc2, 0xe9, 0x91, 0x7c, 0x7b, 0x20, 0x1d, 0xd7, 0x3d, 0x9f, 0x3f, 0xeb, 0xd4, 0xbc,
+	0x19, 0x86, 0xfa, 0xb4, 0x0c, 0xf5, 0x62, 0xb7, 0x50, 0x0b, 0x4c, 0x3d, 0xc4, 0x5a, 0xbc, 0x4b,
+	0x42, 0xc7, 0x67, 0x06, 0x5b, 0xdf, 0x25, 0x21, 0x89, 0xc0, 0x74, 0x2f, 0x46, 0xb4, 0xf7, 0x82,
+	0xd1, 0x21, 0x2d, 0x0c, 0x90, 0x1f, 0x17, 0x8d, 0x07, 0x05, 0x93, 0x4c, 0xcf, 0xed, 0xcc, 0xe9,
+	0x39, 0x9f, 0xac, 0xbf, 0x30, 0x3b, 0xa3, 0x50, 0x86, 0x90, 0x9c, 0x39, 0xa4, 0x47, 0xf7, 0x40,
+	0xeb, 0x6c, 0x44, 0x3e, 0x08, 0x7a, 0x58, 0x2b, 0xf9, 0xd3, 0x31, 0xea, 0x5c, 0x0c, 0x3a, 0x6c,
+	0x9d, 0x71, 0x56, 0x62, 0x55, 0x83, 0x1e, 0x52, 0xc7, 0x0f, 0xae, 0x14, 0xf2, 0x5b, 0x0d, 0xcd,
+	0xa5, 0xd3, 0xa3, 0x0b, 0x7e, 0xc2, 0x05, 0x9a, 0x59, 0x57, 0xc4, 0xee, 0x6e, 0x7c, 0x09, 0xdc,
+	0x80, 0x0f, 0xa0, 0x55, 0x41, 0x36, 0x6f, 0xc6, 0xdd, 0x24, 0xb6, 0xb5, 0x9f, 0x4d, 0xa3, 0x33,
+	0x12, 0x34, 0xfe, 0x16, 0x92, 0x1d, 0xd3, 0xc3, 0x6d, 0x1a, 0xc4, 0x89, 0x75, 0x86, 0xbe, 0xd8,
+	0x9d, 0x51, 0x79, 0x4e, 0x3e, 0xfb, 0xee, 0x5f, 0xff, 0xf5, 0xde, 0xc0, 0x45, 0x3c, 0x5b, 0x4c,
+	0xdd, 0x32, 0xa9, 0x3d, 0xc4, 0xf7, 0x35, 0x34, 0x1c, 0xac, 0x08, 0xf0, 0xe5, 0x0e, 0xba, 0x5b,
+	0xf6, 0x0b, 0xfa, 0x52, 0x4f, 0xbc, 0x00, 0x65, 0x41, 0x42, 0xf9, 0x0c, 0xce, 0xa7, 0x43, 0x09,
+	0x97, 0x0e, 0xf8, 0x97, 0x1a, 0x1a, 0x4b, 0xd6, 0x21, 0xbe, 0xd2, 0xc1, 0x50, 0x6a, 0x45, 0xeb,
+	0xab, 0x19, 0x24, 0x00, 0xe0, 0x8a, 0x04, 0xb8, 0x80, 0x3f, 0x9f, 0x0e, 0x50, 0x4d, 0x3b, 0x61,
+	0x51, 0xe2, 0x5f, 0x6b, 0x68, 0xbc, 0xa5, 0xe0, 0x70, 0x27, 0xab, 0xe9, 0xc5, 0xab, 0xaf, 0x65,
+	0x11, 0x01, 0xa4, 0x05, 0x89, 0x74, 0x11, 0xcf, 0xb7, 0xc9, 0x6a, 0x4b, 0xa5, 0xe2, 0x5f, 0x09,
+	0xa8, 0xc9, 0x6b, 0xbd, 0x33, 0xd4, 0xd4, 0x49, 0x46, 0x5f, 0xcb, 0x22, 0x02, 0x50, 0x97, 0x25,
+	0xd4, 0x79, 0xfc, 0xb9, 0x74, 0xa8, 0xfb, 0x92, 0x9b, 0x96, 0x21, 0xf5, 0xdf, 0xd5, 0xd0, 0xa0,
+	0xd0, 0x84, 0xe7, 0xbb, 0x98, 0x0a, 0x20, 0x2d, 0x74, 0xe5, 0xeb, 0x2d, 0xb9, 0xd2, 0x7c, 0xf1,
+	0x9b, 0x70, 0xb1, 0xbc, 0x83, 0xdf, 0xd7, 0xd0, 0x70, 0xb0, 0xa4, 0xea, 0xf8, 0x49, 0xb4, 0xac,
+	0xc3, 0xf4, 0xa5, 0x9e, 0x78, 0x01, 0xd4, 0xaa, 0x04, 0xb5, 0x84, 0x5f, 0x6c, 0x0f, 0x4a, 0xce,
+	0x6a, 0x31, 0x60, 0x7f, 0xd0, 0x10, 0x3e, 0xb9, 0x36, 0xc2, 0xeb, 0x1d, 0xcc, 0xb6, 0xdd, 0x8f,
+	0xe9, 0xd7, 0x32, 0x4a, 0x01, 0xec, 0x57, 0x25, 0xec, 0xeb, 0xf8, 0x8b, 0x3d, 0xc5, 0xb2, 0xf8,
+	0x16, 0xb3, 0x1d, 0xf5, 0x8c, 0xa7, 0xe2, 0x36, 0x34, 0x6d, 0x07, 0xff, 0x5b, 0x43, 0xb3, 0x1d,
+	0x56, 0x2b, 0xf8, 0xe5, 0x2e, 0xc0, 0x3a, 0xef, 0x8b, 0xf4, 0x57, 0xfa, 0x15, 0x07, 0x07, 0x5f,
+	0x93, 0x0e, 0x6e, 0xe0, 0x1b, 0xbd, 0x39, 0x48, 0x8f, 0x6c, 0xae, 0x1c, 0x54, 0xcb, 0x28, 0x75,
+	0x05, 0x0b, 0x3f, 0x7f, 0xae, 0x21, 0x14, 0xad, 0x58, 0xf0, 0x72, 0x97, 0xe2, 0x48, 0x6c, 0x74,
+	0xf4, 0x95, 0x1e, 0xb9, 0x01, 0xf4, 0xba, 0x04, 0x5d, 0xc0, 0xcb, 0xbd, 0x81, 0x86, 0xfd, 0xcd,
+	0x47, 0x1a, 0xc2, 0x27, 0x77, 0x1c, 0x1d, 0xeb, 0xa9, 0xed, 0x8a, 0x45, 0xbf, 0x96, 0x51, 0x0a,
+	0x90, 0x6f, 0x4a, 0xe4, 0x2f, 0xe1, 0xeb, 0xbd, 0x21, 0x57, 0x8d, 0x58, 0xfe, 0x4c, 0x74, 0xe3,
+	0xb3, 0xb1, 0x37, 0x2e, 0x5e, 0xe9, 0x06, 0x25, 0x59, 0x31, 0x85, 0x5e, 0xd9, 0x01, 0xf2, 0x75,
+	0x09, 0x79, 0x1d, 0xaf, 0x65, 0x81, 0xac, 0x5e, 0xca, 0x61, 0x51, 0xa8, 0x65, 0x4b, 0xd7, 0xa2,
+	0x48, 0xec, 0x76, 0xf4, 0x95, 0x1e, 0xb9, 0xfb, 0x2b, 0x0a, 0xb5, 0x81, 0xc1, 0xbf, 0xd7, 0xd0,
+	0xe4, 0x89, 0x75, 0x09, 0xbe, 0xda, 0xc5, 0x74, 0xda, 0xae, 0x46, 0x5f, 0xcf, 0x26, 0x04, 0xb0,
+	0x5f, 0x92, 0xb0, 0xbf, 0x80, 0xd7, 0x7b, 0x83, 0x9d, 0x5c, 0xbd, 0xe0, 0x0f, 0x34, 0x34, 0x12,
+	0x3e, 0x37, 0x71, 0xa7, 0x8e, 0xdc, 0xba, 0x9d, 0xd0, 0x97, 0x7b, 0x63, 0xee, 0xf3, 0x93, 0x13,
+	0xc2, 0x1e, 0xfe, 0x93, 0x86, 0x2e, 0x6c, 0x79, 0xdc, 0xae, 0x59, 0x9c, 0x9e, 0x78, 0xc2, 0x75,
+	0x8c, 0x72, 0xbb, 0x27, 0xaf, 0xbe, 0x9e, 0x4d, 0x08, 0xe0, 0x6f, 0x49, 0xf8, 0x37, 0xf0, 0xcb,
+	0xe9, 0xf0, 0x23, 0xe0, 0x14, 0xd0, 0x16, 0x63, 0x5d, 0x3c, 0x6a, 0x72, 0x7f, 0xd1, 0x90, 0xde,
+	0xc6, 0x9f, 0x7b, 0x3e, 0xc7, 0x19, 0xb0, 0x45, 0x2f, 0x45, 0xfd, 0x5a, 0x46, 0x29, 0x70, 0x69,
+	0x5b, 0xba, 0xf4, 0x2a, 0x7e, 0xe5, 0x29, 0x5c, 0x62, 0x3e, 0xdf, 0xbc, 0xf3, 0xf1, 0xe3, 0x9c,
+	0xf6, 0xc9, 0xe3, 0x9c, 0xf6, 0xcf, 0xc7, 0x39, 0xed, 0x87, 0x4f, 0x72, 0xa7, 0x3e, 0x79, 0x92,
+	0x3b, 0xf5, 0xb7, 0x27, 0xb9, 0x53, 0x5f, 0xbf, 0x12, 0x1b, 0xf9, 0xc1, 0xc6, 0x4a, 0xd5, 0xda,
+	0xf3, 0x42, 0x83, 0x87, 0xab, 0x6b, 0xc5, 0x23, 0x65, 0x56, 0x3e, 0x00, 0xf6, 0x86, 0xe4, 0x3c,
+	0x76, 0xf5, 0x7f, 0x03, 0x00, 0xe8, 0xf4, 0xf2, 0x48, 0x56, 0x1e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1506,6 +1798,9 @@ type QueryClient interface {
 	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
 	NumPools(ctx context.Context, in *QueryNumPoolsRequest, opts ...grpc.CallOption) (*QueryNumPoolsResponse, error)
 	TotalLiquidity(ctx context.Context, in *QueryTotalLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityResponse, error)
+	// ProtocolRevenue returns the cumulative protocol revenue of all pools from
+	// swap fees.
+	ProtocolRevenue(ctx context.Context, in *QueryProtocolRevenueRequest, opts ...grpc.CallOption) (*QueryProtocolRevenueResponse, error)
 	// PoolsWithFilter allows you to query specific pools with requested
 	// parameters
 	PoolsWithFilter(ctx context.Context, in *QueryPoolsWithFilterRequest, opts ...grpc.CallOption) (*QueryPoolsWithFilterResponse, error)
@@ -1520,6 +1815,12 @@ type QueryClient interface {
 	PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error)
 	TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error)
 	TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error)
+	// PoolVolume returns the cumulative amounts of tokens swapped into the pool,
+	// by denom.
+	PoolVolume(ctx context.Context, in *QueryPoolVolumeRequest, opts ...grpc.CallOption) (*QueryPoolVolumeResponse, error)
+	// PoolFeesCollected returns the cumulative swap fees charged by the pool, by
+	// denom.
+	PoolFeesCollected(ctx context.Context, in *QueryPoolFeesCollectedRequest, opts ...grpc.CallOption) (*QueryPoolFeesCollectedResponse, error)
 	// SpotPrice defines a gRPC query handler that returns the spot price given
 	// a base denomination and a quote denomination.
 	SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error)
@@ -1563,6 +1864,15 @@ func (c *queryClient) TotalLiquidity(ctx context.Context, in *QueryTotalLiquidit
 	return out, nil
 }
 
+func (c *queryClient) ProtocolRevenue(ctx context.Context, in *QueryProtocolRevenueRequest, opts ...grpc.CallOption) (*QueryProtocolRevenueResponse, error) {
+	out := new(QueryProtocolRevenueResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/ProtocolRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) PoolsWithFilter(ctx context.Context, in *QueryPoolsWithFilterRequest, opts ...grpc.CallOption) (*QueryPoolsWithFilterResponse, error) {
 	out := new(QueryPoolsWithFilterResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolsWithFilter", in, out, opts...)
@@ -1635,6 +1945,24 @@ func (c *queryClient) TotalShares(ctx context.Context, in *QueryTotalSharesReque
 	return out, nil
 }
 
+func (c *queryClient) PoolVolume(ctx context.Context, in *QueryPoolVolumeRequest, opts ...grpc.CallOption) (*QueryPoolVolumeResponse, error) {
+	out := new(QueryPoolVolumeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolFeesCollected(ctx context.Context, in *QueryPoolFeesCollectedRequest, opts ...grpc.CallOption) (*QueryPoolFeesCollectedResponse, error) {
+	out := new(QueryPoolFeesCollectedResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolFeesCollected", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error) {
 	out := new(QuerySpotPriceResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/SpotPrice", in, out, opts...)
@@ -1667,6 +1995,9 @@ type QueryServer interface {
 	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
 	NumPools(context.Context, *QueryNumPoolsRequest) (*QueryNumPoolsResponse, error)
 	TotalLiquidity(context.Context, *QueryTotalLiquidityRequest) (*QueryTotalLiquidityResponse, error)
+	// ProtocolRevenue returns the cumulative protocol revenue of all pools from
+	// swap fees.
+	ProtocolRevenue(context.Context, *QueryProtocolRevenueRequest) (*QueryProtocolRevenueResponse, error)
 	// PoolsWithFilter allows you to query specific pools with requested
 	// parameters
 	PoolsWithFilter(context.Context, *QueryPoolsWithFilterRequest) (*QueryPoolsWithFilterResponse, error)
@@ -1681,6 +2012,12 @@ type QueryServer interface {
 	PoolParams(context.Context, *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error)
 	TotalPoolLiquidity(context.Context, *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error)
 	TotalShares(context.Context, *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error)
+	// PoolVolume returns the cumulative amounts of tokens swapped into the pool,
+	// by denom.
+	PoolVolume(context.Context, *QueryPoolVolumeRequest) (*QueryPoolVolumeResponse, error)
+	// PoolFeesCollected returns the cumulative swap fees charged by the pool, by
+	// denom.
+	PoolFeesCollected(context.Context, *QueryPoolFeesCollectedRequest) (*QueryPoolFeesCollectedResponse, error)
 	// SpotPrice defines a gRPC query handler that returns the spot price given
 	// a base denomination and a quote denomination.
 	SpotPrice(context.Context, *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error)
@@ -1702,6 +2039,9 @@ func (*UnimplementedQueryServer) NumPools(ctx context.Context, req *QueryNumPool
 func (*UnimplementedQueryServer) TotalLiquidity(ctx context.Context, req *QueryTotalLiquidityRequest) (*QueryTotalLiquidityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidity not implemented")
 }
+func (*UnimplementedQueryServer) ProtocolRevenue(ctx context.Context, req *QueryProtocolRevenueRequest) (*QueryProtocolRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtocolRevenue not implemented")
+}
 func (*UnimplementedQueryServer) PoolsWithFilter(ctx context.Context, req *QueryPoolsWithFilterRequest) (*QueryPoolsWithFilterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolsWithFilter not implemented")
 }
@@ -1726,6 +2066,12 @@ func (*UnimplementedQueryServer) TotalPoolLiquidity(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) TotalShares(ctx context.Context, req *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalShares not implemented")
 }
+func (*UnimplementedQueryServer) PoolVolume(ctx context.Context, req *QueryPoolVolumeRequest) (*QueryPoolVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolVolume not implemented")
+}
+func (*UnimplementedQueryServer) PoolFeesCollected(ctx context.Context, req *QueryPoolFeesCollectedRequest) (*QueryPoolFeesCollectedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolFeesCollected not implemented")
+}
 func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
 }
@@ -1794,6 +2140,24 @@ func _Query_TotalLiquidity_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProtocolRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProtocolRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProtocolRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/ProtocolRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtocolRevenue(ctx, req.(*QueryProtocolRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_PoolsWithFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPoolsWithFilterRequest)
 	if err := dec(in); err != nil {
@@ -1938,6 +2302,42 @@ func _Query_TotalShares_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PoolVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolVolume(ctx, req.(*QueryPoolVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolFeesCollected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolFeesCollectedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolFeesCollected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolFeesCollected",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolFeesCollected(ctx, req.(*QueryPoolFeesCollectedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySpotPriceRequest)
 	if err := dec(in); err != nil {
@@ -2008,6 +2408,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalLiquidity",
 			Handler:    _Query_TotalLiquidity_Handler,
 		},
+		{
+			MethodName: "ProtocolRevenue",
+			Handler:    _Query_ProtocolRevenue_Handler,
+		},
 		{
 			MethodName: "PoolsWithFilter",
 			Handler:    _Query_PoolsWithFilter_Handler,
@@ -2040,6 +2444,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalShares",
 			Handler:    _Query_TotalShares_Handler,
 		},
+		{
+			MethodName: "PoolVolume",
+			Handler:    _Query_PoolVolume_Handler,
+		},
+		{
+			MethodName: "PoolFeesCollected",
+			Handler:    _Query_PoolFeesCollected_Handler,
+		},
 		{
 			MethodName: "SpotPrice",
 			Handler:    _Query_SpotPrice_Handler,
@@ -2525,9 +2937,9 @@ func (m *QueryPoolParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
+	if m.DynamicSwapFeePolicy != nil {
 		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DynamicSwapFeePolicy.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2535,10 +2947,22 @@ func (m *QueryPoolParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
 func (m *QueryTotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -2605,6 +3029,136 @@ func (m *QueryTotalPoolLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPoolVolumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolVolumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolVolumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolVolumeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolVolumeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolVolumeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolFeesCollectedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolFeesCollectedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolFeesCollectedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolFeesCollectedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolFeesCollectedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolFeesCollectedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeesCollected) > 0 {
+		for iNdEx := len(m.FeesCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTotalSharesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3057,6 +3611,66 @@ func (m *QueryTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProtocolRevenueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolRevenueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProtocolRevenue) > 0 {
+		for iNdEx := len(m.ProtocolRevenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3257,6 +3871,10 @@ func (m *QueryPoolParamsResponse) Size() (n int) {
 		l = m.Params.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.DynamicSwapFeePolicy != nil {
+		l = m.DynamicSwapFeePolicy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3287,6 +3905,60 @@ func (m *QueryTotalPoolLiquidityResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPoolVolumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolVolumeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolFeesCollectedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolFeesCollectedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeesCollected) > 0 {
+		for _, e := range m.FeesCollected {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryTotalSharesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3475,6 +4147,30 @@ func (m *QueryTotalLiquidityResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProtocolRevenueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProtocolRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProtocolRevenue) > 0 {
+		for _, e := range m.ProtocolRevenue {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4164,7 +4860,396 @@ func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
 			}
-			var msglen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensIn = append(m.TokensIn, types1.Coin{})
+			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensOut = append(m.TokensOut, types1.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensOut = append(m.TokensOut, types1.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4174,26 +5259,11 @@ func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokensIn = append(m.TokensIn, types1.Coin{})
-			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4215,7 +5285,7 @@ func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4238,17 +5308,17 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4258,29 +5328,31 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Params == nil {
+				m.Params = &types.Any{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSwapFeePolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4307,8 +5379,10 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokensOut = append(m.TokensOut, types1.Coin{})
-			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DynamicSwapFeePolicy == nil {
+				m.DynamicSwapFeePolicy = &DynamicSwapFeePolicy{}
+			}
+			if err := m.DynamicSwapFeePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4333,7 +5407,7 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4356,10 +5430,10 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4381,40 +5455,6 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4436,7 +5476,7 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4459,15 +5499,15 @@ func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4494,8 +5534,8 @@ func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokensOut = append(m.TokensOut, types1.Coin{})
-			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Liquidity = append(m.Liquidity, types1.Coin{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4520,7 +5560,7 @@ func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolVolumeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4543,10 +5583,10 @@ func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolVolumeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolVolumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4589,7 +5629,7 @@ func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolVolumeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4612,15 +5652,15 @@ func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolVolumeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolVolumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4647,10 +5687,8 @@ func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &types.Any{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Volume = append(m.Volume, types1.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4675,7 +5713,7 @@ func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolFeesCollectedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4698,10 +5736,10 @@ func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolFeesCollectedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolFeesCollectedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4744,7 +5782,7 @@ func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolFeesCollectedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4767,15 +5805,15 @@ func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolFeesCollectedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolFeesCollectedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesCollected", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4802,8 +5840,8 @@ func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, types1.Coin{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeesCollected = append(m.FeesCollected, types1.DecCoin{})
+			if err := m.FeesCollected[len(m.FeesCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6031,6 +7069,140 @@ func (m *QueryTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProtocolRevenueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtocolRevenueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtocolRevenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProtocolRevenueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtocolRevenueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtocolRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolRevenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolRevenue = append(m.ProtocolRevenue, types1.Coin{})
+			if err := m.ProtocolRevenue[len(m.ProtocolRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
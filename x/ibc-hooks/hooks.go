@@ -0,0 +1,73 @@
+package ibc_hooks
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// Hooks is the marker interface for anything that wants to hook into the
+// IBC middleware. Implementations opt in to individual callbacks by also
+// implementing the Override, Before or After interfaces below.
+type Hooks interface{}
+
+// OnRecvPacket hooks
+
+type OnRecvPacketOverrideHooks interface {
+	OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement
+}
+type OnRecvPacketBeforeHooks interface {
+	OnRecvPacketBeforeHook(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress)
+}
+type OnRecvPacketAfterHooks interface {
+	OnRecvPacketAfterHook(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress, ack ibcexported.Acknowledgement)
+}
+
+// OnAcknowledgementPacket hooks
+
+type OnAcknowledgementPacketOverrideHooks interface {
+	OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error
+}
+type OnAcknowledgementPacketBeforeHooks interface {
+	OnAcknowledgementPacketBeforeHook(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress)
+}
+type OnAcknowledgementPacketAfterHooks interface {
+	OnAcknowledgementPacketAfterHook(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress, err error)
+}
+
+// OnTimeoutPacket hooks
+
+type OnTimeoutPacketOverrideHooks interface {
+	OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error
+}
+type OnTimeoutPacketBeforeHooks interface {
+	OnTimeoutPacketBeforeHook(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress)
+}
+type OnTimeoutPacketAfterHooks interface {
+	OnTimeoutPacketAfterHook(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress, err error)
+}
+
+// SendPacket hooks
+
+type SendPacketOverrideHooks interface {
+	SendPacketOverride(i ICS4Middleware, ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error
+}
+type SendPacketBeforeHooks interface {
+	SendPacketBeforeHook(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI)
+}
+type SendPacketAfterHooks interface {
+	SendPacketAfterHook(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, err error)
+}
+
+// WriteAcknowledgement hooks
+
+type WriteAcknowledgementOverrideHooks interface {
+	WriteAcknowledgementOverride(i ICS4Middleware, ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error
+}
+type WriteAcknowledgementBeforeHooks interface {
+	WriteAcknowledgementBeforeHook(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement)
+}
+type WriteAcknowledgementAfterHooks interface {
+	WriteAcknowledgementAfterHook(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement, err error)
+}
@@ -0,0 +1,64 @@
+package ibc_hooks
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ porttypes.ICS4Wrapper = &ICS4Middleware{}
+
+// ICS4Middleware wraps the channel keeper so that hooks can observe or
+// override outgoing packets and acknowledgements.
+type ICS4Middleware struct {
+	channel porttypes.ICS4Wrapper
+
+	// Hooks
+	Hooks Hooks
+}
+
+func NewICS4Middleware(channel porttypes.ICS4Wrapper, hooks Hooks) ICS4Middleware {
+	return ICS4Middleware{
+		channel: channel,
+		Hooks:   hooks,
+	}
+}
+
+// SendPacket implements the ICS4 Wrapper interface
+func (i ICS4Middleware) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	if hook, ok := i.Hooks.(SendPacketOverrideHooks); ok {
+		return hook.SendPacketOverride(i, ctx, chanCap, packet)
+	}
+
+	if hook, ok := i.Hooks.(SendPacketBeforeHooks); ok {
+		hook.SendPacketBeforeHook(ctx, chanCap, packet)
+	}
+
+	err := i.channel.SendPacket(ctx, chanCap, packet)
+
+	if hook, ok := i.Hooks.(SendPacketAfterHooks); ok {
+		hook.SendPacketAfterHook(ctx, chanCap, packet, err)
+	}
+
+	return err
+}
+
+// WriteAcknowledgement implements the ICS4 Wrapper interface
+func (i ICS4Middleware) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	if hook, ok := i.Hooks.(WriteAcknowledgementOverrideHooks); ok {
+		return hook.WriteAcknowledgementOverride(i, ctx, chanCap, packet, ack)
+	}
+
+	if hook, ok := i.Hooks.(WriteAcknowledgementBeforeHooks); ok {
+		hook.WriteAcknowledgementBeforeHook(ctx, chanCap, packet, ack)
+	}
+
+	err := i.channel.WriteAcknowledgement(ctx, chanCap, packet, ack)
+
+	if hook, ok := i.Hooks.(WriteAcknowledgementAfterHooks); ok {
+		hook.WriteAcknowledgementAfterHook(ctx, chanCap, packet, ack, err)
+	}
+
+	return err
+}
@@ -14,6 +14,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 
 	"github.com/osmosis-labs/osmosis/v12/osmoutils"
+	"github.com/osmosis-labs/osmosis/v12/x/ibc-hooks/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -24,7 +25,7 @@ type Ibcmodule struct{}
 var (
 	_          module.AppModule      = AppModule{}
 	_          module.AppModuleBasic = AppModuleBasic{}
-	ModuleName                       = "ibchooks"
+	ModuleName                       = types.ModuleName
 )
 
 // AppModuleBasic defines the basic application module used by the mint module.
@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	ErrBadMetadataFormatMsg = "wasm metadata not properly formatted for: '%v'. %s"
+	ErrBadExecutionMsg      = "cannot execute contract: %v"
+
+	ErrMsgValidation = sdkerrors.Register(ModuleName, 2, "error in wasmhook message validation")
+	ErrMarshaling    = sdkerrors.Register(ModuleName, 3, "cannot marshal the ICS20 packet")
+	ErrInvalidPacket = sdkerrors.Register(ModuleName, 4, "invalid packet data")
+	ErrBadResponse   = sdkerrors.Register(ModuleName, 5, "cannot create response")
+	ErrWasmError     = sdkerrors.Register(ModuleName, 6, "wasm error")
+	ErrBadSender     = sdkerrors.Register(ModuleName, 7, "bad sender")
+)
@@ -0,0 +1,7 @@
+package types
+
+const (
+	EventTypeWasmHookError = "ibc-wasm-hook-error"
+
+	AttributeKeyError = "error"
+)
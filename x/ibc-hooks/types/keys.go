@@ -0,0 +1,9 @@
+package types
+
+const (
+	ModuleName = "ibchooks"
+
+	// SenderPrefix is the prefix used to derive the intermediary account that
+	// executes wasm hooks on behalf of a remote sender.
+	SenderPrefix = "ibc-wasm-hook-intermediary"
+)
@@ -0,0 +1,262 @@
+package ibc_hooks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/osmosis-labs/osmosis/v12/x/ibc-hooks/types"
+)
+
+// ContractAck is the acknowledgement returned to the counterparty chain
+// after a successful wasm hook. It wraps both the contract's response data
+// and the acknowledgement produced by the underlying transfer app.
+type ContractAck struct {
+	ContractResult []byte `json:"contract_result"`
+	IbcAck         []byte `json:"ibc_ack"`
+}
+
+// WasmHooks executes a cosmwasm contract whenever an incoming ICS-20 packet
+// carries a `wasm` memo. See the module README for the memo format.
+type WasmHooks struct {
+	ContractKeeper      *wasmkeeper.PermissionedKeeper
+	bech32PrefixAccAddr string
+}
+
+var _ OnRecvPacketOverrideHooks = WasmHooks{}
+
+func NewWasmHooks(contractKeeper *wasmkeeper.PermissionedKeeper, bech32PrefixAccAddr string) WasmHooks {
+	return WasmHooks{
+		ContractKeeper:      contractKeeper,
+		bech32PrefixAccAddr: bech32PrefixAccAddr,
+	}
+}
+
+// OnRecvPacketOverride routes wasm-hook packets through the contract and
+// passes every other packet down the stack untouched.
+//
+// For a wasm-hook packet the receiver is rewritten to an intermediary account
+// derived from the packet's channel and sender, the underlying app credits the
+// funds to that account, and the contract is then executed with those funds.
+// Any failure results in an error acknowledgement, which reverts the transfer.
+func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	if h.ContractKeeper == nil {
+		// Not configured
+		return im.App.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	isIcs20, data := isIcs20Packet(packet)
+	if !isIcs20 {
+		return im.App.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	isWasmRouted, contractAddr, msgBytes, err := ValidateAndParseMemo(data.GetMemo(), data.Receiver)
+	if !isWasmRouted {
+		return im.App.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation, err.Error())
+	}
+
+	channel := packet.GetDestChannel()
+	sender := data.GetSender()
+	senderBech32, err := DeriveIntermediateSender(channel, sender, h.bech32PrefixAccAddr)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrBadSender, fmt.Sprintf("cannot convert sender address %s/%s to bech32: %s", channel, sender, err.Error()))
+	}
+
+	// Hijack the funds by overriding the receiver, so that the transfer app
+	// credits the intermediary account instead of the contract.
+	data.Receiver = senderBech32
+	bz, err := json.Marshal(data)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrMarshaling, err.Error())
+	}
+	packet.Data = bz
+
+	ack := im.App.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	amount, ok := sdk.NewIntFromString(data.GetAmount())
+	if !ok {
+		// This should have been caught by the transfer app already.
+		return NewEmitErrorAcknowledgement(ctx, types.ErrInvalidPacket, "amount is not an int")
+	}
+
+	// The packet's denom is the counterparty's representation, translate it
+	// into the denom the funds were credited with on this chain.
+	denom := MustExtractDenomFromPacketOnRecv(packet)
+	funds := sdk.NewCoins(sdk.NewCoin(denom, amount))
+
+	execMsg := wasmtypes.MsgExecuteContract{
+		Sender:   senderBech32,
+		Contract: contractAddr.String(),
+		Msg:      msgBytes,
+		Funds:    funds,
+	}
+	response, err := h.execWasmMsg(ctx, &execMsg)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrWasmError, err.Error())
+	}
+
+	fullAck := ContractAck{ContractResult: response.Data, IbcAck: ack.Acknowledgement()}
+	bz, err = json.Marshal(fullAck)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrBadResponse, err.Error())
+	}
+
+	return channeltypes.NewResultAcknowledgement(bz)
+}
+
+func (h WasmHooks) execWasmMsg(ctx sdk.Context, execMsg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
+	if err := execMsg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf(types.ErrBadExecutionMsg, err.Error())
+	}
+	wasmMsgServer := wasmkeeper.NewMsgServerImpl(h.ContractKeeper)
+	return wasmMsgServer.ExecuteContract(sdk.WrapSDKContext(ctx), execMsg)
+}
+
+func isIcs20Packet(packet channeltypes.Packet) (isIcs20 bool, ics20data transfertypes.FungibleTokenPacketData) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return false, data
+	}
+	return true, data
+}
+
+// ValidateAndParseMemo checks whether the memo routes the packet to a wasm
+// contract and, if so, that it is well formed. isWasmRouted is false for any
+// packet that should be passed down the stack untouched.
+func ValidateAndParseMemo(memo string, receiver string) (isWasmRouted bool, contractAddr sdk.AccAddress, msgBytes []byte, err error) {
+	isWasmRouted, metadata := jsonStringHasKey(memo, "wasm")
+	if !isWasmRouted {
+		return isWasmRouted, sdk.AccAddress{}, nil, nil
+	}
+
+	wasm, ok := metadata["wasm"].(map[string]interface{})
+	if !ok {
+		return isWasmRouted, sdk.AccAddress{}, nil,
+			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, "wasm metadata is not a valid JSON map object")
+	}
+
+	if len(wasm) != 2 {
+		return isWasmRouted, sdk.AccAddress{}, nil,
+			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm metadata must only contain the keys "contract" and "msg"`)
+	}
+
+	contract, ok := wasm["contract"].(string)
+	if !ok {
+		return isWasmRouted, sdk.AccAddress{}, nil,
+			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `could not find key wasm["contract"]`)
+	}
+
+	contractAddr, err = sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return isWasmRouted, sdk.AccAddress{}, nil,
+			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm["contract"] is not a valid bech32 address`)
+	}
+
+	if receiver != "" && receiver != contract {
+		return isWasmRouted, sdk.AccAddress{}, nil,
+			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm["contract"] should be the same as the receiver of the packet`)
+	}
+
+	msg, ok := wasm["msg"].(map[string]interface{})
+	if !ok {
+		return isWasmRouted, sdk.AccAddress{}, nil,
+			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm["msg"] is not a JSON map object`)
+	}
+
+	msgBytes, err = json.Marshal(msg)
+	if err != nil {
+		return isWasmRouted, sdk.AccAddress{}, nil,
+			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, err.Error())
+	}
+
+	return isWasmRouted, contractAddr, msgBytes, nil
+}
+
+// jsonStringHasKey parses the memo as a JSON object and reports whether it
+// contains the given top level key.
+func jsonStringHasKey(memo, key string) (found bool, jsonObject map[string]interface{}) {
+	jsonObject = make(map[string]interface{})
+
+	// If there is no memo, the packet was either sent with an earlier version
+	// of IBC or the memo was intentionally left blank.
+	if len(memo) == 0 {
+		return false, jsonObject
+	}
+
+	if err := json.Unmarshal([]byte(memo), &jsonObject); err != nil {
+		return false, jsonObject
+	}
+
+	_, ok := jsonObject[key]
+	return ok, jsonObject
+}
+
+// DeriveIntermediateSender returns the bech32 address of the account that
+// executes wasm hooks on behalf of originalSender on the given channel.
+// The address is unique per (channel, sender) pair, so a remote chain can
+// never impersonate a local account or another chain's users.
+func DeriveIntermediateSender(channel, originalSender, bech32Prefix string) (string, error) {
+	senderStr := fmt.Sprintf("%s/%s", channel, originalSender)
+	senderHash32 := address.Hash(types.SenderPrefix, []byte(senderStr))
+	sender := sdk.AccAddress(senderHash32[:])
+	return sdk.Bech32ifyAddressBytes(bech32Prefix, sender)
+}
+
+// MustExtractDenomFromPacketOnRecv returns the local denom that the transfer
+// app credits when receiving the given ICS-20 packet.
+func MustExtractDenomFromPacketOnRecv(packet ibcexported.PacketI) string {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		panic("unable to unmarshal ICS20 packet data")
+	}
+
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// The token is returning home: strip the prefix added by the sender chain.
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedDenom := data.Denom[len(voucherPrefix):]
+
+		// The denom is either native or the hash of the remaining path.
+		denomTrace := transfertypes.ParseDenomTrace(unprefixedDenom)
+		if denomTrace.Path != "" {
+			return denomTrace.IBCDenom()
+		}
+		return unprefixedDenom
+	}
+
+	prefixedDenom := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.Denom
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
+
+// NewEmitErrorAcknowledgement emits an event describing the failure and
+// returns an error acknowledgement for it.
+func NewEmitErrorAcknowledgement(ctx sdk.Context, err error, errorContexts ...string) channeltypes.Acknowledgement {
+	errorContext := err.Error()
+	if len(errorContexts) > 0 {
+		err = sdkerrors.Wrap(err, errorContexts[0])
+		errorContext = err.Error()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWasmHookError,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyError, errorContext),
+		),
+	)
+
+	return channeltypes.NewErrorAcknowledgement(errorContext)
+}
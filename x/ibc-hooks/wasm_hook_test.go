@@ -0,0 +1,327 @@
+package ibc_hooks_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v12/app"
+	"github.com/osmosis-labs/osmosis/v12/app/apptesting"
+	ibc_hooks "github.com/osmosis-labs/osmosis/v12/x/ibc-hooks"
+	"github.com/osmosis-labs/osmosis/v12/x/ibc-hooks/types"
+	osmosisibctesting "github.com/osmosis-labs/osmosis/v12/x/ibc-rate-limit/testutil"
+)
+
+type HooksTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *osmosisibctesting.TestChain
+	chainB *osmosisibctesting.TestChain
+	path   *ibctesting.Path
+}
+
+func TestIBCHooksTestSuite(t *testing.T) {
+	suite.Run(t, new(HooksTestSuite))
+}
+
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	osmosisApp := app.Setup(false)
+	return osmosisApp, app.NewDefaultGenesisState()
+}
+
+func (suite *HooksTestSuite) SetupTest() {
+	suite.Setup()
+	ibctesting.DefaultTestingAppInit = SetupTestingApp
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = &osmosisibctesting.TestChain{
+		TestChain: suite.coordinator.GetChain(ibctesting.GetChainID(1)),
+	}
+	suite.chainA.MoveEpochsToTheFuture()
+	suite.chainB = &osmosisibctesting.TestChain{
+		TestChain: suite.coordinator.GetChain(ibctesting.GetChainID(2)),
+	}
+	suite.path = ibctesting.NewPath(suite.chainA.TestChain, suite.chainB.TestChain)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	suite.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.coordinator.Setup(suite.path)
+}
+
+// instantiateHackatom stores and instantiates the hackatom contract on chain A.
+// Executing {"release":{}} from verifier sends the contract's balance to beneficiary.
+func (suite *HooksTestSuite) instantiateHackatom(verifier, beneficiary string) sdk.AccAddress {
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	wasmCode, err := os.ReadFile("../../wasmbinding/testdata/hackatom.wasm")
+	suite.Require().NoError(err)
+
+	// Code uploads are permissioned, so store the code through governance
+	govKeeper := osmosisApp.GovKeeper
+	govAddr := osmosisApp.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+	src := wasmtypes.StoreCodeProposalFixture(func(p *wasmtypes.StoreCodeProposal) {
+		p.RunAs = govAddr.String()
+		p.WASMByteCode = wasmCode
+	})
+	storedProposal, err := govKeeper.SubmitProposal(suite.chainA.GetContext(), src, false)
+	suite.Require().NoError(err)
+	handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+	err = handler(suite.chainA.GetContext(), storedProposal.GetContent())
+	suite.Require().NoError(err)
+
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(osmosisApp.WasmKeeper)
+	codeID := uint64(1)
+	initMsg := []byte(fmt.Sprintf(`{"verifier": "%s", "beneficiary": "%s"}`, verifier, beneficiary))
+	addr, _, err := contractKeeper.Instantiate(suite.chainA.GetContext(), codeID, govAddr, govAddr, initMsg, "hackatom", nil)
+	suite.Require().NoError(err)
+	return addr
+}
+
+// transferBToA relays a transfer from chain B to chain A and returns the
+// acknowledgement written by chain A.
+func (suite *HooksTestSuite) transferBToA(amount sdk.Int, receiver, memo string) string {
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointB.ChannelConfig.PortID,
+		suite.path.EndpointB.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, amount),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		receiver,
+		clienttypes.NewHeight(0, 100),
+		0,
+	)
+	msg.Memo = memo
+
+	sendResult, err := suite.chainB.SendMsgsNoCheck(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(sendResult.GetEvents())
+	suite.Require().NoError(err)
+
+	err = suite.path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	res, err := suite.path.EndpointA.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	return string(ack)
+}
+
+func (suite *HooksTestSuite) receivedDenom() string {
+	trace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom))
+	return trace.IBCDenom()
+}
+
+func (suite *HooksTestSuite) TestRecvTransferWithoutMemo() {
+	receiver := suite.chainA.SenderAccount.GetAddress()
+	ack := suite.transferBToA(sdk.NewInt(10), receiver.String(), "")
+	suite.Require().NotContains(ack, "error")
+
+	balance := suite.chainA.GetOsmosisApp().BankKeeper.GetBalance(suite.chainA.GetContext(), receiver, suite.receivedDenom())
+	suite.Require().Equal(sdk.NewInt(10), balance.Amount)
+}
+
+func (suite *HooksTestSuite) TestRecvTransferWithNonWasmMemo() {
+	receiver := suite.chainA.SenderAccount.GetAddress()
+	ack := suite.transferBToA(sdk.NewInt(10), receiver.String(), `{"forward": {}}`)
+	suite.Require().NotContains(ack, "error")
+
+	balance := suite.chainA.GetOsmosisApp().BankKeeper.GetBalance(suite.chainA.GetContext(), receiver, suite.receivedDenom())
+	suite.Require().Equal(sdk.NewInt(10), balance.Amount)
+}
+
+func (suite *HooksTestSuite) TestRecvTransferWithBadMemo() {
+	receiver := suite.chainA.SenderAccount.GetAddress().String()
+	memo := fmt.Sprintf(`{"wasm": {"contract": "%s", "msg": "not an object"}}`, receiver)
+	ack := suite.transferBToA(sdk.NewInt(10), receiver, memo)
+	suite.Require().Contains(ack, "error")
+	suite.Require().Contains(ack, types.ErrMsgValidation.Error())
+}
+
+func (suite *HooksTestSuite) TestRecvTransferToMissingContract() {
+	// A valid address without a contract: the transfer to the intermediary
+	// succeeds but the execution fails, so the whole receive is reverted.
+	receiver := suite.chainA.SenderAccount.GetAddress().String()
+	memo := fmt.Sprintf(`{"wasm": {"contract": "%s", "msg": {}}}`, receiver)
+	ack := suite.transferBToA(sdk.NewInt(10), receiver, memo)
+	suite.Require().Contains(ack, "error")
+	suite.Require().Contains(ack, types.ErrWasmError.Error())
+
+	sender, err := ibc_hooks.DeriveIntermediateSender(suite.path.EndpointA.ChannelID, suite.chainB.SenderAccount.GetAddress().String(), "osmo")
+	suite.Require().NoError(err)
+	senderAddr := sdk.MustAccAddressFromBech32(sender)
+	balance := suite.chainA.GetOsmosisApp().BankKeeper.GetBalance(suite.chainA.GetContext(), senderAddr, suite.receivedDenom())
+	suite.Require().True(balance.IsZero())
+}
+
+func (suite *HooksTestSuite) TestRecvTransferExecutesContract() {
+	intermediary, err := ibc_hooks.DeriveIntermediateSender(suite.path.EndpointA.ChannelID, suite.chainB.SenderAccount.GetAddress().String(), "osmo")
+	suite.Require().NoError(err)
+	beneficiary := suite.TestAccs[0]
+	contractAddr := suite.instantiateHackatom(intermediary, beneficiary.String())
+
+	memo := fmt.Sprintf(`{"wasm": {"contract": "%s", "msg": {"release": {}}}}`, contractAddr)
+	ack := suite.transferBToA(sdk.NewInt(10), contractAddr.String(), memo)
+	suite.Require().NotContains(ack, "error")
+
+	// The ack wraps both the transfer app's ack and the contract's response
+	var ackResult struct {
+		Result []byte `json:"result"`
+	}
+	var contractAck ibc_hooks.ContractAck
+	suite.Require().NoError(json.Unmarshal([]byte(ack), &ackResult))
+	suite.Require().NoError(json.Unmarshal(ackResult.Result, &contractAck))
+	suite.Require().NotEmpty(contractAck.IbcAck)
+
+	// The contract received the funds and released them to the beneficiary
+	bankKeeper := suite.chainA.GetOsmosisApp().BankKeeper
+	balance := bankKeeper.GetBalance(suite.chainA.GetContext(), beneficiary, suite.receivedDenom())
+	suite.Require().Equal(sdk.NewInt(10), balance.Amount)
+	suite.Require().True(bankKeeper.GetAllBalances(suite.chainA.GetContext(), contractAddr).IsZero())
+}
+
+func TestValidateAndParseMemo(t *testing.T) {
+	contract := "osmo14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sq2r9g9"
+	tests := map[string]struct {
+		memo         string
+		receiver     string
+		isWasmRouted bool
+		expectErr    bool
+	}{
+		"empty memo": {
+			memo: "",
+		},
+		"memo is not json": {
+			memo: "hello",
+		},
+		"memo without wasm key": {
+			memo: `{"forward": {}}`,
+		},
+		"valid memo": {
+			memo:         fmt.Sprintf(`{"wasm": {"contract": "%s", "msg": {"echo": {}}}}`, contract),
+			receiver:     contract,
+			isWasmRouted: true,
+		},
+		"valid memo with blank receiver": {
+			memo:         fmt.Sprintf(`{"wasm": {"contract": "%s", "msg": {}}}`, contract),
+			isWasmRouted: true,
+		},
+		"wasm is not an object": {
+			memo:         `{"wasm": "contract"}`,
+			receiver:     contract,
+			isWasmRouted: true,
+			expectErr:    true,
+		},
+		"missing contract": {
+			memo:         `{"wasm": {"msg": {}, "other": 1}}`,
+			receiver:     contract,
+			isWasmRouted: true,
+			expectErr:    true,
+		},
+		"invalid contract address": {
+			memo:         `{"wasm": {"contract": "osmo1invalid", "msg": {}}}`,
+			receiver:     "osmo1invalid",
+			isWasmRouted: true,
+			expectErr:    true,
+		},
+		"receiver differs from contract": {
+			memo:         fmt.Sprintf(`{"wasm": {"contract": "%s", "msg": {}}}`, contract),
+			receiver:     "osmo1cyyzpxplxdzkeea7kwsydadg87357qnahakaks",
+			isWasmRouted: true,
+			expectErr:    true,
+		},
+		"msg is not an object": {
+			memo:         fmt.Sprintf(`{"wasm": {"contract": "%s", "msg": "echo"}}`, contract),
+			receiver:     contract,
+			isWasmRouted: true,
+			expectErr:    true,
+		},
+		"extra keys": {
+			memo:         fmt.Sprintf(`{"wasm": {"contract": "%s", "msg": {}, "funds": []}}`, contract),
+			receiver:     contract,
+			isWasmRouted: true,
+			expectErr:    true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			isWasmRouted, contractAddr, msgBytes, err := ibc_hooks.ValidateAndParseMemo(tc.memo, tc.receiver)
+			require.Equal(t, tc.isWasmRouted, isWasmRouted)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tc.isWasmRouted {
+				require.Equal(t, contract, contractAddr.String())
+				require.True(t, json.Valid(msgBytes))
+			}
+		})
+	}
+}
+
+func TestDeriveIntermediateSender(t *testing.T) {
+	senderA, err := ibc_hooks.DeriveIntermediateSender("channel-0", "cosmos1sender", "osmo")
+	require.NoError(t, err)
+	senderB, err := ibc_hooks.DeriveIntermediateSender("channel-1", "cosmos1sender", "osmo")
+	require.NoError(t, err)
+	senderC, err := ibc_hooks.DeriveIntermediateSender("channel-0", "cosmos1other", "osmo")
+	require.NoError(t, err)
+
+	// Deterministic, and unique per channel and sender
+	again, err := ibc_hooks.DeriveIntermediateSender("channel-0", "cosmos1sender", "osmo")
+	require.NoError(t, err)
+	require.Equal(t, senderA, again)
+	require.NotEqual(t, senderA, senderB)
+	require.NotEqual(t, senderA, senderC)
+}
+
+func TestMustExtractDenomFromPacketOnRecv(t *testing.T) {
+	tests := map[string]struct {
+		packetDenom   string
+		expectedDenom string
+	}{
+		"counterparty native denom": {
+			packetDenom:   "uatom",
+			expectedDenom: transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom(),
+		},
+		"returning native denom": {
+			packetDenom:   "transfer/channel-1/uosmo",
+			expectedDenom: "uosmo",
+		},
+		"returning ibc denom": {
+			packetDenom:   "transfer/channel-1/transfer/channel-5/uatom",
+			expectedDenom: transfertypes.ParseDenomTrace("transfer/channel-5/uatom").IBCDenom(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := transfertypes.NewFungibleTokenPacketData(tc.packetDenom, "1", "sender", "receiver")
+			packet := channeltypes.Packet{
+				SourcePort:         "transfer",
+				SourceChannel:      "channel-1",
+				DestinationPort:    "transfer",
+				DestinationChannel: "channel-0",
+				Data:               data.GetBytes(),
+			}
+			require.Equal(t, tc.expectedDenom, ibc_hooks.MustExtractDenomFromPacketOnRecv(packet))
+		})
+	}
+}
@@ -8,14 +8,17 @@ import (
 
 // Flags for incentives module tx commands.
 const (
-	FlagDuration  = "duration"
-	FlagStartTime = "start-time"
-	FlagEpochs    = "epochs"
-	FlagPerpetual = "perpetual"
-	FlagTimestamp = "timestamp"
-	FlagOwner     = "owner"
-	FlagLockIds   = "lock-ids"
-	FlagEndEpoch  = "end-epoch"
+	FlagDuration     = "duration"
+	FlagStartTime    = "start-time"
+	FlagEpochs       = "epochs"
+	FlagPerpetual    = "perpetual"
+	FlagTimestamp    = "timestamp"
+	FlagStartedAfter = "started-after"
+	FlagWeighting    = "weighting"
+	FlagOwner        = "owner"
+	FlagLockIds      = "lock-ids"
+	FlagEndEpoch     = "end-epoch"
+	FlagGaugeID      = "gauge-id"
 )
 
 // FlagSetCreateGauge returns flags for creating gauges.
@@ -27,5 +30,20 @@ func FlagSetCreateGauge() *flag.FlagSet {
 	fs.String(FlagStartTime, "", "Timestamp to begin distribution")
 	fs.Uint64(FlagEpochs, 0, "Total epochs to distribute tokens")
 	fs.Bool(FlagPerpetual, false, "Perpetual distribution")
+	fs.String(FlagTimestamp, "", "Distribute to locks started at or before this timestamp instead of by duration")
+	fs.Bool(FlagStartedAfter, false, "Distribute to locks started after the timestamp rather than at or before it")
+	return fs
+}
+
+// FlagSetCreatePoolGauge returns flags for creating gauges distributing to the liquidity providers of a pool.
+func FlagSetCreatePoolGauge() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	dur, _ := time.ParseDuration("24h")
+	fs.Duration(FlagDuration, dur, "The duration the pool's shares must be locked for with the shares weighting, default 1d(24h).")
+	fs.String(FlagStartTime, "", "Timestamp to begin distribution")
+	fs.Uint64(FlagEpochs, 0, "Total epochs to distribute tokens")
+	fs.Bool(FlagPerpetual, false, "Perpetual distribution")
+	fs.String(FlagWeighting, "shares", "The rule rewards are split by among liquidity providers, shares or liquidity")
 	return fs
 }
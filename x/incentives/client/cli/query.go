@@ -36,6 +36,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdUpcomingGauges(),
 		GetCmdUpcomingGaugesPerDenom(),
 		GetCmdRewardsEst(),
+		GetCmdRewardHistory(),
 	)
 
 	return cmd
@@ -423,6 +424,58 @@ $ %s query incentives rewards-estimation
 	return cmd
 }
 
+// GetCmdRewardHistory returns the rewards an address received from gauges over the retained distribution epochs.
+func GetCmdRewardHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-history [address]",
+		Short: "Query the rewards an address received from gauges",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards an address received from gauges over the retained distribution epochs, optionally from a single gauge.
+
+Example:
+$ %s query incentives reward-history osmo1... --gauge-id 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			gaugeID, err := cmd.Flags().GetUint64(FlagGaugeID)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RewardHistory(cmd.Context(), &types.RewardHistoryRequest{
+				Address:    args[0],
+				GaugeId:    gaugeID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "reward-history")
+	cmd.Flags().Uint64(FlagGaugeID, 0, "the gauge to restrict the reward history to, all gauges when unset")
+
+	return cmd
+}
+
 func contains(s []uint64, value uint64) bool {
 	for _, v := range s {
 		if v == value {
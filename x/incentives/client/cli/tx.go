@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -29,7 +30,9 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		NewCreateGaugeCmd(),
+		NewCreatePoolGaugeCmd(),
 		NewAddToGaugeCmd(),
+		NewClaimRewardsCmd(),
 	)
 
 	return cmd
@@ -55,18 +58,12 @@ func NewCreateGaugeCmd() *cobra.Command {
 				return err
 			}
 
-			var startTime time.Time
 			timeStr, err := cmd.Flags().GetString(FlagStartTime)
 			if err != nil {
 				return err
 			}
-			if timeStr == "" { // empty start time
-				startTime = time.Unix(0, 0)
-			} else if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil { // unix time
-				startTime = time.Unix(timeUnix, 0)
-			} else if timeRFC, err := time.Parse(time.RFC3339, timeStr); err == nil { // RFC time
-				startTime = timeRFC
-			} else { // invalid input
+			startTime, err := parseTime(timeStr)
+			if err != nil {
 				return errors.New("invalid start time format")
 			}
 
@@ -93,7 +90,26 @@ func NewCreateGaugeCmd() *cobra.Command {
 				LockQueryType: lockuptypes.ByDuration,
 				Denom:         denom,
 				Duration:      duration,
-				Timestamp:     time.Unix(0, 0), // XXX check
+				Timestamp:     time.Unix(0, 0),
+			}
+
+			// a lock start timestamp makes the gauge distribute to locks by their start time
+			timestampStr, err := cmd.Flags().GetString(FlagTimestamp)
+			if err != nil {
+				return err
+			}
+			if timestampStr != "" {
+				timestamp, err := parseTime(timestampStr)
+				if err != nil {
+					return errors.New("invalid timestamp format")
+				}
+				startedAfter, err := cmd.Flags().GetBool(FlagStartedAfter)
+				if err != nil {
+					return err
+				}
+				distributeTo.LockQueryType = lockuptypes.ByTime
+				distributeTo.Timestamp = timestamp
+				distributeTo.StartedAfter = startedAfter
 			}
 
 			msg := types.NewMsgCreateGauge(
@@ -114,6 +130,99 @@ func NewCreateGaugeCmd() *cobra.Command {
 	return cmd
 }
 
+// NewCreatePoolGaugeCmd broadcasts a CreateGauge message distributing to the liquidity providers of a pool.
+func NewCreatePoolGaugeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-pool-gauge [pool_id] [reward] [flags]",
+		Short: "create a gauge to distribute rewards to the liquidity providers of a pool",
+		Long: `create a gauge to distribute rewards to the liquidity providers of a pool.
+With the "shares" weighting, rewards are distributed to the locks of the pool's shares locked for at least the duration.
+With the "liquidity" weighting, rewards are distributed to the positions of a concentrated liquidity pool that are in range of its current tick.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			weightingStr, err := cmd.Flags().GetString(FlagWeighting)
+			if err != nil {
+				return err
+			}
+			var weighting types.PoolWeighting
+			switch weightingStr {
+			case "shares":
+				weighting = types.ByShares
+			case "liquidity":
+				weighting = types.ByLiquidity
+			default:
+				return fmt.Errorf("invalid weighting %s, expected shares or liquidity", weightingStr)
+			}
+
+			timeStr, err := cmd.Flags().GetString(FlagStartTime)
+			if err != nil {
+				return err
+			}
+			startTime, err := parseTime(timeStr)
+			if err != nil {
+				return errors.New("invalid start time format")
+			}
+
+			epochs, err := cmd.Flags().GetUint64(FlagEpochs)
+			if err != nil {
+				return err
+			}
+
+			perpetual, err := cmd.Flags().GetBool(FlagPerpetual)
+			if err != nil {
+				return err
+			}
+
+			if perpetual {
+				epochs = 1
+			}
+
+			duration, err := cmd.Flags().GetDuration(FlagDuration)
+			if err != nil {
+				return err
+			}
+
+			distributeTo := lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.ByDuration,
+				Duration:      duration,
+				Timestamp:     time.Unix(0, 0),
+			}
+
+			msg := types.NewMsgCreateGauge(
+				epochs == 1,
+				clientCtx.GetFromAddress(),
+				distributeTo,
+				coins,
+				startTime,
+				epochs,
+			)
+			msg.DistributeToPool = &types.PoolDistribution{PoolId: poolId, Weighting: weighting}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetCreatePoolGauge())
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewAddToGaugeCmd broadcasts a AddToGauge message.
 func NewAddToGaugeCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -152,3 +261,51 @@ func NewAddToGaugeCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewClaimRewardsCmd broadcasts a ClaimRewards message.
+func NewClaimRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-rewards [lock_ids] [flags]",
+		Short: "claim the rewards accrued by locks, all the locks of the sender if no comma-separated lock IDs are provided",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			lockIDs := []uint64{}
+			if len(args) == 1 {
+				for _, idStr := range strings.Split(args[0], ",") {
+					lockID, err := strconv.ParseUint(strings.TrimSpace(idStr), 10, 64)
+					if err != nil {
+						return err
+					}
+					lockIDs = append(lockIDs, lockID)
+				}
+			}
+
+			msg := types.NewMsgClaimRewards(clientCtx.GetFromAddress(), lockIDs)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseTime parses either a unix or a RFC3339 time, returning the unix epoch on empty input.
+func parseTime(timeStr string) (time.Time, error) {
+	if timeStr == "" { // empty time
+		return time.Unix(0, 0), nil
+	} else if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil { // unix time
+		return time.Unix(timeUnix, 0), nil
+	} else if timeRFC, err := time.Parse(time.RFC3339, timeStr); err == nil { // RFC time
+		return timeRFC, nil
+	}
+	// invalid input
+	return time.Time{}, errors.New("invalid time format")
+}
@@ -0,0 +1,279 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+
+	"github.com/osmosis-labs/osmosis/v12/osmoutils"
+	"github.com/osmosis-labs/osmosis/v12/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// When distribution is lazy, gauges distributing to locks of a native denom by duration accrue the rewards of
+// each epoch to the reward index of their denom and duration, as rewards per locked share, instead of sending them
+// to every lock. A lock accrues the growth of the reward indexes of its denoms, for every duration up to its own,
+// since its checkpoint. The lockup hooks settle the rewards of a lock before its shares change, and owners claim
+// the rewards of their locks in between.
+//
+// Locks without a checkpoint have not changed since before rewards first accrued, as the lockup hooks checkpoint
+// every lock they change, hence they accrued on their shares from zero indexes.
+
+// rewardIndexesDenomPrefix returns the prefix of the reward indexes of the provided denom.
+func rewardIndexesDenomPrefix(denom string) []byte {
+	return append(combineKeys(types.KeyPrefixRewardIndexes, []byte(denom)), types.KeyIndexSeparator...)
+}
+
+// rewardIndexKey returns the store key of the reward index of the provided denom and duration.
+func rewardIndexKey(denom string, duration time.Duration) []byte {
+	return append(rewardIndexesDenomPrefix(denom), sdk.Uint64ToBigEndian(uint64(duration))...)
+}
+
+// lockRewardCheckpointKey returns the store key of the reward checkpoint of the provided lock.
+func lockRewardCheckpointKey(lockID uint64) []byte {
+	return append(append([]byte{}, types.KeyPrefixLockRewardCheckpoints...), sdk.Uint64ToBigEndian(lockID)...)
+}
+
+// getRewardIndex returns the reward index of the provided denom and duration, empty if nothing accrued to it yet.
+func (k Keeper) getRewardIndex(ctx sdk.Context, denom string, duration time.Duration) types.RewardIndex {
+	index := types.RewardIndex{Denom: denom, Duration: duration, RewardsPerShare: sdk.DecCoins{}}
+	store := ctx.KVStore(k.storeKey)
+	key := rewardIndexKey(denom, duration)
+	if store.Has(key) {
+		osmoutils.MustGet(store, key, &index)
+	}
+	return index
+}
+
+// setRewardIndex stores the reward index.
+func (k Keeper) setRewardIndex(ctx sdk.Context, index types.RewardIndex) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), rewardIndexKey(index.Denom, index.Duration), &index)
+}
+
+// GetAllRewardIndexes returns the reward indexes of all denoms and durations.
+func (k Keeper) GetAllRewardIndexes(ctx sdk.Context) ([]types.RewardIndex, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPrefixRewardIndexes, parseRewardIndex)
+}
+
+// cumulativeRewardIndex returns the sum of the reward indexes of the denom for durations up to the provided one,
+// which are the rewards accrued per share by the locks of the denom locked for that duration.
+func (k Keeper) cumulativeRewardIndex(ctx sdk.Context, denom string, duration time.Duration) sdk.DecCoins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), rewardIndexesDenomPrefix(denom))
+	// indexes are ordered by duration, the end key being exclusive
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(duration)+1))
+	defer iter.Close()
+
+	cumulative := sdk.DecCoins{}
+	for ; iter.Valid(); iter.Next() {
+		index, err := parseRewardIndex(iter.Value())
+		if err != nil {
+			panic(err)
+		}
+		cumulative = cumulative.Add(index.RewardsPerShare...)
+	}
+	return cumulative
+}
+
+// accrueGaugeRewards accrues the rewards the gauge distributes in the epoch to the reward index of its denom and
+// duration, pro-rata to the shares locked for at least the duration. It also updates the gauge for the distribution.
+func (k Keeper) accrueGaugeRewards(ctx sdk.Context, gauge types.Gauge) (sdk.Coins, error) {
+	if gauge.Coins.Empty() {
+		return nil, nil
+	}
+	totalShares := k.lk.GetPeriodLocksAccumulation(ctx, gauge.DistributeTo)
+	if !totalShares.IsPositive() {
+		return nil, nil
+	}
+
+	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
+	remainEpochs := uint64(1)
+	if !gauge.IsPerpetual {
+		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
+	}
+
+	index := k.getRewardIndex(ctx, gauge.DistributeTo.Denom, gauge.DistributeTo.Duration)
+	distrCoins := sdk.Coins{}
+	for _, coin := range remainCoins {
+		// distribution amount = gauge_size / remain_epochs
+		amt := coin.Amount.QuoRaw(int64(remainEpochs))
+		if !amt.IsPositive() {
+			continue
+		}
+		distrCoins = distrCoins.Add(sdk.NewCoin(coin.Denom, amt))
+		rewardsPerShare := amt.ToDec().Mul(types.RewardIndexPrecision).QuoInt(totalShares)
+		index.RewardsPerShare = index.RewardsPerShare.Add(sdk.NewDecCoinFromDec(coin.Denom, rewardsPerShare))
+	}
+	k.setRewardIndex(ctx, index)
+
+	err := k.updateGaugePostDistribute(ctx, gauge, distrCoins)
+	return distrCoins, err
+}
+
+// getLockRewardCheckpoint returns the reward checkpoint of the lock, if any.
+func (k Keeper) getLockRewardCheckpoint(ctx sdk.Context, lockID uint64) (types.LockRewardCheckpoint, bool) {
+	checkpoint := types.LockRewardCheckpoint{}
+	store := ctx.KVStore(k.storeKey)
+	key := lockRewardCheckpointKey(lockID)
+	if !store.Has(key) {
+		return checkpoint, false
+	}
+	osmoutils.MustGet(store, key, &checkpoint)
+	return checkpoint, true
+}
+
+// setLockRewardCheckpoint stores the reward checkpoint of a lock.
+func (k Keeper) setLockRewardCheckpoint(ctx sdk.Context, checkpoint types.LockRewardCheckpoint) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), lockRewardCheckpointKey(checkpoint.LockId), &checkpoint)
+}
+
+// deleteLockRewardCheckpoint removes the reward checkpoint of a lock.
+func (k Keeper) deleteLockRewardCheckpoint(ctx sdk.Context, lockID uint64) {
+	ctx.KVStore(k.storeKey).Delete(lockRewardCheckpointKey(lockID))
+}
+
+// GetAllLockRewardCheckpoints returns the reward checkpoints of all locks.
+func (k Keeper) GetAllLockRewardCheckpoints(ctx sdk.Context) ([]types.LockRewardCheckpoint, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPrefixLockRewardCheckpoints, parseLockRewardCheckpoint)
+}
+
+// newLockRewardCheckpoint returns the checkpoint of a lock accruing rewards on the provided shares locked for the duration,
+// as of the current reward indexes.
+func (k Keeper) newLockRewardCheckpoint(ctx sdk.Context, lockID uint64, shares sdk.Coins, duration time.Duration) types.LockRewardCheckpoint {
+	indexes := make([]types.RewardIndex, 0, len(shares))
+	for _, share := range shares {
+		indexes = append(indexes, types.RewardIndex{
+			Denom:           share.Denom,
+			Duration:        duration,
+			RewardsPerShare: k.cumulativeRewardIndex(ctx, share.Denom, duration),
+		})
+	}
+	return types.LockRewardCheckpoint{LockId: lockID, Duration: duration, Shares: shares, Indexes: indexes}
+}
+
+// accruedLockRewards returns the rewards accrued by the checkpointed shares of a lock since its checkpoint.
+// Fractions of a reward coin are truncated.
+func (k Keeper) accruedLockRewards(ctx sdk.Context, checkpoint types.LockRewardCheckpoint) sdk.Coins {
+	rewards := sdk.Coins{}
+	for _, share := range checkpoint.Shares {
+		rewards = rewards.Add(k.accruedShareRewards(ctx, checkpoint, share)...)
+	}
+	return rewards
+}
+
+// accruedShareRewards returns the rewards accrued by one of the checkpointed shares of a lock since its checkpoint.
+func (k Keeper) accruedShareRewards(ctx sdk.Context, checkpoint types.LockRewardCheckpoint, share sdk.Coin) sdk.Coins {
+	accrued := k.cumulativeRewardIndex(ctx, share.Denom, checkpoint.Duration)
+	for _, index := range checkpoint.Indexes {
+		if index.Denom == share.Denom {
+			accrued = accrued.Sub(index.RewardsPerShare)
+		}
+	}
+	rewards := sdk.Coins{}
+	for _, coin := range accrued {
+		// rewards = share_amount * (index - checkpoint_index)
+		amt := coin.Amount.MulInt(share.Amount).Quo(types.RewardIndexPrecision).TruncateInt()
+		if amt.IsPositive() {
+			rewards = rewards.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+	return rewards
+}
+
+// GetLockAccruedRewards returns the rewards the lock accrued since they were last settled, that its owner can claim.
+func (k Keeper) GetLockAccruedRewards(ctx sdk.Context, lock lockuptypes.PeriodLock) sdk.Coins {
+	checkpoint, found := k.getLockRewardCheckpoint(ctx, lock.ID)
+	if !found {
+		checkpoint = types.LockRewardCheckpoint{LockId: lock.ID, Duration: lock.Duration, Shares: lock.Coins}
+	}
+	return k.accruedLockRewards(ctx, checkpoint)
+}
+
+// updateLockRewards sends the rewards the lock accrued on its previous shares to its owner, recording them in the
+// reward history, then checkpoints its current shares. The previous shares and duration are only used for locks
+// without a checkpoint. The checkpoint is removed if the lock has no shares anymore.
+func (k Keeper) updateLockRewards(
+	ctx sdk.Context, owner sdk.AccAddress, lockID uint64,
+	prevShares sdk.Coins, prevDuration time.Duration, shares sdk.Coins, duration time.Duration,
+) (sdk.Coins, error) {
+	checkpoint, found := k.getLockRewardCheckpoint(ctx, lockID)
+	if !found {
+		checkpoint = types.LockRewardCheckpoint{LockId: lockID, Duration: prevDuration, Shares: prevShares}
+	}
+
+	rewards := sdk.Coins{}
+	for _, share := range checkpoint.Shares {
+		shareRewards := k.accruedShareRewards(ctx, checkpoint, share)
+		if shareRewards.Empty() {
+			continue
+		}
+		if err := k.recordSettledRewards(ctx, owner, share.Denom, checkpoint.Duration, shareRewards); err != nil {
+			return nil, err
+		}
+		rewards = rewards.Add(shareRewards...)
+	}
+	if !rewards.Empty() {
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, rewards); err != nil {
+			return nil, err
+		}
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeEvtClaimRewards,
+				sdk.NewAttribute(types.AttributeReceiver, owner.String()),
+				sdk.NewAttribute(types.AttributeLockID, fmt.Sprintf("%d", lockID)),
+				sdk.NewAttribute(types.AttributeAmount, rewards.String()),
+			),
+		})
+	}
+
+	if shares.Empty() {
+		k.deleteLockRewardCheckpoint(ctx, lockID)
+		return rewards, nil
+	}
+	k.setLockRewardCheckpoint(ctx, k.newLockRewardCheckpoint(ctx, lockID, shares, duration))
+	return rewards, nil
+}
+
+// ClaimRewards sends the rewards accrued by the provided locks to their owner, and checkpoints the locks.
+// All the locks of the owner are claimed if no lock IDs are provided.
+func (k Keeper) ClaimRewards(ctx sdk.Context, owner sdk.AccAddress, lockIDs []uint64) (sdk.Coins, error) {
+	var locks []lockuptypes.PeriodLock
+	if len(lockIDs) == 0 {
+		locks = k.lk.GetAccountPeriodLocks(ctx, owner)
+	}
+	for _, lockID := range lockIDs {
+		lock, err := k.lk.GetLockByID(ctx, lockID)
+		if err != nil {
+			return nil, err
+		}
+		if lock.Owner != owner.String() {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "lock %d is not owned by %s", lockID, owner)
+		}
+		locks = append(locks, *lock)
+	}
+
+	claimed := sdk.Coins{}
+	for _, lock := range locks {
+		rewards, err := k.updateLockRewards(ctx, owner, lock.ID, lock.Coins, lock.Duration, lock.Coins, lock.Duration)
+		if err != nil {
+			return nil, err
+		}
+		claimed = claimed.Add(rewards...)
+	}
+	return claimed, nil
+}
+
+func parseRewardIndex(bz []byte) (types.RewardIndex, error) {
+	index := types.RewardIndex{}
+	err := index.Unmarshal(bz)
+	return index, err
+}
+
+func parseLockRewardCheckpoint(bz []byte) (types.LockRewardCheckpoint, error) {
+	checkpoint := types.LockRewardCheckpoint{}
+	err := checkpoint.Unmarshal(bz)
+	return checkpoint, err
+}
@@ -0,0 +1,334 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v12/x/incentives/keeper"
+	"github.com/osmosis-labs/osmosis/v12/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setLazyDistribution enables or disables lazy distribution.
+func (suite *KeeperTestSuite) setLazyDistribution(lazy bool) {
+	params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
+	params.LazyDistribution = lazy
+	suite.App.IncentivesKeeper.SetParams(suite.Ctx, params)
+}
+
+// rewardBalance returns the reward denom balance of the address.
+func (suite *KeeperTestSuite) rewardBalance(addr sdk.AccAddress) sdk.Int {
+	return suite.App.BankKeeper.GetBalance(suite.Ctx, addr, defaultRewardDenom).Amount
+}
+
+// accruedRewards returns the reward denom amount accrued by the lock.
+func (suite *KeeperTestSuite) accruedRewards(lockID uint64) sdk.Int {
+	lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockID)
+	suite.Require().NoError(err)
+	return suite.App.IncentivesKeeper.GetLockAccruedRewards(suite.Ctx, *lock).AmountOf(defaultRewardDenom)
+}
+
+// refillGauge adds rewards to the gauge and returns the refilled gauge.
+func (suite *KeeperTestSuite) refillGauge(rewards sdk.Coins, gaugeID uint64) types.Gauge {
+	suite.AddToGauge(rewards, gaugeID)
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	return *gauge
+}
+
+// TestLazyDistribution tests that gauges accrue their rewards to the locks when distribution is lazy,
+// with the same amounts as pushed distributions, and that lock owners claim them.
+func (suite *KeeperTestSuite) TestLazyDistribution() {
+	suite.SetupTest()
+	suite.setLazyDistribution(true)
+	msgServer := keeper.NewMsgServerImpl(suite.App.IncentivesKeeper)
+
+	// gauge 1 rewards the three locks, gauge 2 only the longer lock of the second user
+	addrs := suite.SetupUserLocks([]userLocks{oneLockupUser, twoLockupUser})
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}
+	gauges := suite.SetupGauges([]perpGaugeDesc{
+		{lockDenom: defaultLPDenom, lockDuration: defaultLockDuration, rewardAmount: rewards},
+		{lockDenom: defaultLPDenom, lockDuration: 2 * defaultLockDuration, rewardAmount: rewards},
+	}, defaultLPDenom)
+	locks := suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addrs[1])
+	suite.Require().Len(locks, 2)
+
+	// the lock of the first user has no checkpoint, like the locks that existed before rewards first accrued
+	suite.App.IncentivesKeeper.DeleteLockRewardCheckpoint(suite.Ctx, 1)
+
+	distributed, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, gauges)
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewards.Add(rewards...), distributed)
+
+	// nothing is sent, the rewards accrue to the locks
+	suite.Require().True(suite.rewardBalance(addrs[0]).IsZero())
+	suite.Require().True(suite.rewardBalance(addrs[1]).IsZero())
+	suite.Require().Equal(sdk.NewInt(1000), suite.accruedRewards(1))
+	suite.Require().Equal(sdk.NewInt(1000), suite.accruedRewards(locks[0].ID))
+	suite.Require().Equal(sdk.NewInt(4000), suite.accruedRewards(locks[1].ID))
+	for _, gauge := range gauges {
+		updated, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gauge.Id)
+		suite.Require().NoError(err)
+		suite.Require().Equal(rewards, updated.DistributedCoins)
+		suite.Require().Equal(uint64(1), updated.FilledEpochs)
+	}
+
+	// locks can only be claimed by their owner
+	_, err = msgServer.ClaimRewards(sdk.WrapSDKContext(suite.Ctx), types.NewMsgClaimRewards(addrs[0], []uint64{locks[0].ID}))
+	suite.Require().Error(err)
+
+	// the rewards of the given locks are claimed
+	res, err := msgServer.ClaimRewards(sdk.WrapSDKContext(suite.Ctx), types.NewMsgClaimRewards(addrs[1], []uint64{locks[1].ID}))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 4000)}, res.Rewards)
+	suite.Require().Equal(sdk.NewInt(4000), suite.rewardBalance(addrs[1]))
+	suite.Require().True(suite.accruedRewards(locks[1].ID).IsZero())
+	suite.Require().Equal(sdk.NewInt(1000), suite.accruedRewards(locks[0].ID))
+
+	// the rewards of all the locks of the owner are claimed without lock IDs
+	res, err = msgServer.ClaimRewards(sdk.WrapSDKContext(suite.Ctx), types.NewMsgClaimRewards(addrs[0], nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}, res.Rewards)
+	suite.Require().Equal(sdk.NewInt(1000), suite.rewardBalance(addrs[0]))
+
+	// claiming again pays nothing
+	res, err = msgServer.ClaimRewards(sdk.WrapSDKContext(suite.Ctx), types.NewMsgClaimRewards(addrs[0], nil))
+	suite.Require().NoError(err)
+	suite.Require().True(res.Rewards.Empty())
+
+	// the reward indexes and checkpoints are exported and imported
+	genesis := suite.App.IncentivesKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Len(genesis.RewardIndexes, 2)
+	suite.Require().Len(genesis.LockRewardCheckpoints, 3)
+	suite.SetupTest()
+	suite.App.IncentivesKeeper.InitGenesis(suite.Ctx, *genesis)
+	indexes, err := suite.App.IncentivesKeeper.GetAllRewardIndexes(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genesis.RewardIndexes, indexes)
+	checkpoints, err := suite.App.IncentivesKeeper.GetAllLockRewardCheckpoints(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genesis.LockRewardCheckpoints, checkpoints)
+}
+
+// TestLazyDistributionSkippedGauges tests that only gauges distributing to locks of a native denom by duration
+// accrue their rewards, other gauges keep sending them.
+func (suite *KeeperTestSuite) TestLazyDistributionSkippedGauges() {
+	suite.SetupTest()
+	suite.setLazyDistribution(true)
+
+	addrs := suite.SetupUserLocks([]userLocks{oneLockupUser})
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}
+	_, gauge := suite.CreateGauge(true, addrs[0], rewards, lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByTime,
+		Denom:         defaultLPDenom,
+		Duration:      defaultLockDuration,
+		Timestamp:     suite.Ctx.BlockTime().Add(time.Hour),
+	}, suite.Ctx.BlockTime(), 1)
+
+	_, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000), suite.rewardBalance(addrs[0]))
+	suite.Require().True(suite.accruedRewards(1).IsZero())
+}
+
+// TestLockRewardsSettlement tests that the rewards accrued by a lock are sent to its owner
+// when its shares change, and that it accrues on its new shares afterwards.
+func (suite *KeeperTestSuite) TestLockRewardsSettlement() {
+	suite.SetupTest()
+	suite.setLazyDistribution(true)
+
+	addrs := suite.SetupUserLocks([]userLocks{oneLockupUser, oneLockupUser})
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2000)}
+	gauges := suite.SetupGauges([]perpGaugeDesc{
+		{lockDenom: defaultLPDenom, lockDuration: defaultLockDuration, rewardAmount: rewards},
+	}, defaultLPDenom)
+	_, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, gauges)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000), suite.accruedRewards(1))
+	suite.Require().Equal(sdk.NewInt(1000), suite.accruedRewards(2))
+
+	// adding tokens to a lock settles its rewards
+	suite.FundAcc(addrs[0], defaultLPTokens)
+	_, err = suite.App.LockupKeeper.AddTokensToLockByID(suite.Ctx, 1, addrs[0], defaultLPTokens[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000), suite.rewardBalance(addrs[0]))
+	suite.Require().True(suite.accruedRewards(1).IsZero())
+
+	// the lock accrues on its new shares
+	gauge := suite.refillGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}, gauges[0].Id)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(2000), suite.accruedRewards(1))
+	suite.Require().Equal(sdk.NewInt(2000), suite.accruedRewards(2))
+
+	// partially unlocking a lock splits it, which settles its rewards
+	err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, 2, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 5)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(2000), suite.rewardBalance(addrs[1]))
+	suite.Require().True(suite.accruedRewards(2).IsZero())
+	splitLockID := suite.App.LockupKeeper.GetLastLockID(suite.Ctx)
+	suite.Require().True(suite.accruedRewards(splitLockID).IsZero())
+
+	// the unlocking split lock accrues until it is unlocked
+	gauge = suite.refillGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}, gauges[0].Id)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(4000), suite.accruedRewards(1))
+	suite.Require().Equal(sdk.NewInt(500), suite.accruedRewards(2))
+	suite.Require().Equal(sdk.NewInt(500), suite.accruedRewards(splitLockID))
+
+	// unlocking settles the rewards and removes the checkpoint
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(defaultLockDuration))
+	suite.App.LockupKeeper.WithdrawAllMaturedLocks(suite.Ctx)
+	suite.Require().Equal(sdk.NewInt(2500), suite.rewardBalance(addrs[1]))
+	checkpoints, err := suite.App.IncentivesKeeper.GetAllLockRewardCheckpoints(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(checkpoints, 2)
+
+	// extending a lock settles the rewards accrued for its previous duration
+	err = suite.App.LockupKeeper.ExtendLockup(suite.Ctx, 1, addrs[0], 2*defaultLockDuration)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(5000), suite.rewardBalance(addrs[0]))
+	suite.Require().True(suite.accruedRewards(1).IsZero())
+}
+
+// TestFailedLockRewardsSettlement tests that a lock whose rewards can not be sent is still checkpointed
+// with its new shares when they change.
+func (suite *KeeperTestSuite) TestFailedLockRewardsSettlement() {
+	suite.SetupTest()
+	suite.setLazyDistribution(true)
+
+	addrs := suite.SetupUserLocks([]userLocks{oneLockupUser})
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}
+	gauges := suite.SetupGauges([]perpGaugeDesc{
+		{lockDenom: defaultLPDenom, lockDuration: defaultLockDuration, rewardAmount: rewards},
+	}, defaultLPDenom)
+	_, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, gauges)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000), suite.accruedRewards(1))
+
+	// the rewards can not be sent once the module account is drained
+	moduleBalance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, suite.App.AccountKeeper.GetModuleAddress(types.ModuleName))
+	err = suite.App.BankKeeper.SendCoinsFromModuleToAccount(suite.Ctx, types.ModuleName, sdk.AccAddress([]byte("drain---------------")), moduleBalance)
+	suite.Require().NoError(err)
+
+	suite.FundAcc(addrs[0], defaultLPTokens)
+	lock, err := suite.App.LockupKeeper.AddTokensToLockByID(suite.Ctx, 1, addrs[0], defaultLPTokens[0])
+	suite.Require().NoError(err)
+	suite.Require().True(suite.rewardBalance(addrs[0]).IsZero())
+
+	// the lock is checkpointed with its new shares, its unsent rewards are forfeited
+	suite.Require().True(suite.accruedRewards(1).IsZero())
+	checkpoints, err := suite.App.IncentivesKeeper.GetAllLockRewardCheckpoints(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(checkpoints, 1)
+	suite.Require().Equal(lock.Coins, checkpoints[0].Shares)
+}
+
+// TestLockTransferRewardsSettlement tests that the rewards a lock accrued before it was transferred or split
+// are sent to its previous owner, and that the lock accrues to its new owner afterwards.
+func (suite *KeeperTestSuite) TestLockTransferRewardsSettlement() {
+	suite.SetupTest()
+	suite.setLazyDistribution(true)
+
+	addrs := suite.SetupUserLocks([]userLocks{oneLockupUser, oneLockupUser})
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2000)}
+	gauges := suite.SetupGauges([]perpGaugeDesc{
+		{lockDenom: defaultLPDenom, lockDuration: defaultLockDuration, rewardAmount: rewards},
+	}, defaultLPDenom)
+	_, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, gauges)
+	suite.Require().NoError(err)
+
+	// transferring a lock settles its rewards to the previous owner
+	err = suite.App.LockupKeeper.TransferLock(suite.Ctx, 1, addrs[0], addrs[1])
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000), suite.rewardBalance(addrs[0]))
+	suite.Require().True(suite.accruedRewards(1).IsZero())
+
+	// splitting a lock settles its rewards
+	splitLock, err := suite.App.LockupKeeper.SplitLock(suite.Ctx, 2, addrs[1], sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 5)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000), suite.rewardBalance(addrs[1]))
+	suite.Require().True(suite.accruedRewards(2).IsZero())
+
+	// the locks accrue to the new owner
+	gauge := suite.refillGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2000)}, gauges[0].Id)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000), suite.accruedRewards(1))
+	suite.Require().Equal(sdk.NewInt(500), suite.accruedRewards(2))
+	suite.Require().Equal(sdk.NewInt(500), suite.accruedRewards(splitLock.ID))
+
+	claimed, err := suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addrs[1], nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2000)}, claimed)
+	suite.Require().Equal(sdk.NewInt(1000), suite.rewardBalance(addrs[0]))
+}
+
+// TestLockMergeRewardsSettlement tests that the rewards accrued by merged locks are sent to their owner,
+// and that the merged lock accrues on the merged shares afterwards.
+func (suite *KeeperTestSuite) TestLockMergeRewardsSettlement() {
+	suite.SetupTest()
+	suite.setLazyDistribution(true)
+
+	addrs := suite.SetupUserLocks([]userLocks{oneLockupUser})
+	suite.FundAcc(addrs[0], defaultLPTokens)
+	lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addrs[0], defaultLPTokens, defaultLockDuration)
+	suite.Require().NoError(err)
+
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2000)}
+	gauges := suite.SetupGauges([]perpGaugeDesc{
+		{lockDenom: defaultLPDenom, lockDuration: defaultLockDuration, rewardAmount: rewards},
+	}, defaultLPDenom)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, gauges)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000), suite.accruedRewards(1))
+	suite.Require().Equal(sdk.NewInt(1000), suite.accruedRewards(lock.ID))
+
+	// merging the locks settles the rewards of both locks, and only the merged lock keeps a checkpoint of the merged shares
+	_, err = suite.App.LockupKeeper.MergeLocks(suite.Ctx, addrs[0], []uint64{1, lock.ID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(2000), suite.rewardBalance(addrs[0]))
+	suite.Require().True(suite.accruedRewards(1).IsZero())
+	checkpoints, err := suite.App.IncentivesKeeper.GetAllLockRewardCheckpoints(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(checkpoints, 1)
+	suite.Require().Equal(uint64(1), checkpoints[0].LockId)
+	suite.Require().Equal(defaultLPTokens.Add(defaultLPTokens...), checkpoints[0].Shares)
+
+	// the merged lock accrues on the merged shares
+	gauge := suite.refillGauge(rewards, gauges[0].Id)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(2000), suite.accruedRewards(1))
+}
+
+// TestCancelUnlockingRewardsSettlement tests that partially cancelling the unlocking of a lock settles its rewards,
+// and that both locks keep accruing afterwards.
+func (suite *KeeperTestSuite) TestCancelUnlockingRewardsSettlement() {
+	suite.SetupTest()
+	suite.setLazyDistribution(true)
+
+	addrs := suite.SetupUserLocks([]userLocks{oneLockupUser})
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}
+	gauges := suite.SetupGauges([]perpGaugeDesc{
+		{lockDenom: defaultLPDenom, lockDuration: defaultLockDuration, rewardAmount: rewards},
+	}, defaultLPDenom)
+	err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, 1, nil)
+	suite.Require().NoError(err)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, gauges)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000), suite.accruedRewards(1))
+
+	relocked, err := suite.App.LockupKeeper.CancelUnlocking(suite.Ctx, 1, addrs[0], sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 4)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000), suite.rewardBalance(addrs[0]))
+	suite.Require().True(suite.accruedRewards(1).IsZero())
+
+	gauge := suite.refillGauge(rewards, gauges[0].Id)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(600), suite.accruedRewards(1))
+	suite.Require().Equal(sdk.NewInt(400), suite.accruedRewards(relocked.ID))
+}
@@ -53,7 +53,7 @@ func genQueryCondition(
 	coins sdk.Coins,
 	durationOptions []time.Duration,
 ) lockuptypes.QueryCondition {
-	// only use lockQueryType ByDuration (0) since ByTime (1) is deprecated
+	// only use lockQueryType ByDuration (0)
 	lockQueryType := 0
 	denom := coins[r.Intn(len(coins))].Denom
 	durationOption := r.Intn(len(durationOptions))
@@ -69,13 +69,17 @@ func genQueryCondition(
 }
 
 // benchmarkDistributionLogic creates gauges with lockups that get distributed to. Benchmarks the performance of the distribution process.
-func benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs int, b *testing.B) {
+// With lazy distribution, the gauges accrue their rewards to reward indexes instead of sending them to every lockup.
+func benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs int, lazyDistribution bool, b *testing.B) {
 	b.StopTimer()
 
 	blockStartTime := time.Now().UTC()
 	app, cleanupFn := app.SetupTestingAppWithLevelDb(false)
 	defer cleanupFn()
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "osmosis-1", Time: blockStartTime})
+	params := app.IncentivesKeeper.GetParams(ctx)
+	params.LazyDistribution = lazyDistribution
+	app.IncentivesKeeper.SetParams(ctx, params)
 
 	r := rand.New(rand.NewSource(10))
 
@@ -175,7 +179,7 @@ func BenchmarkDistributionLogicTiny(b *testing.B) {
 	numGauges := 1
 	numLockups := 1
 	numDistrs := 1
-	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, b)
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, false, b)
 }
 
 func BenchmarkDistributionLogicSmall(b *testing.B) {
@@ -184,7 +188,7 @@ func BenchmarkDistributionLogicSmall(b *testing.B) {
 	numGauges := 10
 	numLockups := 1000
 	numDistrs := 100
-	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, b)
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, false, b)
 }
 
 func BenchmarkDistributionLogicMedium(b *testing.B) {
@@ -194,7 +198,7 @@ func BenchmarkDistributionLogicMedium(b *testing.B) {
 	numLockups := 20000
 	numDistrs := 1
 
-	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, b)
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, false, b)
 }
 
 func BenchmarkDistributionLogicLarge(b *testing.B) {
@@ -204,7 +208,7 @@ func BenchmarkDistributionLogicLarge(b *testing.B) {
 	numLockups := 100000
 	numDistrs := 1
 
-	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, b)
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, false, b)
 }
 
 func BenchmarkDistributionLogicHuge(b *testing.B) {
@@ -213,5 +217,52 @@ func BenchmarkDistributionLogicHuge(b *testing.B) {
 	numGauges := 1000
 	numLockups := 1000
 	numDistrs := 30000
-	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, b)
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, false, b)
+}
+
+func BenchmarkLazyDistributionLogicTiny(b *testing.B) {
+	numAccts := 1
+	numDenoms := 1
+	numGauges := 1
+	numLockups := 1
+	numDistrs := 1
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, true, b)
+}
+
+func BenchmarkLazyDistributionLogicSmall(b *testing.B) {
+	numAccts := 10
+	numDenoms := 1
+	numGauges := 10
+	numLockups := 1000
+	numDistrs := 100
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, true, b)
+}
+
+func BenchmarkLazyDistributionLogicMedium(b *testing.B) {
+	numAccts := 1000
+	numDenoms := 8
+	numGauges := 30
+	numLockups := 20000
+	numDistrs := 1
+
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, true, b)
+}
+
+func BenchmarkLazyDistributionLogicLarge(b *testing.B) {
+	numAccts := 50000
+	numDenoms := 10
+	numGauges := 60
+	numLockups := 100000
+	numDistrs := 1
+
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, true, b)
+}
+
+func BenchmarkLazyDistributionLogicHuge(b *testing.B) {
+	numAccts := 1000
+	numDenoms := 100
+	numGauges := 1000
+	numLockups := 1000
+	numDistrs := 30000
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, true, b)
 }
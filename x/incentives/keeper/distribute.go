@@ -6,6 +6,7 @@ import (
 
 	db "github.com/tendermint/tm-db"
 
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v12/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
 
@@ -80,6 +81,8 @@ func (k Keeper) moveActiveGaugeToFinishedGauge(ctx sdk.Context, gauge types.Gaug
 
 // getLocksToDistributionWithMaxDuration returns locks that match the provided lockuptypes QueryCondition,
 // are greater than the provided minDuration, AND have yet to be distributed to.
+// For time query conditions, minDuration is ignored and locks of any duration started
+// before (or after) the condition's timestamp are returned.
 func (k Keeper) getLocksToDistributionWithMaxDuration(ctx sdk.Context, distrTo lockuptypes.QueryCondition, minDuration time.Duration) []lockuptypes.PeriodLock {
 	switch distrTo.LockQueryType {
 	case lockuptypes.ByDuration:
@@ -89,17 +92,37 @@ func (k Keeper) getLocksToDistributionWithMaxDuration(ctx sdk.Context, distrTo l
 		}
 		return k.lk.GetLocksLongerThanDurationDenom(ctx, distrTo.Denom, distrTo.Duration)
 	case lockuptypes.ByTime:
-		panic("Gauge by time is present, however is no longer supported. This should have been blocked in ValidateBasic")
+		if distrTo.StartedAfter {
+			return k.lk.GetLocksStartedAfterTimeDenom(ctx, distrTo.Denom, distrTo.Timestamp)
+		}
+		return k.lk.GetLocksStartedBeforeTimeDenom(ctx, distrTo.Denom, distrTo.Timestamp)
 	default:
 	}
 	return []lockuptypes.PeriodLock{}
 }
 
+// getLocksByTimeCondition returns the locks that qualify for a time query condition,
+// being started before (or after) the condition's timestamp and having a duration of at least the condition's duration.
+func (k Keeper) getLocksByTimeCondition(ctx sdk.Context, distrTo lockuptypes.QueryCondition) []lockuptypes.PeriodLock {
+	locks := k.getLocksToDistributionWithMaxDuration(ctx, distrTo, distrTo.Duration)
+	return FilterLocksByMinDuration(locks, distrTo.Duration)
+}
+
 // FilteredLocksDistributionEst estimates distribution amount of coins from gauge.
 // It also applies an update for the gauge, handling the sending of the rewards.
 // (Note this update is in-memory, it does not change state.)
 func (k Keeper) FilteredLocksDistributionEst(ctx sdk.Context, gauge types.Gauge, filteredLocks []lockuptypes.PeriodLock) (types.Gauge, sdk.Coins, bool, error) {
-	TotalAmtLocked := k.lk.GetPeriodLocksAccumulation(ctx, gauge.DistributeTo)
+	noFiltering := len(filteredLocks) == 0
+	var TotalAmtLocked sdk.Int
+	if gauge.DistributeTo.LockQueryType == lockuptypes.ByTime {
+		// the accumulation store is only indexed by duration, so we sum up the qualified locks instead.
+		// locks that do not qualify for the gauge are not estimated any rewards.
+		qualifiedLocks := k.getLocksByTimeCondition(ctx, gauge.DistributeTo)
+		TotalAmtLocked = lockuptypes.SumLocksByDenom(qualifiedLocks, gauge.DistributeTo.Denom)
+		filteredLocks = filterLocksByIDs(filteredLocks, qualifiedLocks)
+	} else {
+		TotalAmtLocked = k.lk.GetPeriodLocksAccumulation(ctx, gauge.DistributeTo)
+	}
 	if TotalAmtLocked.IsZero() {
 		return types.Gauge{}, nil, false, nil
 	}
@@ -128,7 +151,7 @@ func (k Keeper) FilteredLocksDistributionEst(ctx sdk.Context, gauge types.Gauge,
 
 	// now we compute the filtered coins
 	filteredDistrCoins := sdk.Coins{}
-	if len(filteredLocks) == 0 {
+	if noFiltering {
 		// if were doing no filtering, we want to calculate the total amount to distributed in
 		// the next epoch.
 		// distribution in next epoch = gauge_size  / (remain_epochs)
@@ -160,16 +183,27 @@ type distributionInfo struct {
 	idToBech32Addr    []string
 	idToDecodedAddr   []sdk.AccAddress
 	idToDistrCoins    []sdk.Coins
+	// rewardRecords are the rewards of each owner per gauge, for the reward history.
+	rewardRecords        []types.RewardRecord
+	gaugeOwnerToRecordID map[gaugeOwner]int
+}
+
+// gaugeOwner identifies the rewards of an owner from a gauge.
+type gaugeOwner struct {
+	gaugeID uint64
+	owner   string
 }
 
 // newDistributionInfo creates a new distributionInfo struct
 func newDistributionInfo() distributionInfo {
 	return distributionInfo{
-		nextID:            0,
-		lockOwnerAddrToID: make(map[string]int),
-		idToBech32Addr:    []string{},
-		idToDecodedAddr:   []sdk.AccAddress{},
-		idToDistrCoins:    []sdk.Coins{},
+		nextID:               0,
+		lockOwnerAddrToID:    make(map[string]int),
+		idToBech32Addr:       []string{},
+		idToDecodedAddr:      []sdk.AccAddress{},
+		idToDistrCoins:       []sdk.Coins{},
+		rewardRecords:        []types.RewardRecord{},
+		gaugeOwnerToRecordID: make(map[gaugeOwner]int),
 	}
 }
 
@@ -193,6 +227,17 @@ func (d *distributionInfo) addLockRewards(owner string, rewards sdk.Coins) error
 	return nil
 }
 
+// addGaugeRewards adds the provided rewards from the gauge to the record of the provided owner.
+func (d *distributionInfo) addGaugeRewards(gaugeID uint64, owner string, rewards sdk.Coins) {
+	key := gaugeOwner{gaugeID: gaugeID, owner: owner}
+	if id, ok := d.gaugeOwnerToRecordID[key]; ok {
+		d.rewardRecords[id].Rewards = d.rewardRecords[id].Rewards.Add(rewards...)
+		return
+	}
+	d.gaugeOwnerToRecordID[key] = len(d.rewardRecords)
+	d.rewardRecords = append(d.rewardRecords, types.RewardRecord{Address: owner, GaugeId: gaugeID, Rewards: rewards})
+}
+
 // doDistributionSends utilizes provided distributionInfo to send coins from the module account to various recipients.
 func (k Keeper) doDistributionSends(ctx sdk.Context, distrs *distributionInfo) error {
 	numIDs := len(distrs.idToDecodedAddr)
@@ -300,6 +345,7 @@ func (k Keeper) distributeInternal(
 		if err != nil {
 			return nil, err
 		}
+		distrInfo.addGaugeRewards(gauge.Id, lock.Owner, distrCoins)
 
 		totalDistrCoins = totalDistrCoins.Add(distrCoins...)
 	}
@@ -308,6 +354,63 @@ func (k Keeper) distributeInternal(
 	return totalDistrCoins, err
 }
 
+// distributeToPositions runs the distribution logic for a gauge distributing to the positions of a pool,
+// and adds the sends to the distrInfo struct. It also updates the gauge for the distribution.
+// Rewards are split among the positions in range of the pool's current tick, pro-rata to their liquidity.
+// The full range position owned by the pool backs its LP shares, whose holders are rewarded by gauges weighting by shares.
+func (k Keeper) distributeToPositions(ctx sdk.Context, gauge types.Gauge, distrInfo *distributionInfo) (sdk.Coins, error) {
+	if gauge.Coins.Empty() {
+		return nil, nil
+	}
+	positions, err := k.gk.GetActivePositions(ctx, gauge.DistributeToPool.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	poolAddr := gammtypes.NewPoolAddress(gauge.DistributeToPool.PoolId).String()
+	liquiditySum := sdk.ZeroDec()
+	lpPositions := make([]gammtypes.Position, 0, len(positions))
+	for _, position := range positions {
+		if position.Owner == poolAddr {
+			continue
+		}
+		lpPositions = append(lpPositions, position)
+		liquiditySum = liquiditySum.Add(position.Liquidity)
+	}
+	if liquiditySum.IsZero() {
+		return nil, nil
+	}
+
+	totalDistrCoins := sdk.NewCoins()
+	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
+	remainEpochs := uint64(1)
+	if !gauge.IsPerpetual {
+		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
+	}
+
+	for _, position := range lpPositions {
+		distrCoins := sdk.Coins{}
+		for _, coin := range remainCoins {
+			// distribution amount = gauge_size * position_liquidity / (total_liquidity * remain_epochs)
+			amt := coin.Amount.ToDec().Mul(position.Liquidity).Quo(liquiditySum.MulInt64(int64(remainEpochs))).TruncateInt()
+			if amt.IsPositive() {
+				distrCoins = distrCoins.Add(sdk.NewCoin(coin.Denom, amt))
+			}
+		}
+		if distrCoins.Empty() {
+			continue
+		}
+		if err := distrInfo.addLockRewards(position.Owner, distrCoins); err != nil {
+			return nil, err
+		}
+		distrInfo.addGaugeRewards(gauge.Id, position.Owner, distrCoins)
+		totalDistrCoins = totalDistrCoins.Add(distrCoins...)
+	}
+
+	err = k.updateGaugePostDistribute(ctx, gauge, totalDistrCoins)
+	return totalDistrCoins, err
+}
+
 // updateGaugePostDistribute increments the gauge's filled epochs field.
 // Also adds the coins that were just distributed to the gauge's distributed coins field.
 func (k Keeper) updateGaugePostDistribute(ctx sdk.Context, gauge types.Gauge, newlyDistributedCoins sdk.Coins) error {
@@ -325,8 +428,12 @@ func (k Keeper) getDistributeToBaseLocks(ctx sdk.Context, gauge types.Gauge, cac
 	if gauge.Coins.Empty() {
 		return []lockuptypes.PeriodLock{}
 	}
+	// Gauges by time select locks on their start time, hence they can not share the cache of locks by denom.
+	if gauge.DistributeTo.LockQueryType == lockuptypes.ByTime {
+		return k.getLocksByTimeCondition(ctx, gauge.DistributeTo)
+	}
 	// Confusingly, there is no way to get all synthetic lockups. Thus we use a separate method `distributeSyntheticInternal` to separately get lockSum for synthetic lockups.
-	// All gauges have a precondition of being ByDuration.
+	// Gauges by time have a precondition of not distributing to synthetic lockups.
 	distributeBaseDenom := lockuptypes.NativeDenom(gauge.DistributeTo.Denom)
 	if _, ok := cache[distributeBaseDenom]; !ok {
 		cache[distributeBaseDenom] = k.getLocksToDistributionWithMaxDuration(
@@ -339,16 +446,36 @@ func (k Keeper) getDistributeToBaseLocks(ctx sdk.Context, gauge types.Gauge, cac
 }
 
 // Distribute distributes coins from an array of gauges to all eligible locks.
+// When distribution is lazy, gauges distributing to locks of a native denom by duration accrue their coins
+// to reward indexes instead, which the lock owners claim.
 func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, error) {
 	distrInfo := newDistributionInfo()
+	lazyDistribution := k.GetParams(ctx).LazyDistribution
 
 	locksByDenomCache := make(map[string][]lockuptypes.PeriodLock)
 	totalDistributedCoins := sdk.Coins{}
 	for _, gauge := range gauges {
-		filteredLocks := k.getDistributeToBaseLocks(ctx, gauge, locksByDenomCache)
-		// send based on synthetic lockup coins if it's distributing to synthetic lockups
 		var gaugeDistributedCoins sdk.Coins
 		var err error
+		if lazyDistribution && gauge.IsLazyGauge() {
+			gaugeDistributedCoins, err = k.accrueGaugeRewards(ctx, gauge)
+			if err != nil {
+				return nil, err
+			}
+			totalDistributedCoins = totalDistributedCoins.Add(gaugeDistributedCoins...)
+			continue
+		}
+		if gauge.IsPositionsGauge() {
+			gaugeDistributedCoins, err = k.distributeToPositions(ctx, gauge, &distrInfo)
+			if err != nil {
+				return nil, err
+			}
+			totalDistributedCoins = totalDistributedCoins.Add(gaugeDistributedCoins...)
+			continue
+		}
+
+		filteredLocks := k.getDistributeToBaseLocks(ctx, gauge, locksByDenomCache)
+		// send based on synthetic lockup coins if it's distributing to synthetic lockups
 		if lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom) {
 			gaugeDistributedCoins, err = k.distributeSyntheticInternal(ctx, gauge, filteredLocks, &distrInfo)
 		} else {
@@ -364,6 +491,9 @@ func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, er
 	if err != nil {
 		return nil, err
 	}
+	if err := k.recordRewards(ctx, &distrInfo); err != nil {
+		return nil, err
+	}
 	k.hooks.AfterEpochDistribution(ctx)
 
 	k.checkFinishDistribution(ctx, gauges)
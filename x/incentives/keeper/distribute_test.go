@@ -176,6 +176,131 @@ func (suite *KeeperTestSuite) TestSyntheticDistribute() {
 	}
 }
 
+// TestDistributeByTime tests that gauges by time only distribute rewards
+// to the locks started before (or after) the gauge's lock start timestamp.
+func (suite *KeeperTestSuite) TestDistributeByTime() {
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}
+	tests := []struct {
+		name            string
+		startedAfter    bool
+		withDuration    time.Duration
+		topUp           bool
+		expectedRewards []sdk.Coins
+	}{
+		{
+			name:            "gauge distributing to locks started before the timestamp",
+			expectedRewards: []sdk.Coins{{sdk.NewInt64Coin(defaultRewardDenom, 1000)}, {sdk.NewInt64Coin(defaultRewardDenom, 2000)}, {}},
+		},
+		{
+			name:            "gauge distributing to locks started after the timestamp",
+			startedAfter:    true,
+			expectedRewards: []sdk.Coins{{}, {}, {sdk.NewInt64Coin(defaultRewardDenom, 3000)}},
+		},
+		{
+			name:            "gauge distributing to locks started before the timestamp with a minimum duration",
+			withDuration:    2 * defaultLockDuration,
+			expectedRewards: []sdk.Coins{{}, {sdk.NewInt64Coin(defaultRewardDenom, 3000)}, {}},
+		},
+		{
+			name:            "gauge distributing to locks started before the timestamp excludes locks topped up afterwards",
+			topUp:           true,
+			expectedRewards: []sdk.Coins{{}, {sdk.NewInt64Coin(defaultRewardDenom, 3000)}, {}},
+		},
+	}
+	for _, tc := range tests {
+		suite.SetupTest()
+		// early supporters lock at the timestamp, another user locks afterwards
+		timestamp := suite.Ctx.BlockTime()
+		addrs := suite.SetupUserLocks([]userLocks{oneLockupUser, twoLockupUser})
+		suite.Ctx = suite.Ctx.WithBlockTime(timestamp.Add(time.Hour))
+		lateAddr := sdk.AccAddress([]byte("addr-late-----------"))
+		suite.LockTokens(lateAddr, defaultLPTokens, defaultLockDuration)
+		addrs = append(addrs, lateAddr)
+		if tc.topUp {
+			suite.FundAcc(addrs[0], defaultLPTokens)
+			_, err := suite.App.LockupKeeper.AddToExistingLock(suite.Ctx, addrs[0], defaultLPTokens[0], defaultLockDuration)
+			suite.Require().NoError(err)
+		}
+
+		distrTo := lockuptypes.QueryCondition{
+			LockQueryType: lockuptypes.ByTime,
+			Denom:         defaultLPDenom,
+			Duration:      tc.withDuration,
+			Timestamp:     timestamp,
+			StartedAfter:  tc.startedAfter,
+		}
+		gaugeCreator := sdk.AccAddress([]byte("addr-gauge----------"))
+		_, gauge := suite.CreateGauge(true, gaugeCreator, rewards, distrTo, suite.Ctx.BlockTime(), 1)
+
+		// distribute along with a gauge by duration for the same denom, which should not affect the time gauge
+		durationGauges := suite.SetupGauges([]perpGaugeDesc{{lockDenom: defaultLPDenom, lockDuration: defaultLockDuration, rewardAmount: defaultLiquidTokens}}, defaultLPDenom)
+		_, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, append(durationGauges, *gauge))
+		suite.Require().NoError(err)
+
+		// check expected rewards against actual rewards received
+		for i, addr := range addrs {
+			bal := suite.App.BankKeeper.GetBalance(suite.Ctx, addr, defaultRewardDenom)
+			suite.Require().Equal(tc.expectedRewards[i].AmountOf(defaultRewardDenom).String(), bal.Amount.String(), "test %v, person %d", tc.name, i)
+		}
+	}
+}
+
+// TestDistributeToPositions tests that gauges weighting by liquidity distribute to the positions
+// in range of a concentrated liquidity pool's current tick, pro-rata to their liquidity.
+func (suite *KeeperTestSuite) TestDistributeToPositions() {
+	suite.SetupTest()
+	poolId := suite.PrepareConcentratedPoolWithCoins(sdk.NewInt64Coin("bar", 1_000_000), sdk.NewInt64Coin("foo", 1_000_000))
+
+	// two positions in range of the current tick, and a range order above it
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr-position-1-----")),
+		sdk.AccAddress([]byte("addr-position-2-----")),
+		sdk.AccAddress([]byte("addr-range-order----")),
+	}
+	positions := []struct {
+		lowerTick, upperTick int64
+		amount0, amount1     int64
+	}{
+		{-1000, 1000, 1_000_000, 1_000_000},
+		{-1000, 1000, 3_000_000, 3_000_000},
+		{2000, 3000, 1_000_000, 0},
+	}
+	for i, position := range positions {
+		suite.FundAcc(addrs[i], sdk.NewCoins(sdk.NewInt64Coin("bar", position.amount0), sdk.NewInt64Coin("foo", position.amount1)))
+		_, _, _, err := suite.App.GAMMKeeper.CreatePosition(suite.Ctx, addrs[i], poolId, position.lowerTick, position.upperTick,
+			sdk.NewInt64Coin("bar", position.amount0), sdk.NewInt64Coin("foo", position.amount1), sdk.ZeroInt(), sdk.ZeroInt())
+		suite.Require().NoError(err)
+	}
+
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 4000)}
+	gaugeCreator := sdk.AccAddress([]byte("addr-gauge----------"))
+	suite.FundAcc(gaugeCreator, rewards)
+	distrToPool := types.PoolDistribution{PoolId: poolId, Weighting: types.ByLiquidity}
+	gaugeID, err := suite.App.IncentivesKeeper.CreatePoolGauge(suite.Ctx, false, gaugeCreator, rewards, distrToPool, 0, suite.Ctx.BlockTime(), 2)
+	suite.Require().NoError(err)
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+
+	distributed, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2000)}, distributed)
+
+	expectedRewards := []int64{500, 1500, 0}
+	for i, addr := range addrs {
+		bal := suite.App.BankKeeper.GetBalance(suite.Ctx, addr, defaultRewardDenom)
+		suite.Require().Equal(expectedRewards[i], bal.Amount.Int64(), "position %d", i)
+	}
+	// the full range position of the pool's shares is not rewarded
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, pool.GetAddress(), defaultRewardDenom).IsZero())
+
+	// locks of the pool's shares are not estimated any rewards from the gauge
+	lockOwner := sdk.AccAddress([]byte("addr-lock-----------"))
+	suite.LockTokens(lockOwner, sdk.Coins{sdk.NewInt64Coin("gamm/pool/1", 100)}, defaultLockDuration)
+	suite.Require().True(suite.App.IncentivesKeeper.GetRewardsEst(suite.Ctx, lockOwner, nil, 100).Empty())
+}
+
 // TestGetModuleToDistributeCoins tests the sum of coins yet to be distributed for all of the module is correct.
 func (suite *KeeperTestSuite) TestGetModuleToDistributeCoins() {
 	suite.SetupTest()
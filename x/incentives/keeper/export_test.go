@@ -40,3 +40,13 @@ func (k Keeper) MoveActiveGaugeToFinishedGauge(ctx sdk.Context, gauge types.Gaug
 func (k Keeper) ChargeFeeIfSufficientFeeDenomBalance(ctx sdk.Context, address sdk.AccAddress, fee sdk.Int, gaugeCoins sdk.Coins) error {
 	return k.chargeFeeIfSufficientFeeDenomBalance(ctx, address, fee, gaugeCoins)
 }
+
+// PruneRewardRecords removes the reward records of the epochs that are no longer retained as of the current epoch.
+func (k Keeper) PruneRewardRecords(ctx sdk.Context) {
+	k.pruneRewardRecords(ctx)
+}
+
+// DeleteLockRewardCheckpoint removes the reward checkpoint of the lock.
+func (k Keeper) DeleteLockRewardCheckpoint(ctx sdk.Context, lockID uint64) {
+	k.deleteLockRewardCheckpoint(ctx, lockID)
+}
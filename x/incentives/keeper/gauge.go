@@ -12,6 +12,7 @@ import (
 	db "github.com/tendermint/tm-db"
 
 	epochtypes "github.com/osmosis-labs/osmosis/v12/x/epochs/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v12/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
 
@@ -93,18 +94,8 @@ func (k Keeper) SetGaugeWithRefKey(ctx sdk.Context, gauge *types.Gauge) error {
 // CreateGauge creates a gauge and sends coins to the gauge.
 func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64) (uint64, error) {
 	// Ensure that this gauge's duration is one of the allowed durations on chain
-	durations := k.GetLockableDurations(ctx)
-	if distrTo.LockQueryType == lockuptypes.ByDuration {
-		durationOk := false
-		for _, duration := range durations {
-			if duration == distrTo.Duration {
-				durationOk = true
-				break
-			}
-		}
-		if !durationOk {
-			return 0, fmt.Errorf("invalid duration: %d", distrTo.Duration)
-		}
+	if distrTo.LockQueryType == lockuptypes.ByDuration && !k.isLockableDuration(ctx, distrTo.Duration) {
+		return 0, fmt.Errorf("invalid duration: %d", distrTo.Duration)
 	}
 
 	// Ensure that the denom this gauge pays out to exists on-chain
@@ -112,6 +103,51 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 		return 0, fmt.Errorf("denom does not exist: %s", distrTo.Denom)
 	}
 
+	return k.createGauge(ctx, isPerpetual, owner, coins, distrTo, nil, startTime, numEpochsPaidOver)
+}
+
+// CreatePoolGauge creates a gauge distributing to the liquidity providers of a pool and sends coins to the gauge.
+// Gauges weighting by shares distribute to the locks of the pool's shares that are locked for at least the given duration,
+// while gauges weighting by liquidity distribute to the active positions of a concentrated liquidity pool.
+func (k Keeper) CreatePoolGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrToPool types.PoolDistribution, duration time.Duration, startTime time.Time, numEpochsPaidOver uint64) (uint64, error) {
+	if _, err := k.gk.GetPoolAndPoke(ctx, distrToPool.PoolId); err != nil {
+		return 0, err
+	}
+
+	// the gauge is indexed by the denom of the pool's shares, along with the gauges distributing to its locks
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         gammtypes.GetPoolShareDenom(distrToPool.PoolId),
+	}
+	switch distrToPool.Weighting {
+	case types.ByShares:
+		if !k.isLockableDuration(ctx, duration) {
+			return 0, fmt.Errorf("invalid duration: %d", duration)
+		}
+		distrTo.Duration = duration
+	case types.ByLiquidity:
+		if _, err := k.gk.GetActivePositions(ctx, distrToPool.PoolId); err != nil {
+			return 0, err
+		}
+	default:
+		return 0, fmt.Errorf("invalid pool weighting: %d", distrToPool.Weighting)
+	}
+
+	return k.createGauge(ctx, isPerpetual, owner, coins, distrTo, &distrToPool, startTime, numEpochsPaidOver)
+}
+
+// isLockableDuration returns true if the duration is one of the allowed durations on chain.
+func (k Keeper) isLockableDuration(ctx sdk.Context, duration time.Duration) bool {
+	for _, lockableDuration := range k.GetLockableDurations(ctx) {
+		if lockableDuration == duration {
+			return true
+		}
+	}
+	return false
+}
+
+// createGauge stores a new gauge with the provided parameters, once they have been validated, and sends coins to the gauge.
+func (k Keeper) createGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, distrToPool *types.PoolDistribution, startTime time.Time, numEpochsPaidOver uint64) (uint64, error) {
 	gauge := types.Gauge{
 		Id:                k.GetLastGaugeID(ctx) + 1,
 		IsPerpetual:       isPerpetual,
@@ -119,6 +155,7 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 		Coins:             coins,
 		StartTime:         startTime,
 		NumEpochsPaidOver: numEpochsPaidOver,
+		DistributeToPool:  distrToPool,
 	}
 
 	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, gauge.Coins); err != nil {
@@ -241,6 +278,10 @@ func (k Keeper) GetRewardsEst(ctx sdk.Context, addr sdk.AccAddress, locks []lock
 			if err != nil {
 				return sdk.Coins{}
 			}
+			// gauges distributing to positions don't reward any lock
+			if gauge.IsPositionsGauge() {
+				continue
+			}
 			gauges = append(gauges, *gauge)
 		}
 	}
@@ -255,7 +296,12 @@ func (k Keeper) GetRewardsEst(ctx sdk.Context, addr sdk.AccAddress, locks []lock
 		distrBeginEpoch := epochInfo.CurrentEpoch
 		blockTime := ctx.BlockTime()
 		if gauge.StartTime.After(blockTime) {
-			distrBeginEpoch = epochInfo.CurrentEpoch + 1 + int64(gauge.StartTime.Sub(blockTime)/epochInfo.Duration)
+			distrBeginEpoch = epochInfo.CurrentEpoch + 1
+			// height based epochs have no duration, gauges starting in the future are estimated
+			// to start distributing in the next epoch
+			if epochInfo.Duration > 0 {
+				distrBeginEpoch += int64(gauge.StartTime.Sub(blockTime) / epochInfo.Duration)
+			}
 		}
 
 		for epoch := distrBeginEpoch; epoch <= endEpoch; epoch++ {
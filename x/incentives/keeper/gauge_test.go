@@ -49,6 +49,91 @@ func (suite *KeeperTestSuite) TestNonExistentDenomGaugeCreation() {
 	suite.Require().NoError(err)
 }
 
+// TestCreatePoolGauge tests the creation of gauges distributing to the liquidity providers of a pool.
+func (suite *KeeperTestSuite) TestCreatePoolGauge() {
+	tests := []struct {
+		name          string
+		concentrated  bool
+		poolId        uint64
+		weighting     types.PoolWeighting
+		duration      time.Duration
+		expectErr     bool
+		expectedDistr lockuptypes.QueryCondition
+	}{
+		{
+			name:          "balancer pool by shares",
+			weighting:     types.ByShares,
+			duration:      defaultLockDuration,
+			expectedDistr: lockuptypes.QueryCondition{Denom: "gamm/pool/1", Duration: defaultLockDuration},
+		},
+		{
+			name:      "balancer pool by shares with an invalid duration",
+			weighting: types.ByShares,
+			duration:  defaultLockDuration / 2,
+			expectErr: true,
+		},
+		{
+			name:      "balancer pool by liquidity",
+			weighting: types.ByLiquidity,
+			expectErr: true,
+		},
+		{
+			name:          "concentrated pool by liquidity",
+			concentrated:  true,
+			weighting:     types.ByLiquidity,
+			duration:      defaultLockDuration,
+			expectedDistr: lockuptypes.QueryCondition{Denom: "gamm/pool/1"},
+		},
+		{
+			name:          "concentrated pool by shares",
+			concentrated:  true,
+			weighting:     types.ByShares,
+			duration:      defaultLockDuration,
+			expectedDistr: lockuptypes.QueryCondition{Denom: "gamm/pool/1", Duration: defaultLockDuration},
+		},
+		{
+			name:      "non-existent pool",
+			poolId:    2,
+			weighting: types.ByShares,
+			duration:  defaultLockDuration,
+			expectErr: true,
+		},
+	}
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			if tc.concentrated {
+				suite.PrepareConcentratedPoolWithCoins(sdk.NewInt64Coin("bar", 1_000_000), sdk.NewInt64Coin("foo", 1_000_000))
+			} else {
+				suite.PrepareBalancerPool()
+			}
+			poolId := tc.poolId
+			if poolId == 0 {
+				poolId = 1
+			}
+
+			addr := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+			suite.FundAcc(addr, defaultLiquidTokens)
+			distrToPool := types.PoolDistribution{PoolId: poolId, Weighting: tc.weighting}
+			gaugeID, err := suite.App.IncentivesKeeper.CreatePoolGauge(suite.Ctx, true, addr, defaultLiquidTokens, distrToPool, tc.duration, suite.Ctx.BlockTime(), 1)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedDistr, gauge.DistributeTo)
+			suite.Require().Equal(&distrToPool, gauge.DistributeToPool)
+
+			// the gauge is listed along with the gauges of the pool's shares
+			gaugeIDs := suite.App.IncentivesKeeper.GetAllGaugeIDsByDenom(suite.Ctx, "gamm/pool/1")
+			suite.Require().Contains(gaugeIDs, gaugeID)
+		})
+	}
+}
+
 // TestGaugeOperations tests perpetual and non-perpetual gauge distribution logic using the gauges by denom keeper.
 func (suite *KeeperTestSuite) TestGaugeOperations() {
 	testCases := []struct {
@@ -17,14 +17,40 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, record := range genState.RewardRecords {
+		if err := k.setRewardRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+	for _, index := range genState.RewardIndexes {
+		k.setRewardIndex(ctx, index)
+	}
+	for _, checkpoint := range genState.LockRewardCheckpoints {
+		k.setLockRewardCheckpoint(ctx, checkpoint)
+	}
 }
 
 // ExportGenesis returns the x/incentives module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	rewardRecords, err := k.GetAllRewardRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+	rewardIndexes, err := k.GetAllRewardIndexes(ctx)
+	if err != nil {
+		panic(err)
+	}
+	lockRewardCheckpoints, err := k.GetAllLockRewardCheckpoints(ctx)
+	if err != nil {
+		panic(err)
+	}
 	return &types.GenesisState{
-		Params:            k.GetParams(ctx),
-		LockableDurations: k.GetLockableDurations(ctx),
-		Gauges:            k.GetNotFinishedGauges(ctx),
-		LastGaugeId:       k.GetLastGaugeID(ctx),
+		Params:                k.GetParams(ctx),
+		LockableDurations:     k.GetLockableDurations(ctx),
+		Gauges:                k.GetNotFinishedGauges(ctx),
+		LastGaugeId:           k.GetLastGaugeID(ctx),
+		RewardRecords:         rewardRecords,
+		RewardIndexes:         rewardIndexes,
+		LockRewardCheckpoints: lockRewardCheckpoints,
 	}
 }
@@ -174,6 +174,33 @@ func (q Querier) LockableDurations(ctx context.Context, _ *types.QueryLockableDu
 	return &types.QueryLockableDurationsResponse{LockableDurations: q.Keeper.GetLockableDurations(sdkCtx)}, nil
 }
 
+// RewardHistory returns the rewards an address received from gauges over the retained distribution epochs.
+func (q Querier) RewardHistory(goCtx context.Context, req *types.RewardHistoryRequest) (*types.RewardHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	records := []types.RewardRecord{}
+	pageRes, err := query.Paginate(q.Keeper.rewardRecordsStore(ctx, addr, req.GaugeId), req.Pagination, func(key, value []byte) error {
+		record, err := parseRewardRecord(value)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.RewardHistoryResponse{RewardRecords: records, Pagination: pageRes}, nil
+}
+
 // getGaugeFromIDJsonBytes returns gauges from the json bytes of gaugeIDs.
 func (q Querier) getGaugeFromIDJsonBytes(ctx sdk.Context, refValue []byte) ([]types.Gauge, error) {
 	gauges := []types.Gauge{}
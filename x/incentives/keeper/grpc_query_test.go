@@ -8,6 +8,7 @@ import (
 
 	query "github.com/cosmos/cosmos-sdk/types/query"
 
+	epochtypes "github.com/osmosis-labs/osmosis/v12/x/epochs/types"
 	"github.com/osmosis-labs/osmosis/v12/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
 	pooltypes "github.com/osmosis-labs/osmosis/v12/x/pool-incentives/types"
@@ -350,6 +351,73 @@ func (suite *KeeperTestSuite) TestGRPCRewardsEst() {
 	suite.Require().Equal(res.Coins, coins)
 }
 
+// TestGRPCRewardsEstByTime tests that querying rewards estimation via gRPC only estimates rewards
+// of gauges by time for the locks started before the gauge's lock start timestamp.
+func (suite *KeeperTestSuite) TestGRPCRewardsEstByTime() {
+	suite.SetupTest()
+
+	// an early supporter locks at the timestamp, another user locks afterwards
+	timestamp := suite.Ctx.BlockTime()
+	earlyOwner := sdk.AccAddress([]byte("addr1---------------"))
+	suite.LockTokens(earlyOwner, defaultLPTokens, time.Second)
+	suite.Ctx = suite.Ctx.WithBlockTime(timestamp.Add(time.Hour))
+	lateOwner := sdk.AccAddress([]byte("addr2---------------"))
+	suite.LockTokens(lateOwner, defaultLPTokens, time.Second)
+
+	// setup a gauge by time, distributing to locks started at or before the timestamp
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByTime,
+		Denom:         defaultLPDenom,
+		Timestamp:     timestamp,
+	}
+	suite.CreateGauge(false, sdk.AccAddress([]byte("addr3---------------")), coins, distrTo, suite.Ctx.BlockTime(), 2)
+
+	// the early supporter is the only lock the gauge is paying out to, so the future rewards should equal the entirety of the gauge
+	res, err := suite.querier.RewardsEst(sdk.WrapSDKContext(suite.Ctx), &types.RewardsEstRequest{
+		Owner:    earlyOwner.String(),
+		EndEpoch: 100,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins, res.Coins)
+
+	// the late lock does not qualify for the gauge
+	res, err = suite.querier.RewardsEst(sdk.WrapSDKContext(suite.Ctx), &types.RewardsEstRequest{
+		Owner:    lateOwner.String(),
+		EndEpoch: 100,
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Coins.Empty())
+}
+
+// TestGRPCRewardsEstHeightBasedEpochs tests that rewards of gauges starting in the future are estimated
+// when the distribution epoch is height based, and has no duration.
+func (suite *KeeperTestSuite) TestGRPCRewardsEstHeightBasedEpochs() {
+	suite.SetupTest()
+	err := suite.App.EpochsKeeper.AddEpochInfo(suite.Ctx, epochtypes.NewGenesisHeightEpochInfo("blocks", 100))
+	suite.Require().NoError(err)
+	params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
+	params.DistrEpochIdentifier = "blocks"
+	suite.App.IncentivesKeeper.SetParams(suite.Ctx, params)
+
+	lockOwner := sdk.AccAddress([]byte("addr1---------------"))
+	suite.LockTokens(lockOwner, defaultLPTokens, time.Second)
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         defaultLPDenom,
+		Duration:      time.Second,
+	}
+	suite.CreateGauge(false, sdk.AccAddress([]byte("addr2---------------")), coins, distrTo, suite.Ctx.BlockTime().Add(time.Hour), 2)
+
+	res, err := suite.querier.RewardsEst(sdk.WrapSDKContext(suite.Ctx), &types.RewardsEstRequest{
+		Owner:    lockOwner.String(),
+		EndEpoch: 100,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins, res.Coins)
+}
+
 // TestRewardsEstWithPoolIncentives tests querying rewards estimation at a future specific time (by epoch) via gRPC returns the correct response.
 // Also changes distribution records for the pool incentives to distribute to the respective lock owner.
 func (suite *KeeperTestSuite) TestRewardsEstWithPoolIncentives() {
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v12/osmoutils"
 	epochstypes "github.com/osmosis-labs/osmosis/v12/x/epochs/types"
 	"github.com/osmosis-labs/osmosis/v12/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
@@ -44,6 +47,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		if err != nil {
 			return err
 		}
+		k.pruneRewardRecords(ctx)
 	}
 	return nil
 }
@@ -55,7 +59,10 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ epochstypes.EpochHooks = Hooks{}
+var (
+	_ epochstypes.EpochHooks  = Hooks{}
+	_ lockuptypes.LockupHooks = Hooks{}
+)
 
 // Hooks returns the hook wrapper struct.
 func (k Keeper) Hooks() Hooks {
@@ -71,3 +78,118 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	return h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+// lockup hooks
+// The rewards a lock accrued are settled before its shares change, see settleLockRewards.
+
+// OnTokenLocked checkpoints the rewards of new locks.
+// Tokens added to existing locks are settled by AfterAddTokensToLock, which knows the amount added.
+func (h Hooks) OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	if _, found := h.k.getLockRewardCheckpoint(ctx, lockID); found {
+		return
+	}
+	lock, err := h.k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		h.k.Logger(ctx).Error(err.Error())
+		return
+	}
+	// locks without a checkpoint that did not start in this block existed before rewards first accrued
+	if lock.StartTime.Equal(ctx.BlockTime()) {
+		h.k.setLockRewardCheckpoint(ctx, h.k.newLockRewardCheckpoint(ctx, lockID, amount, lockDuration))
+	}
+}
+
+// AfterAddTokensToLock settles the rewards the lock accrued before the tokens were added.
+func (h Hooks) AfterAddTokensToLock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins) {
+	lock, err := h.k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		h.k.Logger(ctx).Error(err.Error())
+		return
+	}
+	h.settleLockRewards(ctx, address, lockID, lock.Coins.Sub(amount), lock.Duration, lock.Coins, lock.Duration)
+}
+
+// OnStartUnlock does nothing, as unlocking locks accrue rewards until they are unlocked.
+func (h Hooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+}
+
+// OnTokenUnlocked settles the rewards of the unlocked lock and removes its checkpoint.
+func (h Hooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.settleLockRewards(ctx, address, lockID, amount, lockDuration, sdk.Coins{}, lockDuration)
+}
+
+// OnTokenSlashed settles the rewards the lock accrued before it was slashed.
+func (h Hooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins) {
+	lock, err := h.k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		h.k.Logger(ctx).Error(err.Error())
+		return
+	}
+	h.settleLockRewards(ctx, lock.OwnerAddress(), lockID, lock.Coins.Add(amount...), lock.Duration, lock.Coins, lock.Duration)
+}
+
+// OnLockupExtend settles the rewards the lock accrued for its previous duration.
+func (h Hooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration, newDuration time.Duration) {
+	lock, err := h.k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		h.k.Logger(ctx).Error(err.Error())
+		return
+	}
+	h.settleLockRewards(ctx, lock.OwnerAddress(), lockID, lock.Coins, prevDuration, lock.Coins, newDuration)
+}
+
+// OnLockSplit settles the rewards the lock accrued before it was split, and checkpoints the lock split from it.
+func (h Hooks) OnLockSplit(ctx sdk.Context, lockID uint64, splitLockID uint64, amount sdk.Coins) {
+	lock, err := h.k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		h.k.Logger(ctx).Error(err.Error())
+		return
+	}
+	h.settleLockRewards(ctx, lock.OwnerAddress(), lockID, lock.Coins.Add(amount...), lock.Duration, lock.Coins, lock.Duration)
+	h.k.setLockRewardCheckpoint(ctx, h.k.newLockRewardCheckpoint(ctx, splitLockID, amount, lock.Duration))
+}
+
+// OnLockMerge settles the rewards of the lock merged into another lock and removes its checkpoint,
+// then settles the rewards the other lock accrued before the coins were added to it.
+func (h Hooks) OnLockMerge(ctx sdk.Context, lockID uint64, mergedLockID uint64, amount sdk.Coins) {
+	mergedLock, err := h.k.lk.GetLockByID(ctx, mergedLockID)
+	if err != nil {
+		h.k.Logger(ctx).Error(err.Error())
+		return
+	}
+	owner := mergedLock.OwnerAddress()
+	h.settleLockRewards(ctx, owner, lockID, amount, mergedLock.Duration, sdk.Coins{}, mergedLock.Duration)
+	h.settleLockRewards(ctx, owner, mergedLockID, mergedLock.Coins.Sub(amount), mergedLock.Duration, mergedLock.Coins, mergedLock.Duration)
+}
+
+// OnLockTransfer sends the rewards the lock accrued before it was transferred to its previous owner.
+func (h Hooks) OnLockTransfer(ctx sdk.Context, lockID uint64, prevOwner, newOwner sdk.AccAddress) {
+	lock, err := h.k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		h.k.Logger(ctx).Error(err.Error())
+		return
+	}
+	h.settleLockRewards(ctx, prevOwner, lockID, lock.Coins, lock.Duration, lock.Coins, lock.Duration)
+}
+
+// settleLockRewards sends the rewards the lock accrued on its previous shares to the owner, and checkpoints
+// its current shares. As hooks can not fail, if the rewards can not be sent, the error is logged and the lock
+// is checkpointed all the same, forfeiting the rewards rather than accruing on shares it no longer has.
+func (h Hooks) settleLockRewards(
+	ctx sdk.Context, owner sdk.AccAddress, lockID uint64,
+	prevShares sdk.Coins, prevDuration time.Duration, shares sdk.Coins, duration time.Duration,
+) {
+	err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		_, err := h.k.updateLockRewards(cacheCtx, owner, lockID, prevShares, prevDuration, shares, duration)
+		return err
+	})
+	if err == nil {
+		return
+	}
+	h.k.Logger(ctx).Error("failed to settle lock rewards", "lock_id", lockID, "error", err)
+	if shares.Empty() {
+		h.k.deleteLockRewardCheckpoint(ctx, lockID)
+		return
+	}
+	h.k.setLockRewardCheckpoint(ctx, h.k.newLockRewardCheckpoint(ctx, lockID, shares, duration))
+}
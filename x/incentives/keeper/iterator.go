@@ -72,3 +72,18 @@ func FilterLocksByMinDuration(locks []lockuptypes.PeriodLock, minDuration time.D
 	}
 	return filteredLocks
 }
+
+// filterLocksByIDs returns locks that are also present in qualifiedLocks, preserving the order of locks.
+func filterLocksByIDs(locks []lockuptypes.PeriodLock, qualifiedLocks []lockuptypes.PeriodLock) []lockuptypes.PeriodLock {
+	qualifiedIDs := make(map[uint64]bool, len(qualifiedLocks))
+	for _, lock := range qualifiedLocks {
+		qualifiedIDs[lock.ID] = true
+	}
+	filteredLocks := make([]lockuptypes.PeriodLock, 0, len(locks))
+	for _, lock := range locks {
+		if qualifiedIDs[lock.ID] {
+			filteredLocks = append(filteredLocks, lock)
+		}
+	}
+	return filteredLocks
+}
@@ -20,13 +20,14 @@ type Keeper struct {
 	hooks      types.IncentiveHooks
 	bk         types.BankKeeper
 	lk         types.LockupKeeper
+	gk         types.GAMMKeeper
 	ek         types.EpochKeeper
 	ck         types.CommunityPoolKeeper
 	tk         types.TxFeesKeeper
 }
 
 // NewKeeper returns a new instance of the incentive module keeper struct.
-func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, bk types.BankKeeper, lk types.LockupKeeper, ek types.EpochKeeper, ck types.CommunityPoolKeeper, txfk types.TxFeesKeeper) *Keeper {
+func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, bk types.BankKeeper, lk types.LockupKeeper, gk types.GAMMKeeper, ek types.EpochKeeper, ck types.CommunityPoolKeeper, txfk types.TxFeesKeeper) *Keeper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
@@ -36,6 +37,7 @@ func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, bk types.B
 		paramSpace: paramSpace,
 		bk:         bk,
 		lk:         lk,
+		gk:         gk,
 		ek:         ek,
 		ck:         ck,
 		tk:         txfk,
@@ -37,7 +37,12 @@ func (server msgServer) CreateGauge(goCtx context.Context, msg *types.MsgCreateG
 		return nil, err
 	}
 
-	gaugeID, err := server.keeper.CreateGauge(ctx, msg.IsPerpetual, owner, msg.Coins, msg.DistributeTo, msg.StartTime, msg.NumEpochsPaidOver)
+	var gaugeID uint64
+	if msg.DistributeToPool != nil {
+		gaugeID, err = server.keeper.CreatePoolGauge(ctx, msg.IsPerpetual, owner, msg.Coins, *msg.DistributeToPool, msg.DistributeTo.Duration, msg.StartTime, msg.NumEpochsPaidOver)
+	} else {
+		gaugeID, err = server.keeper.CreateGauge(ctx, msg.IsPerpetual, owner, msg.Coins, msg.DistributeTo, msg.StartTime, msg.NumEpochsPaidOver)
+	}
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -78,3 +83,20 @@ func (server msgServer) AddToGauge(goCtx context.Context, msg *types.MsgAddToGau
 
 	return &types.MsgAddToGaugeResponse{}, nil
 }
+
+// ClaimRewards sends the rewards accrued by the owner's locks to the owner.
+// Emits claim rewards events and returns the claimed rewards.
+func (server msgServer) ClaimRewards(goCtx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, err := server.keeper.ClaimRewards(ctx, owner, msg.LockIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimRewardsResponse{Rewards: rewards}, nil
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/osmosis-labs/osmosis/v12/osmoutils"
+	"github.com/osmosis-labs/osmosis/v12/x/incentives/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Reward records are stored by address, gauge ID and epoch, so that the reward history of an address
+// can be iterated over, optionally for a single gauge. The records of lazily distributed rewards, which have
+// no gauge, are further keyed by the denom and duration of the locks they accrued to. Records are also indexed
+// by epoch, so that the records of epochs that are no longer retained can be pruned without iterating over
+// every record.
+
+// rewardRecordsAddressPrefix returns the prefix of the reward records of the provided address.
+func rewardRecordsAddressPrefix(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, types.KeyPrefixRewardRecords...), address.MustLengthPrefix(addr)...)
+}
+
+// rewardRecordKey returns the store key of the reward record of the provided address, gauge and epoch,
+// and of the provided lock denom and duration for lazily distributed rewards.
+func rewardRecordKey(addr sdk.AccAddress, gaugeID uint64, epoch int64, denom string, duration time.Duration) []byte {
+	key := append(rewardRecordsAddressPrefix(addr), sdk.Uint64ToBigEndian(gaugeID)...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(epoch))...)
+	if denom == "" {
+		return key
+	}
+	key = append(key, address.MustLengthPrefix([]byte(denom))...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(duration))...)
+}
+
+// rewardRecordEpochKey returns the key indexing the reward record stored at the provided key by its epoch.
+func rewardRecordEpochKey(epoch int64, recordKey []byte) []byte {
+	key := append(append([]byte{}, types.KeyPrefixRewardRecordsByEpoch...), sdk.Uint64ToBigEndian(uint64(epoch))...)
+	return append(key, recordKey[len(types.KeyPrefixRewardRecords):]...)
+}
+
+// parseRewardRecordEpochKey returns the key of the reward record indexed by an epoch index key.
+func parseRewardRecordEpochKey(key []byte) []byte {
+	return append(append([]byte{}, types.KeyPrefixRewardRecords...), key[len(types.KeyPrefixRewardRecordsByEpoch)+8:]...)
+}
+
+// setRewardRecord stores the reward record and indexes it by its epoch.
+func (k Keeper) setRewardRecord(ctx sdk.Context, record types.RewardRecord) error {
+	addr, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := rewardRecordKey(addr, record.GaugeId, record.EpochNumber, record.Denom, record.Duration)
+	osmoutils.MustSet(store, key, &record)
+	store.Set(rewardRecordEpochKey(record.EpochNumber, key), []byte{})
+	return nil
+}
+
+// recordRewards stores the rewards distributed in the epoch, if the reward history is enabled.
+func (k Keeper) recordRewards(ctx sdk.Context, distrs *distributionInfo) error {
+	if k.GetParams(ctx).RewardHistoryRetentionEpochs == 0 {
+		return nil
+	}
+	epoch := k.GetEpochInfo(ctx).CurrentEpoch
+	for _, record := range distrs.rewardRecords {
+		record.EpochNumber = epoch
+		if err := k.setRewardRecord(ctx, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordSettledRewards adds the lazily distributed rewards settled to the owner of locks of the provided denom
+// and duration to the owner's reward record of the current epoch, if the reward history is enabled.
+func (k Keeper) recordSettledRewards(ctx sdk.Context, owner sdk.AccAddress, denom string, duration time.Duration, rewards sdk.Coins) error {
+	if k.GetParams(ctx).RewardHistoryRetentionEpochs == 0 {
+		return nil
+	}
+	epoch := k.GetEpochInfo(ctx).CurrentEpoch
+	record := types.RewardRecord{Address: owner.String(), EpochNumber: epoch, Denom: denom, Duration: duration, Rewards: sdk.Coins{}}
+	store := ctx.KVStore(k.storeKey)
+	key := rewardRecordKey(owner, 0, epoch, denom, duration)
+	if store.Has(key) {
+		osmoutils.MustGet(store, key, &record)
+	}
+	record.Rewards = record.Rewards.Add(rewards...)
+	return k.setRewardRecord(ctx, record)
+}
+
+// pruneRewardRecords removes the reward records of the epochs that are no longer retained as of the current epoch.
+// All records are removed when the reward history is disabled.
+func (k Keeper) pruneRewardRecords(ctx sdk.Context) {
+	currentEpoch := k.GetEpochInfo(ctx).CurrentEpoch
+	// records of epochs before this one are pruned
+	pruneBefore := currentEpoch + 1 - int64(k.GetParams(ctx).RewardHistoryRetentionEpochs)
+	if pruneBefore <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	end := append(append([]byte{}, types.KeyPrefixRewardRecordsByEpoch...), sdk.Uint64ToBigEndian(uint64(pruneBefore))...)
+	iter := store.Iterator(types.KeyPrefixRewardRecordsByEpoch, end)
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(parseRewardRecordEpochKey(key))
+		store.Delete(key)
+	}
+}
+
+// GetRewardRecords returns the reward history of the provided address, optionally restricted to a gauge,
+// ordered by gauge ID and epoch.
+func (k Keeper) GetRewardRecords(ctx sdk.Context, addr sdk.AccAddress, gaugeID uint64) ([]types.RewardRecord, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), rewardRecordsPrefix(addr, gaugeID), parseRewardRecord)
+}
+
+// GetAllRewardRecords returns the reward history of all addresses.
+func (k Keeper) GetAllRewardRecords(ctx sdk.Context) ([]types.RewardRecord, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPrefixRewardRecords, parseRewardRecord)
+}
+
+// rewardRecordsStore returns the store of the reward records of the provided address, restricted to a gauge if set.
+func (k Keeper) rewardRecordsStore(ctx sdk.Context, addr sdk.AccAddress, gaugeID uint64) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), rewardRecordsPrefix(addr, gaugeID))
+}
+
+// rewardRecordsPrefix returns the prefix of the reward records of the provided address, restricted to a gauge if set.
+func rewardRecordsPrefix(addr sdk.AccAddress, gaugeID uint64) []byte {
+	keyPrefix := rewardRecordsAddressPrefix(addr)
+	if gaugeID != 0 {
+		keyPrefix = append(keyPrefix, sdk.Uint64ToBigEndian(gaugeID)...)
+	}
+	return keyPrefix
+}
+
+func parseRewardRecord(bz []byte) (types.RewardRecord, error) {
+	record := types.RewardRecord{}
+	err := record.Unmarshal(bz)
+	return record, err
+}
@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/v12/x/incentives/keeper"
+	"github.com/osmosis-labs/osmosis/v12/x/incentives/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setDistrEpoch sets the current epoch of the distribution epoch identifier.
+func (suite *KeeperTestSuite) setDistrEpoch(epoch int64) {
+	epochInfo := suite.App.IncentivesKeeper.GetEpochInfo(suite.Ctx)
+	suite.App.EpochsKeeper.DeleteEpochInfo(suite.Ctx, epochInfo.Identifier)
+	epochInfo.CurrentEpoch = epoch
+	suite.Require().NoError(suite.App.EpochsKeeper.AddEpochInfo(suite.Ctx, epochInfo))
+}
+
+// setRewardHistoryRetention sets the number of epochs the reward history is retained for.
+func (suite *KeeperTestSuite) setRewardHistoryRetention(epochs uint64) {
+	params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
+	params.RewardHistoryRetentionEpochs = epochs
+	suite.App.IncentivesKeeper.SetParams(suite.Ctx, params)
+}
+
+// TestRewardHistory tests that the rewards of each address are recorded per gauge and epoch,
+// can be queried, and are pruned once their epoch is no longer retained.
+func (suite *KeeperTestSuite) TestRewardHistory() {
+	suite.SetupTest()
+	suite.setRewardHistoryRetention(2)
+	querier := suite.querier
+
+	// gauge 1 rewards the three locks, gauge 2 only the longer lock of the second user
+	addrs := suite.SetupUserLocks([]userLocks{oneLockupUser, twoLockupUser})
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}
+	gauges := suite.SetupGauges([]perpGaugeDesc{
+		{lockDenom: defaultLPDenom, lockDuration: defaultLockDuration, rewardAmount: rewards},
+		{lockDenom: defaultLPDenom, lockDuration: 2 * defaultLockDuration, rewardAmount: rewards},
+	}, defaultLPDenom)
+
+	suite.setDistrEpoch(1)
+	_, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, gauges)
+	suite.Require().NoError(err)
+
+	// the rewards of the two locks of the second user from gauge 1 are recorded together
+	records, err := suite.App.IncentivesKeeper.GetRewardRecords(suite.Ctx, addrs[1], 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.RewardRecord{
+		{Address: addrs[1].String(), GaugeId: gauges[0].Id, EpochNumber: 1, Rewards: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2000)}},
+		{Address: addrs[1].String(), GaugeId: gauges[1].Id, EpochNumber: 1, Rewards: rewards},
+	}, records)
+
+	// gauge 1 is refilled and distributes again in the next epoch
+	suite.AddToGauge(rewards, gauges[0].Id)
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gauges[0].Id)
+	suite.Require().NoError(err)
+	suite.setDistrEpoch(2)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+
+	res, err := querier.RewardHistory(sdk.WrapSDKContext(suite.Ctx), &types.RewardHistoryRequest{Address: addrs[0].String(), GaugeId: gauges[0].Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.RewardRecord{
+		{Address: addrs[0].String(), GaugeId: gauges[0].Id, EpochNumber: 1, Rewards: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}},
+		{Address: addrs[0].String(), GaugeId: gauges[0].Id, EpochNumber: 2, Rewards: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}},
+	}, res.RewardRecords)
+
+	// the history is paginated
+	res, err = querier.RewardHistory(sdk.WrapSDKContext(suite.Ctx), &types.RewardHistoryRequest{Address: addrs[1].String(), Pagination: &query.PageRequest{Limit: 2}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RewardRecords, 2)
+	suite.Require().Equal(int64(2), res.RewardRecords[1].EpochNumber)
+	res, err = querier.RewardHistory(sdk.WrapSDKContext(suite.Ctx), &types.RewardHistoryRequest{Address: addrs[1].String(), Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RewardRecords, 1)
+	suite.Require().Equal(gauges[1].Id, res.RewardRecords[0].GaugeId)
+
+	// the history is exported and imported along with the gauges
+	genesis := suite.App.IncentivesKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Len(genesis.RewardRecords, 5)
+	suite.Require().NoError(genesis.Validate())
+
+	// both epochs are retained at epoch 2, only epoch 2 at epoch 3
+	suite.App.IncentivesKeeper.PruneRewardRecords(suite.Ctx)
+	records, err = suite.App.IncentivesKeeper.GetAllRewardRecords(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 5)
+
+	suite.setDistrEpoch(3)
+	suite.App.IncentivesKeeper.PruneRewardRecords(suite.Ctx)
+	records, err = suite.App.IncentivesKeeper.GetAllRewardRecords(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 2)
+	for _, record := range records {
+		suite.Require().Equal(int64(2), record.EpochNumber)
+	}
+
+	// disabling the reward history prunes all records, and no more rewards are recorded
+	suite.setRewardHistoryRetention(0)
+	suite.App.IncentivesKeeper.PruneRewardRecords(suite.Ctx)
+	suite.AddToGauge(rewards, gauges[0].Id)
+	gauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gauges[0].Id)
+	suite.Require().NoError(err)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	records, err = suite.App.IncentivesKeeper.GetAllRewardRecords(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(records)
+
+	suite.SetupTest()
+	suite.App.IncentivesKeeper.InitGenesis(suite.Ctx, *genesis)
+	records, err = suite.App.IncentivesKeeper.GetRewardRecords(suite.Ctx, addrs[1], gauges[0].Id)
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 2)
+}
+
+// TestLazyRewardHistory tests that lazily distributed rewards are recorded by lock denom and duration
+// when they are claimed or settled, and can be queried.
+func (suite *KeeperTestSuite) TestLazyRewardHistory() {
+	suite.SetupTest()
+	suite.setLazyDistribution(true)
+	suite.setRewardHistoryRetention(2)
+	suite.setDistrEpoch(1)
+	msgServer := keeper.NewMsgServerImpl(suite.App.IncentivesKeeper)
+
+	// gauge 1 rewards the three locks, gauge 2 only the longer lock of the second user
+	addrs := suite.SetupUserLocks([]userLocks{oneLockupUser, twoLockupUser})
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}
+	gauges := suite.SetupGauges([]perpGaugeDesc{
+		{lockDenom: defaultLPDenom, lockDuration: defaultLockDuration, rewardAmount: rewards},
+		{lockDenom: defaultLPDenom, lockDuration: 2 * defaultLockDuration, rewardAmount: rewards},
+	}, defaultLPDenom)
+	locks := suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addrs[1])
+	suite.Require().Len(locks, 2)
+
+	_, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, gauges)
+	suite.Require().NoError(err)
+
+	// nothing is recorded until the rewards are claimed
+	records, err := suite.App.IncentivesKeeper.GetAllRewardRecords(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(records)
+
+	_, err = msgServer.ClaimRewards(sdk.WrapSDKContext(suite.Ctx), types.NewMsgClaimRewards(addrs[1], nil))
+	suite.Require().NoError(err)
+
+	// the rewards settled by the lockup hooks are recorded as well
+	suite.FundAcc(addrs[0], defaultLPTokens)
+	_, err = suite.App.LockupKeeper.AddToExistingLock(suite.Ctx, addrs[0], defaultLPTokens[0], defaultLockDuration)
+	suite.Require().NoError(err)
+	records, err = suite.App.IncentivesKeeper.GetRewardRecords(suite.Ctx, addrs[0], 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.RewardRecord{
+		{Address: addrs[0].String(), EpochNumber: 1, Denom: defaultLPDenom, Duration: defaultLockDuration, Rewards: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}},
+	}, records)
+
+	// claims within the same epoch add up
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{suite.refillGauge(rewards, gauges[1].Id)})
+	suite.Require().NoError(err)
+	_, err = msgServer.ClaimRewards(sdk.WrapSDKContext(suite.Ctx), types.NewMsgClaimRewards(addrs[1], []uint64{locks[1].ID}))
+	suite.Require().NoError(err)
+
+	res, err := suite.querier.RewardHistory(sdk.WrapSDKContext(suite.Ctx), &types.RewardHistoryRequest{Address: addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.RewardRecord{
+		{Address: addrs[1].String(), EpochNumber: 1, Denom: defaultLPDenom, Duration: defaultLockDuration, Rewards: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}},
+		{Address: addrs[1].String(), EpochNumber: 1, Denom: defaultLPDenom, Duration: 2 * defaultLockDuration, Rewards: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 7000)}},
+	}, res.RewardRecords)
+
+	// the records are exported and imported, and pruned with the other records
+	genesis := suite.App.IncentivesKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Len(genesis.RewardRecords, 3)
+	suite.Require().NoError(genesis.Validate())
+	suite.setDistrEpoch(3)
+	suite.App.IncentivesKeeper.PruneRewardRecords(suite.Ctx)
+	records, err = suite.App.IncentivesKeeper.GetAllRewardRecords(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(records)
+
+	suite.SetupTest()
+	suite.App.IncentivesKeeper.InitGenesis(suite.Ctx, *genesis)
+	records, err = suite.App.IncentivesKeeper.GetAllRewardRecords(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genesis.RewardRecords, records)
+}
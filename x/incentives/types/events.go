@@ -5,9 +5,11 @@ const (
 	TypeEvtCreateGauge  = "create_gauge"
 	TypeEvtAddToGauge   = "add_to_gauge"
 	TypeEvtDistribution = "distribution"
+	TypeEvtClaimRewards = "claim_rewards"
 
 	AttributeGaugeID     = "gauge_id"
 	AttributeLockedDenom = "denom"
 	AttributeReceiver    = "receiver"
 	AttributeAmount      = "amount"
+	AttributeLockID      = "lock_id"
 )
@@ -13,6 +13,9 @@ var (
 	CreateGaugeFee = sdk.NewInt(50 * 1_000_000)
 	// AddToGagugeFee is the fee required to add to gauge.
 	AddToGaugeFee = sdk.NewInt(25 * 1_000_000)
+	// RewardIndexPrecision scales the rewards per share of reward indexes,
+	// as locked shares commonly have many more decimals than the reward denoms.
+	RewardIndexPrecision = sdk.NewDec(1_000_000_000_000_000_000)
 )
 
 // NewGauge creates a new gauge struct given the required gauge parameters.
@@ -46,3 +49,17 @@ func (gauge Gauge) IsActiveGauge(curTime time.Time) bool {
 func (gauge Gauge) IsFinishedGauge(curTime time.Time) bool {
 	return !gauge.IsUpcomingGauge(curTime) && !gauge.IsActiveGauge(curTime)
 }
+
+// IsPositionsGauge returns true if the gauge distributes to the positions of a pool rather than to locks.
+func (gauge Gauge) IsPositionsGauge() bool {
+	return gauge.DistributeToPool != nil && gauge.DistributeToPool.Weighting == ByLiquidity
+}
+
+// IsLazyGauge returns true if the gauge accrues its rewards to a reward index when distribution is lazy.
+// Only gauges distributing to locks of a native denom by duration can, as the locked shares they distribute to
+// are those summed by the lockup accumulation store.
+func (gauge Gauge) IsLazyGauge() bool {
+	return gauge.DistributeTo.LockQueryType == lockuptypes.ByDuration &&
+		!lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom) &&
+		!gauge.IsPositionsGauge()
+}
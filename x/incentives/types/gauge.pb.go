@@ -30,6 +30,37 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PoolWeighting is the rule by which the rewards of a gauge distributing to a
+// pool are split among its liquidity providers.
+type PoolWeighting int32
+
+const (
+	// ByShares distributes to the locks of the pool's shares that are locked
+	// for at least the gauge's duration, pro-rata to their share amount.
+	ByShares PoolWeighting = 0
+	// ByLiquidity distributes to the positions of a concentrated liquidity pool
+	// that are in range of its current tick, pro-rata to their liquidity.
+	ByLiquidity PoolWeighting = 1
+)
+
+var PoolWeighting_name = map[int32]string{
+	0: "ByShares",
+	1: "ByLiquidity",
+}
+
+var PoolWeighting_value = map[string]int32{
+	"ByShares":    0,
+	"ByLiquidity": 1,
+}
+
+func (x PoolWeighting) String() string {
+	return proto.EnumName(PoolWeighting_name, int32(x))
+}
+
+func (PoolWeighting) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{0}
+}
+
 // Gauge is an object that stores and distributes yields to recipients who
 // satisfy certain conditions. Currently gauges support conditions around the
 // duration for which a given denom is locked.
@@ -58,6 +89,10 @@ type Gauge struct {
 	FilledEpochs uint64 `protobuf:"varint,7,opt,name=filled_epochs,json=filledEpochs,proto3" json:"filled_epochs,omitempty"`
 	// distributed_coins are coins that have been distributed already
 	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+	// distribute_to_pool is set for gauges that distribute to the liquidity
+	// providers of a pool. distribute_to then refers to the locks of the pool's
+	// shares.
+	DistributeToPool *PoolDistribution `protobuf:"bytes,9,opt,name=distribute_to_pool,json=distributeToPool,proto3" json:"distribute_to_pool,omitempty" yaml:"distribute_to_pool"`
 }
 
 func (m *Gauge) Reset()         { *m = Gauge{} }
@@ -149,6 +184,68 @@ func (m *Gauge) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Gauge) GetDistributeToPool() *PoolDistribution {
+	if m != nil {
+		return m.DistributeToPool
+	}
+	return nil
+}
+
+// PoolDistribution targets the liquidity providers of a pool.
+type PoolDistribution struct {
+	// pool_id is the ID of the pool whose liquidity providers are rewarded
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// weighting is the rule the rewards are split by
+	Weighting PoolWeighting `protobuf:"varint,2,opt,name=weighting,proto3,enum=osmosis.incentives.PoolWeighting" json:"weighting,omitempty"`
+}
+
+func (m *PoolDistribution) Reset()         { *m = PoolDistribution{} }
+func (m *PoolDistribution) String() string { return proto.CompactTextString(m) }
+func (*PoolDistribution) ProtoMessage()    {}
+func (*PoolDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{1}
+}
+func (m *PoolDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolDistribution.Merge(m, src)
+}
+func (m *PoolDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolDistribution proto.InternalMessageInfo
+
+func (m *PoolDistribution) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolDistribution) GetWeighting() PoolWeighting {
+	if m != nil {
+		return m.Weighting
+	}
+	return ByShares
+}
+
 type LockableDurationsInfo struct {
 	// List of incentivised durations that gauges will pay out to
 	LockableDurations []time.Duration `protobuf:"bytes,1,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
@@ -158,7 +255,7 @@ func (m *LockableDurationsInfo) Reset()         { *m = LockableDurationsInfo{} }
 func (m *LockableDurationsInfo) String() string { return proto.CompactTextString(m) }
 func (*LockableDurationsInfo) ProtoMessage()    {}
 func (*LockableDurationsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0304e2bb0159901, []int{1}
+	return fileDescriptor_c0304e2bb0159901, []int{2}
 }
 func (m *LockableDurationsInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,49 +292,59 @@ func (m *LockableDurationsInfo) GetLockableDurations() []time.Duration {
 }
 
 func init() {
+	proto.RegisterEnum("osmosis.incentives.PoolWeighting", PoolWeighting_name, PoolWeighting_value)
 	proto.RegisterType((*Gauge)(nil), "osmosis.incentives.Gauge")
+	proto.RegisterType((*PoolDistribution)(nil), "osmosis.incentives.PoolDistribution")
 	proto.RegisterType((*LockableDurationsInfo)(nil), "osmosis.incentives.LockableDurationsInfo")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/gauge.proto", fileDescriptor_c0304e2bb0159901) }
 
 var fileDescriptor_c0304e2bb0159901 = []byte{
-	// 547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc7, 0x9b, 0xad, 0xdd, 0x6f, 0x3f, 0xb7, 0x43, 0xd4, 0x1a, 0x52, 0x5a, 0x89, 0xb4, 0x14,
-	0x21, 0xf5, 0x32, 0x9b, 0x16, 0x89, 0x03, 0xc7, 0x0e, 0x84, 0x26, 0x21, 0x51, 0xaa, 0x1d, 0x10,
-	0x97, 0xc8, 0x49, 0xdc, 0xcc, 0x6a, 0x92, 0x27, 0x8a, 0x9d, 0x6a, 0x7d, 0x07, 0x1c, 0x27, 0x4e,
-	0xbc, 0x06, 0x5e, 0xc9, 0x8e, 0x3b, 0x72, 0xda, 0x50, 0xfb, 0x0e, 0x78, 0x05, 0x28, 0x76, 0xa2,
-	0x56, 0xe5, 0xca, 0xc9, 0xf1, 0xf3, 0x7d, 0xfe, 0x7d, 0x3f, 0x72, 0x90, 0x03, 0x32, 0x06, 0x29,
-	0x24, 0x15, 0x89, 0xcf, 0x13, 0x25, 0x96, 0x5c, 0xd2, 0x90, 0xe5, 0x21, 0x27, 0x69, 0x06, 0x0a,
-	0x30, 0x2e, 0x75, 0xb2, 0xd5, 0xbb, 0xa7, 0x21, 0x84, 0xa0, 0x65, 0x5a, 0x7c, 0x99, 0xcc, 0xae,
-	0x13, 0x02, 0x84, 0x11, 0xa7, 0xfa, 0xe6, 0xe5, 0x73, 0x1a, 0xe4, 0x19, 0x53, 0x02, 0x92, 0x52,
-	0xef, 0xed, 0xeb, 0x4a, 0xc4, 0x5c, 0x2a, 0x16, 0xa7, 0x55, 0x03, 0x5f, 0xcf, 0xa2, 0x1e, 0x93,
-	0x9c, 0x2e, 0x47, 0x1e, 0x57, 0x6c, 0x44, 0x7d, 0x10, 0x55, 0x83, 0x4e, 0xb5, 0x6a, 0x04, 0xfe,
-	0x22, 0x4f, 0xf5, 0x61, 0xa4, 0xc1, 0xb7, 0x3a, 0x6a, 0xbc, 0x2f, 0xb6, 0xc6, 0x8f, 0xd0, 0x81,
-	0x08, 0x6c, 0xab, 0x6f, 0x0d, 0xeb, 0xb3, 0x03, 0x11, 0xe0, 0x67, 0xa8, 0x25, 0xa4, 0x9b, 0xf2,
-	0x2c, 0xe5, 0x2a, 0x67, 0x91, 0x7d, 0xd0, 0xb7, 0x86, 0xc7, 0xb3, 0xa6, 0x90, 0xd3, 0x2a, 0x84,
-	0x2f, 0xd0, 0x49, 0x20, 0xa4, 0xca, 0x84, 0x97, 0x2b, 0xee, 0x2a, 0xb0, 0x0f, 0xfb, 0xd6, 0xb0,
-	0x39, 0x76, 0x48, 0x65, 0xdd, 0xcc, 0x23, 0x9f, 0x72, 0x9e, 0xad, 0xce, 0x21, 0x09, 0x44, 0xe1,
-	0x6a, 0x52, 0xbf, 0xbd, 0xef, 0xd5, 0x66, 0xad, 0x6d, 0xe9, 0x25, 0x60, 0x86, 0x1a, 0xc5, 0xc2,
-	0xd2, 0xae, 0xf7, 0x0f, 0x87, 0xcd, 0x71, 0x87, 0x18, 0x4b, 0xa4, 0xb0, 0x44, 0x4a, 0x4b, 0xe4,
-	0x1c, 0x44, 0x32, 0x79, 0x59, 0x54, 0xff, 0x78, 0xe8, 0x0d, 0x43, 0xa1, 0xae, 0x72, 0x8f, 0xf8,
-	0x10, 0xd3, 0xd2, 0xbf, 0x39, 0xce, 0x64, 0xb0, 0xa0, 0x6a, 0x95, 0x72, 0xa9, 0x0b, 0xe4, 0xcc,
-	0x74, 0xc6, 0x9f, 0x11, 0x92, 0x8a, 0x65, 0xca, 0x2d, 0xf0, 0xd9, 0x0d, 0xbd, 0x6a, 0x97, 0x18,
-	0xb6, 0xa4, 0x62, 0x4b, 0x2e, 0x2b, 0xb6, 0x93, 0xa7, 0xc5, 0xa0, 0xdf, 0xf7, 0xbd, 0xf6, 0x8a,
-	0xc5, 0xd1, 0x9b, 0xc1, 0xb6, 0x76, 0x70, 0xf3, 0xd0, 0xb3, 0x66, 0xff, 0xeb, 0x40, 0x91, 0x8e,
-	0x29, 0x3a, 0x4d, 0xf2, 0xd8, 0xe5, 0x29, 0xf8, 0x57, 0xd2, 0x4d, 0x99, 0x08, 0x5c, 0x58, 0xf2,
-	0xcc, 0x3e, 0xd2, 0x30, 0xdb, 0x49, 0x1e, 0xbf, 0xd3, 0xd2, 0x94, 0x89, 0xe0, 0xe3, 0x92, 0x67,
-	0xf8, 0x39, 0x3a, 0x99, 0x8b, 0x28, 0xe2, 0x41, 0x59, 0x63, 0xff, 0xa7, 0x33, 0x5b, 0x26, 0x68,
-	0x92, 0xf1, 0x35, 0x6a, 0x6f, 0x11, 0x05, 0xae, 0xc1, 0x73, 0xfc, 0xef, 0xf1, 0x3c, 0xde, 0x99,
-	0xa2, 0x23, 0x83, 0xaf, 0x16, 0x7a, 0xf2, 0x01, 0xfc, 0x05, 0xf3, 0x22, 0xfe, 0xb6, 0x7c, 0x8b,
-	0xf2, 0x22, 0x99, 0x03, 0x06, 0x84, 0xa3, 0x52, 0x70, 0xab, 0x57, 0x2a, 0x6d, 0xab, 0x5c, 0x6a,
-	0x9f, 0x65, 0x55, 0x3b, 0x79, 0x51, 0xa2, 0xec, 0x18, 0x94, 0x7f, 0xb7, 0x18, 0x7c, 0x2f, 0x90,
-	0xb6, 0xa3, 0xfd, 0xa1, 0x93, 0xe9, 0xed, 0xda, 0xb1, 0xee, 0xd6, 0x8e, 0xf5, 0x6b, 0xed, 0x58,
-	0x37, 0x1b, 0xa7, 0x76, 0xb7, 0x71, 0x6a, 0x3f, 0x37, 0x4e, 0xed, 0xcb, 0xeb, 0x1d, 0x83, 0xe5,
-	0x7b, 0x3b, 0x8b, 0x98, 0x27, 0xab, 0x0b, 0x5d, 0x8e, 0xc6, 0xf4, 0x7a, 0xf7, 0xef, 0xd4, 0xa6,
-	0xbd, 0x23, 0xbd, 0xde, 0xab, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x45, 0xcb, 0x05, 0xbe, 0xc0,
-	0x03, 0x00, 0x00,
+	// 683 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x18, 0x8d, 0x21, 0xe1, 0x67, 0x12, 0xb8, 0xc9, 0x88, 0x2b, 0x99, 0x48, 0x38, 0xc1, 0xf7, 0x56,
+	0x8a, 0x5a, 0x31, 0x2e, 0x69, 0xd5, 0x45, 0x37, 0x95, 0x0c, 0x55, 0x85, 0x84, 0xd4, 0x34, 0x45,
+	0x6a, 0xd5, 0x8d, 0x35, 0x8e, 0x07, 0x67, 0x84, 0xed, 0x31, 0x9e, 0x71, 0x4a, 0x76, 0xdd, 0x54,
+	0x62, 0xc9, 0xb2, 0xfb, 0xee, 0xfa, 0x24, 0x2c, 0x59, 0x76, 0x15, 0x2a, 0x78, 0x03, 0x9e, 0xa0,
+	0x9a, 0xb1, 0xad, 0x84, 0xa0, 0xee, 0xba, 0xb2, 0xfd, 0x9d, 0xef, 0x7c, 0x3f, 0x67, 0xce, 0x18,
+	0x18, 0x8c, 0x87, 0x8c, 0x53, 0x6e, 0xd1, 0x68, 0x40, 0x22, 0x41, 0x47, 0x84, 0x5b, 0x3e, 0x4e,
+	0x7d, 0x82, 0xe2, 0x84, 0x09, 0x06, 0x61, 0x8e, 0xa3, 0x29, 0xde, 0xdc, 0xf0, 0x99, 0xcf, 0x14,
+	0x6c, 0xc9, 0xb7, 0x2c, 0xb3, 0x69, 0xf8, 0x8c, 0xf9, 0x01, 0xb1, 0xd4, 0x97, 0x9b, 0x1e, 0x5b,
+	0x5e, 0x9a, 0x60, 0x41, 0x59, 0x94, 0xe3, 0xad, 0x79, 0x5c, 0xd0, 0x90, 0x70, 0x81, 0xc3, 0xb8,
+	0x28, 0x30, 0x50, 0xbd, 0x2c, 0x17, 0x73, 0x62, 0x8d, 0x76, 0x5d, 0x22, 0xf0, 0xae, 0x35, 0x60,
+	0xb4, 0x28, 0xb0, 0x59, 0x8c, 0x1a, 0xb0, 0xc1, 0x49, 0x1a, 0xab, 0x47, 0x06, 0x99, 0x5f, 0x2b,
+	0xa0, 0xf2, 0x46, 0x4e, 0x0d, 0xd7, 0xc1, 0x02, 0xf5, 0x74, 0xad, 0xad, 0x75, 0xca, 0xfd, 0x05,
+	0xea, 0xc1, 0x6d, 0x50, 0xa3, 0xdc, 0x89, 0x49, 0x12, 0x13, 0x91, 0xe2, 0x40, 0x5f, 0x68, 0x6b,
+	0x9d, 0x95, 0x7e, 0x95, 0xf2, 0x5e, 0x11, 0x82, 0x07, 0x60, 0xcd, 0xa3, 0x5c, 0x24, 0xd4, 0x4d,
+	0x05, 0x71, 0x04, 0xd3, 0x17, 0xdb, 0x5a, 0xa7, 0xda, 0x35, 0x50, 0xb1, 0x7a, 0xd6, 0x0f, 0xbd,
+	0x4b, 0x49, 0x32, 0xde, 0x63, 0x91, 0x47, 0xe5, 0x56, 0x76, 0xf9, 0x72, 0xd2, 0x2a, 0xf5, 0x6b,
+	0x53, 0xea, 0x11, 0x83, 0x18, 0x54, 0xe4, 0xc0, 0x5c, 0x2f, 0xb7, 0x17, 0x3b, 0xd5, 0xee, 0x26,
+	0xca, 0x56, 0x42, 0x72, 0x25, 0x94, 0xaf, 0x84, 0xf6, 0x18, 0x8d, 0xec, 0xa7, 0x92, 0xfd, 0xe3,
+	0xba, 0xd5, 0xf1, 0xa9, 0x18, 0xa6, 0x2e, 0x1a, 0xb0, 0xd0, 0xca, 0xf7, 0xcf, 0x1e, 0x3b, 0xdc,
+	0x3b, 0xb1, 0xc4, 0x38, 0x26, 0x5c, 0x11, 0x78, 0x3f, 0xab, 0x0c, 0x3f, 0x02, 0xc0, 0x05, 0x4e,
+	0x84, 0x23, 0xe5, 0xd3, 0x2b, 0x6a, 0xd4, 0x26, 0xca, 0xb4, 0x45, 0x85, 0xb6, 0xe8, 0xa8, 0xd0,
+	0xd6, 0xde, 0x92, 0x8d, 0xee, 0x26, 0xad, 0xc6, 0x18, 0x87, 0xc1, 0x4b, 0x73, 0xca, 0x35, 0x2f,
+	0xae, 0x5b, 0x5a, 0x7f, 0x55, 0x05, 0x64, 0x3a, 0xb4, 0xc0, 0x46, 0x94, 0x86, 0x0e, 0x89, 0xd9,
+	0x60, 0xc8, 0x9d, 0x18, 0x53, 0xcf, 0x61, 0x23, 0x92, 0xe8, 0x4b, 0x4a, 0xcc, 0x46, 0x94, 0x86,
+	0xaf, 0x15, 0xd4, 0xc3, 0xd4, 0x7b, 0x3b, 0x22, 0x09, 0xfc, 0x0f, 0xac, 0x1d, 0xd3, 0x20, 0x20,
+	0x5e, 0xce, 0xd1, 0x97, 0x55, 0x66, 0x2d, 0x0b, 0x66, 0xc9, 0xf0, 0x0c, 0x34, 0xa6, 0x12, 0x79,
+	0x4e, 0x26, 0xcf, 0xca, 0xdf, 0x97, 0xa7, 0x3e, 0xd3, 0x45, 0x45, 0xe0, 0x29, 0x80, 0xf7, 0xce,
+	0xd5, 0x89, 0x19, 0x0b, 0xf4, 0x55, 0xa5, 0xd8, 0xff, 0xe8, 0xa1, 0xaf, 0x51, 0x8f, 0xb1, 0x60,
+	0xbf, 0x60, 0xc8, 0x23, 0xde, 0xba, 0x9b, 0xb4, 0x36, 0x33, 0xdd, 0x1e, 0x56, 0x32, 0x67, 0x5b,
+	0x1e, 0x31, 0x49, 0x36, 0xbf, 0x68, 0xa0, 0x3e, 0x5f, 0x05, 0x3e, 0x01, 0xcb, 0x32, 0xdf, 0x29,
+	0x7c, 0x69, 0xc3, 0xbb, 0x49, 0x6b, 0x3d, 0x2b, 0x9b, 0x03, 0x66, 0x7f, 0x49, 0xbe, 0x1d, 0x78,
+	0xf0, 0x15, 0x58, 0xfd, 0x4c, 0xa8, 0x3f, 0x14, 0x34, 0xf2, 0x95, 0x59, 0xd7, 0xbb, 0xdb, 0x7f,
+	0x9a, 0xf5, 0x43, 0x91, 0xd8, 0x9f, 0x72, 0xcc, 0x73, 0x0d, 0xfc, 0x7b, 0xc8, 0x06, 0x27, 0xd8,
+	0x0d, 0xc8, 0x7e, 0x7e, 0x03, 0xf9, 0x41, 0x74, 0xcc, 0x20, 0x03, 0x30, 0xc8, 0x01, 0xa7, 0xb8,
+	0x9b, 0x5c, 0xd7, 0xf2, 0xa3, 0x98, 0x77, 0x50, 0xc1, 0xb5, 0x1f, 0xe5, 0x06, 0xca, 0x85, 0x78,
+	0x58, 0xc2, 0xfc, 0x26, 0x8d, 0xd4, 0x08, 0xe6, 0x9b, 0x3e, 0x7e, 0x0e, 0xd6, 0xee, 0x8d, 0x09,
+	0x6b, 0x60, 0xc5, 0x1e, 0xbf, 0x1f, 0xe2, 0x84, 0xf0, 0x7a, 0x09, 0xfe, 0x03, 0xaa, 0xf6, 0xf8,
+	0x90, 0x9e, 0xa6, 0xd4, 0xa3, 0x62, 0x5c, 0xd7, 0x9a, 0xe5, 0xf3, 0xef, 0x46, 0xc9, 0xee, 0x5d,
+	0xde, 0x18, 0xda, 0xd5, 0x8d, 0xa1, 0xfd, 0xba, 0x31, 0xb4, 0x8b, 0x5b, 0xa3, 0x74, 0x75, 0x6b,
+	0x94, 0x7e, 0xde, 0x1a, 0xa5, 0x4f, 0x2f, 0x66, 0xcc, 0x90, 0x4b, 0xb2, 0x13, 0x60, 0x97, 0x17,
+	0x1f, 0xd6, 0x68, 0xb7, 0x6b, 0x9d, 0xcd, 0xfe, 0xc9, 0x94, 0x41, 0xdc, 0x25, 0xb5, 0xd4, 0xb3,
+	0xdf, 0x03, 0x00, 0x9c, 0xad, 0x9d, 0xa3, 0xec, 0x04, 0x00, 0x00,
 }
 
 func (m *Gauge) Marshal() (dAtA []byte, err error) {
@@ -260,6 +367,18 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DistributeToPool != nil {
+		{
+			size, err := m.DistributeToPool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGauge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.DistributedCoins) > 0 {
 		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -284,12 +403,12 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintGauge(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintGauge(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Coins) > 0 {
@@ -334,6 +453,39 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weighting != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.Weighting))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LockableDurationsInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -414,6 +566,25 @@ func (m *Gauge) Size() (n int) {
 			n += 1 + l + sovGauge(uint64(l))
 		}
 	}
+	if m.DistributeToPool != nil {
+		l = m.DistributeToPool.Size()
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGauge(uint64(m.PoolId))
+	}
+	if m.Weighting != 0 {
+		n += 1 + sovGauge(uint64(m.Weighting))
+	}
 	return n
 }
 
@@ -678,6 +849,130 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributeToPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DistributeToPool == nil {
+				m.DistributeToPool = &PoolDistribution{}
+			}
+			if err := m.DistributeToPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weighting", wireType)
+			}
+			m.Weighting = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weighting |= PoolWeighting(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGauge(dAtA[iNdEx:])
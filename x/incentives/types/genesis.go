@@ -3,9 +3,11 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultIndex is the default incentive module's global index.
@@ -43,5 +45,31 @@ func (gs GenesisState) Validate() error {
 	if gs.Params.DistrEpochIdentifier == "" {
 		return errors.New("epoch identifier should NOT be empty")
 	}
+	for _, record := range gs.RewardRecords {
+		if _, err := sdk.AccAddressFromBech32(record.Address); err != nil {
+			return err
+		}
+		if !record.Rewards.IsValid() {
+			return fmt.Errorf("invalid rewards %s of address %s from gauge %d", record.Rewards, record.Address, record.GaugeId)
+		}
+		if record.Denom != "" {
+			if err := sdk.ValidateDenom(record.Denom); err != nil {
+				return err
+			}
+		}
+	}
+	for _, index := range gs.RewardIndexes {
+		if err := sdk.ValidateDenom(index.Denom); err != nil {
+			return err
+		}
+		if !index.RewardsPerShare.IsValid() {
+			return fmt.Errorf("invalid rewards per share %s of denom %s locked for %s", index.RewardsPerShare, index.Denom, index.Duration)
+		}
+	}
+	for _, checkpoint := range gs.LockRewardCheckpoints {
+		if !checkpoint.Shares.IsValid() {
+			return fmt.Errorf("invalid shares %s of lock %d", checkpoint.Shares, checkpoint.LockId)
+		}
+	}
 	return nil
 }
@@ -40,6 +40,13 @@ type GenesisState struct {
 	// last_gauge_id is what the gauge number will increment from when creating
 	// the next gauge after genesis
 	LastGaugeId uint64 `protobuf:"varint,4,opt,name=last_gauge_id,json=lastGaugeId,proto3" json:"last_gauge_id,omitempty"`
+	// reward_records are the reward history of the retained epochs
+	RewardRecords []RewardRecord `protobuf:"bytes,5,rep,name=reward_records,json=rewardRecords,proto3" json:"reward_records" yaml:"reward_records"`
+	// reward_indexes are the reward indexes of the lazily distributed gauges
+	RewardIndexes []RewardIndex `protobuf:"bytes,6,rep,name=reward_indexes,json=rewardIndexes,proto3" json:"reward_indexes" yaml:"reward_indexes"`
+	// lock_reward_checkpoints are the checkpoints of the locks accruing lazily
+	// distributed rewards
+	LockRewardCheckpoints []LockRewardCheckpoint `protobuf:"bytes,7,rep,name=lock_reward_checkpoints,json=lockRewardCheckpoints,proto3" json:"lock_reward_checkpoints" yaml:"lock_reward_checkpoints"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -103,6 +110,27 @@ func (m *GenesisState) GetLastGaugeId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetRewardRecords() []RewardRecord {
+	if m != nil {
+		return m.RewardRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardIndexes() []RewardIndex {
+	if m != nil {
+		return m.RewardIndexes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockRewardCheckpoints() []LockRewardCheckpoint {
+	if m != nil {
+		return m.LockRewardCheckpoints
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.incentives.GenesisState")
 }
@@ -110,29 +138,37 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/genesis.proto", fileDescriptor_a288ccc95d977d2d) }
 
 var fileDescriptor_a288ccc95d977d2d = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x93, 0xb6, 0xea, 0x90, 0xc2, 0x80, 0xc5, 0x90, 0x76, 0x70, 0xa2, 0x48, 0x48, 0x5d,
-	0xb0, 0x45, 0x91, 0x00, 0x31, 0x56, 0x48, 0x15, 0x5b, 0x55, 0x36, 0x96, 0xca, 0x69, 0x8d, 0xb1,
-	0x48, 0xe2, 0xaa, 0xe7, 0x54, 0xf4, 0x2d, 0x98, 0x10, 0x8f, 0xd4, 0xb1, 0x23, 0x53, 0x41, 0xed,
-	0x1b, 0xf0, 0x04, 0x28, 0x4e, 0x2c, 0x90, 0xda, 0xcd, 0xe7, 0xff, 0xbb, 0xff, 0xfe, 0x3b, 0x2f,
-	0x54, 0x90, 0x2a, 0x90, 0x40, 0x65, 0x36, 0xe1, 0x99, 0x96, 0x0b, 0x0e, 0x54, 0xf0, 0x8c, 0x83,
-	0x04, 0x32, 0x9b, 0x2b, 0xad, 0x10, 0xaa, 0x08, 0xf2, 0x47, 0x74, 0x4e, 0x85, 0x12, 0xca, 0xc8,
-	0xb4, 0x78, 0x95, 0x64, 0x07, 0x0b, 0xa5, 0x44, 0xc2, 0xa9, 0xa9, 0xe2, 0xfc, 0x89, 0x4e, 0xf3,
-	0x39, 0xd3, 0x52, 0x65, 0x95, 0x1e, 0x1c, 0x98, 0x35, 0x63, 0x73, 0x96, 0x82, 0x35, 0x38, 0x14,
-	0x86, 0xe5, 0x82, 0x97, 0x7a, 0xf4, 0x5e, 0xf3, 0x8e, 0x06, 0x65, 0xb8, 0x07, 0xcd, 0x34, 0x47,
-	0x37, 0x5e, 0xb3, 0x34, 0xf0, 0xdd, 0xd0, 0xed, 0xb6, 0x7a, 0x1d, 0xb2, 0x1f, 0x96, 0x0c, 0x0d,
-	0xd1, 0x6f, 0xac, 0x36, 0x81, 0x33, 0xaa, 0x78, 0x74, 0xed, 0x35, 0x8d, 0x33, 0xf8, 0xb5, 0xb0,
-	0xde, 0x6d, 0xf5, 0xda, 0x87, 0x3a, 0x07, 0x05, 0x61, 0x1b, 0x4b, 0x1c, 0x29, 0x0f, 0x25, 0x6a,
-	0xf2, 0xc2, 0xe2, 0x84, 0x8f, 0xed, 0x7e, 0xe0, 0xd7, 0x2b, 0x93, 0xf2, 0x02, 0xc4, 0x5e, 0x80,
-	0xdc, 0x55, 0x44, 0xff, 0xac, 0x30, 0xf9, 0xd9, 0x04, 0xed, 0x25, 0x4b, 0x93, 0xdb, 0x68, 0xdf,
-	0x22, 0xfa, 0xf8, 0x0a, 0xdc, 0xd1, 0x89, 0x15, 0x6c, 0x23, 0xa0, 0xc8, 0x3b, 0x4e, 0x18, 0xe8,
-	0xb1, 0x99, 0x3f, 0x96, 0x53, 0xbf, 0x11, 0xba, 0xdd, 0xc6, 0xa8, 0x55, 0x7c, 0x9a, 0x80, 0xf7,
-	0xd3, 0xfe, 0x70, 0xb5, 0xc5, 0xee, 0x7a, 0x8b, 0xdd, 0xef, 0x2d, 0x76, 0xdf, 0x76, 0xd8, 0x59,
-	0xef, 0xb0, 0xf3, 0xb9, 0xc3, 0xce, 0xe3, 0x95, 0x90, 0xfa, 0x39, 0x8f, 0xc9, 0x44, 0xa5, 0xb4,
-	0xda, 0xf0, 0x3c, 0x61, 0x31, 0xd8, 0x82, 0x2e, 0x2e, 0x7a, 0xf4, 0xf5, 0xff, 0xc1, 0xf5, 0x72,
-	0xc6, 0x21, 0x6e, 0x9a, 0x15, 0x2e, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x4e, 0xbb, 0x49,
-	0x20, 0x02, 0x00, 0x00,
+	// 472 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0x1b, 0x56, 0x8a, 0xe4, 0xb2, 0x49, 0x58, 0x4c, 0x64, 0x95, 0x48, 0xa2, 0x48, 0x9b,
+	0x7a, 0x21, 0x11, 0x45, 0x02, 0xc4, 0x31, 0x20, 0x4d, 0x95, 0x38, 0x4c, 0xe1, 0xc6, 0x25, 0x72,
+	0x12, 0x2f, 0xb3, 0x9a, 0xc6, 0x95, 0xff, 0xee, 0xd8, 0xde, 0x80, 0x23, 0x47, 0x1e, 0x69, 0xc7,
+	0x1d, 0x39, 0x0d, 0xd4, 0xbe, 0x00, 0xe2, 0x09, 0x50, 0x6c, 0x67, 0xeb, 0x34, 0xf7, 0x56, 0xf7,
+	0xff, 0xfb, 0x7f, 0xdf, 0xe7, 0x2f, 0x46, 0x01, 0x87, 0x39, 0x07, 0x06, 0x31, 0x6b, 0x0a, 0xda,
+	0x48, 0x76, 0x4e, 0x21, 0xae, 0x68, 0x43, 0x81, 0x41, 0xb4, 0x10, 0x5c, 0x72, 0x8c, 0x0d, 0x11,
+	0xdd, 0x11, 0xa3, 0xe7, 0x15, 0xaf, 0xb8, 0x1a, 0xc7, 0xed, 0x2f, 0x4d, 0x8e, 0xbc, 0x8a, 0xf3,
+	0xaa, 0xa6, 0xb1, 0x3a, 0xe5, 0xcb, 0xd3, 0xb8, 0x5c, 0x0a, 0x22, 0x19, 0x6f, 0xcc, 0xdc, 0xb7,
+	0x78, 0x2d, 0x88, 0x20, 0x73, 0xe8, 0x04, 0x6c, 0x61, 0xc8, 0xb2, 0xa2, 0x66, 0x7e, 0x64, 0x99,
+	0x0b, 0xfa, 0x8d, 0x88, 0x32, 0x13, 0xb4, 0xe0, 0xa2, 0x34, 0xdc, 0xe1, 0x76, 0x8e, 0x35, 0x25,
+	0xbd, 0xd0, 0x58, 0xf8, 0xb7, 0x8f, 0x9e, 0x1e, 0xeb, 0xbb, 0x7e, 0x91, 0x44, 0x52, 0xfc, 0x1e,
+	0x0d, 0x74, 0x1e, 0xd7, 0x09, 0x9c, 0xf1, 0x70, 0x32, 0x8a, 0x1e, 0xde, 0x3d, 0x3a, 0x51, 0x44,
+	0xd2, 0xbf, 0xba, 0xf1, 0x7b, 0xa9, 0xe1, 0xf1, 0x3b, 0x34, 0x50, 0x41, 0xc1, 0x7d, 0x14, 0xec,
+	0x8c, 0x87, 0x93, 0x03, 0xdb, 0xe6, 0x71, 0x4b, 0x74, 0x8b, 0x1a, 0xc7, 0x1c, 0xe1, 0x9a, 0x17,
+	0x33, 0x92, 0xd7, 0x34, 0xeb, 0xea, 0x02, 0x77, 0xc7, 0x88, 0xe8, 0x42, 0xa3, 0xae, 0xd0, 0xe8,
+	0x93, 0x21, 0x92, 0xc3, 0x56, 0xe4, 0xdf, 0x8d, 0x7f, 0x70, 0x49, 0xe6, 0xf5, 0x87, 0xf0, 0xa1,
+	0x44, 0xf8, 0xf3, 0xb7, 0xef, 0xa4, 0xcf, 0xba, 0x41, 0xb7, 0x08, 0x38, 0x44, 0xbb, 0x35, 0x01,
+	0x99, 0x29, 0xff, 0x8c, 0x95, 0x6e, 0x3f, 0x70, 0xc6, 0xfd, 0x74, 0xd8, 0xfe, 0xa9, 0x02, 0x4e,
+	0x4b, 0x7c, 0x8a, 0xf6, 0xee, 0xd5, 0x0a, 0xee, 0x63, 0x15, 0x28, 0xb0, 0xdd, 0x2a, 0x55, 0x64,
+	0xaa, 0xc0, 0xe4, 0xa5, 0xc9, 0xb5, 0xaf, 0x73, 0xdd, 0x57, 0x09, 0xd3, 0x5d, 0xb1, 0x01, 0x03,
+	0xa6, 0x68, 0x6f, 0xf3, 0xb3, 0x50, 0x70, 0x07, 0xca, 0xc7, 0xdf, 0xee, 0x33, 0x6d, 0xc1, 0x2d,
+	0x36, 0x46, 0xe4, 0xd6, 0x66, 0xaa, 0xcf, 0xf8, 0xbb, 0x83, 0x5e, 0xb4, 0x45, 0x64, 0x86, 0x2b,
+	0xce, 0x68, 0x31, 0x5b, 0x70, 0xd6, 0x48, 0x70, 0x9f, 0x28, 0xc3, 0xb1, 0xcd, 0xf0, 0x33, 0x2f,
+	0x66, 0xda, 0xf4, 0xe3, 0xed, 0x42, 0x72, 0x64, 0x9c, 0xbd, 0xbb, 0xe2, 0x2d, 0xb2, 0x61, 0xba,
+	0x5f, 0x5b, 0xb6, 0x21, 0x39, 0xb9, 0x5a, 0x79, 0xce, 0xf5, 0xca, 0x73, 0xfe, 0xac, 0x3c, 0xe7,
+	0xc7, 0xda, 0xeb, 0x5d, 0xaf, 0xbd, 0xde, 0xaf, 0xb5, 0xd7, 0xfb, 0xfa, 0xb6, 0x62, 0xf2, 0x6c,
+	0x99, 0x47, 0x05, 0x9f, 0xc7, 0x26, 0xcc, 0xab, 0x9a, 0xe4, 0xd0, 0x1d, 0xe2, 0xf3, 0xd7, 0x93,
+	0xf8, 0x62, 0xf3, 0x45, 0xcb, 0xcb, 0x05, 0x85, 0x7c, 0xa0, 0x1e, 0xc7, 0x9b, 0xff, 0x03, 0x00,
+	0xcd, 0x9b, 0x81, 0x41, 0xc9, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -155,6 +191,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockRewardCheckpoints) > 0 {
+		for iNdEx := len(m.LockRewardCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockRewardCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RewardIndexes) > 0 {
+		for iNdEx := len(m.RewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RewardRecords) > 0 {
+		for iNdEx := len(m.RewardRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.LastGaugeId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastGaugeId))
 		i--
@@ -233,6 +311,24 @@ func (m *GenesisState) Size() (n int) {
 	if m.LastGaugeId != 0 {
 		n += 1 + sovGenesis(uint64(m.LastGaugeId))
 	}
+	if len(m.RewardRecords) > 0 {
+		for _, e := range m.RewardRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardIndexes) > 0 {
+		for _, e := range m.RewardIndexes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockRewardCheckpoints) > 0 {
+		for _, e := range m.LockRewardCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -391,6 +487,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardRecords = append(m.RewardRecords, RewardRecord{})
+			if err := m.RewardRecords[len(m.RewardRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardIndexes = append(m.RewardIndexes, RewardIndex{})
+			if err := m.RewardIndexes[len(m.RewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRewardCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockRewardCheckpoints = append(m.LockRewardCheckpoints, LockRewardCheckpoint{})
+			if err := m.LockRewardCheckpoints[len(m.LockRewardCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
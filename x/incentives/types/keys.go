@@ -40,6 +40,18 @@ var (
 	// KeyPrefixGaugesByDenom defines prefix key for storing indexes of gauge IDs by denomination.
 	KeyPrefixGaugesByDenom = []byte{0x05}
 
+	// KeyPrefixRewardRecords defines prefix key for storing reward records by address, gauge ID and epoch.
+	KeyPrefixRewardRecords = []byte{0x08}
+
+	// KeyPrefixRewardRecordsByEpoch defines prefix key for storing indexes of reward records by epoch, for pruning.
+	KeyPrefixRewardRecordsByEpoch = []byte{0x09}
+
+	// KeyPrefixRewardIndexes defines prefix key for storing reward indexes by denom and duration.
+	KeyPrefixRewardIndexes = []byte{0x0A}
+
+	// KeyPrefixLockRewardCheckpoints defines prefix key for storing the reward checkpoints of locks by lock ID.
+	KeyPrefixLockRewardCheckpoints = []byte{0x0B}
+
 	// KeyIndexSeparator defines key for merging bytes.
 	KeyIndexSeparator = []byte{0x07}
 
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	lockuptypes "github.com/osmosis-labs/osmosis/v12/x/lockup/types"
@@ -10,8 +11,9 @@ import (
 )
 
 const (
-	TypeMsgCreateGauge = "create_gauge"
-	TypeMsgAddToGauge  = "add_to_gauge"
+	TypeMsgCreateGauge  = "create_gauge"
+	TypeMsgAddToGauge   = "add_to_gauge"
+	TypeMsgClaimRewards = "claim_rewards"
 )
 
 var _ sdk.Msg = &MsgCreateGauge{}
@@ -39,7 +41,11 @@ func (m MsgCreateGauge) ValidateBasic() error {
 	if m.Owner == "" {
 		return errors.New("owner should be set")
 	}
-	if sdk.ValidateDenom(m.DistributeTo.Denom) != nil {
+	if m.DistributeToPool != nil {
+		if err := m.validateDistributeToPool(); err != nil {
+			return err
+		}
+	} else if sdk.ValidateDenom(m.DistributeTo.Denom) != nil {
 		return errors.New("denom should be valid for the condition")
 	}
 	if lockuptypes.LockQueryType_name[int32(m.DistributeTo.LockQueryType)] == "" {
@@ -55,13 +61,36 @@ func (m MsgCreateGauge) ValidateBasic() error {
 		return errors.New("distribution period should be 1 epoch for perpetual gauge")
 	}
 
-	if lockuptypes.LockQueryType_name[int32(m.DistributeTo.LockQueryType)] != "ByDuration" {
-		return errors.New("only duration query condition is allowed. Start time distr conditions is an obsolete codepath slated for deletion")
+	if m.DistributeTo.LockQueryType == lockuptypes.ByTime {
+		if m.DistributeTo.Timestamp.Equal(time.Time{}) {
+			return errors.New("lock start timestamp should be set for time query condition")
+		}
+		if lockuptypes.IsSyntheticDenom(m.DistributeTo.Denom) {
+			return errors.New("time query condition is not supported for synthetic denoms")
+		}
 	}
 
 	return nil
 }
 
+// validateDistributeToPool checks that the pool the gauge distributes to is valid.
+// The locks of the pool's shares are derived from the pool, hence the condition's denom must be left empty.
+func (m MsgCreateGauge) validateDistributeToPool() error {
+	if m.DistributeToPool.PoolId == 0 {
+		return errors.New("pool id should be set")
+	}
+	if PoolWeighting_name[int32(m.DistributeToPool.Weighting)] == "" {
+		return errors.New("pool weighting is invalid")
+	}
+	if m.DistributeTo.Denom != "" {
+		return errors.New("denom should not be set for gauges distributing to a pool")
+	}
+	if m.DistributeTo.LockQueryType != lockuptypes.ByDuration {
+		return errors.New("only duration query condition is allowed for gauges distributing to a pool")
+	}
+	return nil
+}
+
 // GetSignBytes takes a create gauge message and turns it into a byte array.
 func (m MsgCreateGauge) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
@@ -112,3 +141,45 @@ func (m MsgAddToGauge) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgClaimRewards{}
+
+// NewMsgClaimRewards creates a message to claim the lazily accrued rewards of the provided locks.
+func NewMsgClaimRewards(owner sdk.AccAddress, lockIDs []uint64) *MsgClaimRewards {
+	return &MsgClaimRewards{
+		Owner:   owner.String(),
+		LockIds: lockIDs,
+	}
+}
+
+// Route takes a claim rewards message, then returns the RouterKey used for slashing.
+func (m MsgClaimRewards) Route() string { return RouterKey }
+
+// Type takes a claim rewards message, then returns a claim rewards message type.
+func (m MsgClaimRewards) Type() string { return TypeMsgClaimRewards }
+
+// ValidateBasic checks that the claim rewards message is valid.
+func (m MsgClaimRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return fmt.Errorf("invalid owner address (%s)", err)
+	}
+	seen := make(map[uint64]bool, len(m.LockIds))
+	for _, lockID := range m.LockIds {
+		if seen[lockID] {
+			return fmt.Errorf("duplicate lock ID %d", lockID)
+		}
+		seen[lockID] = true
+	}
+	return nil
+}
+
+// GetSignBytes takes a claim rewards message and turns it into a byte array.
+func (m MsgClaimRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes a claim rewards message and returns the owner in a byte array.
+func (m MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
@@ -105,6 +105,79 @@ func TestMsgCreatePool(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "valid time lock query type",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.ByTime
+				msg.DistributeTo.Timestamp = time.Now()
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "time lock query type without timestamp",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.ByTime
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "time lock query type for synthetic denom",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.ByTime
+				msg.DistributeTo.Timestamp = time.Now()
+				msg.DistributeTo.Denom = "lptoken/superbonding/osmovaloper1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "valid pool distribution",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.Denom = ""
+				msg.DistributeToPool = &incentivestypes.PoolDistribution{PoolId: 1, Weighting: incentivestypes.ByLiquidity}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "pool distribution with denom",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeToPool = &incentivestypes.PoolDistribution{PoolId: 1, Weighting: incentivestypes.ByShares}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "pool distribution without pool id",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.Denom = ""
+				msg.DistributeToPool = &incentivestypes.PoolDistribution{Weighting: incentivestypes.ByShares}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "pool distribution with invalid weighting",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.Denom = ""
+				msg.DistributeToPool = &incentivestypes.PoolDistribution{PoolId: 1, Weighting: -1}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "pool distribution by time",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.Denom = ""
+				msg.DistributeTo.LockQueryType = lockuptypes.ByTime
+				msg.DistributeTo.Timestamp = time.Now()
+				msg.DistributeToPool = &incentivestypes.PoolDistribution{PoolId: 1, Weighting: incentivestypes.ByShares}
+				return msg
+			}),
+			expectPass: false,
+		},
 		{
 			name: "invalid distribution start time",
 			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
@@ -216,6 +289,74 @@ func TestMsgAddToGauge(t *testing.T) {
 	}
 }
 
+func TestMsgClaimRewards(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper claimRewards message
+	createMsg := func(after func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+		properMsg := *incentivestypes.NewMsgClaimRewards(addr1, []uint64{1, 2})
+		return after(properMsg)
+	}
+
+	// validate claimRewards message was created as intended
+	msg := createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+		return msg
+	})
+	require.Equal(t, msg.Route(), incentivestypes.RouterKey)
+	require.Equal(t, msg.Type(), "claim_rewards")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        incentivestypes.MsgClaimRewards
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "all locks",
+			msg: createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+				msg.LockIds = nil
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+				msg.Owner = "invalid"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate lock IDs",
+			msg: createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+				msg.LockIds = []uint64{1, 1}
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 // // Test authz serialize and de-serializes for incentives msg.
 func TestAuthzMsg(t *testing.T) {
 	appParams.SetAddressPrefixes()
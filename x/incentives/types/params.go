@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	epochtypes "github.com/osmosis-labs/osmosis/v12/x/epochs/types"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -8,7 +10,9 @@ import (
 
 // Incentives parameters key store.
 var (
-	KeyDistrEpochIdentifier = []byte("DistrEpochIdentifier")
+	KeyDistrEpochIdentifier         = []byte("DistrEpochIdentifier")
+	KeyRewardHistoryRetentionEpochs = []byte("RewardHistoryRetentionEpochs")
+	KeyLazyDistribution             = []byte("LazyDistribution")
 )
 
 // ParamKeyTable returns the key table for the incentive module's parameters.
@@ -24,9 +28,13 @@ func NewParams(distrEpochIdentifier string) Params {
 }
 
 // DefaultParams returns the default incentives module parameters.
+// The reward history is disabled by default, as it grows with the number of lock owners.
+// Rewards are pushed to the locks every epoch by default, until governance migrates to lazy distribution.
 func DefaultParams() Params {
 	return Params{
-		DistrEpochIdentifier: "week",
+		DistrEpochIdentifier:         "week",
+		RewardHistoryRetentionEpochs: 0,
+		LazyDistribution:             false,
 	}
 }
 
@@ -35,6 +43,31 @@ func (p Params) Validate() error {
 	if err := epochtypes.ValidateEpochIdentifierInterface(p.DistrEpochIdentifier); err != nil {
 		return err
 	}
+	if err := validateRewardHistoryRetentionEpochs(p.RewardHistoryRetentionEpochs); err != nil {
+		return err
+	}
+	if err := validateLazyDistribution(p.LazyDistribution); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateRewardHistoryRetentionEpochs checks that the reward history retention is a number of epochs.
+// The reward history is disabled with 0 epochs.
+func validateRewardHistoryRetentionEpochs(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+// validateLazyDistribution checks that the lazy distribution param is a boolean.
+func validateLazyDistribution(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
 	return nil
 }
 
@@ -42,5 +75,7 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyDistrEpochIdentifier, &p.DistrEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyRewardHistoryRetentionEpochs, &p.RewardHistoryRetentionEpochs, validateRewardHistoryRetentionEpochs),
+		paramtypes.NewParamSetPair(KeyLazyDistribution, &p.LazyDistribution, validateLazyDistribution),
 	}
 }
@@ -28,6 +28,14 @@ type Params struct {
 	// distr_epoch_identifier is what epoch type distribution will be triggered by
 	// (day, week, etc.)
 	DistrEpochIdentifier string `protobuf:"bytes,1,opt,name=distr_epoch_identifier,json=distrEpochIdentifier,proto3" json:"distr_epoch_identifier,omitempty" yaml:"distr_epoch_identifier"`
+	// reward_history_retention_epochs is the number of distribution epochs the
+	// rewards received by each address are recorded for. 0 disables the reward
+	// history.
+	RewardHistoryRetentionEpochs uint64 `protobuf:"varint,2,opt,name=reward_history_retention_epochs,json=rewardHistoryRetentionEpochs,proto3" json:"reward_history_retention_epochs,omitempty" yaml:"reward_history_retention_epochs"`
+	// lazy_distribution makes the gauges distributing to locks of a native denom
+	// by duration accrue their rewards to reward indexes, claimed by the lock
+	// owners, instead of sending them to every lock at the end of each epoch.
+	LazyDistribution bool `protobuf:"varint,3,opt,name=lazy_distribution,json=lazyDistribution,proto3" json:"lazy_distribution,omitempty" yaml:"lazy_distribution"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +78,20 @@ func (m *Params) GetDistrEpochIdentifier() string {
 	return ""
 }
 
+func (m *Params) GetRewardHistoryRetentionEpochs() uint64 {
+	if m != nil {
+		return m.RewardHistoryRetentionEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetLazyDistribution() bool {
+	if m != nil {
+		return m.LazyDistribution
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.incentives.Params")
 }
@@ -77,20 +99,27 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/params.proto", fileDescriptor_1cc8b460d089f845) }
 
 var fileDescriptor_1cc8b460d089f845 = []byte{
-	// 207 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0x2d, 0xd6, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x2a, 0xd0,
-	0x43, 0x28, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58, 0x10, 0x95, 0x4a,
-	0x89, 0x5c, 0x6c, 0x01, 0x60, 0x9d, 0x42, 0xe1, 0x5c, 0x62, 0x29, 0x99, 0xc5, 0x25, 0x45, 0xf1,
-	0xa9, 0x05, 0xf9, 0xc9, 0x19, 0xf1, 0x99, 0x29, 0x20, 0x8d, 0x69, 0x99, 0xa9, 0x45, 0x12, 0x8c,
-	0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x8a, 0x9f, 0xee, 0xc9, 0xcb, 0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29,
-	0x61, 0x57, 0xa7, 0x14, 0x24, 0x02, 0x96, 0x70, 0x05, 0x89, 0x7b, 0xc2, 0x85, 0x9d, 0x02, 0x4e,
-	0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18,
-	0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x2c, 0x3d, 0xb3, 0x24, 0xa3, 0x34,
-	0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xea, 0x62, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x18, 0x47, 0xbf,
-	0xcc, 0xd0, 0x48, 0xbf, 0x02, 0xd9, 0x97, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xb7,
-	0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x57, 0xe5, 0x1b, 0x08, 0x01, 0x00, 0x00,
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x41, 0x4b, 0xf3, 0x30,
+	0x1c, 0xc6, 0x97, 0xbd, 0x2f, 0x43, 0x7b, 0xd2, 0x32, 0xa4, 0xc8, 0x4c, 0x67, 0x0f, 0x32, 0x04,
+	0x17, 0x54, 0xf0, 0xe0, 0xb1, 0x28, 0xb8, 0xdb, 0xe8, 0x45, 0xf0, 0x52, 0xd2, 0x2d, 0xae, 0x81,
+	0xb6, 0xa9, 0x49, 0x36, 0xad, 0x9f, 0xc2, 0x6f, 0xe0, 0xd7, 0xf1, 0xb8, 0xa3, 0xa7, 0x22, 0xed,
+	0x37, 0xe8, 0x27, 0x90, 0x24, 0x9b, 0x0e, 0x14, 0xbc, 0x25, 0xcf, 0xf3, 0x7b, 0xf2, 0x84, 0xff,
+	0xdf, 0x72, 0x99, 0x48, 0x99, 0xa0, 0x02, 0xd1, 0x6c, 0x42, 0x32, 0x49, 0x17, 0x44, 0xa0, 0x1c,
+	0x73, 0x9c, 0x8a, 0x61, 0xce, 0x99, 0x64, 0xb6, 0xbd, 0x02, 0x86, 0xdf, 0xc0, 0x7e, 0x77, 0xc6,
+	0x66, 0x4c, 0xdb, 0x48, 0x9d, 0x0c, 0xe9, 0xbd, 0xb6, 0xad, 0xce, 0x58, 0x47, 0xed, 0x5b, 0x6b,
+	0x6f, 0x4a, 0x85, 0xe4, 0x21, 0xc9, 0xd9, 0x24, 0x0e, 0xe9, 0x54, 0x25, 0xef, 0x29, 0xe1, 0x0e,
+	0xe8, 0x83, 0xc1, 0xb6, 0x7f, 0xd8, 0x94, 0xee, 0x41, 0x81, 0xd3, 0xe4, 0xd2, 0xfb, 0x9d, 0xf3,
+	0x82, 0xae, 0x36, 0xae, 0x95, 0x3e, 0xfa, 0x92, 0xed, 0x07, 0xcb, 0xe5, 0xe4, 0x11, 0xf3, 0x69,
+	0x18, 0x53, 0x21, 0x19, 0x2f, 0x42, 0x4e, 0xa4, 0x72, 0x59, 0x66, 0xde, 0x10, 0x4e, 0xbb, 0x0f,
+	0x06, 0xff, 0xfd, 0xe3, 0xa6, 0x74, 0x8f, 0x4c, 0xc3, 0x1f, 0x01, 0x2f, 0xe8, 0x19, 0xe2, 0xc6,
+	0x00, 0xc1, 0xda, 0xd7, 0xdd, 0xc2, 0x1e, 0x59, 0xbb, 0x09, 0x7e, 0x2e, 0x42, 0xfd, 0x1f, 0x1a,
+	0xcd, 0x95, 0xe5, 0xfc, 0xeb, 0x83, 0xc1, 0x96, 0xdf, 0x6b, 0x4a, 0xd7, 0x31, 0x25, 0x3f, 0x10,
+	0x2f, 0xd8, 0x51, 0xda, 0xd5, 0x86, 0xe4, 0x8f, 0xdf, 0x2a, 0x08, 0x96, 0x15, 0x04, 0x1f, 0x15,
+	0x04, 0x2f, 0x35, 0x6c, 0x2d, 0x6b, 0xd8, 0x7a, 0xaf, 0x61, 0xeb, 0xee, 0x62, 0x46, 0x65, 0x3c,
+	0x8f, 0x86, 0x13, 0x96, 0xa2, 0xd5, 0xc0, 0x4f, 0x12, 0x1c, 0x89, 0xf5, 0x05, 0x2d, 0x4e, 0xcf,
+	0xd0, 0xd3, 0xe6, 0x92, 0x64, 0x91, 0x13, 0x11, 0x75, 0xf4, 0xe8, 0xcf, 0x3f, 0x07, 0x00, 0x8c,
+	0x44, 0x46, 0xaa, 0xc7, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -113,6 +142,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LazyDistribution {
+		i--
+		if m.LazyDistribution {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RewardHistoryRetentionEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RewardHistoryRetentionEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.DistrEpochIdentifier) > 0 {
 		i -= len(m.DistrEpochIdentifier)
 		copy(dAtA[i:], m.DistrEpochIdentifier)
@@ -144,6 +188,12 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.RewardHistoryRetentionEpochs != 0 {
+		n += 1 + sovParams(uint64(m.RewardHistoryRetentionEpochs))
+	}
+	if m.LazyDistribution {
+		n += 2
+	}
 	return n
 }
 
@@ -214,6 +264,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.DistrEpochIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistoryRetentionEpochs", wireType)
+			}
+			m.RewardHistoryRetentionEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardHistoryRetentionEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LazyDistribution", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LazyDistribution = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
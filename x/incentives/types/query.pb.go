@@ -912,6 +912,123 @@ func (m *QueryLockableDurationsResponse) GetLockableDurations() []time.Duration
 	return nil
 }
 
+type RewardHistoryRequest struct {
+	// Address whose reward history is queried
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// Gauge the reward history is restricted to, if set
+	GaugeId uint64 `protobuf:"varint,2,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty" yaml:"gauge_id"`
+	// Pagination defines pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *RewardHistoryRequest) Reset()         { *m = RewardHistoryRequest{} }
+func (m *RewardHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*RewardHistoryRequest) ProtoMessage()    {}
+func (*RewardHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{18}
+}
+func (m *RewardHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardHistoryRequest.Merge(m, src)
+}
+func (m *RewardHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardHistoryRequest proto.InternalMessageInfo
+
+func (m *RewardHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RewardHistoryRequest) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+func (m *RewardHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type RewardHistoryResponse struct {
+	// Rewards received by the address, ordered by gauge ID and epoch
+	RewardRecords []RewardRecord `protobuf:"bytes,1,rep,name=reward_records,json=rewardRecords,proto3" json:"reward_records" yaml:"reward_records"`
+	// Pagination defines pagination for the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *RewardHistoryResponse) Reset()         { *m = RewardHistoryResponse{} }
+func (m *RewardHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*RewardHistoryResponse) ProtoMessage()    {}
+func (*RewardHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{19}
+}
+func (m *RewardHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardHistoryResponse.Merge(m, src)
+}
+func (m *RewardHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardHistoryResponse proto.InternalMessageInfo
+
+func (m *RewardHistoryResponse) GetRewardRecords() []RewardRecord {
+	if m != nil {
+		return m.RewardRecords
+	}
+	return nil
+}
+
+func (m *RewardHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ModuleToDistributeCoinsRequest)(nil), "osmosis.incentives.ModuleToDistributeCoinsRequest")
 	proto.RegisterType((*ModuleToDistributeCoinsResponse)(nil), "osmosis.incentives.ModuleToDistributeCoinsResponse")
@@ -931,79 +1048,90 @@ func init() {
 	proto.RegisterType((*RewardsEstResponse)(nil), "osmosis.incentives.RewardsEstResponse")
 	proto.RegisterType((*QueryLockableDurationsRequest)(nil), "osmosis.incentives.QueryLockableDurationsRequest")
 	proto.RegisterType((*QueryLockableDurationsResponse)(nil), "osmosis.incentives.QueryLockableDurationsResponse")
+	proto.RegisterType((*RewardHistoryRequest)(nil), "osmosis.incentives.RewardHistoryRequest")
+	proto.RegisterType((*RewardHistoryResponse)(nil), "osmosis.incentives.RewardHistoryResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/query.proto", fileDescriptor_8124258a89427f98) }
 
 var fileDescriptor_8124258a89427f98 = []byte{
-	// 1057 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x4e, 0x5c, 0x9a, 0xd7, 0x12, 0x9a, 0x21, 0x40, 0xe2, 0xb6, 0x6b, 0xb3, 0x6a,
-	0x53, 0x37, 0x25, 0x3b, 0xb1, 0x4d, 0x53, 0x04, 0x02, 0x09, 0xe3, 0x52, 0x2a, 0x81, 0x14, 0x56,
-	0x20, 0x24, 0x24, 0xb4, 0x5a, 0x7b, 0x87, 0xed, 0x2a, 0xf6, 0x8e, 0xeb, 0xd9, 0x4d, 0xb0, 0xa2,
-	0x5c, 0x10, 0xe7, 0x0a, 0x44, 0x84, 0x38, 0xf4, 0x2f, 0xe0, 0x08, 0x12, 0x47, 0x0e, 0x9c, 0x7a,
-	0xac, 0x84, 0x84, 0x38, 0xa5, 0x28, 0xe1, 0x2f, 0xe8, 0x5f, 0x80, 0x76, 0x76, 0xd6, 0x5e, 0xdb,
-	0xeb, 0x75, 0x82, 0x68, 0x94, 0x93, 0x33, 0x79, 0xbf, 0x3e, 0xef, 0xeb, 0xf1, 0x7b, 0x03, 0x0a,
-	0xe3, 0x2d, 0xc6, 0x1d, 0x4e, 0x1c, 0xb7, 0x41, 0x5d, 0xcf, 0xd9, 0xa2, 0x9c, 0xdc, 0xf7, 0x69,
-	0xa7, 0xab, 0xb5, 0x3b, 0xcc, 0x63, 0x18, 0x4b, 0xbb, 0xd6, 0xb7, 0xe7, 0x16, 0x6c, 0x66, 0x33,
-	0x61, 0x26, 0xc1, 0x5f, 0xa1, 0x67, 0xee, 0x92, 0xcd, 0x98, 0xdd, 0xa4, 0xc4, 0x6c, 0x3b, 0xc4,
-	0x74, 0x5d, 0xe6, 0x99, 0x9e, 0xc3, 0x5c, 0x2e, 0xad, 0x8a, 0xb4, 0x8a, 0x53, 0xdd, 0xff, 0x92,
-	0x58, 0x7e, 0x47, 0x38, 0x44, 0xf6, 0x86, 0x28, 0x44, 0xea, 0x26, 0xa7, 0x64, 0xab, 0x54, 0xa7,
-	0x9e, 0x59, 0x22, 0x0d, 0xe6, 0x44, 0xf6, 0x95, 0xb8, 0x5d, 0x00, 0xf6, 0xbc, 0xda, 0xa6, 0xed,
-	0xb8, 0x03, 0xb9, 0x12, 0x7a, 0xb2, 0x4d, 0xdf, 0xa6, 0xd2, 0xbe, 0x14, 0xd9, 0x9b, 0xac, 0xb1,
-	0xe9, 0xb7, 0xc5, 0x47, 0x68, 0x52, 0x0b, 0xa0, 0x7c, 0xc4, 0x2c, 0xbf, 0x49, 0x3f, 0x61, 0x35,
-	0x87, 0x7b, 0x1d, 0xa7, 0xee, 0x7b, 0xf4, 0x3d, 0xe6, 0xb8, 0x5c, 0xa7, 0xf7, 0x7d, 0xca, 0x3d,
-	0xf5, 0x1b, 0x04, 0xf9, 0xb1, 0x2e, 0xbc, 0xcd, 0x5c, 0x4e, 0xb1, 0x09, 0xd9, 0x00, 0x9d, 0x2f,
-	0xa2, 0xc2, 0x74, 0xf1, 0x5c, 0x79, 0x49, 0x0b, 0xe1, 0xb5, 0x00, 0x5e, 0x93, 0xd8, 0x5a, 0x10,
-	0x52, 0x5d, 0x7b, 0xb4, 0x9f, 0x9f, 0xfa, 0xe9, 0x49, 0xbe, 0x68, 0x3b, 0xde, 0x3d, 0xbf, 0xae,
-	0x35, 0x58, 0x8b, 0xc8, 0x4e, 0xc3, 0x8f, 0x55, 0x6e, 0x6d, 0x12, 0xaf, 0xdb, 0xa6, 0x5c, 0x0b,
-	0x6b, 0x84, 0x99, 0x55, 0x15, 0x2e, 0xdc, 0x09, 0x5a, 0xaa, 0x76, 0xef, 0xd6, 0x24, 0x1a, 0x9e,
-	0x83, 0x8c, 0x63, 0x2d, 0xa2, 0x02, 0x2a, 0xce, 0xe8, 0x19, 0xc7, 0x52, 0x6b, 0x30, 0x1f, 0xf3,
-	0x91, 0x6c, 0x04, 0xb2, 0x42, 0x0b, 0xe1, 0x17, 0xb0, 0x8d, 0x7e, 0xc1, 0x9a, 0x88, 0xd2, 0x43,
-	0x3f, 0xf5, 0x33, 0x78, 0x5e, 0x9c, 0x23, 0x05, 0xf0, 0xfb, 0x00, 0x7d, 0xc9, 0x65, 0x9a, 0xe5,
-	0x81, 0x16, 0xc3, 0x0b, 0x14, 0x35, 0xba, 0x61, 0xda, 0x54, 0xc6, 0xea, 0xb1, 0x48, 0xf5, 0x01,
-	0x82, 0xb9, 0x28, 0xb3, 0x84, 0xab, 0xc0, 0x8c, 0x65, 0x7a, 0x66, 0x4f, 0xb7, 0x71, 0x6c, 0xd5,
-	0x99, 0x40, 0x37, 0x5d, 0x38, 0xe3, 0x3b, 0x03, 0x3c, 0x19, 0xc1, 0x73, 0x6d, 0x22, 0x4f, 0x58,
-	0x71, 0x00, 0xe8, 0x0b, 0x78, 0xf1, 0xdd, 0x46, 0x50, 0xe5, 0xd9, 0xf4, 0xbb, 0x87, 0x60, 0x61,
-	0x30, 0xff, 0xa9, 0xe8, 0x7a, 0x07, 0x2e, 0xc6, 0xa9, 0x36, 0x68, 0xa7, 0x46, 0x5d, 0xd6, 0x8a,
-	0xba, 0x5f, 0x80, 0xac, 0x15, 0x9c, 0x45, 0xe3, 0xb3, 0x7a, 0x78, 0x18, 0xd2, 0x24, 0xf3, 0x9f,
-	0x35, 0x79, 0x88, 0xe0, 0x52, 0x72, 0xf5, 0x53, 0xa1, 0x8d, 0x01, 0x2f, 0x7d, 0xda, 0x6e, 0xb0,
-	0x96, 0xe3, 0xda, 0xcf, 0xe6, 0x4e, 0xfc, 0x80, 0xe0, 0xe5, 0xe1, 0x0a, 0xa7, 0xa2, 0xf3, 0x5d,
-	0xb8, 0x3c, 0xc8, 0x75, 0xb2, 0xf7, 0xe2, 0x17, 0x04, 0xca, 0xb8, 0xfa, 0x52, 0x9f, 0x0f, 0xe0,
-	0x05, 0x5f, 0x7a, 0x18, 0x62, 0x52, 0xf1, 0xa3, 0x4a, 0x35, 0xe7, 0x0f, 0x64, 0xfe, 0xff, 0x44,
-	0xe3, 0x30, 0xaf, 0xd3, 0x6d, 0xb3, 0x63, 0xf1, 0xdb, 0xdc, 0x8b, 0x84, 0x5a, 0x86, 0x2c, 0xdb,
-	0x76, 0x69, 0x27, 0x14, 0xaa, 0x7a, 0xe1, 0xe9, 0x7e, 0xfe, 0x7c, 0xd7, 0x6c, 0x35, 0xdf, 0x54,
-	0xc5, 0xbf, 0x55, 0x3d, 0x34, 0xe3, 0x25, 0x38, 0x1b, 0x2c, 0x22, 0xc3, 0xb1, 0xf8, 0x62, 0xa6,
-	0x30, 0x5d, 0x9c, 0xd1, 0x9f, 0x0b, 0xce, 0x77, 0x2d, 0x8e, 0x2f, 0xc2, 0x2c, 0x75, 0x2d, 0x83,
-	0xb6, 0x59, 0xe3, 0xde, 0xe2, 0x74, 0x01, 0x15, 0xa7, 0xf5, 0xb3, 0xd4, 0xb5, 0x6e, 0x07, 0x67,
-	0x75, 0x1b, 0x70, 0xbc, 0xe8, 0xc9, 0xad, 0xa0, 0x3c, 0x5c, 0xfe, 0x38, 0xd0, 0xe5, 0x43, 0xd6,
-	0xd8, 0x34, 0xeb, 0x4d, 0x5a, 0x93, 0x1b, 0xbd, 0xb7, 0x2a, 0xbf, 0x43, 0xa0, 0x8c, 0xf3, 0x90,
-	0x98, 0x0c, 0x70, 0x53, 0x1a, 0x8d, 0xe8, 0x45, 0xd0, 0x67, 0x0e, 0xdf, 0x0c, 0x5a, 0xf4, 0x66,
-	0xd0, 0xa2, 0xf8, 0xea, 0xd5, 0x80, 0xf9, 0xe9, 0x7e, 0x7e, 0x29, 0x14, 0x72, 0x34, 0x85, 0xfa,
-	0xe3, 0x93, 0x3c, 0xd2, 0xe7, 0x9b, 0xc3, 0x85, 0xcb, 0x7f, 0x9e, 0x83, 0xac, 0x60, 0xc2, 0xbf,
-	0x23, 0x78, 0x65, 0xcc, 0x22, 0xc7, 0xe5, 0xa4, 0x2b, 0x94, 0xfe, 0x30, 0xc8, 0x55, 0x8e, 0x15,
-	0x13, 0xf6, 0xaf, 0xbe, 0xf3, 0xf5, 0x1f, 0xff, 0x7c, 0x9f, 0x79, 0x03, 0xaf, 0x93, 0x84, 0x37,
-	0x4b, 0xf4, 0xc0, 0x69, 0x89, 0x24, 0x86, 0xc7, 0x0c, 0xab, 0x97, 0xc6, 0x10, 0xdf, 0x01, 0x7e,
-	0x80, 0x60, 0xb6, 0xb7, 0xe3, 0xf1, 0x95, 0xf1, 0x37, 0xbf, 0xff, 0x4c, 0xc8, 0x5d, 0x9d, 0xe0,
-	0x25, 0xd1, 0x5e, 0x17, 0x68, 0x1a, 0x7e, 0x2d, 0x0d, 0x4d, 0xfc, 0xf0, 0x8c, 0x7a, 0xd7, 0x70,
-	0x2c, 0xb2, 0xe3, 0x58, 0xbb, 0x78, 0x07, 0xce, 0xc8, 0x5f, 0xd5, 0xab, 0x63, 0xcb, 0xf4, 0x24,
-	0x53, 0xd3, 0x5c, 0x24, 0xc6, 0x8a, 0xc0, 0xb8, 0x82, 0xd5, 0x89, 0x18, 0x1c, 0xef, 0x21, 0x38,
-	0x1f, 0xdf, 0x26, 0xf8, 0x5a, 0x52, 0x81, 0x84, 0x1d, 0x9f, 0x2b, 0x4e, 0x76, 0x94, 0x3c, 0x25,
-	0xc1, 0x73, 0x03, 0x5f, 0x4f, 0xe3, 0x31, 0x45, 0xa4, 0x1c, 0x4b, 0xf8, 0xd7, 0xa1, 0xc5, 0x1f,
-	0x8d, 0x32, 0x4c, 0x26, 0x55, 0x1d, 0x1a, 0xba, 0xb9, 0xb5, 0xa3, 0x07, 0x48, 0xdc, 0xb7, 0x04,
-	0xee, 0x4d, 0x5c, 0x39, 0x32, 0xae, 0xd1, 0xa6, 0x1d, 0x23, 0x9c, 0xe6, 0x0f, 0x11, 0xcc, 0x0d,
-	0x4e, 0x61, 0x7c, 0x3d, 0x89, 0x20, 0x71, 0x47, 0xe6, 0x56, 0x8e, 0xe2, 0x2a, 0x31, 0x2b, 0x02,
-	0x73, 0x15, 0xdf, 0x48, 0xc3, 0x1c, 0x1a, 0xf7, 0xf8, 0xb7, 0x91, 0xe5, 0xd9, 0x53, 0xb6, 0x34,
-	0xb9, 0xf6, 0xb0, 0xb6, 0xe5, 0xe3, 0x84, 0x48, 0xec, 0xb7, 0x05, 0xf6, 0x2d, 0x7c, 0xf3, 0x18,
-	0xd8, 0x31, 0x7d, 0xf7, 0x10, 0x40, 0x7f, 0x76, 0xe3, 0xc4, 0x1f, 0xe6, 0xc8, 0x42, 0xc9, 0x2d,
-	0x4f, 0x72, 0x93, 0x70, 0xb7, 0x04, 0x5c, 0x09, 0x93, 0x34, 0xb8, 0x4e, 0x18, 0x67, 0x50, 0xee,
-	0x91, 0x1d, 0xb1, 0x88, 0x76, 0xf1, 0xcf, 0x08, 0xe6, 0x47, 0x46, 0x76, 0xb2, 0xa4, 0xa9, 0x0b,
-	0x20, 0x59, 0xd2, 0xf4, 0x8d, 0xa0, 0xae, 0x0b, 0xea, 0x35, 0xac, 0xa5, 0x51, 0x8f, 0x0e, 0xfc,
-	0xea, 0xc6, 0xa3, 0x03, 0x05, 0x3d, 0x3e, 0x50, 0xd0, 0xdf, 0x07, 0x0a, 0xfa, 0xf6, 0x50, 0x99,
-	0x7a, 0x7c, 0xa8, 0x4c, 0xfd, 0x75, 0xa8, 0x4c, 0x7d, 0xbe, 0x1e, 0x5b, 0x6c, 0x32, 0xe7, 0x6a,
-	0xd3, 0xac, 0xf3, 0x5e, 0x81, 0xad, 0x52, 0x99, 0x7c, 0x15, 0x2f, 0x23, 0x96, 0x5d, 0xfd, 0x8c,
-	0xd8, 0x3b, 0x95, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xab, 0x12, 0x45, 0x20, 0x23, 0x0f, 0x00,
-	0x00,
+	// 1215 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0xc7, 0x19, 0x7e, 0x04, 0x78, 0x05, 0x27, 0x4c, 0xa0, 0x05, 0x27, 0xd8, 0xee, 0x2a, 0x10,
+	0x43, 0xc2, 0x2e, 0x36, 0x09, 0xa9, 0xfa, 0x4b, 0xaa, 0x4b, 0x7e, 0x20, 0xb5, 0x12, 0x5d, 0xb5,
+	0xaa, 0x54, 0xa9, 0x5a, 0xad, 0xbd, 0x13, 0xb3, 0xc2, 0xde, 0x71, 0x76, 0xd6, 0x50, 0x0b, 0x71,
+	0xa9, 0x7a, 0x8e, 0x5a, 0x15, 0x55, 0x3d, 0xe4, 0xd4, 0x63, 0x8f, 0xad, 0xd4, 0x53, 0xd5, 0x43,
+	0x4f, 0x1c, 0x23, 0xf5, 0xd2, 0x13, 0xa9, 0xa0, 0x7f, 0x01, 0xd7, 0x5e, 0xaa, 0x9d, 0x99, 0x35,
+	0xbb, 0x66, 0x6d, 0x43, 0x94, 0x44, 0x9c, 0xf0, 0xf0, 0x7e, 0x7d, 0xde, 0x77, 0x67, 0xf7, 0x3d,
+	0x48, 0x51, 0x56, 0xa5, 0xcc, 0x66, 0x9a, 0xed, 0x94, 0x88, 0xe3, 0xd9, 0x9b, 0x84, 0x69, 0x8f,
+	0xea, 0xc4, 0x6d, 0xa8, 0x35, 0x97, 0x7a, 0x14, 0x63, 0x69, 0x57, 0x8f, 0xed, 0xc9, 0xf1, 0x32,
+	0x2d, 0x53, 0x6e, 0xd6, 0xfc, 0x5f, 0xc2, 0x33, 0x79, 0xb5, 0x4c, 0x69, 0xb9, 0x42, 0x34, 0xb3,
+	0x66, 0x6b, 0xa6, 0xe3, 0x50, 0xcf, 0xf4, 0x6c, 0xea, 0x30, 0x69, 0x4d, 0x49, 0x2b, 0x3f, 0x15,
+	0xeb, 0x0f, 0x35, 0xab, 0xee, 0x72, 0x87, 0xc0, 0x5e, 0xe2, 0x85, 0xb4, 0xa2, 0xc9, 0x88, 0xb6,
+	0x99, 0x2b, 0x12, 0xcf, 0xcc, 0x69, 0x25, 0x6a, 0x07, 0xf6, 0xf9, 0xb0, 0x9d, 0x03, 0x36, 0xbd,
+	0x6a, 0x66, 0xd9, 0x76, 0x22, 0xb9, 0x62, 0x7a, 0x2a, 0x9b, 0xf5, 0x32, 0x91, 0xf6, 0xd9, 0x18,
+	0xbb, 0x4b, 0xb6, 0x4c, 0xd7, 0x32, 0x5c, 0x52, 0xa2, 0xae, 0x25, 0xfd, 0xa6, 0x02, 0xbf, 0x0a,
+	0x2d, 0x6d, 0xd4, 0x6b, 0xfc, 0x8f, 0x30, 0x29, 0x19, 0x48, 0x7d, 0x4c, 0xad, 0x7a, 0x85, 0x7c,
+	0x4a, 0x57, 0x6c, 0xe6, 0xb9, 0x76, 0xb1, 0xee, 0x91, 0x0f, 0xa9, 0xed, 0x30, 0x9d, 0x3c, 0xaa,
+	0x13, 0xe6, 0x29, 0xdf, 0x20, 0x48, 0xb7, 0x75, 0x61, 0x35, 0xea, 0x30, 0x82, 0x4d, 0x18, 0xf0,
+	0x5b, 0x64, 0x93, 0x28, 0xd3, 0x97, 0x7d, 0x2d, 0x3f, 0xa5, 0x8a, 0x26, 0x55, 0xbf, 0x49, 0x55,
+	0xb6, 0xa7, 0xfa, 0x21, 0x85, 0xc5, 0xbd, 0xfd, 0x74, 0xcf, 0xcf, 0xcf, 0xd2, 0xd9, 0xb2, 0xed,
+	0xad, 0xd7, 0x8b, 0x6a, 0x89, 0x56, 0x35, 0xa9, 0x88, 0xf8, 0xb3, 0xc0, 0xac, 0x0d, 0xcd, 0x6b,
+	0xd4, 0x08, 0x53, 0x45, 0x0d, 0x91, 0x59, 0x51, 0xe0, 0xd2, 0x7d, 0xbf, 0xf5, 0x42, 0x63, 0x75,
+	0x45, 0xa2, 0xe1, 0x04, 0xf4, 0xda, 0xd6, 0x24, 0xca, 0xa0, 0x6c, 0xbf, 0xde, 0x6b, 0x5b, 0xca,
+	0x0a, 0x8c, 0x85, 0x7c, 0x24, 0x9b, 0x06, 0x03, 0x5c, 0x33, 0xee, 0xe7, 0xb3, 0x9d, 0xbc, 0x08,
+	0x2a, 0x8f, 0xd2, 0x85, 0x9f, 0xf2, 0x39, 0x8c, 0xf2, 0x73, 0xa0, 0x00, 0xbe, 0x07, 0x70, 0xfc,
+	0x68, 0x64, 0x9a, 0xd9, 0x48, 0x8b, 0xe2, 0xa2, 0x05, 0x8d, 0xae, 0x99, 0x65, 0x22, 0x63, 0xf5,
+	0x50, 0xa4, 0xf2, 0x18, 0x41, 0x22, 0xc8, 0x2c, 0xe1, 0x96, 0xa0, 0xdf, 0x32, 0x3d, 0xb3, 0xa9,
+	0x5b, 0x3b, 0xb6, 0x42, 0xbf, 0xaf, 0x9b, 0xce, 0x9d, 0xf1, 0xfd, 0x08, 0x4f, 0x2f, 0xe7, 0xb9,
+	0xde, 0x95, 0x47, 0x54, 0x8c, 0x00, 0x7d, 0x09, 0x97, 0x3f, 0x28, 0xf9, 0x55, 0x5e, 0x4e, 0xbf,
+	0xbb, 0x08, 0xc6, 0xa3, 0xf9, 0xcf, 0x45, 0xd7, 0xdb, 0x70, 0x25, 0x4c, 0xb5, 0x46, 0xdc, 0x15,
+	0xe2, 0xd0, 0x6a, 0xd0, 0xfd, 0x38, 0x0c, 0x58, 0xfe, 0x99, 0x37, 0x3e, 0xac, 0x8b, 0x03, 0xbe,
+	0x17, 0x53, 0xfd, 0x79, 0x34, 0x79, 0x82, 0xe0, 0x6a, 0x7c, 0xf5, 0x73, 0xa1, 0x8d, 0x01, 0x13,
+	0x9f, 0xd5, 0x4a, 0xb4, 0x6a, 0x3b, 0xe5, 0x97, 0x73, 0x27, 0x7e, 0x40, 0xf0, 0x7a, 0x6b, 0x85,
+	0x73, 0xd1, 0xf9, 0x0e, 0x4c, 0x47, 0xb9, 0x5e, 0xed, 0xbd, 0xf8, 0x15, 0x41, 0xaa, 0x5d, 0x7d,
+	0xa9, 0xcf, 0x03, 0xb8, 0x58, 0x97, 0x1e, 0x06, 0xff, 0x52, 0xb1, 0xd3, 0x4a, 0x95, 0xa8, 0x47,
+	0x32, 0xbf, 0x38, 0xd1, 0x18, 0x8c, 0xe9, 0x7c, 0xde, 0xb0, 0xbb, 0xcc, 0x0b, 0x84, 0x9a, 0x85,
+	0x01, 0xba, 0xe5, 0x10, 0x57, 0x08, 0x55, 0xb8, 0x74, 0xb4, 0x9f, 0x1e, 0x69, 0x98, 0xd5, 0xca,
+	0xdb, 0x0a, 0xff, 0xb7, 0xa2, 0x0b, 0x33, 0x9e, 0x82, 0x21, 0x7f, 0x10, 0x19, 0xb6, 0xc5, 0x26,
+	0x7b, 0x33, 0x7d, 0xd9, 0x7e, 0x7d, 0xd0, 0x3f, 0xaf, 0x5a, 0x0c, 0x5f, 0x81, 0x61, 0xe2, 0x58,
+	0x06, 0xa9, 0xd1, 0xd2, 0xfa, 0x64, 0x5f, 0x06, 0x65, 0xfb, 0xf4, 0x21, 0xe2, 0x58, 0x77, 0xfd,
+	0xb3, 0xb2, 0x05, 0x38, 0x5c, 0xf4, 0xd5, 0x8d, 0xa0, 0x34, 0x4c, 0x7f, 0xe2, 0xeb, 0xf2, 0x11,
+	0x2d, 0x6d, 0x98, 0xc5, 0x0a, 0x59, 0x91, 0x93, 0xbf, 0x39, 0x2a, 0xbf, 0x43, 0x90, 0x6a, 0xe7,
+	0x21, 0x31, 0x29, 0xe0, 0x8a, 0x34, 0x1a, 0xc1, 0xe6, 0x70, 0xcc, 0x2c, 0x76, 0x0b, 0x35, 0xd8,
+	0x2d, 0xd4, 0x20, 0xbe, 0x30, 0xe3, 0x33, 0x1f, 0xed, 0xa7, 0xa7, 0x84, 0x90, 0x27, 0x53, 0x28,
+	0x3f, 0x3e, 0x4b, 0x23, 0x7d, 0xac, 0xd2, 0x5a, 0x58, 0xf9, 0x1d, 0xc1, 0xb8, 0x90, 0xeb, 0x81,
+	0xcd, 0x3c, 0xea, 0x36, 0x82, 0xc7, 0x74, 0x13, 0x06, 0x4d, 0xcb, 0x72, 0x09, 0x63, 0xf2, 0x41,
+	0xe1, 0xa3, 0xfd, 0x74, 0x42, 0xe4, 0x97, 0x06, 0x45, 0x0f, 0x5c, 0xb0, 0x0a, 0x43, 0xfc, 0xce,
+	0x19, 0xb6, 0xc5, 0x2f, 0x4c, 0x7f, 0xe1, 0xf2, 0xd1, 0x7e, 0xfa, 0xa2, 0x70, 0x0f, 0x2c, 0x8a,
+	0x3e, 0xc8, 0x7f, 0xae, 0x5a, 0x2d, 0xef, 0x45, 0xdf, 0x73, 0xbf, 0x17, 0x7b, 0x08, 0x26, 0x5a,
+	0xf0, 0xa5, 0x92, 0x0f, 0x21, 0x11, 0xd9, 0x75, 0x02, 0x15, 0x33, 0x71, 0x6f, 0x83, 0x48, 0xa1,
+	0x73, 0xc7, 0xc2, 0xb4, 0x14, 0x73, 0x42, 0xd0, 0x47, 0xb3, 0x28, 0xfa, 0xa8, 0x1b, 0x72, 0x7e,
+	0x71, 0x2f, 0x4b, 0xfe, 0xbf, 0x11, 0x18, 0xe0, 0xb7, 0x03, 0xff, 0x89, 0xe0, 0x8d, 0x36, 0x2b,
+	0x15, 0xce, 0xc7, 0xe1, 0x77, 0x5e, 0xd1, 0x92, 0x4b, 0x67, 0x8a, 0x11, 0x68, 0xca, 0xfb, 0x5f,
+	0xff, 0xf5, 0xef, 0xf7, 0xbd, 0x6f, 0xe1, 0x65, 0x2d, 0x66, 0x8b, 0x0c, 0x56, 0xd2, 0x2a, 0x4f,
+	0x62, 0x78, 0xd4, 0xb0, 0x9a, 0x69, 0x0c, 0xfe, 0x36, 0xe0, 0xc7, 0x08, 0x86, 0x9b, 0xdb, 0x16,
+	0xbe, 0xd6, 0xfe, 0x1b, 0x74, 0xbc, 0xb0, 0x25, 0x67, 0xba, 0x78, 0x49, 0xb4, 0x5b, 0x1c, 0x4d,
+	0xc5, 0x37, 0x3b, 0xa1, 0x89, 0x4b, 0x57, 0x6c, 0x18, 0xb6, 0xa5, 0x6d, 0xdb, 0xd6, 0x0e, 0xde,
+	0x86, 0x0b, 0xf2, 0xfb, 0xf6, 0x66, 0xdb, 0x32, 0x4d, 0xc9, 0x94, 0x4e, 0x2e, 0x12, 0x63, 0x9e,
+	0x63, 0x5c, 0xc3, 0x4a, 0x57, 0x0c, 0x86, 0x77, 0x11, 0x8c, 0x84, 0xe7, 0x3a, 0xbe, 0x1e, 0x57,
+	0x20, 0x66, 0xdb, 0x4a, 0x66, 0xbb, 0x3b, 0x4a, 0x9e, 0x1c, 0xe7, 0xb9, 0x81, 0xe7, 0x3a, 0xf1,
+	0x98, 0x3c, 0x52, 0x0e, 0x08, 0xfc, 0x5b, 0xcb, 0x0a, 0x16, 0x0c, 0x15, 0xac, 0x75, 0xab, 0xda,
+	0x32, 0xfe, 0x92, 0x8b, 0xa7, 0x0f, 0x90, 0xb8, 0xef, 0x70, 0xdc, 0xdb, 0x78, 0xe9, 0xd4, 0xb8,
+	0x46, 0x8d, 0xb8, 0x86, 0x98, 0xab, 0x4f, 0x10, 0x24, 0xa2, 0xf3, 0x10, 0xcf, 0xc5, 0x11, 0xc4,
+	0x6e, 0x2b, 0xc9, 0xf9, 0xd3, 0xb8, 0x4a, 0xcc, 0x25, 0x8e, 0xb9, 0x80, 0x6f, 0x74, 0xc2, 0x6c,
+	0x19, 0xbc, 0xf8, 0x8f, 0x13, 0x6b, 0x4c, 0x53, 0xd9, 0x5c, 0xf7, 0xda, 0xad, 0xda, 0xe6, 0xcf,
+	0x12, 0x22, 0xb1, 0xdf, 0xe3, 0xd8, 0x77, 0xf0, 0xed, 0x33, 0x60, 0x87, 0xf4, 0xdd, 0x45, 0x00,
+	0xc7, 0x53, 0x14, 0xcf, 0xb4, 0xff, 0x68, 0x86, 0x46, 0x7b, 0x72, 0xb6, 0x9b, 0x9b, 0x84, 0xbb,
+	0xc3, 0xe1, 0x72, 0x58, 0xeb, 0x04, 0x27, 0x3e, 0xb3, 0xcc, 0x20, 0xcc, 0xd3, 0xb6, 0xf9, 0x4a,
+	0xb0, 0x83, 0x7f, 0x41, 0x30, 0x76, 0x62, 0x78, 0xc6, 0x4b, 0xda, 0x71, 0x14, 0x27, 0xf3, 0x67,
+	0x09, 0x91, 0xd4, 0xcb, 0x9c, 0x7a, 0x11, 0xab, 0x9d, 0xa8, 0x4f, 0x8e, 0x5e, 0xfc, 0x13, 0x82,
+	0xd1, 0xc8, 0x8c, 0xc2, 0xd9, 0xf6, 0x3a, 0x45, 0xa7, 0x70, 0x72, 0xee, 0x14, 0x9e, 0x12, 0xef,
+	0x5d, 0x8e, 0xb7, 0x8c, 0x6f, 0x75, 0x17, 0xd5, 0x58, 0x17, 0xb1, 0xda, 0xb6, 0x9c, 0xdf, 0x3b,
+	0x85, 0xb5, 0xbd, 0x83, 0x14, 0x7a, 0x7a, 0x90, 0x42, 0xff, 0x1c, 0xa4, 0xd0, 0xb7, 0x87, 0xa9,
+	0x9e, 0xa7, 0x87, 0xa9, 0x9e, 0xbf, 0x0f, 0x53, 0x3d, 0x5f, 0x2c, 0x87, 0xf6, 0x20, 0x99, 0x79,
+	0xa1, 0x62, 0x16, 0x59, 0xb3, 0xcc, 0x66, 0x2e, 0xaf, 0x7d, 0x15, 0x2e, 0xc6, 0x77, 0xa3, 0xe2,
+	0x05, 0xbe, 0xa6, 0x2c, 0xfd, 0x3f, 0x00, 0x9a, 0x5a, 0x9b, 0x47, 0x7a, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1040,6 +1168,9 @@ type QueryClient interface {
 	// LockableDurations returns lockable durations that are valid to distribute
 	// incentives for
 	LockableDurations(ctx context.Context, in *QueryLockableDurationsRequest, opts ...grpc.CallOption) (*QueryLockableDurationsResponse, error)
+	// RewardHistory returns the rewards an address received from gauges over
+	// the retained distribution epochs
+	RewardHistory(ctx context.Context, in *RewardHistoryRequest, opts ...grpc.CallOption) (*RewardHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -1131,6 +1262,15 @@ func (c *queryClient) LockableDurations(ctx context.Context, in *QueryLockableDu
 	return out, nil
 }
 
+func (c *queryClient) RewardHistory(ctx context.Context, in *RewardHistoryRequest, opts ...grpc.CallOption) (*RewardHistoryResponse, error) {
+	out := new(RewardHistoryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/RewardHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// ModuleToDistributeCoins returns coins that are going to be distributed
@@ -1155,6 +1295,9 @@ type QueryServer interface {
 	// LockableDurations returns lockable durations that are valid to distribute
 	// incentives for
 	LockableDurations(context.Context, *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error)
+	// RewardHistory returns the rewards an address received from gauges over
+	// the retained distribution epochs
+	RewardHistory(context.Context, *RewardHistoryRequest) (*RewardHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1188,6 +1331,9 @@ func (*UnimplementedQueryServer) RewardsEst(ctx context.Context, req *RewardsEst
 func (*UnimplementedQueryServer) LockableDurations(ctx context.Context, req *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockableDurations not implemented")
 }
+func (*UnimplementedQueryServer) RewardHistory(ctx context.Context, req *RewardHistoryRequest) (*RewardHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1355,6 +1501,24 @@ func _Query_LockableDurations_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RewardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewardHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/RewardHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardHistory(ctx, req.(*RewardHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.incentives.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1395,6 +1559,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LockableDurations",
 			Handler:    _Query_LockableDurations_Handler,
 		},
+		{
+			MethodName: "RewardHistory",
+			Handler:    _Query_RewardHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/incentives/query.proto",
@@ -2105,6 +2273,102 @@ func (m *QueryLockableDurationsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *RewardHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GaugeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RewardRecords) > 0 {
+		for iNdEx := len(m.RewardRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2395,6 +2659,45 @@ func (m *QueryLockableDurationsResponse) Size() (n int) {
 	return n
 }
 
+func (m *RewardHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GaugeId != 0 {
+		n += 1 + sovQuery(uint64(m.GaugeId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RewardHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardRecords) > 0 {
+		for _, e := range m.RewardRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4179,6 +4482,263 @@ func (m *QueryLockableDurationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RewardHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardRecords = append(m.RewardRecords, RewardRecord{})
+			if err := m.RewardRecords[len(m.RewardRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
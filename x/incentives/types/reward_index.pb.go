@@ -0,0 +1,800 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/incentives/reward_index.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RewardIndex is the rewards accrued per locked share by the gauges
+// distributing to the locks of a denom locked for at least a duration, when
+// rewards are distributed lazily. Rewards per share are scaled by
+// RewardIndexPrecision.
+type RewardIndex struct {
+	// denom is the locked denom the gauges distribute to
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// duration is the minimum lock duration the gauges distribute to
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	// rewards_per_share are the rewards accrued per locked share
+	RewardsPerShare github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=rewards_per_share,json=rewardsPerShare,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards_per_share" yaml:"rewards_per_share"`
+}
+
+func (m *RewardIndex) Reset()         { *m = RewardIndex{} }
+func (m *RewardIndex) String() string { return proto.CompactTextString(m) }
+func (*RewardIndex) ProtoMessage()    {}
+func (*RewardIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f10d280771874fb, []int{0}
+}
+func (m *RewardIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardIndex.Merge(m, src)
+}
+func (m *RewardIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardIndex proto.InternalMessageInfo
+
+func (m *RewardIndex) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RewardIndex) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *RewardIndex) GetRewardsPerShare() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardsPerShare
+	}
+	return nil
+}
+
+// LockRewardCheckpoint is the state of a lock when its lazily accrued rewards
+// were last settled. The lock accrues the growth of the reward indexes of its
+// denoms since then.
+type LockRewardCheckpoint struct {
+	// lock_id is the ID of the lock
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
+	// duration is the lock duration the lock accrues rewards for
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	// shares are the locked coins the lock accrues rewards for
+	Shares github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=shares,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"shares" yaml:"shares"`
+	// indexes are the cumulative reward indexes of the lock's denoms at its
+	// duration, summed over all the reward indexes of shorter or equal durations
+	Indexes []RewardIndex `protobuf:"bytes,4,rep,name=indexes,proto3" json:"indexes" yaml:"indexes"`
+}
+
+func (m *LockRewardCheckpoint) Reset()         { *m = LockRewardCheckpoint{} }
+func (m *LockRewardCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*LockRewardCheckpoint) ProtoMessage()    {}
+func (*LockRewardCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f10d280771874fb, []int{1}
+}
+func (m *LockRewardCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockRewardCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockRewardCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockRewardCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRewardCheckpoint.Merge(m, src)
+}
+func (m *LockRewardCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockRewardCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRewardCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockRewardCheckpoint proto.InternalMessageInfo
+
+func (m *LockRewardCheckpoint) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *LockRewardCheckpoint) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *LockRewardCheckpoint) GetShares() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+func (m *LockRewardCheckpoint) GetIndexes() []RewardIndex {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RewardIndex)(nil), "osmosis.incentives.RewardIndex")
+	proto.RegisterType((*LockRewardCheckpoint)(nil), "osmosis.incentives.LockRewardCheckpoint")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/incentives/reward_index.proto", fileDescriptor_7f10d280771874fb)
+}
+
+var fileDescriptor_7f10d280771874fb = []byte{
+	// 478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0x6e, 0x74, 0xe0, 0x02, 0x83, 0x68, 0x42, 0xd9, 0x40, 0x49, 0x15, 0x09, 0x54,
+	0x69, 0x9a, 0xad, 0x16, 0x89, 0x03, 0x37, 0xb2, 0x5d, 0x26, 0x21, 0x31, 0xc2, 0x8d, 0x4b, 0x95,
+	0x38, 0x26, 0xb5, 0xda, 0xe6, 0xab, 0x62, 0xb7, 0x6c, 0x6f, 0xc1, 0x11, 0x1e, 0x80, 0x0b, 0x4f,
+	0xb2, 0xe3, 0x8e, 0x9c, 0xba, 0xa9, 0x7d, 0x83, 0x3e, 0x01, 0x8a, 0xfd, 0x05, 0x2a, 0x8d, 0x03,
+	0x17, 0x4e, 0xb1, 0xfd, 0x7d, 0xfe, 0xff, 0xff, 0xdf, 0x4f, 0x0e, 0x79, 0x0e, 0x6a, 0x02, 0x4a,
+	0x2a, 0x26, 0x0b, 0x2e, 0x0a, 0x2d, 0xe7, 0x42, 0xb1, 0x52, 0x7c, 0x4e, 0xca, 0x6c, 0x20, 0x8b,
+	0x4c, 0x9c, 0xd3, 0x69, 0x09, 0x1a, 0x5c, 0x17, 0xdb, 0xe8, 0x9f, 0xb6, 0x83, 0xbd, 0x1c, 0x72,
+	0x30, 0x65, 0x56, 0xad, 0x6c, 0xe7, 0x81, 0x9f, 0x03, 0xe4, 0x63, 0xc1, 0xcc, 0x2e, 0x9d, 0x7d,
+	0x62, 0xd9, 0xac, 0x4c, 0xb4, 0x84, 0xa2, 0xae, 0x73, 0x23, 0xc5, 0xd2, 0x44, 0x09, 0x36, 0xef,
+	0xa5, 0x42, 0x27, 0x3d, 0xc6, 0x41, 0x62, 0x3d, 0xfc, 0xde, 0x24, 0xed, 0xd8, 0x04, 0x38, 0xad,
+	0xfc, 0xdd, 0x17, 0xe4, 0x4e, 0x26, 0x0a, 0x98, 0x78, 0x4e, 0xc7, 0xe9, 0xde, 0x8b, 0x1e, 0xad,
+	0x17, 0xc1, 0xfd, 0x8b, 0x64, 0x32, 0x7e, 0x1d, 0x9a, 0xe3, 0x30, 0xb6, 0x65, 0x37, 0x26, 0x77,
+	0x6b, 0x27, 0xaf, 0xd9, 0x71, 0xba, 0xed, 0xfe, 0x3e, 0xb5, 0x51, 0x68, 0x1d, 0x85, 0x9e, 0x60,
+	0x43, 0xf4, 0xf4, 0x72, 0x11, 0x34, 0xd6, 0x8b, 0x60, 0x17, 0x95, 0xf0, 0x3c, 0xfc, 0x7a, 0x1d,
+	0x38, 0xf1, 0x6f, 0x1d, 0xf7, 0x9b, 0x43, 0x1e, 0x5b, 0x18, 0x6a, 0x30, 0x15, 0xe5, 0x40, 0x0d,
+	0x93, 0x52, 0x78, 0x5b, 0x9d, 0xad, 0x6e, 0xbb, 0xff, 0x8c, 0xda, 0x41, 0x68, 0x35, 0x08, 0xc5,
+	0x41, 0xe8, 0x89, 0xe0, 0xc7, 0x20, 0x8b, 0xe8, 0x1d, 0x1a, 0x78, 0xd6, 0xe0, 0x96, 0x48, 0xf8,
+	0xe3, 0x3a, 0x38, 0xcc, 0xa5, 0x1e, 0xce, 0x52, 0xca, 0x61, 0xc2, 0x10, 0x8a, 0xfd, 0x1c, 0xa9,
+	0x6c, 0xc4, 0xf4, 0xc5, 0x54, 0xa8, 0x5a, 0x4f, 0xc5, 0xbb, 0x28, 0x71, 0x26, 0xca, 0x0f, 0x46,
+	0xe0, 0xa6, 0x49, 0xf6, 0xde, 0x02, 0x1f, 0x59, 0x56, 0xc7, 0x43, 0xc1, 0x47, 0x53, 0x90, 0x85,
+	0x76, 0x0f, 0xc9, 0xce, 0x18, 0xf8, 0x68, 0x20, 0x33, 0x83, 0x6c, 0x3b, 0x72, 0xd7, 0x8b, 0xe0,
+	0xa1, 0xcd, 0x81, 0x85, 0x30, 0x6e, 0x55, 0xab, 0xd3, 0xec, 0xbf, 0x50, 0xd3, 0xa4, 0x65, 0x66,
+	0x54, 0x48, 0x6a, 0xff, 0xaf, 0xa4, 0x0c, 0xa6, 0x37, 0xa8, 0xf8, 0xc0, 0x2a, 0xda, 0x6b, 0x15,
+	0x9b, 0xee, 0x3f, 0xb0, 0xb1, 0x60, 0xd0, 0xcb, 0x7d, 0x4f, 0x76, 0xcc, 0x83, 0x15, 0xca, 0xdb,
+	0x36, 0xb6, 0x01, 0xbd, 0xfd, 0x66, 0xe9, 0xc6, 0xcb, 0x8a, 0x9e, 0xa0, 0x39, 0xb2, 0xc1, 0xdb,
+	0x61, 0x5c, 0xeb, 0x44, 0x67, 0x97, 0x4b, 0xdf, 0xb9, 0x5a, 0xfa, 0xce, 0xcd, 0xd2, 0x77, 0xbe,
+	0xac, 0xfc, 0xc6, 0xd5, 0xca, 0x6f, 0xfc, 0x5c, 0xf9, 0x8d, 0x8f, 0xaf, 0x36, 0xe2, 0xa1, 0xcb,
+	0xd1, 0x38, 0x49, 0x55, 0xbd, 0x61, 0xf3, 0x5e, 0x9f, 0x9d, 0x6f, 0xfe, 0x53, 0x26, 0x72, 0xda,
+	0x32, 0x50, 0x5f, 0xfe, 0x1a, 0x00, 0xcb, 0x2e, 0x50, 0xee, 0x76, 0x03, 0x00, 0x00,
+}
+
+func (m *RewardIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsPerShare) > 0 {
+		for iNdEx := len(m.RewardsPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewardIndex(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRewardIndex(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRewardIndex(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockRewardCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockRewardCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockRewardCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		for iNdEx := len(m.Indexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Indexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewardIndex(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewardIndex(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintRewardIndex(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.LockId != 0 {
+		i = encodeVarintRewardIndex(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRewardIndex(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRewardIndex(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RewardIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRewardIndex(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovRewardIndex(uint64(l))
+	if len(m.RewardsPerShare) > 0 {
+		for _, e := range m.RewardsPerShare {
+			l = e.Size()
+			n += 1 + l + sovRewardIndex(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockRewardCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovRewardIndex(uint64(m.LockId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovRewardIndex(uint64(l))
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovRewardIndex(uint64(l))
+		}
+	}
+	if len(m.Indexes) > 0 {
+		for _, e := range m.Indexes {
+			l = e.Size()
+			n += 1 + l + sovRewardIndex(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRewardIndex(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRewardIndex(x uint64) (n int) {
+	return sovRewardIndex(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RewardIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewardIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewardIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewardIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsPerShare = append(m.RewardsPerShare, types1.DecCoin{})
+			if err := m.RewardsPerShare[len(m.RewardsPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewardIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewardIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockRewardCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewardIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockRewardCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockRewardCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewardIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewardIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, types1.Coin{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewardIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, RewardIndex{})
+			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewardIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewardIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRewardIndex(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRewardIndex
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewardIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewardIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRewardIndex
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRewardIndex
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRewardIndex
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRewardIndex        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRewardIndex          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRewardIndex = fmt.Errorf("proto: unexpected end of group")
+)
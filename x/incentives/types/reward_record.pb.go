@@ -0,0 +1,588 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/incentives/reward_record.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RewardRecord is a record of the rewards an address received from a gauge in
+// a distribution epoch. Records are kept for the number of epochs given by the
+// reward_history_retention_epochs param.
+// Lazily distributed rewards accrue to locks rather than being sent by gauges,
+// they are recorded when they are settled or claimed, with a gauge_id of 0, by
+// the denom and duration of the locks they accrued to.
+type RewardRecord struct {
+	// address is the address that received the rewards
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// gauge_id is the ID of the gauge the rewards were distributed from
+	GaugeId uint64 `protobuf:"varint,2,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty" yaml:"gauge_id"`
+	// epoch_number is the distribution epoch the rewards were distributed in
+	EpochNumber int64 `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty" yaml:"epoch_number"`
+	// rewards are the coins received, summed over all the locks or positions of
+	// the address
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// denom is the denom of the locks lazily distributed rewards accrued to
+	Denom string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// duration is the duration of the locks lazily distributed rewards accrued
+	// to
+	Duration time.Duration `protobuf:"bytes,6,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+}
+
+func (m *RewardRecord) Reset()         { *m = RewardRecord{} }
+func (m *RewardRecord) String() string { return proto.CompactTextString(m) }
+func (*RewardRecord) ProtoMessage()    {}
+func (*RewardRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49e78a69de542ae3, []int{0}
+}
+func (m *RewardRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardRecord.Merge(m, src)
+}
+func (m *RewardRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardRecord proto.InternalMessageInfo
+
+func (m *RewardRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RewardRecord) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+func (m *RewardRecord) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *RewardRecord) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *RewardRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RewardRecord) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RewardRecord)(nil), "osmosis.incentives.RewardRecord")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/incentives/reward_record.proto", fileDescriptor_49e78a69de542ae3)
+}
+
+var fileDescriptor_49e78a69de542ae3 = []byte{
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x52, 0x31, 0x6f, 0xd4, 0x30,
+	0x18, 0x3d, 0x73, 0x6d, 0xaf, 0xb8, 0x27, 0x40, 0x2e, 0x12, 0xa1, 0x48, 0x49, 0x94, 0xa1, 0xca,
+	0x40, 0x6d, 0xae, 0x48, 0x0c, 0x1d, 0x03, 0x0b, 0x0b, 0x42, 0x1e, 0x59, 0x4e, 0x4e, 0x6c, 0x52,
+	0x8b, 0x4b, 0x7c, 0xb2, 0x93, 0x83, 0xfe, 0x06, 0x16, 0x46, 0x7e, 0x03, 0xbf, 0xa4, 0x63, 0x47,
+	0xa6, 0x14, 0xdd, 0xfd, 0x83, 0xfc, 0x02, 0x74, 0x76, 0xdc, 0xbb, 0x29, 0x7e, 0x79, 0xef, 0xfb,
+	0xf4, 0xde, 0xb3, 0xe1, 0xb9, 0x32, 0x95, 0x32, 0xd2, 0x10, 0x59, 0x17, 0xa2, 0x6e, 0xe4, 0x4a,
+	0x18, 0xa2, 0xc5, 0x77, 0xa6, 0xf9, 0x5c, 0x8b, 0x42, 0x69, 0x8e, 0x97, 0x5a, 0x35, 0x0a, 0xa1,
+	0x41, 0x87, 0x77, 0xba, 0xb3, 0xe7, 0xa5, 0x2a, 0x95, 0xa5, 0xc9, 0xf6, 0xe4, 0x94, 0x67, 0x61,
+	0xa9, 0x54, 0xb9, 0x10, 0xc4, 0xa2, 0xbc, 0xfd, 0x4a, 0x78, 0xab, 0x59, 0x23, 0x55, 0xed, 0xf9,
+	0xc2, 0xae, 0x22, 0x39, 0x33, 0x82, 0xac, 0x66, 0xb9, 0x68, 0xd8, 0x8c, 0x14, 0x4a, 0x0e, 0x7c,
+	0xf2, 0x73, 0x0c, 0xa7, 0xd4, 0x3a, 0xa0, 0xd6, 0x00, 0x7a, 0x0d, 0x27, 0x8c, 0x73, 0x2d, 0x8c,
+	0x09, 0x40, 0x0c, 0xd2, 0xc7, 0x19, 0xea, 0xbb, 0xe8, 0xc9, 0x0d, 0xab, 0x16, 0x57, 0xc9, 0x40,
+	0x24, 0xd4, 0x4b, 0x10, 0x86, 0xc7, 0x25, 0x6b, 0x4b, 0x31, 0x97, 0x3c, 0x78, 0x14, 0x83, 0xf4,
+	0x20, 0x3b, 0xed, 0xbb, 0xe8, 0xa9, 0x93, 0x7b, 0x26, 0xa1, 0x13, 0x7b, 0xfc, 0xc8, 0xd1, 0x15,
+	0x9c, 0x8a, 0xa5, 0x2a, 0xae, 0xe7, 0x75, 0x5b, 0xe5, 0x42, 0x07, 0xe3, 0x18, 0xa4, 0xe3, 0xec,
+	0x45, 0xdf, 0x45, 0xa7, 0x6e, 0x66, 0x9f, 0x4d, 0xe8, 0x89, 0x85, 0x9f, 0x2c, 0x42, 0x02, 0x4e,
+	0x5c, 0x57, 0x26, 0x38, 0x88, 0xc7, 0xe9, 0xc9, 0xe5, 0x4b, 0xec, 0xc2, 0xe1, 0x6d, 0x38, 0x3c,
+	0x84, 0xc3, 0xef, 0x95, 0xac, 0xb3, 0x37, 0xb7, 0x5d, 0x34, 0xfa, 0x73, 0x1f, 0xa5, 0xa5, 0x6c,
+	0xae, 0xdb, 0x1c, 0x17, 0xaa, 0x22, 0x43, 0x13, 0xee, 0x73, 0x61, 0xf8, 0x37, 0xd2, 0xdc, 0x2c,
+	0x85, 0xb1, 0x03, 0x86, 0xfa, 0xdd, 0xe8, 0x1c, 0x1e, 0x72, 0x51, 0xab, 0x2a, 0x38, 0xb4, 0xf1,
+	0x9f, 0xf5, 0x5d, 0x34, 0x75, 0xde, 0xec, 0xef, 0x84, 0x3a, 0x1a, 0x51, 0x78, 0xec, 0xbb, 0x0e,
+	0x8e, 0x62, 0x60, 0xfd, 0xb8, 0xcb, 0xc0, 0xfe, 0x32, 0xf0, 0x87, 0x41, 0x90, 0xbd, 0xda, 0xfa,
+	0xd9, 0x35, 0xe3, 0x07, 0x93, 0xdf, 0xf7, 0x11, 0xa0, 0x0f, 0x7b, 0xb2, 0xcf, 0xb7, 0xeb, 0x10,
+	0xdc, 0xad, 0x43, 0xf0, 0x6f, 0x1d, 0x82, 0x5f, 0x9b, 0x70, 0x74, 0xb7, 0x09, 0x47, 0x7f, 0x37,
+	0xe1, 0xe8, 0xcb, 0xbb, 0xbd, 0x20, 0xc3, 0xe3, 0xb8, 0x58, 0xb0, 0xdc, 0x78, 0x40, 0x56, 0xb3,
+	0x4b, 0xf2, 0x63, 0xff, 0x5d, 0xd9, 0x70, 0xf9, 0x91, 0xf5, 0xf2, 0xf6, 0xff, 0x00, 0xae, 0x6d,
+	0x99, 0x03, 0x7a, 0x02, 0x00, 0x00,
+}
+
+func (m *RewardRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRewardRecord(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRewardRecord(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewardRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintRewardRecord(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GaugeId != 0 {
+		i = encodeVarintRewardRecord(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRewardRecord(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRewardRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRewardRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RewardRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRewardRecord(uint64(l))
+	}
+	if m.GaugeId != 0 {
+		n += 1 + sovRewardRecord(uint64(m.GaugeId))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovRewardRecord(uint64(m.EpochNumber))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovRewardRecord(uint64(l))
+		}
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRewardRecord(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovRewardRecord(uint64(l))
+	return n
+}
+
+func sovRewardRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRewardRecord(x uint64) (n int) {
+	return sovRewardRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RewardRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewardRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewardRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewardRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewardRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewardRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRewardRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRewardRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewardRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewardRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRewardRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRewardRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRewardRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRewardRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRewardRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRewardRecord = fmt.Errorf("proto: unexpected end of group")
+)
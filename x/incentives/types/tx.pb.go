@@ -55,6 +55,10 @@ type MsgCreateGauge struct {
 	// num_epochs_paid_over is the number of epochs distribution will be completed
 	// over
 	NumEpochsPaidOver uint64 `protobuf:"varint,6,opt,name=num_epochs_paid_over,json=numEpochsPaidOver,proto3" json:"num_epochs_paid_over,omitempty"`
+	// distribute_to_pool makes the gauge distribute to the liquidity providers of
+	// a pool. distribute_to's denom must then be empty, its duration selects the
+	// share locks of ByShares gauges.
+	DistributeToPool *PoolDistribution `protobuf:"bytes,7,opt,name=distribute_to_pool,json=distributeToPool,proto3" json:"distribute_to_pool,omitempty" yaml:"distribute_to_pool"`
 }
 
 func (m *MsgCreateGauge) Reset()         { *m = MsgCreateGauge{} }
@@ -132,6 +136,13 @@ func (m *MsgCreateGauge) GetNumEpochsPaidOver() uint64 {
 	return 0
 }
 
+func (m *MsgCreateGauge) GetDistributeToPool() *PoolDistribution {
+	if m != nil {
+		return m.DistributeToPool
+	}
+	return nil
+}
+
 type MsgCreateGaugeResponse struct {
 }
 
@@ -268,55 +279,165 @@ func (m *MsgAddToGaugeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddToGaugeResponse proto.InternalMessageInfo
 
+// MsgClaimRewards claims the lazily accrued rewards of locks
+type MsgClaimRewards struct {
+	// owner is the owner of the locks
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// lock_ids are the IDs of the locks to claim the rewards of. All the locks of
+	// the owner are claimed if empty.
+	LockIds []uint64 `protobuf:"varint,2,rep,packed,name=lock_ids,json=lockIds,proto3" json:"lock_ids,omitempty" yaml:"lock_ids"`
+}
+
+func (m *MsgClaimRewards) Reset()         { *m = MsgClaimRewards{} }
+func (m *MsgClaimRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewards) ProtoMessage()    {}
+func (*MsgClaimRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{4}
+}
+func (m *MsgClaimRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewards.Merge(m, src)
+}
+func (m *MsgClaimRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewards proto.InternalMessageInfo
+
+func (m *MsgClaimRewards) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgClaimRewards) GetLockIds() []uint64 {
+	if m != nil {
+		return m.LockIds
+	}
+	return nil
+}
+
+type MsgClaimRewardsResponse struct {
+	// rewards are the claimed coins
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *MsgClaimRewardsResponse) Reset()         { *m = MsgClaimRewardsResponse{} }
+func (m *MsgClaimRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{5}
+}
+func (m *MsgClaimRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateGauge)(nil), "osmosis.incentives.MsgCreateGauge")
 	proto.RegisterType((*MsgCreateGaugeResponse)(nil), "osmosis.incentives.MsgCreateGaugeResponse")
 	proto.RegisterType((*MsgAddToGauge)(nil), "osmosis.incentives.MsgAddToGauge")
 	proto.RegisterType((*MsgAddToGaugeResponse)(nil), "osmosis.incentives.MsgAddToGaugeResponse")
+	proto.RegisterType((*MsgClaimRewards)(nil), "osmosis.incentives.MsgClaimRewards")
+	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "osmosis.incentives.MsgClaimRewardsResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/tx.proto", fileDescriptor_8ea120e22291556e) }
 
 var fileDescriptor_8ea120e22291556e = []byte{
-	// 596 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xcd, 0x7c, 0x49, 0xff, 0x26, 0xed, 0xa7, 0x62, 0x15, 0x70, 0x03, 0xb2, 0x53, 0x2f, 0x90,
-	0x41, 0xea, 0x0c, 0x0d, 0x12, 0x0b, 0x76, 0xa4, 0x42, 0xa8, 0x8b, 0x88, 0x60, 0x45, 0x42, 0xaa,
-	0x84, 0xac, 0xb1, 0x3d, 0xb8, 0xa3, 0xc6, 0x1e, 0xcb, 0x33, 0x4e, 0x9b, 0xb7, 0xa8, 0xc4, 0x5b,
-	0xf0, 0x06, 0xec, 0x58, 0x76, 0xd9, 0x25, 0xab, 0x14, 0x25, 0x6f, 0xd0, 0x27, 0x40, 0x1e, 0xdb,
-	0x4d, 0x22, 0x7e, 0xba, 0x61, 0x35, 0x99, 0x39, 0xe7, 0x9e, 0xb9, 0x73, 0xce, 0x8d, 0xe1, 0x23,
-	0x2e, 0x22, 0x2e, 0x98, 0xc0, 0x2c, 0xf6, 0x69, 0x2c, 0xd9, 0x88, 0x0a, 0x2c, 0xcf, 0x51, 0x92,
-	0x72, 0xc9, 0x35, 0xad, 0x04, 0xd1, 0x1c, 0x6c, 0xed, 0x84, 0x3c, 0xe4, 0x0a, 0xc6, 0xf9, 0xaf,
-	0x82, 0xd9, 0x32, 0x43, 0xce, 0xc3, 0x21, 0xc5, 0x6a, 0xe7, 0x65, 0x9f, 0xb0, 0x64, 0x11, 0x15,
-	0x92, 0x44, 0x49, 0x49, 0x30, 0x7c, 0xa5, 0x85, 0x3d, 0x22, 0x28, 0x1e, 0x1d, 0x78, 0x54, 0x92,
-	0x03, 0xec, 0x73, 0x16, 0x57, 0xf8, 0x6f, 0xfa, 0x08, 0x49, 0x16, 0xd2, 0x12, 0xdf, 0xad, 0xf0,
-	0x21, 0xf7, 0x4f, 0xb3, 0x44, 0x2d, 0x05, 0x64, 0x7d, 0xae, 0xc3, 0xff, 0x7b, 0x22, 0x3c, 0x4c,
-	0x29, 0x91, 0xf4, 0x6d, 0x5e, 0xa3, 0xed, 0xc1, 0x4d, 0x26, 0xdc, 0x84, 0xa6, 0x09, 0x95, 0x19,
-	0x19, 0xea, 0xa0, 0x0d, 0xec, 0x75, 0xa7, 0xc9, 0x44, 0xbf, 0x3a, 0xd2, 0x9e, 0xc0, 0x15, 0x7e,
-	0x16, 0xd3, 0x54, 0xff, 0xaf, 0x0d, 0xec, 0x8d, 0xee, 0xf6, 0xcd, 0xc4, 0xdc, 0x1c, 0x93, 0x68,
-	0xf8, 0xca, 0x52, 0xc7, 0x96, 0x53, 0xc0, 0xda, 0x11, 0xdc, 0x0a, 0x98, 0x90, 0x29, 0xf3, 0x32,
-	0x49, 0x5d, 0xc9, 0xf5, 0x7a, 0x1b, 0xd8, 0xcd, 0x8e, 0x81, 0x2a, 0x6f, 0x8a, 0x86, 0xd0, 0xfb,
-	0x8c, 0xa6, 0xe3, 0x43, 0x1e, 0x07, 0x4c, 0x32, 0x1e, 0x77, 0x1b, 0x97, 0x13, 0xb3, 0xe6, 0x6c,
-	0xce, 0x4b, 0x07, 0x5c, 0x23, 0x70, 0x25, 0x7f, 0xb1, 0xd0, 0x1b, 0xed, 0xba, 0xdd, 0xec, 0xec,
-	0xa2, 0xc2, 0x13, 0x94, 0x7b, 0x82, 0x4a, 0x4f, 0xd0, 0x21, 0x67, 0x71, 0xf7, 0x79, 0x5e, 0xfd,
-	0xe5, 0xda, 0xb4, 0x43, 0x26, 0x4f, 0x32, 0x0f, 0xf9, 0x3c, 0xc2, 0xa5, 0x81, 0xc5, 0xb2, 0x2f,
-	0x82, 0x53, 0x2c, 0xc7, 0x09, 0x15, 0xaa, 0x40, 0x38, 0x85, 0xb2, 0xf6, 0x01, 0x42, 0x21, 0x49,
-	0x2a, 0xdd, 0xdc, 0x7f, 0x7d, 0x45, 0xb5, 0xda, 0x42, 0x45, 0x38, 0xa8, 0x0a, 0x07, 0x0d, 0xaa,
-	0x70, 0xba, 0x8f, 0xf3, 0x8b, 0x6e, 0x26, 0xe6, 0x76, 0xf1, 0xf4, 0xdb, 0xd4, 0xac, 0x8b, 0x6b,
-	0x13, 0x38, 0x1b, 0x4a, 0x2b, 0x67, 0x6b, 0x18, 0xee, 0xc4, 0x59, 0xe4, 0xd2, 0x84, 0xfb, 0x27,
-	0xc2, 0x4d, 0x08, 0x0b, 0x5c, 0x3e, 0xa2, 0xa9, 0xbe, 0xda, 0x06, 0x76, 0xc3, 0xb9, 0x17, 0x67,
-	0xd1, 0x1b, 0x05, 0xf5, 0x09, 0x0b, 0xde, 0x8d, 0x68, 0x6a, 0xe9, 0xf0, 0xc1, 0x72, 0x28, 0x0e,
-	0x15, 0x09, 0x8f, 0x05, 0xb5, 0xbe, 0x02, 0xb8, 0xd5, 0x13, 0xe1, 0xeb, 0x20, 0x18, 0xf0, 0x22,
-	0xae, 0xdb, 0x2c, 0xc0, 0xdf, 0xb3, 0xd8, 0x85, 0xeb, 0x6a, 0x26, 0x5c, 0x16, 0xa8, 0xd8, 0x1a,
-	0xce, 0x9a, 0xda, 0x1f, 0x05, 0x1a, 0x85, 0x6b, 0x29, 0x3d, 0x23, 0x69, 0x20, 0xf4, 0xfa, 0xbf,
-	0x77, 0xb7, 0xd2, 0xb6, 0x1e, 0xc2, 0xfb, 0x4b, 0xad, 0x57, 0x8f, 0xea, 0x7c, 0x03, 0xb0, 0xde,
-	0x13, 0xa1, 0xf6, 0x11, 0x36, 0x17, 0x07, 0xd1, 0x42, 0xbf, 0xfe, 0x85, 0xd0, 0xb2, 0x2f, 0xad,
-	0x67, 0x77, 0x73, 0xaa, 0x6b, 0xb4, 0x63, 0x08, 0x17, 0x7c, 0xdb, 0xfb, 0x43, 0xe5, 0x9c, 0xd2,
-	0x7a, 0x7a, 0x27, 0xa5, 0xd2, 0xee, 0xf6, 0x2f, 0xa7, 0x06, 0xb8, 0x9a, 0x1a, 0xe0, 0xc7, 0xd4,
-	0x00, 0x17, 0x33, 0xa3, 0x76, 0x35, 0x33, 0x6a, 0xdf, 0x67, 0x46, 0xed, 0xf8, 0xe5, 0x82, 0x51,
-	0xa5, 0xdc, 0xfe, 0x90, 0x78, 0xa2, 0xda, 0xe0, 0xd1, 0x41, 0x07, 0x9f, 0x2f, 0x7d, 0x42, 0x72,
-	0xf3, 0xbc, 0x55, 0x35, 0x71, 0x2f, 0x7e, 0x06, 0x00, 0x00, 0xff, 0xff, 0x41, 0xff, 0xee, 0x97,
-	0x65, 0x04, 0x00, 0x00,
+	// 711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xc1, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8d, 0x49, 0x20, 0x30, 0x09, 0x0f, 0x9e, 0x1f, 0xef, 0xe1, 0xe4, 0xb5, 0x76, 0x70, 0xab,
+	0x2a, 0x6d, 0xc5, 0xb8, 0xa4, 0x52, 0x17, 0xdd, 0x35, 0xb4, 0xaa, 0x58, 0xa0, 0xa6, 0x16, 0x52,
+	0x25, 0xa4, 0xca, 0x1d, 0xc7, 0x53, 0x33, 0xc2, 0xf6, 0xb8, 0x9e, 0x71, 0x80, 0x5d, 0x57, 0x5d,
+	0xf3, 0x0d, 0x5d, 0xf6, 0x0f, 0xfa, 0x07, 0x2c, 0x59, 0x76, 0x15, 0x2a, 0xf8, 0x83, 0x7c, 0x41,
+	0x35, 0xe3, 0x38, 0x24, 0x05, 0x0a, 0x8b, 0x76, 0x65, 0x8f, 0xcf, 0xb9, 0x77, 0xee, 0x3d, 0xe7,
+	0x40, 0xc0, 0xff, 0x94, 0x85, 0x94, 0x11, 0x66, 0x91, 0xa8, 0x8b, 0x23, 0x4e, 0x7a, 0x98, 0x59,
+	0x7c, 0x1f, 0xc6, 0x09, 0xe5, 0x54, 0x55, 0x87, 0x20, 0x3c, 0x07, 0xeb, 0x4b, 0x3e, 0xf5, 0xa9,
+	0x84, 0x2d, 0xf1, 0x96, 0x31, 0xeb, 0x86, 0x4f, 0xa9, 0x1f, 0x60, 0x4b, 0x9e, 0xdc, 0xf4, 0xbd,
+	0xc5, 0x49, 0x88, 0x19, 0x47, 0x61, 0x3c, 0x24, 0xe8, 0x5d, 0xd9, 0xcb, 0x72, 0x11, 0xc3, 0x56,
+	0x6f, 0xcd, 0xc5, 0x1c, 0xad, 0x59, 0x5d, 0x4a, 0xa2, 0x1c, 0xbf, 0x64, 0x0e, 0x1f, 0xa5, 0x3e,
+	0x1e, 0xe2, 0xb5, 0x1c, 0x0f, 0x68, 0x77, 0x37, 0x8d, 0xe5, 0x23, 0x83, 0xcc, 0x4f, 0x25, 0xf0,
+	0xd7, 0x26, 0xf3, 0xd7, 0x13, 0x8c, 0x38, 0x7e, 0x29, 0x6a, 0xd4, 0x15, 0x50, 0x25, 0xcc, 0x89,
+	0x71, 0x12, 0x63, 0x9e, 0xa2, 0x40, 0x53, 0x1a, 0x4a, 0x73, 0xd6, 0xae, 0x10, 0xd6, 0xc9, 0x3f,
+	0xa9, 0xf7, 0xc0, 0x34, 0xdd, 0x8b, 0x70, 0xa2, 0x4d, 0x35, 0x94, 0xe6, 0x5c, 0x7b, 0x71, 0xd0,
+	0x37, 0xaa, 0x07, 0x28, 0x0c, 0x9e, 0x9a, 0xf2, 0xb3, 0x69, 0x67, 0xb0, 0xba, 0x01, 0xe6, 0x3d,
+	0xc2, 0x78, 0x42, 0xdc, 0x94, 0x63, 0x87, 0x53, 0xad, 0xd8, 0x50, 0x9a, 0x95, 0x96, 0x0e, 0x73,
+	0x6d, 0xb2, 0x81, 0xe0, 0xeb, 0x14, 0x27, 0x07, 0xeb, 0x34, 0xf2, 0x08, 0x27, 0x34, 0x6a, 0x97,
+	0x8e, 0xfa, 0x46, 0xc1, 0xae, 0x9e, 0x97, 0x6e, 0x51, 0x15, 0x81, 0x69, 0xb1, 0x31, 0xd3, 0x4a,
+	0x8d, 0x62, 0xb3, 0xd2, 0xaa, 0xc1, 0x4c, 0x13, 0x28, 0x34, 0x81, 0x43, 0x4d, 0xe0, 0x3a, 0x25,
+	0x51, 0xfb, 0x91, 0xa8, 0xfe, 0x72, 0x62, 0x34, 0x7d, 0xc2, 0x77, 0x52, 0x17, 0x76, 0x69, 0x68,
+	0x0d, 0x05, 0xcc, 0x1e, 0xab, 0xcc, 0xdb, 0xb5, 0xf8, 0x41, 0x8c, 0x99, 0x2c, 0x60, 0x76, 0xd6,
+	0x59, 0x7d, 0x03, 0x00, 0xe3, 0x28, 0xe1, 0x8e, 0xd0, 0x5f, 0x9b, 0x96, 0xa3, 0xd6, 0x61, 0x66,
+	0x0e, 0xcc, 0xcd, 0x81, 0x5b, 0xb9, 0x39, 0xed, 0x5b, 0xe2, 0xa2, 0x41, 0xdf, 0x58, 0xcc, 0x56,
+	0x1f, 0xb9, 0x66, 0x1e, 0x9e, 0x18, 0x8a, 0x3d, 0x27, 0x7b, 0x09, 0xb6, 0x6a, 0x81, 0xa5, 0x28,
+	0x0d, 0x1d, 0x1c, 0xd3, 0xee, 0x0e, 0x73, 0x62, 0x44, 0x3c, 0x87, 0xf6, 0x70, 0xa2, 0xcd, 0x34,
+	0x94, 0x66, 0xc9, 0xfe, 0x3b, 0x4a, 0xc3, 0x17, 0x12, 0xea, 0x20, 0xe2, 0xbd, 0xea, 0xe1, 0x44,
+	0xfd, 0x00, 0xd4, 0x09, 0xdd, 0x9c, 0x98, 0xd2, 0x40, 0x2b, 0xcb, 0x89, 0xee, 0xc2, 0x8b, 0xc1,
+	0x82, 0x1d, 0x4a, 0x83, 0xe7, 0x79, 0x85, 0x90, 0xf0, 0xf6, 0xa0, 0x6f, 0xd4, 0xb2, 0xb9, 0x2e,
+	0x76, 0x32, 0xed, 0xc5, 0x71, 0x6d, 0x45, 0xb1, 0xa9, 0x81, 0xff, 0x26, 0x73, 0x60, 0x63, 0x16,
+	0xd3, 0x88, 0x61, 0xf3, 0xab, 0x02, 0xe6, 0x37, 0x99, 0xff, 0xcc, 0xf3, 0xb6, 0x68, 0x96, 0x90,
+	0x91, 0xfd, 0xca, 0xaf, 0xed, 0xaf, 0x81, 0x59, 0x19, 0x43, 0x87, 0x78, 0x32, 0x29, 0x25, 0xbb,
+	0x2c, 0xcf, 0x1b, 0x9e, 0x8a, 0x41, 0x39, 0xc1, 0x7b, 0x28, 0xf1, 0x98, 0x56, 0xfc, 0xfd, 0x86,
+	0xe6, 0xbd, 0xcd, 0x65, 0xf0, 0xef, 0xc4, 0xe8, 0xa3, 0xa5, 0x08, 0x58, 0x10, 0xeb, 0x06, 0x88,
+	0x84, 0x76, 0xc6, 0xbd, 0xf1, 0x56, 0x10, 0xcc, 0x8a, 0xd8, 0x3a, 0xc4, 0x63, 0xda, 0x54, 0xa3,
+	0xd8, 0x2c, 0xb5, 0xff, 0x19, 0xf4, 0x8d, 0x85, 0x8c, 0x9a, 0x23, 0xa6, 0x5d, 0x16, 0xaf, 0x1b,
+	0x1e, 0x33, 0x3f, 0x2a, 0x60, 0xf9, 0xa7, 0xbb, 0xf2, 0x31, 0xc6, 0x65, 0x50, 0xfe, 0x9c, 0x0c,
+	0xad, 0xcf, 0x53, 0xa0, 0xb8, 0xc9, 0x7c, 0xf5, 0x2d, 0xa8, 0x8c, 0xff, 0xa5, 0x9b, 0x97, 0x45,
+	0x69, 0x32, 0x05, 0xf5, 0x07, 0xd7, 0x73, 0x46, 0xdb, 0x6c, 0x03, 0x30, 0x96, 0x92, 0x95, 0x2b,
+	0x2a, 0xcf, 0x29, 0xf5, 0xfb, 0xd7, 0x52, 0x46, 0xbd, 0xdf, 0x81, 0xea, 0x84, 0x5b, 0x77, 0xae,
+	0x9a, 0x6b, 0x8c, 0x54, 0x7f, 0x78, 0x03, 0x52, 0x7e, 0x43, 0xbb, 0x73, 0x74, 0xaa, 0x2b, 0xc7,
+	0xa7, 0xba, 0xf2, 0xfd, 0x54, 0x57, 0x0e, 0xcf, 0xf4, 0xc2, 0xf1, 0x99, 0x5e, 0xf8, 0x76, 0xa6,
+	0x17, 0xb6, 0x9f, 0x8c, 0x29, 0x3e, 0x6c, 0xb8, 0x1a, 0x20, 0x97, 0xe5, 0x07, 0xab, 0xb7, 0xd6,
+	0xb2, 0xf6, 0x27, 0x7e, 0x05, 0x84, 0x0b, 0xee, 0x8c, 0xfc, 0xa7, 0xf1, 0xf8, 0xc7, 0x00, 0x7d,
+	0x70, 0xc5, 0xc2, 0x28, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -333,6 +454,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error)
 	AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...grpc.CallOption) (*MsgAddToGaugeResponse, error)
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
 }
 
 type msgClient struct {
@@ -361,10 +483,20 @@ func (c *msgClient) AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Msg/ClaimRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error)
 	AddToGauge(context.Context, *MsgAddToGauge) (*MsgAddToGaugeResponse, error)
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -377,6 +509,9 @@ func (*UnimplementedMsgServer) CreateGauge(ctx context.Context, req *MsgCreateGa
 func (*UnimplementedMsgServer) AddToGauge(ctx context.Context, req *MsgAddToGauge) (*MsgAddToGaugeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddToGauge not implemented")
 }
+func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -418,6 +553,24 @@ func _Msg_AddToGauge_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Msg/ClaimRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.incentives.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -430,6 +583,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddToGauge",
 			Handler:    _Msg_AddToGauge_Handler,
 		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/incentives/tx.proto",
@@ -455,17 +612,29 @@ func (m *MsgCreateGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DistributeToPool != nil {
+		{
+			size, err := m.DistributeToPool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.NumEpochsPaidOver != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.NumEpochsPaidOver))
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Coins) > 0 {
@@ -607,6 +776,91 @@ func (m *MsgAddToGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockIds) > 0 {
+		dAtA5 := make([]byte, len(m.LockIds)*10)
+		var j4 int
+		for _, num := range m.LockIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTx(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -644,6 +898,10 @@ func (m *MsgCreateGauge) Size() (n int) {
 	if m.NumEpochsPaidOver != 0 {
 		n += 1 + sovTx(uint64(m.NumEpochsPaidOver))
 	}
+	if m.DistributeToPool != nil {
+		l = m.DistributeToPool.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -687,6 +945,41 @@ func (m *MsgAddToGaugeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgClaimRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.LockIds) > 0 {
+		l = 0
+		for _, e := range m.LockIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgClaimRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -893,6 +1186,42 @@ func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributeToPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DistributeToPool == nil {
+				m.DistributeToPool = &PoolDistribution{}
+			}
+			if err := m.DistributeToPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1149,6 +1478,248 @@ func (m *MsgAddToGaugeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LockIds = append(m.LockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LockIds) == 0 {
+					m.LockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LockIds = append(m.LockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types1.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,6 +9,14 @@ const (
 	FlagDuration    = "duration"
 	FlagMinDuration = "min-duration"
 	FlagAmount      = "amount"
+
+	FlagOwner           = "owner"
+	FlagDenom           = "denom"
+	FlagMaxDuration     = "max-duration"
+	FlagMinEndTime      = "min-end-time"
+	FlagMaxEndTime      = "max-end-time"
+	FlagUnlockingStatus = "unlocking-status"
+	FlagSyntheticStatus = "synthetic-status"
 )
 
 // FlagSetLockTokens returns flags for LockTokens msg builder.
@@ -26,9 +34,31 @@ func FlagSetUnlockTokens() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetRelockTokens() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagAmount, "", "The amount to be relocked. e.g. 1osmo")
+	return fs
+}
+
 func FlagSetMinDuration() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
 	fs.String(FlagMinDuration, "336h", "The minimum duration of token bonded. e.g. 24h, 168h, 336h")
 	return fs
 }
+
+// FlagSetLocksByFilter returns flags for the LocksByFilter query.
+func FlagSetLocksByFilter() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagOwner, "", "The owner of the locks")
+	fs.String(FlagDenom, "", "The denom of the locks")
+	fs.String(FlagMinDuration, "", "The minimum duration of the locks. e.g. 24h, 168h, 336h")
+	fs.String(FlagMaxDuration, "", "The maximum duration of the locks, inclusive. e.g. 24h, 168h, 336h")
+	fs.Int64(FlagMinEndTime, 0, "The minimum unlock time of the unlocking locks, in unix seconds")
+	fs.Int64(FlagMaxEndTime, 0, "The maximum unlock time of the unlocking locks, in unix seconds, inclusive")
+	fs.String(FlagUnlockingStatus, "any", "The unlocking status of the locks, one of any, unlocking, not-unlocking")
+	fs.String(FlagSyntheticStatus, "any", "The synthetic status of the locks, one of any, synthetic, not-synthetic")
+	return fs
+}
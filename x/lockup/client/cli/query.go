@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/v12/x/lockup/types"
 )
@@ -48,6 +49,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdOutputLocksJson(),
 		GetCmdSyntheticLockupsByLockupID(),
 		GetCmdAccountLockedDuration(),
+		GetCmdLocksByFilter(),
 		GetCmdParams(),
 	)
 
@@ -267,9 +269,14 @@ $ %s query lockup account-locked-pastime <address> <timestamp>
 			}
 			timestamp := time.Unix(i, 0)
 
+			pageReq, err := readOptionalPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedPastTime(cmd.Context(), &types.AccountLockedPastTimeRequest{Owner: args[0], Timestamp: timestamp})
+			res, err := queryClient.AccountLockedPastTime(cmd.Context(), &types.AccountLockedPastTimeRequest{Owner: args[0], Timestamp: timestamp, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -279,6 +286,7 @@ $ %s query lockup account-locked-pastime <address> <timestamp>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account locks")
 
 	return cmd
 }
@@ -311,9 +319,14 @@ $ %s query lockup account-locked-pastime-not-unlocking <address> <timestamp>
 			}
 			timestamp := time.Unix(i, 0)
 
+			pageReq, err := readOptionalPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedPastTimeNotUnlockingOnly(cmd.Context(), &types.AccountLockedPastTimeNotUnlockingOnlyRequest{Owner: args[0], Timestamp: timestamp})
+			res, err := queryClient.AccountLockedPastTimeNotUnlockingOnly(cmd.Context(), &types.AccountLockedPastTimeNotUnlockingOnlyRequest{Owner: args[0], Timestamp: timestamp, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -323,6 +336,7 @@ $ %s query lockup account-locked-pastime-not-unlocking <address> <timestamp>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account locks")
 
 	return cmd
 }
@@ -354,9 +368,14 @@ $ %s query lockup account-locked-pastime <address> <timestamp>
 			}
 			timestamp := time.Unix(i, 0)
 
+			pageReq, err := readOptionalPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountUnlockedBeforeTime(cmd.Context(), &types.AccountUnlockedBeforeTimeRequest{Owner: args[0], Timestamp: timestamp})
+			res, err := queryClient.AccountUnlockedBeforeTime(cmd.Context(), &types.AccountUnlockedBeforeTimeRequest{Owner: args[0], Timestamp: timestamp, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -366,6 +385,7 @@ $ %s query lockup account-locked-pastime <address> <timestamp>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account locks")
 
 	return cmd
 }
@@ -400,9 +420,14 @@ $ %s query lockup account-locked-pastime-denom <address> <timestamp> <denom>
 
 			denom := args[2]
 
+			pageReq, err := readOptionalPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedPastTimeDenom(cmd.Context(), &types.AccountLockedPastTimeDenomRequest{Owner: args[0], Timestamp: timestamp, Denom: denom})
+			res, err := queryClient.AccountLockedPastTimeDenom(cmd.Context(), &types.AccountLockedPastTimeDenomRequest{Owner: args[0], Timestamp: timestamp, Denom: denom, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -412,6 +437,7 @@ $ %s query lockup account-locked-pastime-denom <address> <timestamp> <denom>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account locks")
 
 	return cmd
 }
@@ -526,9 +552,14 @@ $ %s query lockup account-locked-longer-duration <address> <duration>
 				return err
 			}
 
+			pageReq, err := readOptionalPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedLongerDuration(cmd.Context(), &types.AccountLockedLongerDurationRequest{Owner: args[0], Duration: duration})
+			res, err := queryClient.AccountLockedLongerDuration(cmd.Context(), &types.AccountLockedLongerDurationRequest{Owner: args[0], Duration: duration, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -538,6 +569,7 @@ $ %s query lockup account-locked-longer-duration <address> <duration>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account locks")
 
 	return cmd
 }
@@ -567,9 +599,14 @@ $ %s query lockup account-locked-duration osmo1yl6hdjhmkf37639730gffanpzndzdpmhx
 				return err
 			}
 
+			pageReq, err := readOptionalPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedDuration(cmd.Context(), &types.AccountLockedDurationRequest{Owner: args[0], Duration: duration})
+			res, err := queryClient.AccountLockedDuration(cmd.Context(), &types.AccountLockedDurationRequest{Owner: args[0], Duration: duration, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -579,6 +616,7 @@ $ %s query lockup account-locked-duration osmo1yl6hdjhmkf37639730gffanpzndzdpmhx
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account locks")
 
 	return cmd
 }
@@ -609,9 +647,14 @@ $ %s query lockup account-locked-longer-duration-not-unlocking <address> <durati
 				return err
 			}
 
+			pageReq, err := readOptionalPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedLongerDurationNotUnlockingOnly(cmd.Context(), &types.AccountLockedLongerDurationNotUnlockingOnlyRequest{Owner: args[0], Duration: duration})
+			res, err := queryClient.AccountLockedLongerDurationNotUnlockingOnly(cmd.Context(), &types.AccountLockedLongerDurationNotUnlockingOnlyRequest{Owner: args[0], Duration: duration, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -621,6 +664,7 @@ $ %s query lockup account-locked-longer-duration-not-unlocking <address> <durati
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account locks")
 
 	return cmd
 }
@@ -654,9 +698,63 @@ $ %s query lockup account-locked-pastime <address> <duration> <denom>
 
 			denom := args[2]
 
+			pageReq, err := readOptionalPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountLockedLongerDurationDenom(cmd.Context(), &types.AccountLockedLongerDurationDenomRequest{Owner: args[0], Duration: duration, Denom: denom, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account locks")
+
+	return cmd
+}
+
+// GetCmdLocksByFilter returns the locks matching all the conditions of the filter flags.
+func GetCmdLocksByFilter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locks-by-filter",
+		Short: "Query locks matching a filter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query locks matching all the conditions of the filter flags.
+End time bounds only match locks that started unlocking.
+
+Example:
+$ %s query lockup locks-by-filter --owner=<address> --denom=<denom> --min-duration=24h --unlocking-status=not-unlocking
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req, err := locksByFilterRequestFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			req.Pagination, err = readOptionalPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedLongerDurationDenom(cmd.Context(), &types.AccountLockedLongerDurationDenomRequest{Owner: args[0], Duration: duration, Denom: denom})
+			res, err := queryClient.LocksByFilter(cmd.Context(), req)
 			if err != nil {
 				return err
 			}
@@ -665,11 +763,94 @@ $ %s query lockup account-locked-pastime <address> <duration> <denom>
 		},
 	}
 
+	cmd.Flags().AddFlagSet(FlagSetLocksByFilter())
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "locks")
 
 	return cmd
 }
 
+// locksByFilterRequestFromFlags builds the LocksByFilter request from the filter flags.
+func locksByFilterRequestFromFlags(cmd *cobra.Command) (*types.LocksByFilterRequest, error) {
+	req := &types.LocksByFilterRequest{}
+	var err error
+
+	if req.Owner, err = cmd.Flags().GetString(FlagOwner); err != nil {
+		return nil, err
+	}
+	if req.Denom, err = cmd.Flags().GetString(FlagDenom); err != nil {
+		return nil, err
+	}
+
+	for flagName, duration := range map[string]*time.Duration{FlagMinDuration: &req.MinDuration, FlagMaxDuration: &req.MaxDuration} {
+		durationStr, err := cmd.Flags().GetString(flagName)
+		if err != nil {
+			return nil, err
+		}
+		if durationStr == "" {
+			continue
+		}
+		if *duration, err = time.ParseDuration(durationStr); err != nil {
+			return nil, err
+		}
+	}
+
+	for flagName, endTime := range map[string]**time.Time{FlagMinEndTime: &req.MinEndTime, FlagMaxEndTime: &req.MaxEndTime} {
+		if !cmd.Flags().Changed(flagName) {
+			continue
+		}
+		unix, err := cmd.Flags().GetInt64(flagName)
+		if err != nil {
+			return nil, err
+		}
+		t := time.Unix(unix, 0)
+		*endTime = &t
+	}
+
+	unlockingStatus, err := cmd.Flags().GetString(FlagUnlockingStatus)
+	if err != nil {
+		return nil, err
+	}
+	switch unlockingStatus {
+	case "any":
+		req.UnlockingStatus = types.AnyUnlockingStatus
+	case "unlocking":
+		req.UnlockingStatus = types.UnlockingOnly
+	case "not-unlocking":
+		req.UnlockingStatus = types.NotUnlockingOnly
+	default:
+		return nil, fmt.Errorf("invalid unlocking status %s", unlockingStatus)
+	}
+
+	syntheticStatus, err := cmd.Flags().GetString(FlagSyntheticStatus)
+	if err != nil {
+		return nil, err
+	}
+	switch syntheticStatus {
+	case "any":
+		req.SyntheticStatus = types.AnySyntheticStatus
+	case "synthetic":
+		req.SyntheticStatus = types.SyntheticOnly
+	case "not-synthetic":
+		req.SyntheticStatus = types.NotSyntheticOnly
+	default:
+		return nil, fmt.Errorf("invalid synthetic status %s", syntheticStatus)
+	}
+
+	return req, nil
+}
+
+// readOptionalPageRequest reads the pagination flags, returning nil if none of them is set
+// so that all the locks are queried.
+func readOptionalPageRequest(cmd *cobra.Command) (*query.PageRequest, error) {
+	for _, flagName := range []string{flags.FlagPage, flags.FlagPageKey, flags.FlagOffset, flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse} {
+		if cmd.Flags().Changed(flagName) {
+			return client.ReadPageRequest(cmd.Flags())
+		}
+	}
+	return nil, nil
+}
+
 // GetCmdTotalBondedByDenom returns total amount of locked asset of a specific denom.
 func GetCmdTotalLockedByDenom() *cobra.Command {
 	cmd := &cobra.Command{
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -30,6 +31,10 @@ func GetTxCmd() *cobra.Command {
 		NewBeginUnlockingCmd(),
 		NewBeginUnlockByIDCmd(),
 		NewForceUnlockByIdCmd(),
+		NewTransferLockCmd(),
+		NewSplitLockCmd(),
+		NewMergeLocksCmd(),
+		NewCancelUnlockingCmd(),
 	)
 
 	return cmd
@@ -204,3 +209,164 @@ func NewForceUnlockByIdCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewTransferLockCmd transfers the ownership of individual period lock by ID to the recipient.
+func NewTransferLockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-lock [id] [recipient]",
+		Short: "transfer individual period lock by ID to the recipient",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferLock(
+				clientCtx.GetFromAddress(),
+				uint64(id),
+				recipient,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSplitLockCmd splits the given tokens out of individual period lock by ID into a new lock.
+func NewSplitLockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split-lock [id] [tokens]",
+		Short: "split tokens out of individual period lock by ID into a new lock",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSplitLock(
+				clientCtx.GetFromAddress(),
+				uint64(id),
+				coins,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMergeLocksCmd merges the period locks by ID into the first lock.
+func NewMergeLocksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge-locks [lock_ids]",
+		Short: "merge comma-separated period locks by ID of the same denom and duration into the first lock",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			lockIDs := []uint64{}
+			for _, idStr := range strings.Split(args[0], ",") {
+				lockID, err := strconv.ParseUint(strings.TrimSpace(idStr), 10, 64)
+				if err != nil {
+					return err
+				}
+				lockIDs = append(lockIDs, lockID)
+			}
+
+			msg := types.NewMsgMergeLocks(
+				clientCtx.GetFromAddress(),
+				lockIDs,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelUnlockingCmd cancels the unlocking of individual period lock by ID.
+func NewCancelUnlockingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-unlocking [id]",
+		Short: "cancel unlocking of individual period lock by ID",
+		Long:  "cancel unlocking of individual period lock by ID, restoring its original duration. if no amount provided, entire lock is restored",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			coins := sdk.Coins(nil)
+			amountStr, err := cmd.Flags().GetString(FlagAmount)
+			if err != nil {
+				return err
+			}
+
+			if amountStr != "" {
+				coins, err = sdk.ParseCoinsNormalized(amountStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgCancelUnlocking(
+				clientCtx.GetFromAddress(),
+				uint64(id),
+				coins,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetRelockTokens())
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
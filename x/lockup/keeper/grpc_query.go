@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -109,7 +110,11 @@ func (q Querier) AccountLockedPastTime(goCtx context.Context, req *types.Account
 		return nil, err
 	}
 
-	return &types.AccountLockedPastTimeResponse{Locks: q.Keeper.GetAccountLockedPastTime(ctx, owner, req.Timestamp)}, nil
+	locks, pageRes, err := q.Keeper.paginateLocks(ctx, accountLockedPastTimeRanges(ctx, owner, req.Timestamp), req.Pagination, nil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.AccountLockedPastTimeResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountUnlockedBeforeTime returns locks of an account of which unlock time is before the provided timestamp.
@@ -128,7 +133,11 @@ func (q Querier) AccountUnlockedBeforeTime(goCtx context.Context, req *types.Acc
 		return nil, err
 	}
 
-	return &types.AccountUnlockedBeforeTimeResponse{Locks: q.Keeper.GetAccountUnlockedBeforeTime(ctx, owner, req.Timestamp)}, nil
+	locks, pageRes, err := q.Keeper.paginateLocks(ctx, accountUnlockedBeforeTimeRanges(ctx, owner, req.Timestamp), req.Pagination, nil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.AccountUnlockedBeforeTimeResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedPastTimeDenom returns the locks of an account whose unlock time is beyond provided timestamp, limited to locks with
@@ -148,7 +157,11 @@ func (q Querier) AccountLockedPastTimeDenom(goCtx context.Context, req *types.Ac
 		return nil, err
 	}
 
-	return &types.AccountLockedPastTimeDenomResponse{Locks: q.Keeper.GetAccountLockedPastTimeDenom(ctx, owner, req.Denom, req.Timestamp)}, nil
+	locks, pageRes, err := q.Keeper.paginateLocks(ctx, accountLockedPastTimeDenomRanges(ctx, owner, req.Denom, req.Timestamp), req.Pagination, nil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.AccountLockedPastTimeDenomResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // LockedByID returns lock by lock ID.
@@ -189,8 +202,11 @@ func (q Querier) AccountLockedLongerDuration(goCtx context.Context, req *types.A
 		return nil, err
 	}
 
-	locks := q.Keeper.GetAccountLockedLongerDuration(ctx, owner, req.Duration)
-	return &types.AccountLockedLongerDurationResponse{Locks: locks}, nil
+	locks, pageRes, err := q.Keeper.paginateLocks(ctx, accountLockedLongerDurationRanges(owner, req.Duration), req.Pagination, nil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.AccountLockedLongerDurationResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedLongerDurationDenom returns locks of an account with duration longer than specified with specific denom.
@@ -209,8 +225,11 @@ func (q Querier) AccountLockedLongerDurationDenom(goCtx context.Context, req *ty
 		return nil, err
 	}
 
-	locks := q.Keeper.GetAccountLockedLongerDurationDenom(ctx, owner, req.Denom, req.Duration)
-	return &types.AccountLockedLongerDurationDenomResponse{Locks: locks}, nil
+	locks, pageRes, err := q.Keeper.paginateLocks(ctx, accountLockedLongerDurationDenomRanges(owner, req.Denom, req.Duration), req.Pagination, nil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.AccountLockedLongerDurationDenomResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedDuration returns the account locked with the specified duration.
@@ -229,8 +248,11 @@ func (q Querier) AccountLockedDuration(goCtx context.Context, req *types.Account
 		return nil, err
 	}
 
-	locks := q.Keeper.GetAccountLockedDuration(ctx, owner, req.Duration)
-	return &types.AccountLockedDurationResponse{Locks: locks}, nil
+	locks, pageRes, err := q.Keeper.paginateLocks(ctx, accountLockedDurationRanges(owner, req.Duration), req.Pagination, nil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.AccountLockedDurationResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedPastTimeNotUnlockingOnly returns locks of an account with unlock time beyond
@@ -250,7 +272,11 @@ func (q Querier) AccountLockedPastTimeNotUnlockingOnly(goCtx context.Context, re
 		return nil, err
 	}
 
-	return &types.AccountLockedPastTimeNotUnlockingOnlyResponse{Locks: q.Keeper.GetAccountLockedPastTimeNotUnlockingOnly(ctx, owner, req.Timestamp)}, nil
+	locks, pageRes, err := q.Keeper.paginateLocks(ctx, accountLockedPastTimeNotUnlockingOnlyRanges(ctx, owner, req.Timestamp), req.Pagination, nil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.AccountLockedPastTimeNotUnlockingOnlyResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedLongerDurationNotUnlockingOnly returns locks of an account with longer duration
@@ -270,7 +296,47 @@ func (q Querier) AccountLockedLongerDurationNotUnlockingOnly(goCtx context.Conte
 		return nil, err
 	}
 
-	return &types.AccountLockedLongerDurationNotUnlockingOnlyResponse{Locks: q.Keeper.GetAccountLockedLongerDurationNotUnlockingOnly(ctx, owner, req.Duration)}, nil
+	locks, pageRes, err := q.Keeper.paginateLocks(ctx, accountLockedLongerDurationNotUnlockingOnlyRanges(owner, req.Duration), req.Pagination, nil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.AccountLockedLongerDurationNotUnlockingOnlyResponse{Locks: locks, Pagination: pageRes}, nil
+}
+
+// LocksByFilter returns the locks matching all the conditions of the filter.
+func (q Querier) LocksByFilter(goCtx context.Context, req *types.LocksByFilterRequest) (*types.LocksByFilterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var owner sdk.AccAddress
+	if len(req.Owner) != 0 {
+		var err error
+		owner, err = sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.MaxDuration != 0 && req.MaxDuration < req.MinDuration {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max duration is shorter than min duration")
+	}
+	if req.MinEndTime != nil && req.MaxEndTime != nil && req.MaxEndTime.Before(*req.MinEndTime) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max end time is before min end time")
+	}
+
+	// unlike the account queries, a filter can match all the locks of the chain, so its locks are always paged
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{Limit: query.DefaultLimit}
+	}
+
+	locks, pageRes, err := q.Keeper.paginateLocks(ctx, locksByFilterRanges(owner, req), pageReq, q.Keeper.locksByFilterMatcher(ctx, req))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.LocksByFilterResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // LockedDenom returns the total amount of denom locked throughout all locks.
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/v12/x/lockup/types"
 )
@@ -528,3 +529,268 @@ func (suite *KeeperTestSuite) TestParams() {
 	suite.Require().NoError(err)
 	suite.Require().Equal([]string{suite.TestAccs[0].String()}, res.Params.ForceUnlockAllowedAddresses)
 }
+
+func (suite *KeeperTestSuite) TestAccountLockQueriesPagination() {
+	suite.SetupTest()
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	now := suite.Ctx.BlockTime()
+
+	// lock coins with several durations, half of them unlocking
+	for i := 1; i <= 5; i++ {
+		suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Duration(i)*time.Second)
+		suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("foo", 10)}, time.Duration(i)*time.Second)
+	}
+	suite.BeginUnlocking(addr1)
+	for i := 1; i <= 5; i++ {
+		suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Duration(i)*time.Second)
+		suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("foo", 10)}, time.Second)
+	}
+
+	queries := map[string]struct {
+		expected []types.PeriodLock
+		query    func(pageReq *query.PageRequest) ([]types.PeriodLock, *query.PageResponse, error)
+	}{
+		"AccountLockedPastTime": {
+			expected: suite.App.LockupKeeper.GetAccountLockedPastTime(suite.Ctx, addr1, now.Add(2*time.Second)),
+			query: func(pageReq *query.PageRequest) ([]types.PeriodLock, *query.PageResponse, error) {
+				res, err := suite.querier.AccountLockedPastTime(sdk.WrapSDKContext(suite.Ctx), &types.AccountLockedPastTimeRequest{Owner: addr1.String(), Timestamp: now.Add(2 * time.Second), Pagination: pageReq})
+				if err != nil {
+					return nil, nil, err
+				}
+				return res.Locks, res.Pagination, nil
+			},
+		},
+		"AccountLockedPastTimeNotUnlockingOnly": {
+			expected: suite.App.LockupKeeper.GetAccountLockedPastTimeNotUnlockingOnly(suite.Ctx, addr1, now.Add(2*time.Second)),
+			query: func(pageReq *query.PageRequest) ([]types.PeriodLock, *query.PageResponse, error) {
+				res, err := suite.querier.AccountLockedPastTimeNotUnlockingOnly(sdk.WrapSDKContext(suite.Ctx), &types.AccountLockedPastTimeNotUnlockingOnlyRequest{Owner: addr1.String(), Timestamp: now.Add(2 * time.Second), Pagination: pageReq})
+				if err != nil {
+					return nil, nil, err
+				}
+				return res.Locks, res.Pagination, nil
+			},
+		},
+		"AccountUnlockedBeforeTime": {
+			expected: suite.App.LockupKeeper.GetAccountUnlockedBeforeTime(suite.Ctx, addr1, now.Add(3*time.Second)),
+			query: func(pageReq *query.PageRequest) ([]types.PeriodLock, *query.PageResponse, error) {
+				res, err := suite.querier.AccountUnlockedBeforeTime(sdk.WrapSDKContext(suite.Ctx), &types.AccountUnlockedBeforeTimeRequest{Owner: addr1.String(), Timestamp: now.Add(3 * time.Second), Pagination: pageReq})
+				if err != nil {
+					return nil, nil, err
+				}
+				return res.Locks, res.Pagination, nil
+			},
+		},
+		"AccountLockedPastTimeDenom": {
+			expected: suite.App.LockupKeeper.GetAccountLockedPastTimeDenom(suite.Ctx, addr1, "stake", now.Add(2*time.Second)),
+			query: func(pageReq *query.PageRequest) ([]types.PeriodLock, *query.PageResponse, error) {
+				res, err := suite.querier.AccountLockedPastTimeDenom(sdk.WrapSDKContext(suite.Ctx), &types.AccountLockedPastTimeDenomRequest{Owner: addr1.String(), Denom: "stake", Timestamp: now.Add(2 * time.Second), Pagination: pageReq})
+				if err != nil {
+					return nil, nil, err
+				}
+				return res.Locks, res.Pagination, nil
+			},
+		},
+		"AccountLockedLongerDuration": {
+			expected: suite.App.LockupKeeper.GetAccountLockedLongerDuration(suite.Ctx, addr1, 2*time.Second),
+			query: func(pageReq *query.PageRequest) ([]types.PeriodLock, *query.PageResponse, error) {
+				res, err := suite.querier.AccountLockedLongerDuration(sdk.WrapSDKContext(suite.Ctx), &types.AccountLockedLongerDurationRequest{Owner: addr1.String(), Duration: 2 * time.Second, Pagination: pageReq})
+				if err != nil {
+					return nil, nil, err
+				}
+				return res.Locks, res.Pagination, nil
+			},
+		},
+		"AccountLockedDuration": {
+			expected: suite.App.LockupKeeper.GetAccountLockedDuration(suite.Ctx, addr1, time.Second),
+			query: func(pageReq *query.PageRequest) ([]types.PeriodLock, *query.PageResponse, error) {
+				res, err := suite.querier.AccountLockedDuration(sdk.WrapSDKContext(suite.Ctx), &types.AccountLockedDurationRequest{Owner: addr1.String(), Duration: time.Second, Pagination: pageReq})
+				if err != nil {
+					return nil, nil, err
+				}
+				return res.Locks, res.Pagination, nil
+			},
+		},
+		"AccountLockedLongerDurationNotUnlockingOnly": {
+			expected: suite.App.LockupKeeper.GetAccountLockedLongerDurationNotUnlockingOnly(suite.Ctx, addr1, 2*time.Second),
+			query: func(pageReq *query.PageRequest) ([]types.PeriodLock, *query.PageResponse, error) {
+				res, err := suite.querier.AccountLockedLongerDurationNotUnlockingOnly(sdk.WrapSDKContext(suite.Ctx), &types.AccountLockedLongerDurationNotUnlockingOnlyRequest{Owner: addr1.String(), Duration: 2 * time.Second, Pagination: pageReq})
+				if err != nil {
+					return nil, nil, err
+				}
+				return res.Locks, res.Pagination, nil
+			},
+		},
+		"AccountLockedLongerDurationDenom": {
+			expected: suite.App.LockupKeeper.GetAccountLockedLongerDurationDenom(suite.Ctx, addr1, "stake", 2*time.Second),
+			query: func(pageReq *query.PageRequest) ([]types.PeriodLock, *query.PageResponse, error) {
+				res, err := suite.querier.AccountLockedLongerDurationDenom(sdk.WrapSDKContext(suite.Ctx), &types.AccountLockedLongerDurationDenomRequest{Owner: addr1.String(), Denom: "stake", Duration: 2 * time.Second, Pagination: pageReq})
+				if err != nil {
+					return nil, nil, err
+				}
+				return res.Locks, res.Pagination, nil
+			},
+		},
+	}
+
+	for name, q := range queries {
+		suite.Run(name, func() {
+			suite.Require().NotEmpty(q.expected)
+
+			// without pagination all the locks are returned
+			locks, pageRes, err := q.query(nil)
+			suite.Require().NoError(err)
+			suite.Require().Nil(pageRes)
+			suite.Require().Equal(q.expected, locks)
+
+			// walk through the pages with the next key
+			locks = []types.PeriodLock{}
+			pageReq := &query.PageRequest{Limit: 3}
+			for {
+				page, pageRes, err := q.query(pageReq)
+				suite.Require().NoError(err)
+				suite.Require().LessOrEqual(len(page), 3)
+				locks = append(locks, page...)
+				if pageRes.NextKey == nil {
+					break
+				}
+				pageReq = &query.PageRequest{Key: pageRes.NextKey, Limit: 3}
+			}
+			suite.Require().Equal(q.expected, locks)
+
+			// offset based page with total
+			page, pageRes, err := q.query(&query.PageRequest{Offset: 1, Limit: 2, CountTotal: true})
+			suite.Require().NoError(err)
+			suite.Require().Equal(q.expected[1:3], page)
+			suite.Require().Equal(uint64(len(q.expected)), pageRes.Total)
+
+			// key and offset are exclusive
+			_, _, err = q.query(&query.PageRequest{Key: pageRes.NextKey, Offset: 1})
+			suite.Require().Error(err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestLocksByFilter() {
+	suite.SetupTest()
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	now := suite.Ctx.BlockTime()
+
+	// addr1: unlocking stake locks of 1s, 2s, 3s
+	for i := 1; i <= 3; i++ {
+		suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Duration(i)*time.Second)
+	}
+	suite.BeginUnlocking(addr1)
+	// addr1: not unlocking stake and foo locks of 1s, 2s, 3s
+	for i := 1; i <= 3; i++ {
+		suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Duration(i)*time.Second)
+		suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("foo", 10)}, time.Duration(i)*time.Second)
+	}
+	// addr2: not unlocking stake lock of 2s, with a synthetic lockup
+	suite.LockTokens(addr2, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, 2*time.Second)
+	err := suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, 10, "synthstakestakedtovalidator", 2*time.Second, false)
+	suite.Require().NoError(err)
+
+	minEndTime := now.Add(2 * time.Second)
+	maxEndTime := now.Add(2 * time.Second)
+	tests := []struct {
+		name        string
+		req         types.LocksByFilterRequest
+		expectedIDs []uint64
+		expectErr   bool
+	}{
+		{
+			name:        "no filter",
+			req:         types.LocksByFilterRequest{},
+			expectedIDs: []uint64{4, 5, 6, 7, 10, 8, 9, 1, 2, 3},
+		},
+		{
+			name:        "owner",
+			req:         types.LocksByFilterRequest{Owner: addr2.String()},
+			expectedIDs: []uint64{10},
+		},
+		{
+			name:        "owner and denom",
+			req:         types.LocksByFilterRequest{Owner: addr1.String(), Denom: "foo"},
+			expectedIDs: []uint64{5, 7, 9},
+		},
+		{
+			name:        "denom and duration range",
+			req:         types.LocksByFilterRequest{Denom: "stake", MinDuration: 2 * time.Second, MaxDuration: 2 * time.Second},
+			expectedIDs: []uint64{6, 10, 2},
+		},
+		{
+			name:        "min duration",
+			req:         types.LocksByFilterRequest{Owner: addr1.String(), Denom: "stake", MinDuration: 3 * time.Second},
+			expectedIDs: []uint64{8, 3},
+		},
+		{
+			name:        "unlocking only",
+			req:         types.LocksByFilterRequest{Owner: addr1.String(), UnlockingStatus: types.UnlockingOnly},
+			expectedIDs: []uint64{1, 2, 3},
+		},
+		{
+			name:        "not unlocking only",
+			req:         types.LocksByFilterRequest{Denom: "stake", UnlockingStatus: types.NotUnlockingOnly},
+			expectedIDs: []uint64{4, 6, 10, 8},
+		},
+		{
+			name:        "min end time",
+			req:         types.LocksByFilterRequest{MinEndTime: &minEndTime},
+			expectedIDs: []uint64{2, 3},
+		},
+		{
+			name:        "max end time",
+			req:         types.LocksByFilterRequest{MaxEndTime: &maxEndTime},
+			expectedIDs: []uint64{1, 2},
+		},
+		{
+			name:        "synthetic only",
+			req:         types.LocksByFilterRequest{SyntheticStatus: types.SyntheticOnly},
+			expectedIDs: []uint64{10},
+		},
+		{
+			name:        "not synthetic only",
+			req:         types.LocksByFilterRequest{Denom: "stake", MinDuration: 2 * time.Second, SyntheticStatus: types.NotSyntheticOnly},
+			expectedIDs: []uint64{6, 8, 2, 3},
+		},
+		{
+			name:        "paginated",
+			req:         types.LocksByFilterRequest{Denom: "stake", Pagination: &query.PageRequest{Offset: 2, Limit: 3}},
+			expectedIDs: []uint64{10, 8, 1},
+		},
+		{
+			name:      "invalid owner",
+			req:       types.LocksByFilterRequest{Owner: "invalid"},
+			expectErr: true,
+		},
+		{
+			name:      "max duration shorter than min duration",
+			req:       types.LocksByFilterRequest{MinDuration: 2 * time.Second, MaxDuration: time.Second},
+			expectErr: true,
+		},
+		{
+			name:      "max end time before min end time",
+			req:       types.LocksByFilterRequest{MinEndTime: &maxEndTime, MaxEndTime: &now},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			res, err := suite.querier.LocksByFilter(sdk.WrapSDKContext(suite.Ctx), &test.req)
+			if test.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			// the locks are paged even without pagination in the request
+			suite.Require().NotNil(res.Pagination)
+			ids := []uint64{}
+			for _, lock := range res.Locks {
+				ids = append(ids, lock.ID)
+			}
+			suite.Require().Equal(test.expectedIDs, ids)
+		})
+	}
+}
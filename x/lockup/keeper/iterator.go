@@ -18,56 +18,91 @@ func unlockingPrefix(isUnlocking bool) []byte {
 	return types.KeyPrefixNotUnlocking
 }
 
-// iteratorAfterTime iterates through keys between that use prefix, and have a time.
-func (k Keeper) iteratorAfterTime(ctx sdk.Context, prefix []byte, time time.Time) sdk.Iterator {
-	store := ctx.KVStore(k.storeKey)
+// lockRefRange is a domain of lock ref keys, from start inclusive to end exclusive.
+type lockRefRange struct {
+	start []byte
+	end   []byte
+}
+
+// rangeAfterTime is the domain of keys that use prefix, and have a time after the given time.
+func rangeAfterTime(prefix []byte, time time.Time) lockRefRange {
 	timeKey := getTimeKey(time)
 	key := combineKeys(prefix, timeKey)
 	// If it’s unlockTime, then it should count as unlocked
 	// inclusive end bytes = key + 1, next iterator
-	return store.Iterator(storetypes.PrefixEndBytes(key), storetypes.PrefixEndBytes(prefix))
+	return lockRefRange{start: storetypes.PrefixEndBytes(key), end: storetypes.PrefixEndBytes(prefix)}
 }
 
-// iteratorBeforeTime iterates through keys between that use prefix, and have a time LTE max time.
-func (k Keeper) iteratorBeforeTime(ctx sdk.Context, prefix []byte, maxTime time.Time) sdk.Iterator {
-	store := ctx.KVStore(k.storeKey)
+// rangeBeforeTime is the domain of keys that use prefix, and have a time LTE max time.
+func rangeBeforeTime(prefix []byte, maxTime time.Time) lockRefRange {
 	timeKey := getTimeKey(maxTime)
 	key := combineKeys(prefix, timeKey)
 	// If it’s unlockTime, then it should count as unlocked
 	// inclusive end bytes = key + 1, next iterator
-	return store.Iterator(prefix, storetypes.PrefixEndBytes(key))
+	return lockRefRange{start: prefix, end: storetypes.PrefixEndBytes(key)}
 }
 
-// iteratorDuration iterates over a domain of keys for a specified duration.
-func (k Keeper) iteratorDuration(ctx sdk.Context, prefix []byte, duration time.Duration) sdk.Iterator {
+// rangeDuration is the domain of keys that use prefix, and have the specified duration.
+func rangeDuration(prefix []byte, duration time.Duration) lockRefRange {
 	durationKey := getDurationKey(duration)
-	key := combineKeys(prefix, durationKey)
-	store := ctx.KVStore(k.storeKey)
-	return sdk.KVStorePrefixIterator(store, key)
+	return rangePrefix(combineKeys(prefix, durationKey))
 }
 
-// iteratorLongerDuration iterates over a domain of keys for longer than a specified duration.
-func (k Keeper) iteratorLongerDuration(ctx sdk.Context, prefix []byte, duration time.Duration) sdk.Iterator {
-	store := ctx.KVStore(k.storeKey)
+// rangeLongerDuration is the domain of keys that use prefix, and have a duration longer than the specified duration.
+func rangeLongerDuration(prefix []byte, duration time.Duration) lockRefRange {
 	durationKey := getDurationKey(duration)
 	key := combineKeys(prefix, durationKey)
 	// inclusive on longer side, means >= (longer or equal)
-	return store.Iterator(key, storetypes.PrefixEndBytes(prefix))
+	return lockRefRange{start: key, end: storetypes.PrefixEndBytes(prefix)}
 }
 
-// iteratorShorterDuration iterates over a domain of keys for shorter than a specified duration.
-func (k Keeper) iteratorShorterDuration(ctx sdk.Context, prefix []byte, duration time.Duration) sdk.Iterator {
-	store := ctx.KVStore(k.storeKey)
+// rangeShorterDuration is the domain of keys that use prefix, and have a duration shorter than the specified duration.
+func rangeShorterDuration(prefix []byte, duration time.Duration) lockRefRange {
 	durationKey := getDurationKey(duration)
 	key := combineKeys(prefix, durationKey)
 	// inclusive on longer side, shorter means < (lower)
-	return store.Iterator(prefix, key)
+	return lockRefRange{start: prefix, end: key}
+}
+
+// rangePrefix is the domain of keys that use prefix.
+func rangePrefix(prefix []byte) lockRefRange {
+	return lockRefRange{start: prefix, end: storetypes.PrefixEndBytes(prefix)}
+}
+
+// rangeIterator iterates over a domain of keys.
+func (k Keeper) rangeIterator(ctx sdk.Context, r lockRefRange) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(r.start, r.end)
+}
+
+// iteratorAfterTime iterates through keys between that use prefix, and have a time.
+func (k Keeper) iteratorAfterTime(ctx sdk.Context, prefix []byte, time time.Time) sdk.Iterator {
+	return k.rangeIterator(ctx, rangeAfterTime(prefix, time))
+}
+
+// iteratorBeforeTime iterates through keys between that use prefix, and have a time LTE max time.
+func (k Keeper) iteratorBeforeTime(ctx sdk.Context, prefix []byte, maxTime time.Time) sdk.Iterator {
+	return k.rangeIterator(ctx, rangeBeforeTime(prefix, maxTime))
+}
+
+// iteratorDuration iterates over a domain of keys for a specified duration.
+func (k Keeper) iteratorDuration(ctx sdk.Context, prefix []byte, duration time.Duration) sdk.Iterator {
+	return k.rangeIterator(ctx, rangeDuration(prefix, duration))
+}
+
+// iteratorLongerDuration iterates over a domain of keys for longer than a specified duration.
+func (k Keeper) iteratorLongerDuration(ctx sdk.Context, prefix []byte, duration time.Duration) sdk.Iterator {
+	return k.rangeIterator(ctx, rangeLongerDuration(prefix, duration))
+}
+
+// iteratorShorterDuration iterates over a domain of keys for shorter than a specified duration.
+func (k Keeper) iteratorShorterDuration(ctx sdk.Context, prefix []byte, duration time.Duration) sdk.Iterator {
+	return k.rangeIterator(ctx, rangeShorterDuration(prefix, duration))
 }
 
 // iterator iterates over a domain of keys.
 func (k Keeper) iterator(ctx sdk.Context, prefix []byte) sdk.Iterator {
-	store := ctx.KVStore(k.storeKey)
-	return sdk.KVStorePrefixIterator(store, prefix)
+	return k.rangeIterator(ctx, rangePrefix(prefix))
 }
 
 // LockIteratorAfterTime returns the iterator to get locked coins.
@@ -112,6 +147,18 @@ func (k Keeper) LockIteratorDenom(ctx sdk.Context, isUnlocking bool, denom strin
 	return k.iterator(ctx, combineKeys(unlockingPrefix, types.KeyPrefixDenomLockDuration, []byte(denom)))
 }
 
+// LockIteratorStartedAfterTimeDenom returns the iterator to get locks by denom started after the given time.
+func (k Keeper) LockIteratorStartedAfterTimeDenom(ctx sdk.Context, isUnlocking bool, denom string, time time.Time) sdk.Iterator {
+	unlockingPrefix := unlockingPrefix(isUnlocking)
+	return k.iteratorAfterTime(ctx, combineKeys(unlockingPrefix, types.KeyPrefixDenomLockStartTime, []byte(denom)), time)
+}
+
+// LockIteratorStartedBeforeTimeDenom returns the iterator to get locks by denom started at or before the given time.
+func (k Keeper) LockIteratorStartedBeforeTimeDenom(ctx sdk.Context, isUnlocking bool, denom string, time time.Time) sdk.Iterator {
+	unlockingPrefix := unlockingPrefix(isUnlocking)
+	return k.iteratorBeforeTime(ctx, combineKeys(unlockingPrefix, types.KeyPrefixDenomLockStartTime, []byte(denom)), time)
+}
+
 // AccountLockIteratorAfterTime returns the iterator to get locked coins by account.
 func (k Keeper) AccountLockIteratorAfterTime(ctx sdk.Context, addr sdk.AccAddress, time time.Time) sdk.Iterator {
 	unlockingPrefix := unlockingPrefix(true)
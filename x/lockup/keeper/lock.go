@@ -95,6 +95,13 @@ func (k Keeper) AddTokensToLockByID(ctx sdk.Context, lockID uint64, owner sdk.Ac
 		return nil, err
 	}
 
+	// the lock restarts when tokens are added to it, so that the added tokens
+	// do not qualify for gauges rewarding locks started before the block time.
+	err = k.setLockStartTime(ctx, lock, ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
 	for _, synthlock := range k.GetAllSyntheticLockupsByLockup(ctx, lock.ID) {
 		k.accumulationStore(ctx, synthlock.SynthDenom).Increase(accumulationKey(synthlock.Duration), tokensToAdd.Amount)
 	}
@@ -116,6 +123,7 @@ func (k Keeper) CreateLock(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coin
 	// unlock time is initially set without a value, gets set as unlock start time + duration
 	// when unlocking starts.
 	lock := types.NewPeriodLock(ID, owner, duration, time.Time{}, coins)
+	lock.StartTime = ctx.BlockTime()
 	err := k.lock(ctx, lock, lock.Coins)
 	if err != nil {
 		return lock, err
@@ -131,6 +139,24 @@ func (k Keeper) CreateLock(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coin
 	return lock, nil
 }
 
+// setLockStartTime sets the start time of the lock, and reindexes the lock by its new start time.
+func (k Keeper) setLockStartTime(ctx sdk.Context, lock *types.PeriodLock, startTime time.Time) error {
+	if lock.StartTime.Equal(startTime) {
+		return nil
+	}
+
+	err := k.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), *lock)
+	if err != nil {
+		return err
+	}
+	lock.StartTime = startTime
+	err = k.addLockRefs(ctx, *lock)
+	if err != nil {
+		return err
+	}
+	return k.setLock(ctx, *lock)
+}
+
 // lock is an internal utility to lock coins and set corresponding states.
 // This is only called by either of the two possible entry points to lock tokens.
 // 1. CreateLock
@@ -456,6 +482,232 @@ func (k Keeper) ExtendLockup(ctx sdk.Context, lockID uint64, owner sdk.AccAddres
 	return nil
 }
 
+// TransferLock transfers the ownership of the lock to the new owner.
+// TransferLock fails if the lock has a synthetic lock, i.e. the lock is superfluid delegated or undelegating.
+func (k Keeper) TransferLock(ctx sdk.Context, lockID uint64, owner, newOwner sdk.AccAddress) error {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+
+	if lock.GetOwner() != owner.String() {
+		return types.ErrNotLockOwner
+	}
+
+	if owner.Equals(newOwner) {
+		return fmt.Errorf("cannot transfer lock %d to its owner", lock.ID)
+	}
+
+	// check synthetic lockup exists
+	if k.HasAnySyntheticLockups(ctx, lock.ID) {
+		return fmt.Errorf("cannot transfer lockup with synthetic lock %d", lock.ID)
+	}
+
+	// the account lock refs are keyed by the owner
+	err = k.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), *lock)
+	if err != nil {
+		return err
+	}
+
+	lock.Owner = newOwner.String()
+
+	err = k.addLockRefs(ctx, *lock)
+	if err != nil {
+		return err
+	}
+
+	err = k.setLock(ctx, *lock)
+	if err != nil {
+		return err
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLockTransfer(ctx, lock.ID, owner, newOwner)
+	}
+	return nil
+}
+
+// SplitLock moves the given coins out of the lock into a new lock with the same owner, duration and end time,
+// and returns the new lock. The coins must be less than the coins of the lock.
+// SplitLock fails if the lock is unlocking or if the lock has a synthetic lock.
+func (k Keeper) SplitLock(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, coins sdk.Coins) (types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	if lock.GetOwner() != owner.String() {
+		return types.PeriodLock{}, types.ErrNotLockOwner
+	}
+
+	if lock.IsUnlocking() {
+		return types.PeriodLock{}, fmt.Errorf("cannot split unlocking lockup for lock %d", lock.ID)
+	}
+
+	// check synthetic lockup exists
+	if k.HasAnySyntheticLockups(ctx, lock.ID) {
+		return types.PeriodLock{}, fmt.Errorf("cannot split lockup with synthetic lock %d", lock.ID)
+	}
+
+	if !coins.IsAllPositive() || !lock.Coins.IsAllGT(coins) {
+		return types.PeriodLock{}, fmt.Errorf("requested amount to split (%s) should be less than the locked amount (%s)", coins, lock.Coins)
+	}
+
+	splitLock, err := k.splitLock(ctx, *lock, coins, false)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	// the accumulation store is unchanged, as the split lock has the same duration
+	err = k.addLockRefs(ctx, splitLock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	return splitLock, nil
+}
+
+// MergeLocks merges the coins of the given locks into the first lock, deleting the other locks, and returns the merged lock.
+// The merged lock keeps the ID and start time of the first lock.
+// MergeLocks fails if a lock is given more than once, the locks are not owned by the owner, do not have
+// the same denom and duration, or if any of the locks is unlocking or has a synthetic lock.
+func (k Keeper) MergeLocks(ctx sdk.Context, owner sdk.AccAddress, lockIDs []uint64) (*types.PeriodLock, error) {
+	if len(lockIDs) < 2 {
+		return nil, fmt.Errorf("at least two locks are required to merge, got %d", len(lockIDs))
+	}
+
+	locks := make([]types.PeriodLock, 0, len(lockIDs))
+	seenLockIDs := make(map[uint64]bool, len(lockIDs))
+	for _, lockID := range lockIDs {
+		if seenLockIDs[lockID] {
+			return nil, fmt.Errorf("duplicate lock %d", lockID)
+		}
+		seenLockIDs[lockID] = true
+
+		lock, err := k.GetLockByID(ctx, lockID)
+		if err != nil {
+			return nil, err
+		}
+
+		if lock.GetOwner() != owner.String() {
+			return nil, types.ErrNotLockOwner
+		}
+
+		if lock.IsUnlocking() {
+			return nil, fmt.Errorf("cannot merge unlocking lockup for lock %d", lock.ID)
+		}
+
+		// check synthetic lockup exists
+		if k.HasAnySyntheticLockups(ctx, lock.ID) {
+			return nil, fmt.Errorf("cannot merge lockup with synthetic lock %d", lock.ID)
+		}
+
+		// we only allow locks with one denom for now
+		if lock.Coins.Len() != 1 {
+			return nil, fmt.Errorf("cannot merge lock %d with multiple denoms %s", lock.ID, lock.Coins)
+		}
+
+		if len(locks) > 0 && (lock.Duration != locks[0].Duration || lock.Coins[0].Denom != locks[0].Coins[0].Denom) {
+			return nil, fmt.Errorf("cannot merge lock %d with lock %d of a different denom or duration", lock.ID, locks[0].ID)
+		}
+		locks = append(locks, *lock)
+	}
+
+	// The coins stay in the module account, they are only moved from the lock to the merged lock.
+	// As the locks share the denom and duration of the merged lock, the accumulation store is unchanged.
+	// The merged lock starts with the latest of the locks, so that merging locks never makes coins
+	// qualify for gauges rewarding locks started before a given time.
+	mergedLock := &locks[0]
+	for _, lock := range locks[1:] {
+		err := k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, lock)
+		if err != nil {
+			return nil, err
+		}
+		k.deleteLock(ctx, lock.ID)
+
+		mergedLock.Coins = mergedLock.Coins.Add(lock.Coins...)
+		if err := k.setLock(ctx, *mergedLock); err != nil {
+			return nil, err
+		}
+		if lock.StartTime.After(mergedLock.StartTime) {
+			if err := k.setLockStartTime(ctx, mergedLock, lock.StartTime); err != nil {
+				return nil, err
+			}
+		}
+
+		if k.hooks != nil {
+			k.hooks.OnLockMerge(ctx, lock.ID, mergedLock.ID, lock.Coins)
+		}
+	}
+
+	return mergedLock, nil
+}
+
+// CancelUnlocking restores the unlocking lock to a not unlocking lock with its original duration, and returns the restored lock.
+// If coins are provided and less than the coins of the lock, they are split into a new lock which is restored,
+// while the rest of the lock keeps unlocking.
+// CancelUnlocking fails if the lock is not unlocking, has matured, or has a synthetic lock.
+func (k Keeper) CancelUnlocking(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, coins sdk.Coins) (types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	if lock.GetOwner() != owner.String() {
+		return types.PeriodLock{}, types.ErrNotLockOwner
+	}
+
+	if !lock.IsUnlocking() {
+		return types.PeriodLock{}, fmt.Errorf("cannot cancel unlocking of not unlocking lock %d", lock.ID)
+	}
+
+	if !lock.EndTime.After(ctx.BlockTime()) {
+		return types.PeriodLock{}, fmt.Errorf("cannot cancel unlocking of matured lock %d", lock.ID)
+	}
+
+	// check synthetic lockup exists
+	if k.HasAnySyntheticLockups(ctx, lock.ID) {
+		return types.PeriodLock{}, fmt.Errorf("cannot cancel unlocking of lockup with synthetic lock %d", lock.ID)
+	}
+
+	if !coins.IsAllLTE(lock.Coins) {
+		return types.PeriodLock{}, fmt.Errorf("requested amount to relock exceeds unlocking tokens")
+	}
+
+	// If the amount to relock is empty, or the entire coins amount, relock the entire lock.
+	// Otherwise, split the lock into two locks, and relock the newly created lock.
+	if len(coins) != 0 && !coins.IsEqual(lock.Coins) {
+		splitLock, err := k.splitLock(ctx, *lock, coins, true)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+		lock = &splitLock
+	}
+
+	// remove existing lock refs from unlocking queue
+	err = k.deleteLockRefs(ctx, types.KeyPrefixUnlocking, *lock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	// the accumulation store keeps counting unlocking locks at their duration, so the restored lock is already accounted for
+	lock.EndTime = time.Time{}
+	err = k.setLock(ctx, *lock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	// add lock refs into not unlocking queue
+	err = k.addLockRefs(ctx, *lock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnTokenLocked(ctx, owner, lock.ID, lock.Coins, lock.Duration, lock.EndTime)
+	}
+	return *lock, nil
+}
+
 // InitializeAllLocks takes a set of locks, and initializes state to be storing
 // them all correctly. This utilizes batch optimizations to improve efficiency,
 // as this becomes a bottleneck at chain initialization & upgrades.
@@ -701,9 +953,18 @@ func (k Keeper) splitLock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coin
 	k.SetLastLockID(ctx, splitLockID)
 
 	splitLock := types.NewPeriodLock(splitLockID, lock.OwnerAddress(), lock.Duration, lock.EndTime, coins)
+	// the split lock keeps the start time of the lock it was split from
+	splitLock.StartTime = lock.StartTime
 
 	err = k.setLock(ctx, splitLock)
-	return splitLock, err
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLockSplit(ctx, lock.ID, splitLock.ID, coins)
+	}
+	return splitLock, nil
 }
 
 func (k Keeper) getCoinsFromLocks(locks []types.PeriodLock) sdk.Coins {
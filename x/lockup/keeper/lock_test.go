@@ -272,6 +272,76 @@ func (suite *KeeperTestSuite) TestLocksLongerThanDurationDenom() {
 	suite.Require().Len(locks, 1)
 }
 
+func (suite *KeeperTestSuite) TestLocksDenomPage() {
+	suite.SetupTest()
+
+	// lock coins of the denom, one of them unlocking, and of a denom sharing its prefix
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+	suite.LockTokens(addr1, coins, time.Hour)
+	suite.LockTokens(addr1, coins, time.Minute)
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake1", 10)}, time.Second)
+	err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, 1, nil)
+	suite.Require().NoError(err)
+
+	// not unlocking locks come first, ordered by duration
+	locks, key, err := suite.App.LockupKeeper.GetLocksDenomPage(suite.Ctx, "stake", nil, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{3, 2}, []uint64{locks[0].ID, locks[1].ID})
+	suite.Require().NotNil(key)
+
+	locks, key, err = suite.App.LockupKeeper.GetLocksDenomPage(suite.Ctx, "stake", key, 2)
+	suite.Require().NoError(err)
+	suite.Require().Len(locks, 1)
+	suite.Require().Equal(uint64(1), locks[0].ID)
+	suite.Require().Nil(key)
+}
+
+func (suite *KeeperTestSuite) TestLocksStartedTimeDenom() {
+	suite.SetupTest()
+
+	now := time.Now()
+	suite.Ctx = suite.Ctx.WithBlockTime(now)
+
+	// initial check
+	locks := suite.App.LockupKeeper.GetLocksStartedBeforeTimeDenom(suite.Ctx, "stake", now)
+	suite.Require().Len(locks, 0)
+
+	// lock coins at the current time, and begin unlocking one of them
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+	suite.LockTokens(addr1, coins, time.Hour)
+	err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, 1, nil)
+	suite.Require().NoError(err)
+
+	// lock coins an hour later
+	suite.Ctx = suite.Ctx.WithBlockTime(now.Add(time.Hour))
+	suite.LockTokens(addr1, coins, time.Second)
+
+	lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, 3)
+	suite.Require().NoError(err)
+	suite.Require().Equal(now.Add(time.Hour).UTC(), lock.StartTime.UTC())
+
+	// locks started at or before the timestamp include unlocking locks
+	locks = suite.App.LockupKeeper.GetLocksStartedBeforeTimeDenom(suite.Ctx, "stake", now)
+	suite.Require().Len(locks, 2)
+	locks = suite.App.LockupKeeper.GetLocksStartedAfterTimeDenom(suite.Ctx, "stake", now)
+	suite.Require().Len(locks, 1)
+	suite.Require().Equal(uint64(3), locks[0].ID)
+
+	// a lock split upon partial unlocking keeps its start time
+	err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, 2, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
+	suite.Require().NoError(err)
+	locks = suite.App.LockupKeeper.GetLocksStartedBeforeTimeDenom(suite.Ctx, "stake", now)
+	suite.Require().Len(locks, 3)
+
+	// other denoms are not included
+	locks = suite.App.LockupKeeper.GetLocksStartedAfterTimeDenom(suite.Ctx, "foo", time.Time{})
+	suite.Require().Len(locks, 0)
+}
+
 func (suite *KeeperTestSuite) TestCreateLock() {
 	suite.SetupTest()
 
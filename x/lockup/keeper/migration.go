@@ -132,3 +132,24 @@ func MergeLockupsForSimilarDurations(
 		// numLocksNormalized, numLocksCreated)
 	}
 }
+
+// AddStartTimeLockRefs indexes every existing lock by its start time.
+// Locks created before start times were tracked have an uninitialized start time,
+// hence they are indexed as started before any other lock.
+func (k Keeper) AddStartTimeLockRefs(ctx sdk.Context) error {
+	locks, err := k.GetPeriodLocks(ctx)
+	if err != nil {
+		return err
+	}
+	for _, lock := range locks {
+		lockRefPrefix := unlockingPrefix(lock.IsUnlocking())
+		startTimeKey := getTimeKey(lock.StartTime)
+		for _, coin := range lock.Coins {
+			refKey := combineKeys(types.KeyPrefixDenomLockStartTime, []byte(coin.Denom), startTimeKey)
+			if err := k.addLockRefByKey(ctx, combineKeys(lockRefPrefix, refKey), lock.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
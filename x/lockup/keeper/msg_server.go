@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/osmosis-labs/osmosis/v12/x/gamm/utils"
 	"github.com/osmosis-labs/osmosis/v12/x/lockup/types"
@@ -222,3 +223,125 @@ func (server msgServer) ForceUnlock(goCtx context.Context, msg *types.MsgForceUn
 
 	return &types.MsgForceUnlockResponse{Success: true}, nil
 }
+
+// TransferLock transfers the ownership of the lock to the recipient.
+// TransferLock would fail if the lock is superfluid delegated or undelegating.
+func (server msgServer) TransferLock(goCtx context.Context, msg *types.MsgTransferLock) (*types.MsgTransferLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.TransferLock(ctx, msg.ID, owner, recipient)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtTransferLock,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockRecipient, msg.Recipient),
+		),
+	})
+
+	return &types.MsgTransferLockResponse{Success: true}, nil
+}
+
+// SplitLock moves coins out of the lock into a new lock with the same owner, duration and end time.
+// SplitLock would fail if the lock is unlocking or superfluid delegated.
+func (server msgServer) SplitLock(goCtx context.Context, msg *types.MsgSplitLock) (*types.MsgSplitLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	splitLock, err := server.keeper.SplitLock(ctx, msg.ID, owner, msg.Coins)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSplitLock,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributeSplitLockID, utils.Uint64ToString(splitLock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, msg.Coins.String()),
+		),
+	})
+
+	return &types.MsgSplitLockResponse{ID: splitLock.ID}, nil
+}
+
+// MergeLocks merges the coins of the locks into the first lock, deleting the other locks.
+// MergeLocks would fail if the locks have different denoms or durations, or if any of the locks is unlocking or superfluid delegated.
+func (server msgServer) MergeLocks(goCtx context.Context, msg *types.MsgMergeLocks) (*types.MsgMergeLocksResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := server.keeper.MergeLocks(ctx, owner, msg.LockIds)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	mergedLockIDs := make([]string, 0, len(msg.LockIds)-1)
+	for _, lockID := range msg.LockIds[1:] {
+		mergedLockIDs = append(mergedLockIDs, utils.Uint64ToString(lockID))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtMergeLocks,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(lock.ID)),
+			sdk.NewAttribute(types.AttributeMergedLockIDs, strings.Join(mergedLockIDs, ",")),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, lock.Coins.String()),
+			sdk.NewAttribute(types.AttributePeriodLockDuration, lock.Duration.String()),
+		),
+	})
+
+	return &types.MsgMergeLocksResponse{ID: lock.ID}, nil
+}
+
+// CancelUnlocking restores the unlocking lock, or the given coins of it split into a new lock, to a not unlocking lock.
+// CancelUnlocking would fail if the lock is not unlocking, has matured or is superfluid undelegating.
+func (server msgServer) CancelUnlocking(goCtx context.Context, msg *types.MsgCancelUnlocking) (*types.MsgCancelUnlockingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := server.keeper.CancelUnlocking(ctx, msg.ID, owner, msg.Coins)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCancelUnlocking,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(lock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, lock.Coins.String()),
+			sdk.NewAttribute(types.AttributePeriodLockDuration, lock.Duration.String()),
+		),
+	})
+
+	return &types.MsgCancelUnlockingResponse{ID: lock.ID}, nil
+}
@@ -447,3 +447,514 @@ func (suite *KeeperTestSuite) TestMsgForceUnlock() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgTransferLock() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	defaultPoolID, defaultLockID := uint64(1), uint64(1)
+	defaultLockAmount := sdk.NewInt(1000000000)
+
+	tests := []struct {
+		name          string
+		postLockSetup func()
+		sender        sdk.AccAddress
+		expectPass    bool
+	}{
+		{
+			"happy path",
+			func() {},
+			addr1,
+			true,
+		},
+		{
+			"transfer unlocking lock",
+			func() {
+				err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, defaultLockID, nil)
+				suite.Require().NoError(err)
+			},
+			addr1,
+			true,
+		},
+		{
+			"sender is not the lock owner",
+			func() {},
+			addr2,
+			false,
+		},
+		{
+			"transfer superfluid delegated lock",
+			func() {
+				err := suite.SuperfluidDelegateToDefaultVal(addr1, defaultPoolID, defaultLockID)
+				suite.Require().NoError(err)
+			},
+			addr1,
+			false,
+		},
+		{
+			"transfer superfluid undelegating lock",
+			func() {
+				err := suite.SuperfluidDelegateToDefaultVal(addr1, defaultPoolID, defaultLockID)
+				suite.Require().NoError(err)
+
+				err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, addr1.String(), defaultLockID)
+				suite.Require().NoError(err)
+			},
+			addr1,
+			false,
+		},
+		{
+			"transfer superfluid undelegated lock",
+			func() {
+				err := suite.SuperfluidDelegateToDefaultVal(addr1, defaultPoolID, defaultLockID)
+				suite.Require().NoError(err)
+
+				err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, addr1.String(), defaultLockID)
+				suite.Require().NoError(err)
+
+				unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+				suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(unbondingDuration))
+				suite.App.LockupKeeper.DeleteAllMaturedSyntheticLocks(suite.Ctx)
+			},
+			addr1,
+			true,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		// prepare pool for superfluid staking cases
+		poolId := suite.PrepareBalancerPoolWithCoins(sdk.NewCoin("stake", sdk.NewInt(1000000000000)), sdk.NewCoin("foo", sdk.NewInt(5000)))
+
+		msgServer := keeper.NewMsgServerImpl(suite.App.LockupKeeper)
+		poolDenom := gammtypes.GetPoolShareDenom(poolId)
+		coinsToLock := sdk.Coins{sdk.NewCoin(poolDenom, defaultLockAmount)}
+		suite.FundAcc(addr1, coinsToLock)
+
+		unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+		resp, err := msgServer.LockTokens(sdk.WrapSDKContext(suite.Ctx), types.NewMsgLockTokens(addr1, unbondingDuration, coinsToLock))
+		suite.Require().NoError(err)
+
+		test.postLockSetup()
+		lockBefore, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, resp.ID)
+		suite.Require().NoError(err)
+
+		_, err = msgServer.TransferLock(sdk.WrapSDKContext(suite.Ctx), types.NewMsgTransferLock(test.sender, resp.ID, addr2))
+		if !test.expectPass {
+			suite.Require().Error(err, test.name)
+			lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, resp.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(addr1.String(), lock.Owner, test.name)
+			continue
+		}
+		suite.Require().NoError(err, test.name)
+
+		// the lock and its account references moved to the recipient
+		lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, resp.ID)
+		suite.Require().NoError(err)
+		suite.Require().Equal(addr2.String(), lock.Owner, test.name)
+		suite.Require().Equal(lockBefore.EndTime, lock.EndTime, test.name)
+		suite.Require().Empty(suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addr1), test.name)
+		suite.Require().Len(suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addr2), 1, test.name)
+		if lock.IsUnlocking() {
+			suite.Require().Equal(coinsToLock, suite.App.LockupKeeper.GetAccountUnlockingCoins(suite.Ctx, addr2), test.name)
+		} else {
+			suite.Require().Equal(coinsToLock, suite.App.LockupKeeper.GetAccountLockedLongerDuration(suite.Ctx, addr2, unbondingDuration)[0].Coins, test.name)
+		}
+		suite.Require().Empty(suite.App.LockupKeeper.GetAccountLockedLongerDuration(suite.Ctx, addr1, 0), test.name)
+
+		// the recipient withdraws the lock once matured
+		if !lock.IsUnlocking() {
+			err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, resp.ID, nil)
+			suite.Require().NoError(err)
+		}
+		suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(unbondingDuration))
+		suite.App.LockupKeeper.WithdrawAllMaturedLocks(suite.Ctx)
+		suite.Require().Equal(defaultLockAmount, suite.App.BankKeeper.GetBalance(suite.Ctx, addr2, poolDenom).Amount, test.name)
+		suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, addr1, poolDenom).Amount.IsZero(), test.name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSplitLock() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	defaultCoins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	tests := []struct {
+		name          string
+		postLockSetup func(lockID uint64)
+		sender        sdk.AccAddress
+		splitCoins    sdk.Coins
+		expectPass    bool
+	}{
+		{
+			"happy path",
+			func(uint64) {},
+			addr1,
+			sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+			true,
+		},
+		{
+			"split all the coins of the lock",
+			func(uint64) {},
+			addr1,
+			defaultCoins,
+			false,
+		},
+		{
+			"split more than the coins of the lock",
+			func(uint64) {},
+			addr1,
+			sdk.Coins{sdk.NewInt64Coin("stake", 11)},
+			false,
+		},
+		{
+			"split other denom",
+			func(uint64) {},
+			addr1,
+			sdk.Coins{sdk.NewInt64Coin("foo", 4)},
+			false,
+		},
+		{
+			"sender is not the lock owner",
+			func(uint64) {},
+			addr2,
+			sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+			false,
+		},
+		{
+			"split unlocking lock",
+			func(lockID uint64) {
+				err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lockID, nil)
+				suite.Require().NoError(err)
+			},
+			addr1,
+			sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+			false,
+		},
+		{
+			"split lock with synthetic lockup",
+			func(lockID uint64) {
+				err := suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, lockID, "synthetic", time.Second, false)
+				suite.Require().NoError(err)
+			},
+			addr1,
+			sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+			false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+		suite.FundAcc(addr1, defaultCoins)
+
+		msgServer := keeper.NewMsgServerImpl(suite.App.LockupKeeper)
+		resp, err := msgServer.LockTokens(sdk.WrapSDKContext(suite.Ctx), types.NewMsgLockTokens(addr1, time.Second, defaultCoins))
+		suite.Require().NoError(err)
+		test.postLockSetup(resp.ID)
+
+		splitResp, err := msgServer.SplitLock(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSplitLock(test.sender, resp.ID, test.splitCoins))
+		if !test.expectPass {
+			suite.Require().Error(err, test.name)
+			continue
+		}
+		suite.Require().NoError(err, test.name)
+
+		lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, resp.ID)
+		suite.Require().NoError(err)
+		splitLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, splitResp.ID)
+		suite.Require().NoError(err)
+		suite.Require().Equal(defaultCoins.Sub(test.splitCoins), lock.Coins, test.name)
+		suite.Require().Equal(test.splitCoins, splitLock.Coins, test.name)
+		suite.Require().Equal(lock.Owner, splitLock.Owner, test.name)
+		suite.Require().Equal(lock.Duration, splitLock.Duration, test.name)
+		suite.Require().Equal(lock.EndTime, splitLock.EndTime, test.name)
+
+		// the split lock is referenced and the total locked amount is unchanged
+		suite.Require().Len(suite.App.LockupKeeper.GetAccountLockedDuration(suite.Ctx, addr1, time.Second), 2, test.name)
+		suite.Require().Equal(defaultCoins, suite.App.LockupKeeper.GetAccountLockedCoins(suite.Ctx, addr1), test.name)
+		suite.Require().Equal(defaultCoins[0].Amount, suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+			Denom:    "stake",
+			Duration: time.Second,
+		}), test.name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgMergeLocks() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	defaultCoins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	tests := []struct {
+		name string
+		// locks are created with the given coins and durations, for addr1 unless the owner is set
+		lockCoins     []sdk.Coins
+		lockDurations []time.Duration
+		lockOwner     sdk.AccAddress
+		postLockSetup func(lockIDs []uint64)
+		// duplicateLock merges the last lock twice
+		duplicateLock bool
+		expectPass    bool
+	}{
+		{
+			name:          "merge two locks",
+			lockCoins:     []sdk.Coins{defaultCoins, defaultCoins},
+			lockDurations: []time.Duration{time.Second, time.Second},
+			postLockSetup: func([]uint64) {},
+			expectPass:    true,
+		},
+		{
+			name:          "merge three locks",
+			lockCoins:     []sdk.Coins{defaultCoins, {sdk.NewInt64Coin("stake", 5)}, {sdk.NewInt64Coin("stake", 7)}},
+			lockDurations: []time.Duration{time.Second, time.Second, time.Second},
+			postLockSetup: func([]uint64) {},
+			expectPass:    true,
+		},
+		{
+			name:          "merge a lock twice",
+			lockCoins:     []sdk.Coins{defaultCoins, defaultCoins},
+			lockDurations: []time.Duration{time.Second, time.Second},
+			postLockSetup: func([]uint64) {},
+			duplicateLock: true,
+			expectPass:    false,
+		},
+		{
+			name:          "merge locks of different durations",
+			lockCoins:     []sdk.Coins{defaultCoins, defaultCoins},
+			lockDurations: []time.Duration{time.Second, 2 * time.Second},
+			postLockSetup: func([]uint64) {},
+			expectPass:    false,
+		},
+		{
+			name:          "merge locks of different denoms",
+			lockCoins:     []sdk.Coins{defaultCoins, {sdk.NewInt64Coin("foo", 10)}},
+			lockDurations: []time.Duration{time.Second, time.Second},
+			postLockSetup: func([]uint64) {},
+			expectPass:    false,
+		},
+		{
+			name:          "merge locks of another owner",
+			lockCoins:     []sdk.Coins{defaultCoins, defaultCoins},
+			lockDurations: []time.Duration{time.Second, time.Second},
+			lockOwner:     addr2,
+			postLockSetup: func([]uint64) {},
+			expectPass:    false,
+		},
+		{
+			name:          "merge unlocking lock",
+			lockCoins:     []sdk.Coins{defaultCoins, defaultCoins},
+			lockDurations: []time.Duration{time.Second, time.Second},
+			postLockSetup: func(lockIDs []uint64) {
+				err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lockIDs[1], nil)
+				suite.Require().NoError(err)
+			},
+			expectPass: false,
+		},
+		{
+			name:          "merge lock with synthetic lockup",
+			lockCoins:     []sdk.Coins{defaultCoins, defaultCoins},
+			lockDurations: []time.Duration{time.Second, time.Second},
+			postLockSetup: func(lockIDs []uint64) {
+				err := suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, lockIDs[0], "synthetic", time.Second, false)
+				suite.Require().NoError(err)
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		lockOwner := addr1
+		if test.lockOwner != nil {
+			lockOwner = test.lockOwner
+		}
+
+		// locks of the same owner and duration are created directly, as LockTokens would add to the existing lock,
+		// each an hour after the previous one
+		lockIDs := []uint64{}
+		totalCoins := sdk.Coins{}
+		for i, coins := range test.lockCoins {
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+			suite.FundAcc(lockOwner, coins)
+			lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, lockOwner, coins, test.lockDurations[i])
+			suite.Require().NoError(err)
+			lockIDs = append(lockIDs, lock.ID)
+			totalCoins = totalCoins.Add(coins...)
+		}
+		test.postLockSetup(lockIDs)
+		mergeLockIDs := lockIDs
+		if test.duplicateLock {
+			mergeLockIDs = append(mergeLockIDs, lockIDs[len(lockIDs)-1])
+		}
+
+		msgServer := keeper.NewMsgServerImpl(suite.App.LockupKeeper)
+		resp, err := msgServer.MergeLocks(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMergeLocks(addr1, mergeLockIDs))
+		if !test.expectPass {
+			suite.Require().Error(err, test.name)
+			suite.Require().Len(suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, lockOwner), len(lockIDs), test.name)
+			continue
+		}
+		suite.Require().NoError(err, test.name)
+		suite.Require().Equal(lockIDs[0], resp.ID, test.name)
+
+		// the coins are merged into the first lock and the other locks are deleted
+		lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, resp.ID)
+		suite.Require().NoError(err)
+		suite.Require().Equal(totalCoins, lock.Coins, test.name)
+		for _, lockID := range lockIDs[1:] {
+			_, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockID)
+			suite.Require().Error(err, test.name)
+		}
+		suite.Require().Len(suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addr1), 1, test.name)
+		suite.Require().Equal(totalCoins, suite.App.LockupKeeper.GetAccountLockedCoins(suite.Ctx, addr1), test.name)
+		suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr1).IsZero(), test.name)
+		suite.Require().Equal(totalCoins.AmountOf("stake"), suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+			Denom:    "stake",
+			Duration: time.Second,
+		}), test.name)
+
+		// the merged lock starts with the latest lock
+		suite.Require().Equal(suite.Ctx.BlockTime(), lock.StartTime, test.name)
+		firstStartTime := suite.Ctx.BlockTime().Add(-time.Duration(len(lockIDs)-1) * time.Hour)
+		suite.Require().Empty(suite.App.LockupKeeper.GetLocksStartedBeforeTimeDenom(suite.Ctx, "stake", firstStartTime), test.name)
+		suite.Require().Len(suite.App.LockupKeeper.GetLocksStartedAfterTimeDenom(suite.Ctx, "stake", firstStartTime), 1, test.name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgCancelUnlocking() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	defaultCoins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	tests := []struct {
+		name          string
+		postLockSetup func(lockID uint64)
+		sender        sdk.AccAddress
+		relockCoins   sdk.Coins
+		expectPass    bool
+		// expectSplit is set if the relocked coins are split into a new lock
+		expectSplit bool
+	}{
+		{
+			name: "cancel unlocking of entire lock",
+			postLockSetup: func(lockID uint64) {
+				err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lockID, nil)
+				suite.Require().NoError(err)
+			},
+			sender:     addr1,
+			expectPass: true,
+		},
+		{
+			name: "cancel unlocking of all the coins",
+			postLockSetup: func(lockID uint64) {
+				err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lockID, nil)
+				suite.Require().NoError(err)
+			},
+			sender:      addr1,
+			relockCoins: defaultCoins,
+			expectPass:  true,
+		},
+		{
+			name: "cancel unlocking of part of the coins",
+			postLockSetup: func(lockID uint64) {
+				err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lockID, nil)
+				suite.Require().NoError(err)
+			},
+			sender:      addr1,
+			relockCoins: sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+			expectPass:  true,
+			expectSplit: true,
+		},
+		{
+			name: "cancel unlocking of more than the coins",
+			postLockSetup: func(lockID uint64) {
+				err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lockID, nil)
+				suite.Require().NoError(err)
+			},
+			sender:      addr1,
+			relockCoins: sdk.Coins{sdk.NewInt64Coin("stake", 11)},
+			expectPass:  false,
+		},
+		{
+			name: "sender is not the lock owner",
+			postLockSetup: func(lockID uint64) {
+				err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lockID, nil)
+				suite.Require().NoError(err)
+			},
+			sender:     addr2,
+			expectPass: false,
+		},
+		{
+			name:          "cancel unlocking of not unlocking lock",
+			postLockSetup: func(uint64) {},
+			sender:        addr1,
+			expectPass:    false,
+		},
+		{
+			name: "cancel unlocking of matured lock",
+			postLockSetup: func(lockID uint64) {
+				err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lockID, nil)
+				suite.Require().NoError(err)
+				suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second))
+			},
+			sender:     addr1,
+			expectPass: false,
+		},
+		{
+			name: "cancel unlocking of lock with synthetic lockup",
+			postLockSetup: func(lockID uint64) {
+				err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lockID, nil)
+				suite.Require().NoError(err)
+				err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, lockID, "synthetic", time.Second, true)
+				suite.Require().NoError(err)
+			},
+			sender:     addr1,
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+		suite.FundAcc(addr1, defaultCoins)
+
+		msgServer := keeper.NewMsgServerImpl(suite.App.LockupKeeper)
+		resp, err := msgServer.LockTokens(sdk.WrapSDKContext(suite.Ctx), types.NewMsgLockTokens(addr1, time.Second, defaultCoins))
+		suite.Require().NoError(err)
+		test.postLockSetup(resp.ID)
+
+		cancelResp, err := msgServer.CancelUnlocking(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCancelUnlocking(test.sender, resp.ID, test.relockCoins))
+		if !test.expectPass {
+			suite.Require().Error(err, test.name)
+			continue
+		}
+		suite.Require().NoError(err, test.name)
+
+		relockedCoins := defaultCoins
+		if test.expectSplit {
+			relockedCoins = test.relockCoins
+			suite.Require().NotEqual(resp.ID, cancelResp.ID, test.name)
+		} else {
+			suite.Require().Equal(resp.ID, cancelResp.ID, test.name)
+		}
+
+		// the relocked coins are not unlocking with the original duration
+		lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, cancelResp.ID)
+		suite.Require().NoError(err)
+		suite.Require().False(lock.IsUnlocking(), test.name)
+		suite.Require().Equal(time.Second, lock.Duration, test.name)
+		suite.Require().Equal(relockedCoins, lock.Coins, test.name)
+		suite.Require().Equal(relockedCoins, suite.App.LockupKeeper.GetAccountLockedLongerDurationNotUnlockingOnly(suite.Ctx, addr1, time.Second)[0].Coins, test.name)
+		suite.Require().True(defaultCoins.Sub(relockedCoins).IsEqual(suite.App.LockupKeeper.GetAccountUnlockingCoins(suite.Ctx, addr1)), test.name)
+		suite.Require().Equal(defaultCoins[0].Amount, suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+			Denom:    "stake",
+			Duration: time.Second,
+		}), test.name)
+
+		// only the coins still unlocking are withdrawn once matured
+		suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second))
+		suite.App.LockupKeeper.WithdrawAllMaturedLocks(suite.Ctx)
+		suite.Require().True(defaultCoins.Sub(relockedCoins).IsEqual(suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr1)), test.name)
+		suite.Require().Equal(relockedCoins, suite.App.LockupKeeper.GetAccountLockedCoins(suite.Ctx, addr1), test.name)
+	}
+}
@@ -0,0 +1,279 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/v12/x/lockup/types"
+)
+
+// paginateLocks returns the locks referenced in the lock ref ranges that pass the filter, in the order of the ranges.
+// All the matching locks are returned if pageReq is nil. Otherwise, pageReq is handled the same way as
+// query.Paginate does, the next key being the store key of the lock ref that follows the page.
+// A nil filter matches every lock.
+func (k Keeper) paginateLocks(
+	ctx sdk.Context,
+	ranges []lockRefRange,
+	pageReq *query.PageRequest,
+	filter func(lock types.PeriodLock) bool,
+) ([]types.PeriodLock, *query.PageResponse, error) {
+	locks := []types.PeriodLock{}
+	if pageReq == nil {
+		for _, r := range ranges {
+			err := k.forEachLockInRange(ctx, r, func(_ []byte, lock types.PeriodLock) bool {
+				if filter == nil || filter(lock) {
+					locks = append(locks, lock)
+				}
+				return false
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		return locks, nil, nil
+	}
+
+	if pageReq.Reverse {
+		return nil, nil, fmt.Errorf("reverse pagination is not supported for locks")
+	}
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	offset := pageReq.Offset
+	limit := pageReq.Limit
+	countTotal := pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		// count total results when the limit is zero/not supplied
+		countTotal = true
+	}
+
+	// with key based pagination, iteration resumes from the range that holds the key
+	start := 0
+	if len(pageReq.Key) != 0 {
+		start = -1
+		for i, r := range ranges {
+			if bytes.Compare(pageReq.Key, r.start) >= 0 && bytes.Compare(pageReq.Key, r.end) < 0 {
+				start = i
+				break
+			}
+		}
+		if start == -1 {
+			return nil, nil, fmt.Errorf("invalid pagination key")
+		}
+	}
+
+	var count uint64
+	var nextKey []byte
+	for i := start; i < len(ranges); i++ {
+		r := ranges[i]
+		if i == start && len(pageReq.Key) != 0 {
+			r.start = pageReq.Key
+		}
+		err := k.forEachLockInRange(ctx, r, func(key []byte, lock types.PeriodLock) bool {
+			if filter != nil && !filter(lock) {
+				return false
+			}
+			count++
+			if count <= offset {
+				return false
+			}
+			if count <= offset+limit {
+				locks = append(locks, lock)
+				return false
+			}
+			if nextKey == nil {
+				nextKey = key
+			}
+			// keep counting for the total only on offset based pages
+			return !countTotal || len(pageReq.Key) != 0
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if nextKey != nil && (!countTotal || len(pageReq.Key) != 0) {
+			break
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal && len(pageReq.Key) == 0 {
+		pageRes.Total = count
+	}
+	return locks, pageRes, nil
+}
+
+// GetLocksDenomPage returns up to limit locks of the denom, starting from the lock ref store key,
+// or from the first lock if the key is empty. It also returns the key to resume from on the next page,
+// which is nil once all the locks of the denom are returned.
+func (k Keeper) GetLocksDenomPage(ctx sdk.Context, denom string, key []byte, limit uint64) ([]types.PeriodLock, []byte, error) {
+	locks, pageRes, err := k.paginateLocks(ctx, locksDenomRanges(denom), &query.PageRequest{Key: key, Limit: limit}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return locks, pageRes.NextKey, nil
+}
+
+// forEachLockInRange calls fn with the key of each lock ref in the range and the lock it references,
+// until fn returns true.
+func (k Keeper) forEachLockInRange(ctx sdk.Context, r lockRefRange, fn func(key []byte, lock types.PeriodLock) (stop bool)) error {
+	iterator := k.rangeIterator(ctx, r)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		lock, err := k.GetLockByID(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if err != nil {
+			return err
+		}
+		key := make([]byte, len(iterator.Key()))
+		copy(key, iterator.Key())
+		if fn(key, *lock) {
+			break
+		}
+	}
+	return nil
+}
+
+// lockedPastTimeDuration returns the duration a not unlocking lock needs to still be locked at timestamp
+// if it started unlocking now.
+func lockedPastTimeDuration(ctx sdk.Context, timestamp time.Time) time.Duration {
+	duration := time.Duration(0)
+	if timestamp.After(ctx.BlockTime()) {
+		duration = timestamp.Sub(ctx.BlockTime())
+	}
+	return duration
+}
+
+// accountLockedPastTimeRanges returns the lock ref ranges of GetAccountLockedPastTime.
+func accountLockedPastTimeRanges(ctx sdk.Context, addr sdk.AccAddress, timestamp time.Time) []lockRefRange {
+	return []lockRefRange{
+		rangeLongerDuration(combineKeys(types.KeyPrefixNotUnlocking, types.KeyPrefixAccountLockDuration, addr), lockedPastTimeDuration(ctx, timestamp)),
+		rangeAfterTime(combineKeys(types.KeyPrefixUnlocking, types.KeyPrefixAccountLockTimestamp, addr), timestamp),
+	}
+}
+
+// accountLockedPastTimeNotUnlockingOnlyRanges returns the lock ref ranges of GetAccountLockedPastTimeNotUnlockingOnly.
+func accountLockedPastTimeNotUnlockingOnlyRanges(ctx sdk.Context, addr sdk.AccAddress, timestamp time.Time) []lockRefRange {
+	return []lockRefRange{
+		rangeLongerDuration(combineKeys(types.KeyPrefixNotUnlocking, types.KeyPrefixAccountLockDuration, addr), lockedPastTimeDuration(ctx, timestamp)),
+	}
+}
+
+// accountUnlockedBeforeTimeRanges returns the lock ref ranges of GetAccountUnlockedBeforeTime.
+func accountUnlockedBeforeTimeRanges(ctx sdk.Context, addr sdk.AccAddress, timestamp time.Time) []lockRefRange {
+	unlockings := rangeBeforeTime(combineKeys(types.KeyPrefixUnlocking, types.KeyPrefixAccountLockTimestamp, addr), timestamp)
+	if timestamp.Before(ctx.BlockTime()) {
+		return []lockRefRange{unlockings}
+	}
+	duration := timestamp.Sub(ctx.BlockTime())
+	return []lockRefRange{
+		rangeShorterDuration(combineKeys(types.KeyPrefixNotUnlocking, types.KeyPrefixAccountLockDuration, addr), duration),
+		unlockings,
+	}
+}
+
+// accountLockedPastTimeDenomRanges returns the lock ref ranges of GetAccountLockedPastTimeDenom.
+func accountLockedPastTimeDenomRanges(ctx sdk.Context, addr sdk.AccAddress, denom string, timestamp time.Time) []lockRefRange {
+	return []lockRefRange{
+		rangeLongerDuration(combineKeys(types.KeyPrefixNotUnlocking, types.KeyPrefixAccountDenomLockDuration, addr, []byte(denom)), lockedPastTimeDuration(ctx, timestamp)),
+		rangeAfterTime(combineKeys(types.KeyPrefixUnlocking, types.KeyPrefixAccountDenomLockTimestamp, addr, []byte(denom)), timestamp),
+	}
+}
+
+// accountLockedLongerDurationRanges returns the lock ref ranges of GetAccountLockedLongerDuration.
+func accountLockedLongerDurationRanges(addr sdk.AccAddress, duration time.Duration) []lockRefRange {
+	return []lockRefRange{
+		rangeLongerDuration(combineKeys(types.KeyPrefixNotUnlocking, types.KeyPrefixAccountLockDuration, addr), duration),
+		rangeLongerDuration(combineKeys(types.KeyPrefixUnlocking, types.KeyPrefixAccountLockDuration, addr), duration),
+	}
+}
+
+// accountLockedDurationRanges returns the lock ref ranges of GetAccountLockedDuration.
+func accountLockedDurationRanges(addr sdk.AccAddress, duration time.Duration) []lockRefRange {
+	return []lockRefRange{
+		rangeDuration(combineKeys(types.KeyPrefixUnlocking, types.KeyPrefixAccountLockDuration, addr), duration),
+		rangeDuration(combineKeys(types.KeyPrefixNotUnlocking, types.KeyPrefixAccountLockDuration, addr), duration),
+	}
+}
+
+// accountLockedLongerDurationNotUnlockingOnlyRanges returns the lock ref ranges of GetAccountLockedLongerDurationNotUnlockingOnly.
+func accountLockedLongerDurationNotUnlockingOnlyRanges(addr sdk.AccAddress, duration time.Duration) []lockRefRange {
+	return []lockRefRange{
+		rangeLongerDuration(combineKeys(types.KeyPrefixNotUnlocking, types.KeyPrefixAccountLockDuration, addr), duration),
+	}
+}
+
+// accountLockedLongerDurationDenomRanges returns the lock ref ranges of GetAccountLockedLongerDurationDenom.
+func accountLockedLongerDurationDenomRanges(addr sdk.AccAddress, denom string, duration time.Duration) []lockRefRange {
+	return []lockRefRange{
+		rangeLongerDuration(combineKeys(types.KeyPrefixNotUnlocking, types.KeyPrefixAccountDenomLockDuration, addr, []byte(denom)), duration),
+		rangeLongerDuration(combineKeys(types.KeyPrefixUnlocking, types.KeyPrefixAccountDenomLockDuration, addr, []byte(denom)), duration),
+	}
+}
+
+// locksDenomRanges returns the lock ref ranges of GetLocksDenom.
+func locksDenomRanges(denom string) []lockRefRange {
+	return []lockRefRange{
+		rangeLongerDuration(combineKeys(types.KeyPrefixNotUnlocking, types.KeyPrefixDenomLockDuration, []byte(denom)), 0),
+		rangeLongerDuration(combineKeys(types.KeyPrefixUnlocking, types.KeyPrefixDenomLockDuration, []byte(denom)), 0),
+	}
+}
+
+// locksByFilterRanges returns the lock ref ranges holding the locks of the filter's owner and denom
+// whose duration is within the filter's duration bounds.
+func locksByFilterRanges(owner sdk.AccAddress, req *types.LocksByFilterRequest) []lockRefRange {
+	var indexPrefix []byte
+	switch {
+	case owner != nil && req.Denom != "":
+		indexPrefix = combineKeys(types.KeyPrefixAccountDenomLockDuration, owner, []byte(req.Denom))
+	case owner != nil:
+		indexPrefix = combineKeys(types.KeyPrefixAccountLockDuration, owner)
+	case req.Denom != "":
+		indexPrefix = combineKeys(types.KeyPrefixDenomLockDuration, []byte(req.Denom))
+	default:
+		indexPrefix = types.KeyPrefixLockDuration
+	}
+
+	durationRange := func(isUnlocking bool) lockRefRange {
+		prefix := combineKeys(unlockingPrefix(isUnlocking), indexPrefix)
+		r := rangeLongerDuration(prefix, req.MinDuration)
+		if req.MaxDuration != 0 {
+			r.end = rangeDuration(prefix, req.MaxDuration).end
+		}
+		return r
+	}
+
+	// end time bounds can only be met by unlocking locks
+	hasEndTimeBound := req.MinEndTime != nil || req.MaxEndTime != nil
+	ranges := []lockRefRange{}
+	if req.UnlockingStatus != types.UnlockingOnly && !hasEndTimeBound {
+		ranges = append(ranges, durationRange(false))
+	}
+	if req.UnlockingStatus != types.NotUnlockingOnly {
+		ranges = append(ranges, durationRange(true))
+	}
+	return ranges
+}
+
+// locksByFilterMatcher returns the in memory part of the filter, the end time and synthetic status conditions.
+func (k Keeper) locksByFilterMatcher(ctx sdk.Context, req *types.LocksByFilterRequest) func(lock types.PeriodLock) bool {
+	return func(lock types.PeriodLock) bool {
+		if req.MinEndTime != nil && lock.EndTime.Before(*req.MinEndTime) {
+			return false
+		}
+		if req.MaxEndTime != nil && lock.EndTime.After(*req.MaxEndTime) {
+			return false
+		}
+		switch req.SyntheticStatus {
+		case types.SyntheticOnly:
+			return k.HasAnySyntheticLockups(ctx, lock.ID)
+		case types.NotSyntheticOnly:
+			return !k.HasAnySyntheticLockups(ctx, lock.ID)
+		}
+		return true
+	}
+}
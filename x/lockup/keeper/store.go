@@ -235,6 +235,22 @@ func (k Keeper) GetLocksLongerThanDurationDenom(ctx sdk.Context, denom string, d
 	return combineLocks(notUnlockings, unlockings)
 }
 
+// GetLocksStartedBeforeTimeDenom Returns the locks of denom started at or before timestamp.
+func (k Keeper) GetLocksStartedBeforeTimeDenom(ctx sdk.Context, denom string, timestamp time.Time) []types.PeriodLock {
+	// returns both unlocking started and not started
+	unlockings := k.getLocksFromIterator(ctx, k.LockIteratorStartedBeforeTimeDenom(ctx, true, denom, timestamp))
+	notUnlockings := k.getLocksFromIterator(ctx, k.LockIteratorStartedBeforeTimeDenom(ctx, false, denom, timestamp))
+	return combineLocks(notUnlockings, unlockings)
+}
+
+// GetLocksStartedAfterTimeDenom Returns the locks of denom started after timestamp.
+func (k Keeper) GetLocksStartedAfterTimeDenom(ctx sdk.Context, denom string, timestamp time.Time) []types.PeriodLock {
+	// returns both unlocking started and not started
+	unlockings := k.getLocksFromIterator(ctx, k.LockIteratorStartedAfterTimeDenom(ctx, true, denom, timestamp))
+	notUnlockings := k.getLocksFromIterator(ctx, k.LockIteratorStartedAfterTimeDenom(ctx, false, denom, timestamp))
+	return combineLocks(notUnlockings, unlockings)
+}
+
 // GetLockByID Returns lock from lockID.
 func (k Keeper) GetLockByID(ctx sdk.Context, lockID uint64) (*types.PeriodLock, error) {
 	lock := types.PeriodLock{}
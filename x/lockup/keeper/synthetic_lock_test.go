@@ -49,11 +49,12 @@ func (suite *KeeperTestSuite) TestSyntheticLockupCreateGetDeleteAccumulation() {
 
 	expectedLocks := []types.PeriodLock{
 		{
-			ID:       1,
-			Owner:    addr1.String(),
-			Duration: time.Second,
-			EndTime:  time.Time{},
-			Coins:    coins,
+			ID:        1,
+			Owner:     addr1.String(),
+			Duration:  time.Second,
+			EndTime:   time.Time{},
+			Coins:     coins,
+			StartTime: suite.Ctx.BlockTime().UTC(),
 		},
 	}
 	// check locks
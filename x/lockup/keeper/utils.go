@@ -55,10 +55,12 @@ func durationLockRefKeys(lock types.PeriodLock) ([][]byte, error) {
 	refKeys = append(refKeys, combineKeys(types.KeyPrefixLockDuration, durationKey))
 	refKeys = append(refKeys, combineKeys(types.KeyPrefixAccountLockDuration, owner, durationKey))
 
+	startTimeKey := getTimeKey(lock.StartTime)
 	for _, coin := range lock.Coins {
 		denomBz := []byte(coin.Denom)
 		refKeys = append(refKeys, combineKeys(types.KeyPrefixDenomLockDuration, denomBz, durationKey))
 		refKeys = append(refKeys, combineKeys(types.KeyPrefixAccountDenomLockDuration, owner, denomBz, durationKey))
+		refKeys = append(refKeys, combineKeys(types.KeyPrefixDenomLockStartTime, denomBz, startTimeKey))
 	}
 	return refKeys, nil
 }
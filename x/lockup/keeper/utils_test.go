@@ -54,13 +54,13 @@ func TestLockRefKeys(t *testing.T) {
 	// not empty address and 1 coin
 	lock3 := types.NewPeriodLock(1, addr1, time.Second, time.Now(), sdk.Coins{sdk.NewInt64Coin("stake", 10)})
 	keys3, err := lockRefKeys(lock3)
-	require.Len(t, keys3, 8)
+	require.Len(t, keys3, 9)
 	// not empty address and empty coin
 	lock4 := types.NewPeriodLock(1, addr1, time.Second, time.Now(), sdk.Coins{sdk.NewInt64Coin("stake", 10)})
 	keys4, err := lockRefKeys(lock4)
-	require.Len(t, keys4, 8)
+	require.Len(t, keys4, 9)
 	// not empty address and 2 coins
 	lock5 := types.NewPeriodLock(1, addr1, time.Second, time.Now(), sdk.Coins{sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("atom", 1)})
 	keys5, err := lockRefKeys(lock5)
-	require.Len(t, keys5, 12)
+	require.Len(t, keys5, 14)
 }
@@ -6,6 +6,10 @@ const (
 	TypeEvtAddTokensToLock = "add_tokens_to_lock"
 	TypeEvtBeginUnlockAll  = "begin_unlock_all"
 	TypeEvtBeginUnlock     = "begin_unlock"
+	TypeEvtTransferLock    = "transfer_lock"
+	TypeEvtSplitLock       = "split_lock"
+	TypeEvtMergeLocks      = "merge_locks"
+	TypeEvtCancelUnlocking = "cancel_unlocking"
 
 	AttributePeriodLockID         = "period_lock_id"
 	AttributePeriodLockOwner      = "owner"
@@ -13,4 +17,7 @@ const (
 	AttributePeriodLockDuration   = "duration"
 	AttributePeriodLockUnlockTime = "unlock_time"
 	AttributeUnlockedCoins        = "unlocked_coins"
+	AttributePeriodLockRecipient  = "recipient"
+	AttributeSplitLockID          = "split_lock_id"
+	AttributeMergedLockIDs        = "merged_lock_ids"
 )
@@ -13,6 +13,9 @@ type LockupHooks interface {
 	OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 	OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration time.Duration, newDuration time.Duration)
+	OnLockSplit(ctx sdk.Context, lockID uint64, splitLockID uint64, amount sdk.Coins)
+	OnLockMerge(ctx sdk.Context, lockID uint64, mergedLockID uint64, amount sdk.Coins)
+	OnLockTransfer(ctx sdk.Context, lockID uint64, prevOwner sdk.AccAddress, newOwner sdk.AccAddress)
 }
 
 var _ LockupHooks = MultiLockupHooks{}
@@ -59,3 +62,21 @@ func (h MultiLockupHooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDur
 		h[i].OnLockupExtend(ctx, lockID, prevDuration, newDuration)
 	}
 }
+
+func (h MultiLockupHooks) OnLockSplit(ctx sdk.Context, lockID uint64, splitLockID uint64, amount sdk.Coins) {
+	for i := range h {
+		h[i].OnLockSplit(ctx, lockID, splitLockID, amount)
+	}
+}
+
+func (h MultiLockupHooks) OnLockMerge(ctx sdk.Context, lockID uint64, mergedLockID uint64, amount sdk.Coins) {
+	for i := range h {
+		h[i].OnLockMerge(ctx, lockID, mergedLockID, amount)
+	}
+}
+
+func (h MultiLockupHooks) OnLockTransfer(ctx sdk.Context, lockID uint64, prevOwner sdk.AccAddress, newOwner sdk.AccAddress) {
+	for i := range h {
+		h[i].OnLockTransfer(ctx, lockID, prevOwner, newOwner)
+	}
+}
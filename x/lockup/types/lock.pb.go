@@ -78,6 +78,10 @@ type PeriodLock struct {
 	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
 	// Coins are the tokens locked within the lock, kept in the module account.
 	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// StartTime refers to the time at which the lock was created.
+	// Locks created before this field was introduced have an uninitialized
+	// value, and are considered to be started before any other lock.
+	StartTime time.Time `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
 }
 
 func (m *PeriodLock) Reset()         { *m = PeriodLock{} }
@@ -148,6 +152,13 @@ func (m *PeriodLock) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *PeriodLock) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
 // QueryCondition is a struct used for querying locks upon different conditions.
 // Duration field and timestamp fields could be optional, depending on the
 // LockQueryType.
@@ -160,10 +171,13 @@ type QueryCondition struct {
 	// duration. Duration field must not be nil when the lock query type is
 	// `ByLockDuration`.
 	Duration time.Duration `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
-	// Timestamp is used by locks started before the specified duration.
+	// Timestamp is used to query locks started at or before the specified
+	// timestamp, or after it when `started_after` is set.
 	// Timestamp field must not be nil when the lock query type is `ByLockTime`.
-	// Querying locks with timestamp is currently not implemented.
 	Timestamp time.Time `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
+	// StartedAfter switches a `ByLockTime` query to locks started strictly
+	// after the timestamp, rather than at or before it.
+	StartedAfter bool `protobuf:"varint,5,opt,name=started_after,json=startedAfter,proto3" json:"started_after,omitempty" yaml:"started_after"`
 }
 
 func (m *QueryCondition) Reset()         { *m = QueryCondition{} }
@@ -227,6 +241,13 @@ func (m *QueryCondition) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
+func (m *QueryCondition) GetStartedAfter() bool {
+	if m != nil {
+		return m.StartedAfter
+	}
+	return false
+}
+
 // SyntheticLock is creating virtual lockup where new denom is combination of
 // original denom and synthetic suffix. At the time of synthetic lockup creation
 // and deletion, accumulation store is also being updated and on querier side,
@@ -317,45 +338,48 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/lock.proto", fileDescriptor_7e9d7527a237b489) }
 
 var fileDescriptor_7e9d7527a237b489 = []byte{
-	// 596 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xb6, 0x9d, 0xa4, 0xbf, 0xf6, 0xfa, 0x4b, 0x1a, 0x9d, 0x3a, 0xa4, 0x01, 0xec, 0xc8, 0x03,
-	0x8a, 0x50, 0x6b, 0x93, 0xb0, 0x31, 0xba, 0x61, 0x88, 0xd4, 0x01, 0x4c, 0xc5, 0xc0, 0x12, 0xf9,
-	0xcf, 0xe1, 0x9c, 0x62, 0xfb, 0x8c, 0xff, 0x14, 0xfc, 0x0d, 0x18, 0x3b, 0x82, 0xc4, 0xc6, 0xc6,
-	0x27, 0xe9, 0xd8, 0x91, 0x29, 0x45, 0x89, 0x58, 0x18, 0xfb, 0x09, 0xd0, 0xdd, 0xf9, 0x92, 0xb4,
-	0x08, 0xa9, 0x03, 0x4c, 0xe7, 0xf7, 0x9e, 0xf7, 0x7d, 0xde, 0xf7, 0x9e, 0xf7, 0x91, 0xc1, 0x01,
-	0xc9, 0x22, 0x92, 0xe1, 0xcc, 0x0c, 0x89, 0x37, 0x2b, 0x12, 0x76, 0x18, 0x49, 0x4a, 0x72, 0x02,
-	0x5b, 0x15, 0x64, 0x70, 0xa8, 0xbb, 0x1f, 0x90, 0x80, 0x30, 0xc8, 0xa4, 0x5f, 0x3c, 0xab, 0xab,
-	0x06, 0x84, 0x04, 0x21, 0x32, 0x59, 0xe4, 0x16, 0x6f, 0x4c, 0xbf, 0x48, 0x9d, 0x1c, 0x93, 0xb8,
-	0xc2, 0xb5, 0xdb, 0x78, 0x8e, 0x23, 0x94, 0xe5, 0x4e, 0x94, 0x08, 0x02, 0x8f, 0xf5, 0x31, 0x5d,
-	0x27, 0x43, 0xe6, 0xd9, 0xc0, 0x45, 0xb9, 0x33, 0x30, 0x3d, 0x82, 0x2b, 0x02, 0xfd, 0x87, 0x02,
-	0xc0, 0x73, 0x94, 0x62, 0xe2, 0x9f, 0x10, 0x6f, 0x06, 0x5b, 0x40, 0x19, 0x8f, 0x3a, 0x72, 0x4f,
-	0xee, 0xd7, 0x6d, 0x65, 0x3c, 0x82, 0x0f, 0x41, 0x83, 0xbc, 0x8b, 0x51, 0xda, 0x51, 0x7a, 0x72,
-	0x7f, 0xc7, 0x6a, 0x5f, 0xcf, 0xb5, 0xff, 0x4b, 0x27, 0x0a, 0x9f, 0xea, 0xec, 0x5a, 0xb7, 0x39,
-	0x0c, 0xa7, 0x60, 0x5b, 0x4c, 0xd6, 0xa9, 0xf5, 0xe4, 0xfe, 0xee, 0xf0, 0xc0, 0xe0, 0xa3, 0x19,
-	0x62, 0x34, 0x63, 0x54, 0x25, 0x58, 0x83, 0x8b, 0xb9, 0x26, 0xfd, 0x9c, 0x6b, 0x50, 0x94, 0x1c,
-	0x92, 0x08, 0xe7, 0x28, 0x4a, 0xf2, 0xf2, 0x7a, 0xae, 0xed, 0x71, 0x7e, 0x81, 0xe9, 0x1f, 0xaf,
-	0x34, 0xd9, 0x5e, 0xb1, 0x43, 0x1b, 0x6c, 0xa3, 0xd8, 0x9f, 0xd0, 0x77, 0x76, 0xea, 0xac, 0x53,
-	0xf7, 0xb7, 0x4e, 0xa7, 0x42, 0x04, 0xeb, 0x1e, 0x6d, 0xb5, 0x26, 0x15, 0x95, 0xfa, 0x39, 0x25,
-	0xfd, 0x0f, 0xc5, 0x3e, 0x4d, 0x85, 0x0e, 0x68, 0x50, 0x49, 0xb2, 0x4e, 0xa3, 0x57, 0x63, 0xa3,
-	0x73, 0xd1, 0x0c, 0x2a, 0x9a, 0x51, 0x89, 0x66, 0x1c, 0x13, 0x1c, 0x5b, 0x8f, 0x29, 0xdf, 0xd7,
-	0x2b, 0xad, 0x1f, 0xe0, 0x7c, 0x5a, 0xb8, 0x86, 0x47, 0x22, 0xb3, 0x52, 0x98, 0x1f, 0x47, 0x99,
-	0x3f, 0x33, 0xf3, 0x32, 0x41, 0x19, 0x2b, 0xc8, 0x6c, 0xce, 0xac, 0x7f, 0x52, 0x40, 0xeb, 0x45,
-	0x81, 0xd2, 0xf2, 0x98, 0xc4, 0x3e, 0x66, 0x2f, 0x79, 0x06, 0xf6, 0xe8, 0xee, 0x27, 0x6f, 0xe9,
-	0xf5, 0x84, 0xd6, 0x30, 0xe1, 0x5b, 0xc3, 0x07, 0xc6, 0x4d, 0x6f, 0x18, 0x74, 0x35, 0xac, 0xf8,
-	0xb4, 0x4c, 0x90, 0xdd, 0x0c, 0x37, 0x43, 0xb8, 0x0f, 0x1a, 0x3e, 0x8a, 0x49, 0xc4, 0x57, 0x64,
-	0xf3, 0x80, 0xca, 0x74, 0xf7, 0x85, 0xdc, 0x52, 0xe9, 0x4f, 0xd2, 0xbf, 0x02, 0x3b, 0x2b, 0x7b,
-	0xdd, 0x41, 0xfb, 0xfb, 0x15, 0x6b, 0x9b, 0xb3, 0xae, 0x4a, 0xb9, 0xf8, 0x6b, 0x2a, 0xfd, 0xb3,
-	0x02, 0x9a, 0x2f, 0xcb, 0x38, 0x9f, 0xa2, 0x1c, 0x7b, 0xcc, 0x86, 0x87, 0x00, 0x16, 0xb1, 0x8f,
-	0xd2, 0xb0, 0xc4, 0x71, 0x30, 0x61, 0x2a, 0x61, 0xbf, 0xb2, 0x65, 0x7b, 0x8d, 0xd0, 0xdc, 0xb1,
-	0x0f, 0x35, 0xb0, 0x9b, 0xd1, 0xf2, 0xc9, 0xa6, 0x0e, 0x80, 0x5d, 0x8d, 0x84, 0x18, 0x2b, 0xcf,
-	0xd4, 0xfe, 0x92, 0x67, 0x36, 0x1d, 0x5f, 0xff, 0x97, 0x8e, 0x7f, 0x34, 0x00, 0xcd, 0x1b, 0x06,
-	0x80, 0x2d, 0x00, 0xac, 0x52, 0x70, 0xb7, 0x25, 0x08, 0xc0, 0x96, 0x55, 0xd2, 0xa1, 0xda, 0x72,
-	0xb7, 0xfe, 0xe1, 0x8b, 0x2a, 0x59, 0x27, 0x17, 0x0b, 0x55, 0xbe, 0x5c, 0xa8, 0xf2, 0xf7, 0x85,
-	0x2a, 0x9f, 0x2f, 0x55, 0xe9, 0x72, 0xa9, 0x4a, 0xdf, 0x96, 0xaa, 0xf4, 0x7a, 0xb8, 0x61, 0xdc,
-	0xca, 0x65, 0x47, 0xa1, 0xe3, 0x66, 0x22, 0x30, 0xcf, 0x06, 0x43, 0xf3, 0xbd, 0xf8, 0x5f, 0x31,
-	0x23, 0xbb, 0x5b, 0xec, 0x41, 0x4f, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x72, 0x1a, 0xe9, 0x52,
-	0xce, 0x04, 0x00, 0x00,
+	// 654 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x3f, 0x6f, 0xd3, 0x5e,
+	0x14, 0x8d, 0xf3, 0xa7, 0xbf, 0xf6, 0xb6, 0x49, 0xf3, 0x7b, 0xea, 0xe0, 0x06, 0x6a, 0x47, 0x1e,
+	0x50, 0x84, 0x5a, 0x9b, 0x84, 0x0d, 0x89, 0x01, 0x37, 0x0c, 0x95, 0x3a, 0x80, 0xa9, 0x10, 0x62,
+	0x89, 0x9c, 0xf8, 0x35, 0xb5, 0x1a, 0xfb, 0x05, 0xbf, 0x97, 0x82, 0xbf, 0x01, 0x63, 0x47, 0x06,
+	0x36, 0x36, 0xbe, 0x04, 0x6b, 0xc7, 0x8e, 0x9d, 0x52, 0xd4, 0x6e, 0x8c, 0xfd, 0x04, 0xe8, 0xfd,
+	0x4b, 0xd3, 0x22, 0x44, 0x07, 0x98, 0x9c, 0xfb, 0xce, 0xbd, 0xe7, 0x9d, 0x77, 0xee, 0xbd, 0x81,
+	0x75, 0x42, 0x13, 0x42, 0x63, 0xea, 0x8d, 0xc8, 0xe0, 0x70, 0x32, 0x16, 0x1f, 0x77, 0x9c, 0x11,
+	0x46, 0x50, 0x4d, 0x41, 0xae, 0x84, 0x1a, 0x6b, 0x43, 0x32, 0x24, 0x02, 0xf2, 0xf8, 0x2f, 0x99,
+	0xd5, 0xb0, 0x86, 0x84, 0x0c, 0x47, 0xd8, 0x13, 0x51, 0x7f, 0xb2, 0xef, 0x45, 0x93, 0x2c, 0x64,
+	0x31, 0x49, 0x15, 0x6e, 0xdf, 0xc6, 0x59, 0x9c, 0x60, 0xca, 0xc2, 0x64, 0xac, 0x09, 0x06, 0xe2,
+	0x1e, 0xaf, 0x1f, 0x52, 0xec, 0x1d, 0xb5, 0xfb, 0x98, 0x85, 0x6d, 0x6f, 0x40, 0x62, 0x45, 0xe0,
+	0x7c, 0x2b, 0x01, 0xbc, 0xc0, 0x59, 0x4c, 0xa2, 0x5d, 0x32, 0x38, 0x44, 0x35, 0x28, 0xee, 0x74,
+	0x4d, 0xa3, 0x69, 0xb4, 0xca, 0x41, 0x71, 0xa7, 0x8b, 0x1e, 0x40, 0x85, 0xbc, 0x4f, 0x71, 0x66,
+	0x16, 0x9b, 0x46, 0x6b, 0xc9, 0xaf, 0x5f, 0x4d, 0xed, 0x95, 0x3c, 0x4c, 0x46, 0x4f, 0x1c, 0x71,
+	0xec, 0x04, 0x12, 0x46, 0x07, 0xb0, 0xa8, 0x95, 0x99, 0xa5, 0xa6, 0xd1, 0x5a, 0xee, 0xac, 0xbb,
+	0x52, 0x9a, 0xab, 0xa5, 0xb9, 0x5d, 0x95, 0xe0, 0xb7, 0x4f, 0xa6, 0x76, 0xe1, 0xc7, 0xd4, 0x46,
+	0xba, 0x64, 0x93, 0x24, 0x31, 0xc3, 0xc9, 0x98, 0xe5, 0x57, 0x53, 0x7b, 0x55, 0xf2, 0x6b, 0xcc,
+	0xf9, 0x74, 0x6e, 0x1b, 0xc1, 0x8c, 0x1d, 0x05, 0xb0, 0x88, 0xd3, 0xa8, 0xc7, 0xdf, 0x69, 0x96,
+	0xc5, 0x4d, 0x8d, 0x5f, 0x6e, 0xda, 0xd3, 0x26, 0xf8, 0xf7, 0xf8, 0x55, 0xd7, 0xa4, 0xba, 0xd2,
+	0x39, 0xe6, 0xa4, 0xff, 0xe1, 0x34, 0xe2, 0xa9, 0x28, 0x84, 0x0a, 0xb7, 0x84, 0x9a, 0x95, 0x66,
+	0x49, 0x48, 0x97, 0xa6, 0xb9, 0xdc, 0x34, 0x57, 0x99, 0xe6, 0x6e, 0x93, 0x38, 0xf5, 0x1f, 0x71,
+	0xbe, 0xaf, 0xe7, 0x76, 0x6b, 0x18, 0xb3, 0x83, 0x49, 0xdf, 0x1d, 0x90, 0xc4, 0x53, 0x0e, 0xcb,
+	0xcf, 0x16, 0x8d, 0x0e, 0x3d, 0x96, 0x8f, 0x31, 0x15, 0x05, 0x34, 0x90, 0xcc, 0xe8, 0x0d, 0x00,
+	0x65, 0x61, 0xc6, 0xa4, 0xf0, 0x85, 0x3f, 0x0a, 0xdf, 0x50, 0xc2, 0xff, 0x97, 0xc2, 0xaf, 0x6b,
+	0xa5, 0xf4, 0x25, 0x71, 0xc0, 0xd3, 0x9d, 0xb3, 0x22, 0xd4, 0x5e, 0x4e, 0x70, 0x96, 0x6f, 0x93,
+	0x34, 0x8a, 0x85, 0x47, 0xcf, 0x61, 0x95, 0x4f, 0x55, 0xef, 0x1d, 0x3f, 0xee, 0x71, 0x35, 0xa2,
+	0xa5, 0xb5, 0xce, 0x86, 0x7b, 0x73, 0xea, 0x5c, 0xde, 0x74, 0x51, 0xbc, 0x97, 0x8f, 0x71, 0x50,
+	0x1d, 0xcd, 0x87, 0x68, 0x0d, 0x2a, 0x11, 0x4e, 0x49, 0x22, 0x9b, 0x1f, 0xc8, 0x80, 0x37, 0xe0,
+	0xee, 0xad, 0xbe, 0xe5, 0xff, 0xef, 0x9a, 0xfa, 0x1a, 0x96, 0x66, 0x83, 0x7b, 0x87, 0xae, 0xde,
+	0x57, 0xac, 0x75, 0xc9, 0x3a, 0x2b, 0x55, 0xde, 0xcc, 0x62, 0xf4, 0x14, 0xaa, 0xc2, 0x28, 0x1c,
+	0xf5, 0xc2, 0x7d, 0x86, 0x33, 0xb3, 0xd2, 0x34, 0x5a, 0x8b, 0xbe, 0x79, 0x35, 0xb5, 0xd7, 0xe6,
+	0x8c, 0xd5, 0xb0, 0x13, 0xac, 0xa8, 0xf8, 0x99, 0x08, 0x3f, 0x17, 0xa1, 0xfa, 0x2a, 0x4f, 0xd9,
+	0x01, 0x66, 0xf1, 0x40, 0xec, 0xc7, 0x26, 0xa0, 0x49, 0x1a, 0xe1, 0x6c, 0x94, 0xc7, 0xe9, 0xb0,
+	0x27, 0x4c, 0x8e, 0x23, 0xb5, 0x2f, 0xf5, 0x6b, 0x84, 0xe7, 0xee, 0x44, 0xc8, 0x86, 0x65, 0xca,
+	0xcb, 0x7b, 0xf3, 0x36, 0x82, 0x38, 0xea, 0x6a, 0x2f, 0x67, 0xc3, 0x5c, 0xfa, 0x4b, 0xc3, 0x3c,
+	0xbf, 0x8a, 0xe5, 0x7f, 0xb9, 0x8a, 0x0f, 0xdb, 0x50, 0xbd, 0x31, 0x3f, 0xa8, 0x06, 0xe0, 0xe7,
+	0x9a, 0xbb, 0x5e, 0x40, 0x00, 0x0b, 0x7e, 0xce, 0x45, 0xd5, 0x8d, 0x46, 0xf9, 0xe3, 0x17, 0xab,
+	0xe0, 0xef, 0x9e, 0x5c, 0x58, 0xc6, 0xe9, 0x85, 0x65, 0x7c, 0xbf, 0xb0, 0x8c, 0xe3, 0x4b, 0xab,
+	0x70, 0x7a, 0x69, 0x15, 0xce, 0x2e, 0xad, 0xc2, 0xdb, 0xce, 0xdc, 0x46, 0xa9, 0x21, 0xdd, 0x1a,
+	0x85, 0x7d, 0xaa, 0x03, 0xef, 0xa8, 0xdd, 0xf1, 0x3e, 0xe8, 0x3f, 0x52, 0xb1, 0x61, 0xfd, 0x05,
+	0xf1, 0xa0, 0xc7, 0x3f, 0x07, 0x00, 0x15, 0x16, 0x2d, 0xf8, 0x67, 0x05, 0x00, 0x00,
 }
 
 func (m *PeriodLock) Marshal() (dAtA []byte, err error) {
@@ -378,6 +402,14 @@ func (m *PeriodLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLock(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
 	if len(m.Coins) > 0 {
 		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -392,21 +424,21 @@ func (m *PeriodLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x2a
 		}
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintLock(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintLock(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintLock(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x1a
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
@@ -443,21 +475,31 @@ func (m *QueryCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err3 != nil {
-		return 0, err3
+	if m.StartedAfter {
+		i--
+		if m.StartedAfter {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	i -= n3
-	i = encodeVarintLock(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x22
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintLock(dAtA, i, uint64(n4))
 	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintLock(dAtA, i, uint64(n5))
+	i--
 	dAtA[i] = 0x1a
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
@@ -494,21 +536,21 @@ func (m *SyntheticLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err5 != nil {
-		return 0, err5
-	}
-	i -= n5
-	i = encodeVarintLock(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x22
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
 	if err6 != nil {
 		return 0, err6
 	}
 	i -= n6
 	i = encodeVarintLock(dAtA, i, uint64(n6))
 	i--
+	dAtA[i] = 0x22
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintLock(dAtA, i, uint64(n7))
+	i--
 	dAtA[i] = 0x1a
 	if len(m.SynthDenom) > 0 {
 		i -= len(m.SynthDenom)
@@ -559,6 +601,8 @@ func (m *PeriodLock) Size() (n int) {
 			n += 1 + l + sovLock(uint64(l))
 		}
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovLock(uint64(l))
 	return n
 }
 
@@ -579,6 +623,9 @@ func (m *QueryCondition) Size() (n int) {
 	n += 1 + l + sovLock(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
 	n += 1 + l + sovLock(uint64(l))
+	if m.StartedAfter {
+		n += 2
+	}
 	return n
 }
 
@@ -788,6 +835,39 @@ func (m *PeriodLock) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
@@ -955,6 +1035,26 @@ func (m *QueryCondition) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAfter", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StartedAfter = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
@@ -15,6 +15,10 @@ const (
 	TypeMsgBeginUnlocking    = "begin_unlocking"
 	TypeMsgExtendLockup      = "edit_lockup"
 	TypeForceUnlock          = "force_unlock"
+	TypeMsgTransferLock      = "transfer_lock"
+	TypeMsgSplitLock         = "split_lock"
+	TypeMsgMergeLocks        = "merge_locks"
+	TypeMsgCancelUnlocking   = "cancel_unlocking"
 )
 
 var _ sdk.Msg = &MsgLockTokens{}
@@ -204,3 +208,157 @@ func (m MsgForceUnlock) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgTransferLock{}
+
+// NewMsgTransferLock creates a message to transfer the ownership of a lock.
+func NewMsgTransferLock(owner sdk.AccAddress, id uint64, recipient sdk.AccAddress) *MsgTransferLock {
+	return &MsgTransferLock{
+		Owner:     owner.String(),
+		ID:        id,
+		Recipient: recipient.String(),
+	}
+}
+
+func (m MsgTransferLock) Route() string { return RouterKey }
+func (m MsgTransferLock) Type() string  { return TypeMsgTransferLock }
+func (m MsgTransferLock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(m.Recipient)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+	}
+	if m.Owner == m.Recipient {
+		return fmt.Errorf("recipient should differ from the owner")
+	}
+	if m.ID == 0 {
+		return fmt.Errorf("id is empty")
+	}
+	return nil
+}
+
+func (m MsgTransferLock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTransferLock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgSplitLock{}
+
+// NewMsgSplitLock creates a message to split coins out of a lock into a new lock.
+func NewMsgSplitLock(owner sdk.AccAddress, id uint64, coins sdk.Coins) *MsgSplitLock {
+	return &MsgSplitLock{
+		Owner: owner.String(),
+		ID:    id,
+		Coins: coins,
+	}
+}
+
+func (m MsgSplitLock) Route() string { return RouterKey }
+func (m MsgSplitLock) Type() string  { return TypeMsgSplitLock }
+func (m MsgSplitLock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+	if m.ID == 0 {
+		return fmt.Errorf("id is empty")
+	}
+	if !m.Coins.IsValid() || m.Coins.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Coins.String())
+	}
+	return nil
+}
+
+func (m MsgSplitLock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSplitLock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgMergeLocks{}
+
+// NewMsgMergeLocks creates a message to merge locks into the first lock.
+func NewMsgMergeLocks(owner sdk.AccAddress, lockIDs []uint64) *MsgMergeLocks {
+	return &MsgMergeLocks{
+		Owner:   owner.String(),
+		LockIds: lockIDs,
+	}
+}
+
+func (m MsgMergeLocks) Route() string { return RouterKey }
+func (m MsgMergeLocks) Type() string  { return TypeMsgMergeLocks }
+func (m MsgMergeLocks) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+	if len(m.LockIds) < 2 {
+		return fmt.Errorf("at least two locks are required to merge, got %d", len(m.LockIds))
+	}
+	seen := make(map[uint64]bool, len(m.LockIds))
+	for _, id := range m.LockIds {
+		if id == 0 {
+			return fmt.Errorf("id is empty")
+		}
+		if seen[id] {
+			return fmt.Errorf("duplicate lock id %d", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+func (m MsgMergeLocks) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgMergeLocks) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgCancelUnlocking{}
+
+// NewMsgCancelUnlocking creates a message to cancel the unlocking of a lock.
+func NewMsgCancelUnlocking(owner sdk.AccAddress, id uint64, coins sdk.Coins) *MsgCancelUnlocking {
+	return &MsgCancelUnlocking{
+		Owner: owner.String(),
+		ID:    id,
+		Coins: coins,
+	}
+}
+
+func (m MsgCancelUnlocking) Route() string { return RouterKey }
+func (m MsgCancelUnlocking) Type() string  { return TypeMsgCancelUnlocking }
+func (m MsgCancelUnlocking) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+	if m.ID == 0 {
+		return fmt.Errorf("id is empty")
+	}
+	if !m.Coins.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Coins.String())
+	}
+	return nil
+}
+
+func (m MsgCancelUnlocking) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCancelUnlocking) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
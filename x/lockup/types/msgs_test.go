@@ -262,6 +262,271 @@ func TestMsgExtendLockup(t *testing.T) {
 	}
 }
 
+func TestMsgTransferLock(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgTransferLock
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgTransferLock{
+				Owner:     addr1,
+				ID:        1,
+				Recipient: addr2,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgTransferLock{
+				Owner:     invalidAddr,
+				ID:        1,
+				Recipient: addr2,
+			},
+		},
+		{
+			name: "invalid recipient",
+			msg: types.MsgTransferLock{
+				Owner:     addr1,
+				ID:        1,
+				Recipient: invalidAddr,
+			},
+		},
+		{
+			name: "recipient is the owner",
+			msg: types.MsgTransferLock{
+				Owner:     addr1,
+				ID:        1,
+				Recipient: addr1,
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgTransferLock{
+				Owner:     addr1,
+				ID:        0,
+				Recipient: addr2,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "transfer_lock")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgSplitLock(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSplitLock
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgSplitLock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(sdk.NewInt64Coin("test", 10)),
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgSplitLock{
+				Owner: invalidAddr,
+				ID:    1,
+				Coins: sdk.NewCoins(sdk.NewInt64Coin("test", 10)),
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgSplitLock{
+				Owner: addr1,
+				ID:    0,
+				Coins: sdk.NewCoins(sdk.NewInt64Coin("test", 10)),
+			},
+		},
+		{
+			name: "empty coins",
+			msg: types.MsgSplitLock{
+				Owner: addr1,
+				ID:    1,
+			},
+		},
+		{
+			name: "invalid coins",
+			msg: types.MsgSplitLock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.Coins{sdk.Coin{Denom: "test", Amount: sdk.NewInt(-10)}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "split_lock")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgMergeLocks(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgMergeLocks
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgMergeLocks{
+				Owner:   addr1,
+				LockIds: []uint64{1, 2, 3},
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgMergeLocks{
+				Owner:   invalidAddr,
+				LockIds: []uint64{1, 2},
+			},
+		},
+		{
+			name: "single lock",
+			msg: types.MsgMergeLocks{
+				Owner:   addr1,
+				LockIds: []uint64{1},
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgMergeLocks{
+				Owner:   addr1,
+				LockIds: []uint64{1, 0},
+			},
+		},
+		{
+			name: "duplicate lockup ID",
+			msg: types.MsgMergeLocks{
+				Owner:   addr1,
+				LockIds: []uint64{1, 2, 1},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "merge_locks")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgCancelUnlocking(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgCancelUnlocking
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgCancelUnlocking{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(sdk.NewInt64Coin("test", 10)),
+			},
+			expectPass: true,
+		},
+		{
+			name: "proper msg without coins",
+			msg: types.MsgCancelUnlocking{
+				Owner: addr1,
+				ID:    1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgCancelUnlocking{
+				Owner: invalidAddr,
+				ID:    1,
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgCancelUnlocking{
+				Owner: addr1,
+				ID:    0,
+			},
+		},
+		{
+			name: "invalid coins",
+			msg: types.MsgCancelUnlocking{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.Coins{sdk.Coin{Denom: "test", Amount: sdk.NewInt(-10)}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "cancel_unlocking")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
 // // Test authz serialize and de-serializes for lockup msg.
 func TestAuthzMsg(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
@@ -299,6 +564,37 @@ func TestAuthzMsg(t *testing.T) {
 				Owner: addr1,
 			},
 		},
+		{
+			name: "MsgTransferLock",
+			msg: &types.MsgTransferLock{
+				Owner:     addr1,
+				ID:        1,
+				Recipient: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			},
+		},
+		{
+			name: "MsgSplitLock",
+			msg: &types.MsgSplitLock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(coin),
+			},
+		},
+		{
+			name: "MsgMergeLocks",
+			msg: &types.MsgMergeLocks{
+				Owner:   addr1,
+				LockIds: []uint64{1, 2},
+			},
+		},
+		{
+			name: "MsgCancelUnlocking",
+			msg: &types.MsgCancelUnlocking{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(coin),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -35,6 +36,65 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// UnlockingStatus filters locks by whether they started unlocking.
+type UnlockingStatus int32
+
+const (
+	AnyUnlockingStatus UnlockingStatus = 0
+	UnlockingOnly      UnlockingStatus = 1
+	NotUnlockingOnly   UnlockingStatus = 2
+)
+
+var UnlockingStatus_name = map[int32]string{
+	0: "AnyUnlockingStatus",
+	1: "UnlockingOnly",
+	2: "NotUnlockingOnly",
+}
+
+var UnlockingStatus_value = map[string]int32{
+	"AnyUnlockingStatus": 0,
+	"UnlockingOnly":      1,
+	"NotUnlockingOnly":   2,
+}
+
+func (x UnlockingStatus) String() string {
+	return proto.EnumName(UnlockingStatus_name, int32(x))
+}
+
+func (UnlockingStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{0}
+}
+
+// SyntheticStatus filters locks by whether they have synthetic lockups, e.g.
+// superfluid delegated or undelegating locks.
+type SyntheticStatus int32
+
+const (
+	AnySyntheticStatus SyntheticStatus = 0
+	SyntheticOnly      SyntheticStatus = 1
+	NotSyntheticOnly   SyntheticStatus = 2
+)
+
+var SyntheticStatus_name = map[int32]string{
+	0: "AnySyntheticStatus",
+	1: "SyntheticOnly",
+	2: "NotSyntheticOnly",
+}
+
+var SyntheticStatus_value = map[string]int32{
+	"AnySyntheticStatus": 0,
+	"SyntheticOnly":      1,
+	"NotSyntheticOnly":   2,
+}
+
+func (x SyntheticStatus) String() string {
+	return proto.EnumName(SyntheticStatus_name, int32(x))
+}
+
+func (SyntheticStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{1}
+}
+
 type ModuleBalanceRequest struct {
 }
 
@@ -462,6 +522,9 @@ func (m *AccountLockedCoinsResponse) GetCoins() github_com_cosmos_cosmos_sdk_typ
 type AccountLockedPastTimeRequest struct {
 	Owner     string    `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
+	// pagination defines an optional pagination for the request, all the locks
+	// are returned if not set.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedPastTimeRequest) Reset()         { *m = AccountLockedPastTimeRequest{} }
@@ -511,8 +574,17 @@ func (m *AccountLockedPastTimeRequest) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
+func (m *AccountLockedPastTimeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedPastTimeResponse struct {
 	Locks []PeriodLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedPastTimeResponse) Reset()         { *m = AccountLockedPastTimeResponse{} }
@@ -555,9 +627,19 @@ func (m *AccountLockedPastTimeResponse) GetLocks() []PeriodLock {
 	return nil
 }
 
+func (m *AccountLockedPastTimeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedPastTimeNotUnlockingOnlyRequest struct {
 	Owner     string    `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
+	// pagination defines an optional pagination for the request, all the locks
+	// are returned if not set.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedPastTimeNotUnlockingOnlyRequest) Reset() {
@@ -611,8 +693,17 @@ func (m *AccountLockedPastTimeNotUnlockingOnlyRequest) GetTimestamp() time.Time
 	return time.Time{}
 }
 
+func (m *AccountLockedPastTimeNotUnlockingOnlyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedPastTimeNotUnlockingOnlyResponse struct {
 	Locks []PeriodLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedPastTimeNotUnlockingOnlyResponse) Reset() {
@@ -659,9 +750,19 @@ func (m *AccountLockedPastTimeNotUnlockingOnlyResponse) GetLocks() []PeriodLock
 	return nil
 }
 
+func (m *AccountLockedPastTimeNotUnlockingOnlyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountUnlockedBeforeTimeRequest struct {
 	Owner     string    `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
+	// pagination defines an optional pagination for the request, all the locks
+	// are returned if not set.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountUnlockedBeforeTimeRequest) Reset()         { *m = AccountUnlockedBeforeTimeRequest{} }
@@ -711,8 +812,17 @@ func (m *AccountUnlockedBeforeTimeRequest) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
+func (m *AccountUnlockedBeforeTimeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountUnlockedBeforeTimeResponse struct {
 	Locks []PeriodLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountUnlockedBeforeTimeResponse) Reset()         { *m = AccountUnlockedBeforeTimeResponse{} }
@@ -755,10 +865,20 @@ func (m *AccountUnlockedBeforeTimeResponse) GetLocks() []PeriodLock {
 	return nil
 }
 
+func (m *AccountUnlockedBeforeTimeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedPastTimeDenomRequest struct {
 	Owner     string    `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
 	Denom     string    `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pagination defines an optional pagination for the request, all the locks
+	// are returned if not set.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedPastTimeDenomRequest) Reset()         { *m = AccountLockedPastTimeDenomRequest{} }
@@ -815,8 +935,17 @@ func (m *AccountLockedPastTimeDenomRequest) GetDenom() string {
 	return ""
 }
 
+func (m *AccountLockedPastTimeDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedPastTimeDenomResponse struct {
 	Locks []PeriodLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedPastTimeDenomResponse) Reset()         { *m = AccountLockedPastTimeDenomResponse{} }
@@ -859,6 +988,13 @@ func (m *AccountLockedPastTimeDenomResponse) GetLocks() []PeriodLock {
 	return nil
 }
 
+func (m *AccountLockedPastTimeDenomResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type LockedDenomRequest struct {
 	Denom    string        `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
@@ -1127,6 +1263,9 @@ func (m *SyntheticLockupsByLockupIDResponse) GetSyntheticLocks() []SyntheticLock
 type AccountLockedLongerDurationRequest struct {
 	Owner    string        `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	// pagination defines an optional pagination for the request, all the locks
+	// are returned if not set.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedLongerDurationRequest) Reset()         { *m = AccountLockedLongerDurationRequest{} }
@@ -1176,8 +1315,17 @@ func (m *AccountLockedLongerDurationRequest) GetDuration() time.Duration {
 	return 0
 }
 
+func (m *AccountLockedLongerDurationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedLongerDurationResponse struct {
 	Locks []PeriodLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedLongerDurationResponse) Reset()         { *m = AccountLockedLongerDurationResponse{} }
@@ -1220,9 +1368,19 @@ func (m *AccountLockedLongerDurationResponse) GetLocks() []PeriodLock {
 	return nil
 }
 
+func (m *AccountLockedLongerDurationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedDurationRequest struct {
 	Owner    string        `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	// pagination defines an optional pagination for the request, all the locks
+	// are returned if not set.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedDurationRequest) Reset()         { *m = AccountLockedDurationRequest{} }
@@ -1272,8 +1430,17 @@ func (m *AccountLockedDurationRequest) GetDuration() time.Duration {
 	return 0
 }
 
+func (m *AccountLockedDurationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedDurationResponse struct {
 	Locks []PeriodLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedDurationResponse) Reset()         { *m = AccountLockedDurationResponse{} }
@@ -1316,9 +1483,19 @@ func (m *AccountLockedDurationResponse) GetLocks() []PeriodLock {
 	return nil
 }
 
+func (m *AccountLockedDurationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedLongerDurationNotUnlockingOnlyRequest struct {
 	Owner    string        `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	// pagination defines an optional pagination for the request, all the locks
+	// are returned if not set.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedLongerDurationNotUnlockingOnlyRequest) Reset() {
@@ -1372,8 +1549,17 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyRequest) GetDuration() time.
 	return 0
 }
 
+func (m *AccountLockedLongerDurationNotUnlockingOnlyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedLongerDurationNotUnlockingOnlyResponse struct {
 	Locks []PeriodLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedLongerDurationNotUnlockingOnlyResponse) Reset() {
@@ -1420,10 +1606,20 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyResponse) GetLocks() []Perio
 	return nil
 }
 
+func (m *AccountLockedLongerDurationNotUnlockingOnlyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedLongerDurationDenomRequest struct {
 	Owner    string        `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
 	Denom    string        `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pagination defines an optional pagination for the request, all the locks
+	// are returned if not set.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedLongerDurationDenomRequest) Reset() {
@@ -1482,8 +1678,17 @@ func (m *AccountLockedLongerDurationDenomRequest) GetDenom() string {
 	return ""
 }
 
+func (m *AccountLockedLongerDurationDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedLongerDurationDenomResponse struct {
 	Locks []PeriodLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedLongerDurationDenomResponse) Reset() {
@@ -1528,6 +1733,180 @@ func (m *AccountLockedLongerDurationDenomResponse) GetLocks() []PeriodLock {
 	return nil
 }
 
+func (m *AccountLockedLongerDurationDenomResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// LocksByFilterRequest filters the locks by the set fields. Locks that are
+// not unlocking have no end time, so setting an end time bound only matches
+// unlocking locks.
+type LocksByFilterRequest struct {
+	Owner       string        `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	Denom       string        `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	MinDuration time.Duration `protobuf:"bytes,3,opt,name=min_duration,json=minDuration,proto3,stdduration" json:"min_duration" yaml:"min_duration"`
+	// max_duration is inclusive, no upper bound if zero.
+	MaxDuration time.Duration `protobuf:"bytes,4,opt,name=max_duration,json=maxDuration,proto3,stdduration" json:"max_duration" yaml:"max_duration"`
+	MinEndTime  *time.Time    `protobuf:"bytes,5,opt,name=min_end_time,json=minEndTime,proto3,stdtime" json:"min_end_time,omitempty" yaml:"min_end_time"`
+	// max_end_time is inclusive.
+	MaxEndTime      *time.Time      `protobuf:"bytes,6,opt,name=max_end_time,json=maxEndTime,proto3,stdtime" json:"max_end_time,omitempty" yaml:"max_end_time"`
+	UnlockingStatus UnlockingStatus `protobuf:"varint,7,opt,name=unlocking_status,json=unlockingStatus,proto3,enum=osmosis.lockup.UnlockingStatus" json:"unlocking_status,omitempty" yaml:"unlocking_status"`
+	SyntheticStatus SyntheticStatus `protobuf:"varint,8,opt,name=synthetic_status,json=syntheticStatus,proto3,enum=osmosis.lockup.SyntheticStatus" json:"synthetic_status,omitempty" yaml:"synthetic_status"`
+	// pagination defines the pagination for the request, a page of the default
+	// limit is returned when it is not set
+	Pagination *query.PageRequest `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *LocksByFilterRequest) Reset()         { *m = LocksByFilterRequest{} }
+func (m *LocksByFilterRequest) String() string { return proto.CompactTextString(m) }
+func (*LocksByFilterRequest) ProtoMessage()    {}
+func (*LocksByFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{32}
+}
+func (m *LocksByFilterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LocksByFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LocksByFilterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LocksByFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocksByFilterRequest.Merge(m, src)
+}
+func (m *LocksByFilterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LocksByFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocksByFilterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocksByFilterRequest proto.InternalMessageInfo
+
+func (m *LocksByFilterRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LocksByFilterRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *LocksByFilterRequest) GetMinDuration() time.Duration {
+	if m != nil {
+		return m.MinDuration
+	}
+	return 0
+}
+
+func (m *LocksByFilterRequest) GetMaxDuration() time.Duration {
+	if m != nil {
+		return m.MaxDuration
+	}
+	return 0
+}
+
+func (m *LocksByFilterRequest) GetMinEndTime() *time.Time {
+	if m != nil {
+		return m.MinEndTime
+	}
+	return nil
+}
+
+func (m *LocksByFilterRequest) GetMaxEndTime() *time.Time {
+	if m != nil {
+		return m.MaxEndTime
+	}
+	return nil
+}
+
+func (m *LocksByFilterRequest) GetUnlockingStatus() UnlockingStatus {
+	if m != nil {
+		return m.UnlockingStatus
+	}
+	return AnyUnlockingStatus
+}
+
+func (m *LocksByFilterRequest) GetSyntheticStatus() SyntheticStatus {
+	if m != nil {
+		return m.SyntheticStatus
+	}
+	return AnySyntheticStatus
+}
+
+func (m *LocksByFilterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type LocksByFilterResponse struct {
+	Locks      []PeriodLock        `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *LocksByFilterResponse) Reset()         { *m = LocksByFilterResponse{} }
+func (m *LocksByFilterResponse) String() string { return proto.CompactTextString(m) }
+func (*LocksByFilterResponse) ProtoMessage()    {}
+func (*LocksByFilterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{33}
+}
+func (m *LocksByFilterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LocksByFilterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LocksByFilterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LocksByFilterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocksByFilterResponse.Merge(m, src)
+}
+func (m *LocksByFilterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LocksByFilterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocksByFilterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocksByFilterResponse proto.InternalMessageInfo
+
+func (m *LocksByFilterResponse) GetLocks() []PeriodLock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+func (m *LocksByFilterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryParamsRequest struct {
 }
 
@@ -1535,7 +1914,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e906fda01cffd91a, []int{32}
+	return fileDescriptor_e906fda01cffd91a, []int{34}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1572,7 +1951,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e906fda01cffd91a, []int{33}
+	return fileDescriptor_e906fda01cffd91a, []int{35}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1609,6 +1988,8 @@ func (m *QueryParamsResponse) GetParams() Params {
 }
 
 func init() {
+	proto.RegisterEnum("osmosis.lockup.UnlockingStatus", UnlockingStatus_name, UnlockingStatus_value)
+	proto.RegisterEnum("osmosis.lockup.SyntheticStatus", SyntheticStatus_name, SyntheticStatus_value)
 	proto.RegisterType((*ModuleBalanceRequest)(nil), "osmosis.lockup.ModuleBalanceRequest")
 	proto.RegisterType((*ModuleBalanceResponse)(nil), "osmosis.lockup.ModuleBalanceResponse")
 	proto.RegisterType((*ModuleLockedAmountRequest)(nil), "osmosis.lockup.ModuleLockedAmountRequest")
@@ -1641,6 +2022,8 @@ func init() {
 	proto.RegisterType((*AccountLockedLongerDurationNotUnlockingOnlyResponse)(nil), "osmosis.lockup.AccountLockedLongerDurationNotUnlockingOnlyResponse")
 	proto.RegisterType((*AccountLockedLongerDurationDenomRequest)(nil), "osmosis.lockup.AccountLockedLongerDurationDenomRequest")
 	proto.RegisterType((*AccountLockedLongerDurationDenomResponse)(nil), "osmosis.lockup.AccountLockedLongerDurationDenomResponse")
+	proto.RegisterType((*LocksByFilterRequest)(nil), "osmosis.lockup.LocksByFilterRequest")
+	proto.RegisterType((*LocksByFilterResponse)(nil), "osmosis.lockup.LocksByFilterResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.lockup.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.lockup.QueryParamsResponse")
 }
@@ -1648,101 +2031,122 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/query.proto", fileDescriptor_e906fda01cffd91a) }
 
 var fileDescriptor_e906fda01cffd91a = []byte{
-	// 1492 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x8f, 0x14, 0x45,
-	0x14, 0xdf, 0x02, 0x76, 0x95, 0x87, 0x7c, 0xa4, 0x58, 0x70, 0xb7, 0x17, 0x66, 0x96, 0x02, 0xd6,
-	0x51, 0x77, 0xbb, 0xd9, 0x81, 0x00, 0x92, 0xe5, 0x6b, 0x58, 0x31, 0xab, 0xa3, 0xc2, 0x80, 0x12,
-	0xbf, 0x32, 0xe9, 0x99, 0x69, 0x86, 0x0e, 0x33, 0x5d, 0xc3, 0x74, 0x0f, 0x3a, 0x12, 0x24, 0x01,
-	0x8f, 0x1e, 0x30, 0x5e, 0x8c, 0x07, 0xa3, 0xde, 0xf4, 0x60, 0xbc, 0x78, 0x20, 0xde, 0x0d, 0xd1,
-	0xc4, 0x90, 0x78, 0x31, 0x1e, 0x16, 0xc3, 0xfa, 0x17, 0x70, 0xf2, 0xe0, 0xc1, 0x74, 0x55, 0x75,
-	0xef, 0xf4, 0xe7, 0xf4, 0xcc, 0xb8, 0x9b, 0x3d, 0xed, 0x4e, 0xbf, 0x57, 0xef, 0xfd, 0x7e, 0xaf,
-	0x5e, 0xbf, 0xaa, 0x5f, 0x83, 0x44, 0xcd, 0x3a, 0x35, 0x75, 0x53, 0xa9, 0xd1, 0xf2, 0xd5, 0x56,
-	0x43, 0xb9, 0xd6, 0xd2, 0x9a, 0x6d, 0xb9, 0xd1, 0xa4, 0x16, 0xc5, 0x5b, 0x84, 0x4d, 0xe6, 0x36,
-	0x69, 0xb4, 0x4a, 0xab, 0x94, 0x99, 0x14, 0xfb, 0x3f, 0xee, 0x25, 0xa5, 0xca, 0xcc, 0x4d, 0x29,
-	0xa9, 0xa6, 0xa6, 0x5c, 0x9f, 0x2d, 0x69, 0x96, 0x3a, 0xab, 0x94, 0xa9, 0x6e, 0x08, 0xfb, 0xae,
-	0x2a, 0xa5, 0xd5, 0x9a, 0xa6, 0xa8, 0x0d, 0x5d, 0x51, 0x0d, 0x83, 0x5a, 0xaa, 0xa5, 0x53, 0xc3,
-	0x14, 0xd6, 0xb4, 0xb0, 0xb2, 0x5f, 0xa5, 0xd6, 0x65, 0xc5, 0xd2, 0xeb, 0x9a, 0x69, 0xa9, 0xf5,
-	0x86, 0x13, 0xde, 0xef, 0x50, 0x69, 0x35, 0x59, 0x04, 0x61, 0x1f, 0xf7, 0x11, 0xb0, 0xff, 0x08,
-	0xd3, 0x84, 0xcf, 0xd4, 0x50, 0x9b, 0x6a, 0x5d, 0x24, 0x26, 0x3b, 0x61, 0xf4, 0x55, 0x5a, 0x69,
-	0xd5, 0xb4, 0x9c, 0x5a, 0x53, 0x8d, 0xb2, 0x56, 0xd0, 0xae, 0xb5, 0x34, 0xd3, 0x22, 0x1f, 0xc2,
-	0x0e, 0xdf, 0x73, 0xb3, 0x41, 0x0d, 0x53, 0xc3, 0x2a, 0x0c, 0xdb, 0xac, 0xcc, 0x31, 0x34, 0xb9,
-	0x3e, 0xb3, 0x29, 0x3b, 0x2e, 0x73, 0xde, 0xb2, 0xcd, 0x5b, 0x16, 0xbc, 0xe5, 0x33, 0x54, 0x37,
-	0x72, 0x07, 0xee, 0x2f, 0xa6, 0x87, 0xbe, 0x7b, 0x98, 0xce, 0x54, 0x75, 0xeb, 0x4a, 0xab, 0x24,
-	0x97, 0x69, 0x5d, 0x11, 0x45, 0xe2, 0x7f, 0x66, 0xcc, 0xca, 0x55, 0xc5, 0x6a, 0x37, 0x34, 0x93,
-	0x2d, 0x30, 0x0b, 0x3c, 0x32, 0x99, 0x80, 0x71, 0x9e, 0x3b, 0x4f, 0xcb, 0x57, 0xb5, 0xca, 0xe9,
-	0x3a, 0x6d, 0x19, 0x96, 0x03, 0xec, 0x16, 0x48, 0x61, 0xc6, 0xd5, 0x43, 0xf7, 0x12, 0xec, 0x3e,
-	0x5d, 0x2e, 0xdb, 0x59, 0xdf, 0x30, 0xec, 0x8a, 0xaa, 0xa5, 0x9a, 0xc6, 0x1d, 0x38, 0x42, 0x3c,
-	0x05, 0xc3, 0xf4, 0x7d, 0x43, 0x6b, 0x8e, 0xa1, 0x49, 0x94, 0xd9, 0x98, 0xdb, 0xf6, 0x78, 0x31,
-	0xfd, 0x54, 0x5b, 0xad, 0xd7, 0x8e, 0x11, 0xf6, 0x98, 0x14, 0xb8, 0x99, 0xdc, 0x41, 0x90, 0x8a,
-	0x8a, 0xb4, 0x7a, 0x74, 0xce, 0xc2, 0x2e, 0x0f, 0x08, 0xdd, 0xa8, 0xf6, 0xc5, 0xe6, 0x36, 0xf2,
-	0xd5, 0x65, 0x39, 0xd0, 0xea, 0x91, 0x39, 0x03, 0xe3, 0x02, 0x03, 0xef, 0x8e, 0xbe, 0x98, 0xdc,
-	0x02, 0x29, 0x2c, 0xc8, 0xea, 0xb1, 0xf8, 0x12, 0xb9, 0x7b, 0xc2, 0x11, 0x9c, 0x53, 0x4d, 0xeb,
-	0xa2, 0x5e, 0xd7, 0x7a, 0x64, 0x82, 0xdf, 0x84, 0x8d, 0xee, 0x1c, 0x19, 0x5b, 0x37, 0x89, 0x32,
-	0x9b, 0xb2, 0x92, 0xcc, 0x07, 0x89, 0xec, 0x0c, 0x12, 0xf9, 0xa2, 0xe3, 0x91, 0xdb, 0x65, 0x03,
-	0x7e, 0xbc, 0x98, 0xde, 0xc6, 0x63, 0xb9, 0x4b, 0xc9, 0xdd, 0x87, 0x69, 0x54, 0x58, 0x0e, 0x45,
-	0x2e, 0xb9, 0x5b, 0xed, 0xc7, 0x27, 0x8a, 0x74, 0x18, 0x86, 0xed, 0x16, 0x70, 0x8a, 0x24, 0xc9,
-	0xde, 0x11, 0x2a, 0x9f, 0xd3, 0x9a, 0x3a, 0xad, 0xd8, 0x8b, 0x73, 0x1b, 0xec, 0xa4, 0x05, 0xee,
-	0x4e, 0xbe, 0x47, 0x30, 0x1d, 0x1a, 0xf9, 0x35, 0xba, 0xdc, 0x55, 0xaf, 0x1b, 0xb5, 0xf6, 0x5a,
-	0xa9, 0x44, 0x15, 0x66, 0x12, 0xe2, 0x1d, 0xb0, 0x32, 0xdf, 0x20, 0x98, 0xf4, 0xbc, 0x5e, 0x5a,
-	0x25, 0xa7, 0x5d, 0xa6, 0x4d, 0x6d, 0x2d, 0xf5, 0xc5, 0x3b, 0xb0, 0x27, 0x06, 0xe3, 0x80, 0x15,
-	0xb8, 0x87, 0xdc, 0xe8, 0xde, 0x5a, 0xcf, 0x6b, 0x06, 0xad, 0xaf, 0x91, 0x12, 0xe0, 0x51, 0x18,
-	0xae, 0xd8, 0x78, 0xc6, 0xd6, 0xdb, 0xf9, 0x0b, 0xfc, 0x07, 0x79, 0x17, 0x48, 0x1c, 0xf4, 0x01,
-	0x2b, 0xf3, 0x11, 0x60, 0x1e, 0xd6, 0x53, 0x09, 0x17, 0x09, 0xea, 0x40, 0x82, 0x0b, 0xf0, 0xa4,
-	0x73, 0x73, 0x10, 0xb4, 0xc7, 0x03, 0xb4, 0xe7, 0x85, 0x43, 0x6e, 0x42, 0xb0, 0xde, 0xca, 0x59,
-	0x3b, 0x0b, 0xc9, 0xe7, 0x36, 0x69, 0x37, 0x0e, 0x31, 0x60, 0xbb, 0x27, 0xbf, 0xa0, 0x73, 0x09,
-	0x46, 0x54, 0x76, 0x3a, 0x8b, 0xbd, 0x38, 0x69, 0x47, 0xfb, 0x73, 0x31, 0x3d, 0x95, 0x60, 0x1e,
-	0x2e, 0x18, 0xd6, 0xe3, 0xc5, 0xf4, 0x66, 0x9e, 0x97, 0x47, 0x21, 0x05, 0x11, 0x8e, 0x64, 0x60,
-	0x33, 0xcf, 0xe7, 0x50, 0x7d, 0x1a, 0x9e, 0xb0, 0x2b, 0x51, 0xd4, 0x2b, 0x2c, 0xd5, 0x86, 0xc2,
-	0x88, 0xfd, 0x73, 0xa1, 0x42, 0x4e, 0xc1, 0x16, 0xc7, 0x53, 0x80, 0x92, 0x61, 0x83, 0x6d, 0x63,
-	0x7e, 0xb1, 0x25, 0x2e, 0x30, 0x3f, 0x32, 0x07, 0x7b, 0x2e, 0xb4, 0x0d, 0xeb, 0x8a, 0x66, 0xe9,
-	0xe5, 0x3c, 0xf3, 0x31, 0x73, 0x6d, 0xfe, 0xcf, 0xc2, 0x7c, 0xd7, 0xfc, 0x4d, 0x20, 0x71, 0xab,
-	0x05, 0xa6, 0x3c, 0x6c, 0x35, 0x1d, 0xaf, 0x62, 0x67, 0x07, 0xec, 0xf6, 0xc3, 0xf3, 0x04, 0x13,
-	0x4d, 0xb0, 0xc5, 0xec, 0x7c, 0x68, 0x92, 0xaf, 0x90, 0xaf, 0xd9, 0xf2, 0xd4, 0xa8, 0x6a, 0x4d,
-	0x67, 0x53, 0x7b, 0x7d, 0x51, 0x56, 0xa2, 0x61, 0xde, 0x83, 0xbd, 0xb1, 0x08, 0x07, 0x7c, 0x1f,
-	0xbe, 0xf0, 0x9f, 0x9f, 0x6b, 0x89, 0xbb, 0xff, 0xec, 0xfc, 0xdf, 0x58, 0xff, 0x80, 0x20, 0x1b,
-	0x53, 0xd5, 0x41, 0x4f, 0xd0, 0x95, 0xa8, 0x45, 0x1d, 0x0e, 0xf6, 0x84, 0x78, 0xc0, 0x0a, 0xfd,
-	0x84, 0xe0, 0x99, 0x98, 0x7c, 0x7d, 0x9d, 0x23, 0x2b, 0x50, 0x96, 0x88, 0x33, 0xa4, 0x04, 0x99,
-	0xee, 0xe0, 0x07, 0xac, 0xd0, 0x28, 0xe0, 0xf3, 0xb6, 0xf2, 0x3d, 0xc7, 0x24, 0xa2, 0x23, 0xb9,
-	0x5e, 0x81, 0xed, 0x9e, 0xa7, 0x22, 0xc9, 0x21, 0x18, 0xe1, 0x52, 0x52, 0x0c, 0xd3, 0x9d, 0x81,
-	0x2c, 0xcc, 0x2a, 0x32, 0x08, 0xdf, 0xec, 0xbf, 0x63, 0x30, 0xcc, 0xa2, 0xe1, 0x4f, 0x10, 0x6c,
-	0xf6, 0x68, 0x4c, 0xbc, 0xcf, 0x1f, 0x21, 0x4c, 0x9a, 0x4a, 0xfb, 0xbb, 0x78, 0x71, 0x78, 0x44,
-	0xbe, 0xfd, 0xfb, 0xdf, 0x9f, 0xad, 0xcb, 0xe0, 0x29, 0xc5, 0xa7, 0x7f, 0x1d, 0x71, 0x5e, 0x67,
-	0xcb, 0x8a, 0x25, 0x91, 0xfc, 0x6b, 0x04, 0x38, 0xa8, 0x2c, 0xf1, 0xb3, 0xe1, 0xd9, 0x42, 0xa4,
-	0xa9, 0xf4, 0x5c, 0x12, 0x57, 0x81, 0xee, 0x10, 0x43, 0x27, 0xe3, 0xe9, 0x2e, 0xe8, 0xf8, 0x35,
-	0xaa, 0xc8, 0x4f, 0x3e, 0x7c, 0x0f, 0xc1, 0xce, 0x70, 0xc9, 0x88, 0x67, 0xfc, 0xc9, 0x63, 0x45,
-	0xaa, 0x24, 0x27, 0x75, 0x17, 0x78, 0x4f, 0x31, 0xbc, 0xc7, 0xf0, 0xd1, 0x28, 0xbc, 0x2a, 0x5f,
-	0x5f, 0x6c, 0xb9, 0x01, 0x8a, 0x4c, 0xcd, 0x28, 0x37, 0xd8, 0x8b, 0x72, 0x13, 0xff, 0x88, 0x60,
-	0x47, 0xa8, 0x40, 0xc4, 0xd3, 0xb1, 0x58, 0x7c, 0x82, 0x54, 0x9a, 0x49, 0xe8, 0x2d, 0x80, 0x9f,
-	0x64, 0xc0, 0x5f, 0xc0, 0x47, 0x92, 0x01, 0xd7, 0x8d, 0xaa, 0x0f, 0xf7, 0xb7, 0x08, 0x70, 0x50,
-	0x0f, 0x06, 0xfb, 0x22, 0x52, 0x78, 0x06, 0xfb, 0x22, 0x5a, 0x5e, 0x92, 0x39, 0x06, 0xf7, 0x30,
-	0x3e, 0xd4, 0x0d, 0xae, 0x68, 0x8c, 0xc8, 0x1a, 0x7b, 0x2f, 0x9a, 0x91, 0x35, 0x0e, 0x15, 0x98,
-	0x91, 0x35, 0x0e, 0x97, 0x7b, 0xc9, 0x6b, 0x2c, 0x40, 0x37, 0x54, 0xd3, 0xb2, 0xaf, 0xcc, 0x2e,
-	0xee, 0x7f, 0x10, 0xec, 0x4f, 0xa4, 0xa3, 0xf0, 0x5c, 0x22, 0x64, 0x11, 0x87, 0x9d, 0x74, 0xbc,
-	0xcf, 0xd5, 0x82, 0x67, 0x81, 0xf1, 0xcc, 0xe3, 0x97, 0x7b, 0xe4, 0x59, 0x34, 0x68, 0x67, 0x7f,
-	0x51, 0xa3, 0xd6, 0x76, 0xa9, 0xff, 0x8c, 0xdc, 0x6f, 0x16, 0x41, 0xd1, 0x84, 0x0f, 0xc4, 0x36,
-	0x7b, 0x88, 0x06, 0x94, 0x66, 0x7b, 0x58, 0x21, 0x68, 0xcd, 0x33, 0x5a, 0x27, 0xf0, 0x5c, 0xb2,
-	0x57, 0x44, 0xab, 0x14, 0x4b, 0x2c, 0x48, 0xd1, 0xb3, 0x87, 0xbf, 0x20, 0xdf, 0x77, 0x13, 0x8f,
-	0xc8, 0xc1, 0xb3, 0x89, 0x4a, 0xdf, 0x79, 0x06, 0x4b, 0xd9, 0x5e, 0x96, 0x08, 0x2e, 0x2f, 0x32,
-	0x2e, 0x27, 0xf1, 0xf1, 0x5e, 0xb7, 0x88, 0x1d, 0xb2, 0x2e, 0x99, 0x8f, 0x11, 0x6c, 0xea, 0xd0,
-	0x34, 0x98, 0xf8, 0xa1, 0x04, 0x05, 0x97, 0xb4, 0x37, 0xd6, 0x47, 0xe0, 0x9b, 0x66, 0xf8, 0xa6,
-	0xf0, 0xbe, 0x28, 0x7c, 0x02, 0x17, 0x57, 0x6b, 0x77, 0x10, 0x00, 0x8f, 0x92, 0x6b, 0x2f, 0xcc,
-	0xe3, 0xdd, 0xe1, 0x19, 0x1c, 0x00, 0xa9, 0x28, 0xb3, 0xc8, 0x7d, 0x98, 0xe5, 0x3e, 0x80, 0xe5,
-	0x2e, 0xb9, 0x4b, 0xed, 0xa2, 0x5e, 0x51, 0x6e, 0x08, 0x49, 0x73, 0x13, 0xff, 0x8a, 0x40, 0x8a,
-	0x96, 0x31, 0xc1, 0x9d, 0xed, 0x2a, 0x98, 0x82, 0x3b, 0xdb, 0x5d, 0x25, 0x91, 0xb3, 0x0c, 0xfd,
-	0x29, 0x7c, 0x22, 0x0a, 0xbd, 0x57, 0x43, 0xb5, 0x1a, 0xa6, 0x4d, 0x44, 0x90, 0xe8, 0x60, 0xf3,
-	0x1b, 0x82, 0x89, 0x98, 0x8b, 0x14, 0x8e, 0xef, 0xba, 0x50, 0x31, 0x25, 0x1d, 0xec, 0x69, 0x4d,
-	0x52, 0x42, 0xbe, 0x56, 0xad, 0xb1, 0x30, 0x45, 0xe7, 0x9a, 0x18, 0x3d, 0xf4, 0x5d, 0x2a, 0xf1,
-	0x43, 0xdf, 0x4f, 0x62, 0x26, 0xa1, 0x77, 0x9f, 0x43, 0x3f, 0x80, 0xfb, 0xd3, 0x75, 0xf0, 0x7c,
-	0x0f, 0xd7, 0x7f, 0x9c, 0xeb, 0xa1, 0xc8, 0x51, 0x07, 0xc0, 0x99, 0x81, 0x62, 0x08, 0xe6, 0x6f,
-	0x31, 0xe6, 0x17, 0xf0, 0xf9, 0xfe, 0x36, 0x2e, 0xee, 0x34, 0x58, 0x5a, 0xfe, 0xcc, 0x17, 0x79,
-	0xcb, 0xc7, 0x47, 0x7a, 0x20, 0xe1, 0x99, 0x50, 0x47, 0x7b, 0x5f, 0x28, 0x28, 0xe7, 0x19, 0xe5,
-	0xb3, 0x78, 0xbe, 0x4f, 0xca, 0xde, 0xe9, 0xda, 0x86, 0x11, 0xae, 0x0d, 0x82, 0x73, 0x35, 0x28,
-	0x3f, 0x82, 0x73, 0x35, 0x44, 0x8c, 0x90, 0x29, 0x06, 0x70, 0x12, 0xa7, 0xa2, 0x00, 0x72, 0xf9,
-	0x91, 0xcb, 0xdf, 0x7f, 0x94, 0x42, 0x0f, 0x1e, 0xa5, 0xd0, 0x5f, 0x8f, 0x52, 0xe8, 0xee, 0x52,
-	0x6a, 0xe8, 0xc1, 0x52, 0x6a, 0xe8, 0x8f, 0xa5, 0xd4, 0xd0, 0xdb, 0xd9, 0x8e, 0xcf, 0x52, 0x22,
-	0xc6, 0x4c, 0x4d, 0x2d, 0x99, 0x6e, 0xc0, 0xeb, 0xb3, 0x59, 0xe5, 0x03, 0x27, 0x2c, 0xfb, 0x4c,
-	0x55, 0x1a, 0x61, 0x1a, 0xef, 0xe0, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x35, 0x14, 0x89, 0xb7,
-	0x3f, 0x1c, 0x00, 0x00,
+	// 1837 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdf, 0x6f, 0xd3, 0xd6,
+	0x17, 0xef, 0x2d, 0x6d, 0xa1, 0xa7, 0xf4, 0xc7, 0xf7, 0x52, 0x4a, 0xeb, 0xb6, 0x49, 0x31, 0x50,
+	0x42, 0xbf, 0xad, 0x4d, 0x03, 0x02, 0x86, 0xca, 0xaf, 0x50, 0x8a, 0xba, 0x75, 0x5b, 0x09, 0xec,
+	0x07, 0x93, 0xa6, 0xc8, 0x49, 0x4c, 0xb0, 0x48, 0xec, 0x10, 0x3b, 0xac, 0x19, 0x62, 0x68, 0xb0,
+	0x87, 0x3d, 0x30, 0x89, 0x69, 0x2f, 0x3c, 0x6e, 0xda, 0x0f, 0x69, 0xdb, 0xcb, 0x5e, 0x36, 0x69,
+	0xff, 0xc0, 0x84, 0x36, 0x69, 0x42, 0xda, 0xcb, 0xb4, 0x87, 0x32, 0xb5, 0xd3, 0x34, 0xed, 0x91,
+	0x87, 0x89, 0xc7, 0xc9, 0xf7, 0x5e, 0x3b, 0xb1, 0x9d, 0x38, 0x71, 0x18, 0x55, 0xc4, 0x53, 0x1b,
+	0xdf, 0x73, 0x3f, 0xe7, 0xf3, 0x39, 0xe7, 0xf8, 0xf8, 0xfa, 0x18, 0x38, 0x4d, 0xcf, 0x69, 0xba,
+	0xa2, 0x8b, 0x59, 0x2d, 0x75, 0xa5, 0x98, 0x17, 0xaf, 0x16, 0xe5, 0x42, 0x49, 0xc8, 0x17, 0x34,
+	0x43, 0xc3, 0x7d, 0x6c, 0x4d, 0xa0, 0x6b, 0xdc, 0x60, 0x46, 0xcb, 0x68, 0x64, 0x49, 0x34, 0xff,
+	0xa3, 0x56, 0x5c, 0x28, 0x45, 0xcc, 0xc4, 0xa4, 0xa4, 0xcb, 0xe2, 0xb5, 0xd9, 0xa4, 0x6c, 0x48,
+	0xb3, 0x62, 0x4a, 0x53, 0x54, 0xb6, 0x3e, 0x55, 0xb9, 0x4e, 0xe0, 0x6d, 0xab, 0xbc, 0x94, 0x51,
+	0x54, 0xc9, 0x50, 0x34, 0xcb, 0x76, 0x2c, 0xa3, 0x69, 0x99, 0xac, 0x2c, 0x4a, 0x79, 0x45, 0x94,
+	0x54, 0x55, 0x33, 0xc8, 0xa2, 0xce, 0x56, 0xc3, 0x6c, 0x95, 0xfc, 0x4a, 0x16, 0x2f, 0x89, 0x86,
+	0x92, 0x93, 0x75, 0x43, 0xca, 0xe5, 0x2d, 0x2a, 0x6e, 0x83, 0x74, 0xb1, 0x50, 0x09, 0x3f, 0xe2,
+	0x12, 0x6b, 0xfe, 0x61, 0x4b, 0xa3, 0xae, 0xa5, 0xbc, 0x54, 0x90, 0x72, 0xcc, 0x31, 0x3f, 0x04,
+	0x83, 0x2f, 0x6a, 0xe9, 0x62, 0x56, 0x8e, 0x49, 0x59, 0x49, 0x4d, 0xc9, 0x71, 0xf9, 0x6a, 0x51,
+	0xd6, 0x0d, 0xfe, 0x6d, 0xd8, 0xee, 0xba, 0xae, 0xe7, 0x35, 0x55, 0x97, 0xb1, 0x04, 0x9d, 0x66,
+	0x04, 0xf4, 0x61, 0x34, 0xb1, 0x29, 0xd2, 0x13, 0x1d, 0x11, 0x68, 0x0c, 0x04, 0x33, 0x06, 0x02,
+	0x53, 0x2f, 0x9c, 0xd6, 0x14, 0x35, 0xb6, 0xff, 0xfe, 0x6a, 0xb8, 0xed, 0xab, 0x87, 0xe1, 0x48,
+	0x46, 0x31, 0x2e, 0x17, 0x93, 0x42, 0x4a, 0xcb, 0x89, 0x2c, 0x60, 0xf4, 0xcf, 0x8c, 0x9e, 0xbe,
+	0x22, 0x1a, 0xa5, 0xbc, 0xac, 0x93, 0x0d, 0x7a, 0x9c, 0x22, 0xf3, 0xa3, 0x30, 0x42, 0x7d, 0x2f,
+	0x69, 0xa9, 0x2b, 0x72, 0xfa, 0x54, 0x4e, 0x2b, 0xaa, 0x86, 0x45, 0xec, 0x26, 0x70, 0xd5, 0x16,
+	0x37, 0x8e, 0xdd, 0x59, 0x18, 0x3f, 0x95, 0x4a, 0x99, 0x5e, 0x5f, 0x51, 0xcd, 0x88, 0x4a, 0xc9,
+	0xac, 0x4c, 0x0d, 0x28, 0x43, 0x3c, 0x09, 0x9d, 0xda, 0x5b, 0xaa, 0x5c, 0x18, 0x46, 0x13, 0x28,
+	0xd2, 0x1d, 0x1b, 0x78, 0xb4, 0x1a, 0xde, 0x5a, 0x92, 0x72, 0xd9, 0xa3, 0x3c, 0xb9, 0xcc, 0xc7,
+	0xe9, 0x32, 0x7f, 0x1b, 0x41, 0xa8, 0x16, 0xd2, 0xc6, 0xc9, 0x59, 0x80, 0x31, 0x07, 0x09, 0x45,
+	0xcd, 0x34, 0xa5, 0xe6, 0x16, 0x82, 0xf1, 0x1a, 0x40, 0x1b, 0x27, 0xe6, 0x34, 0x8c, 0x30, 0x0e,
+	0xb4, 0x3a, 0x9a, 0x52, 0x72, 0x13, 0xb8, 0x6a, 0x20, 0x1b, 0xa7, 0xe2, 0x4f, 0x04, 0x63, 0x0e,
+	0x06, 0xcb, 0x92, 0x6e, 0x5c, 0x50, 0x72, 0x72, 0x40, 0x25, 0xf8, 0x55, 0xe8, 0xb6, 0xfb, 0xc8,
+	0x70, 0xfb, 0x04, 0x8a, 0xf4, 0x44, 0x39, 0x81, 0x36, 0x12, 0xc1, 0x6a, 0x24, 0xc2, 0x05, 0xcb,
+	0x22, 0x36, 0x66, 0x12, 0x7e, 0xb4, 0x1a, 0x1e, 0xa0, 0x58, 0xf6, 0x56, 0xfe, 0xee, 0xc3, 0x30,
+	0x8a, 0x97, 0xa1, 0xf0, 0x02, 0x40, 0xb9, 0xbf, 0x0d, 0x6f, 0x22, 0xc0, 0x93, 0x8e, 0x40, 0xd0,
+	0x5e, 0x6b, 0x85, 0x63, 0x59, 0xca, 0x58, 0xdc, 0xe3, 0x15, 0x3b, 0xf9, 0x8f, 0xcb, 0x35, 0xe3,
+	0x16, 0xca, 0xa2, 0x7d, 0x08, 0x3a, 0xcd, 0x5a, 0xb2, 0xa2, 0xcd, 0x09, 0xce, 0xbe, 0x2d, 0x2c,
+	0xcb, 0x05, 0x45, 0x4b, 0x9b, 0x9b, 0x63, 0x1d, 0x26, 0xfb, 0x38, 0x35, 0xc7, 0x67, 0x1d, 0x0c,
+	0xa9, 0xf4, 0xbd, 0x75, 0x19, 0x52, 0xa7, 0x0e, 0x8a, 0xff, 0x20, 0x98, 0xae, 0x4a, 0xf1, 0x25,
+	0xad, 0x5c, 0xe7, 0x2f, 0xab, 0xd9, 0xd2, 0xb3, 0x96, 0x9b, 0x6f, 0x10, 0xcc, 0x34, 0x28, 0xbc,
+	0x55, 0x72, 0xf5, 0x37, 0x82, 0x09, 0x47, 0x0b, 0x92, 0xd3, 0x31, 0xf9, 0x92, 0x56, 0x90, 0x9f,
+	0xc5, 0x7b, 0xe7, 0x33, 0x04, 0x3b, 0x7d, 0xc4, 0xb6, 0x4a, 0x4e, 0xde, 0x6d, 0xb7, 0x69, 0x3a,
+	0xcb, 0x68, 0x5e, 0x56, 0xb5, 0x5c, 0xab, 0x24, 0x65, 0x10, 0x3a, 0xd3, 0x26, 0x1f, 0x92, 0x8f,
+	0xee, 0x38, 0xfd, 0xe1, 0x4a, 0x55, 0x47, 0xd3, 0xa9, 0xfa, 0x1c, 0x01, 0xef, 0x17, 0x83, 0x56,
+	0xc9, 0xd5, 0x3b, 0x80, 0x29, 0x3f, 0x47, 0x6e, 0xec, 0xd8, 0xa0, 0xca, 0xd8, 0xc4, 0x61, 0x8b,
+	0x75, 0x02, 0x65, 0x2e, 0x47, 0x3c, 0x89, 0x98, 0x67, 0x06, 0xb1, 0x51, 0x96, 0x87, 0x7e, 0x9a,
+	0x07, 0x6b, 0x23, 0x7f, 0xcf, 0x4c, 0x83, 0x8d, 0xc3, 0xab, 0xb0, 0xcd, 0xe1, 0x9f, 0xc5, 0xe5,
+	0x35, 0xe8, 0x92, 0xc8, 0x29, 0x8f, 0x55, 0xc7, 0x09, 0x13, 0xed, 0xb7, 0xd5, 0xf0, 0x64, 0x03,
+	0xcf, 0xd5, 0x45, 0xd5, 0x78, 0xb4, 0x1a, 0xee, 0xa5, 0x7e, 0x29, 0x0a, 0x1f, 0x67, 0x70, 0x7c,
+	0x04, 0x7a, 0xa9, 0x3f, 0x4b, 0xea, 0x0e, 0xd8, 0x6c, 0x86, 0x34, 0xa1, 0xa4, 0x89, 0xab, 0x8e,
+	0x78, 0x97, 0xf9, 0x73, 0x31, 0xcd, 0x9f, 0x84, 0x3e, 0xcb, 0x92, 0x91, 0x12, 0xa0, 0xc3, 0x5c,
+	0x23, 0x76, 0xbe, 0xb9, 0x8a, 0x13, 0x3b, 0x7e, 0x0e, 0x76, 0x9e, 0x2f, 0xa9, 0xc6, 0x65, 0xd9,
+	0x50, 0x52, 0x4b, 0xc4, 0x46, 0x8f, 0x95, 0xe8, 0x3f, 0x8b, 0xf3, 0x75, 0xfd, 0x17, 0x80, 0xf7,
+	0xdb, 0xcd, 0x38, 0x2d, 0x41, 0xbf, 0x6e, 0x59, 0x25, 0x2a, 0x4b, 0x69, 0xdc, 0x4d, 0xcf, 0x01,
+	0xc6, 0xaa, 0xa9, 0x4f, 0xaf, 0xbc, 0xa8, 0xf3, 0x7f, 0xb9, 0xab, 0x76, 0x49, 0x53, 0x33, 0x72,
+	0xc1, 0x4a, 0x6a, 0xd0, 0x5b, 0xf7, 0x29, 0x14, 0xcc, 0x7f, 0xd6, 0x4b, 0xbf, 0x40, 0xb0, 0xcb,
+	0x57, 0x6a, 0xab, 0xdc, 0xa1, 0x6b, 0xee, 0x93, 0xe1, 0xb3, 0x98, 0x0d, 0xcf, 0xa9, 0xb0, 0xf5,
+	0xf2, 0xf0, 0x18, 0x41, 0xd4, 0xa7, 0x60, 0x9e, 0xf4, 0x6c, 0xd8, 0xca, 0xd9, 0xf9, 0x0e, 0xc1,
+	0x81, 0x40, 0xd2, 0x5b, 0x25, 0x67, 0xb7, 0xdb, 0x61, 0xaf, 0x0f, 0xf1, 0xa6, 0xce, 0x23, 0x4f,
+	0x23, 0x51, 0x4f, 0xf7, 0x2c, 0xf2, 0x35, 0x82, 0x48, 0xfd, 0x28, 0xb4, 0x4c, 0xbf, 0xeb, 0x84,
+	0x41, 0x13, 0x5e, 0x8f, 0x95, 0x16, 0x94, 0xac, 0x21, 0x17, 0x82, 0x26, 0xc8, 0x0e, 0x66, 0x7b,
+	0x65, 0x30, 0xdf, 0x84, 0xad, 0x39, 0x45, 0x4d, 0xd8, 0xa9, 0xdb, 0x54, 0x2f, 0x75, 0x61, 0x96,
+	0xba, 0x6d, 0xd4, 0x47, 0xe5, 0x66, 0x9a, 0xbe, 0x9e, 0x9c, 0xa2, 0x5a, 0xd6, 0x04, 0x5e, 0x5a,
+	0x29, 0xc3, 0x77, 0x04, 0x85, 0x97, 0x56, 0x3c, 0xf0, 0xd2, 0x8a, 0x0d, 0x7f, 0x91, 0xb2, 0x97,
+	0xd5, 0x74, 0xc2, 0x3c, 0xc1, 0x0e, 0x77, 0xd6, 0x3d, 0x07, 0x8f, 0x3a, 0xa9, 0x5b, 0x3b, 0xe9,
+	0x31, 0x18, 0x72, 0x8a, 0x7a, 0x46, 0x4d, 0x9b, 0xd6, 0x04, 0x5a, 0x5a, 0x29, 0x43, 0x77, 0x05,
+	0x82, 0x96, 0x56, 0x3c, 0xd0, 0xd2, 0x8a, 0x05, 0x9d, 0x81, 0x81, 0xa2, 0xd5, 0x18, 0x12, 0xba,
+	0x21, 0x19, 0x45, 0x7d, 0x78, 0xf3, 0x04, 0x8a, 0xf4, 0x45, 0xc3, 0xee, 0xb2, 0xb2, 0x1b, 0xc8,
+	0x79, 0x62, 0x46, 0x7c, 0xec, 0xa0, 0x3e, 0xdc, 0x10, 0x7c, 0xbc, 0xbf, 0xe8, 0xb4, 0x36, 0x1d,
+	0x95, 0x4f, 0x41, 0xcc, 0xd1, 0x96, 0xea, 0x8e, 0xec, 0x63, 0x90, 0xd7, 0x91, 0x1b, 0x82, 0x8f,
+	0xf7, 0xeb, 0x4e, 0x6b, 0xd7, 0x2d, 0xd9, 0xdd, 0xf4, 0x2d, 0x79, 0x0f, 0xc1, 0x76, 0x57, 0x91,
+	0xb7, 0xca, 0xfd, 0x37, 0x08, 0xf8, 0x9c, 0x69, 0xb9, 0x4c, 0x46, 0xc6, 0xd6, 0x08, 0xf6, 0x05,
+	0xd8, 0xe6, 0xb8, 0xca, 0xd8, 0x1e, 0x84, 0x2e, 0x3a, 0x5a, 0x66, 0x87, 0xe2, 0x21, 0x0f, 0x5d,
+	0xb2, 0xca, 0xa8, 0x32, 0xdb, 0xa9, 0xd7, 0xa1, 0xdf, 0x95, 0x6f, 0x3c, 0x04, 0xf8, 0x94, 0x5a,
+	0x72, 0x5d, 0x1d, 0x68, 0xc3, 0xff, 0x83, 0x5e, 0xc7, 0xb3, 0x65, 0x00, 0xe1, 0x41, 0x18, 0x70,
+	0x3f, 0x71, 0x06, 0xda, 0xb9, 0x8e, 0xf7, 0x3f, 0x0d, 0xb5, 0x99, 0xc8, 0xae, 0x04, 0x33, 0x64,
+	0xd7, 0x55, 0x8a, 0x6c, 0x5f, 0x74, 0x20, 0x3b, 0xaf, 0x32, 0xe4, 0xe8, 0x1d, 0x0e, 0x3a, 0x49,
+	0x04, 0xf0, 0x1d, 0x04, 0xbd, 0x8e, 0x39, 0x39, 0xde, 0xed, 0x56, 0x5d, 0x6d, 0xbc, 0xce, 0xed,
+	0xa9, 0x63, 0x45, 0x43, 0xca, 0x0b, 0xb7, 0x7e, 0xf9, 0xe3, 0xa3, 0xf6, 0x08, 0x9e, 0x14, 0x5d,
+	0x33, 0x7c, 0xeb, 0x33, 0x43, 0x8e, 0x6c, 0x4b, 0x24, 0x99, 0xf3, 0x4f, 0x10, 0x60, 0xef, 0x74,
+	0x1c, 0xef, 0xab, 0xee, 0xad, 0xca, 0x78, 0x9d, 0x9b, 0x6a, 0xc4, 0x94, 0xb1, 0x3b, 0x48, 0xd8,
+	0x09, 0x78, 0xba, 0x0e, 0x3b, 0x3a, 0x9d, 0x48, 0xd0, 0xb7, 0x2e, 0xfc, 0x3d, 0x82, 0xa1, 0xea,
+	0x63, 0x6f, 0x3c, 0xe3, 0x76, 0xee, 0x3b, 0x68, 0xe7, 0x84, 0x46, 0xcd, 0x19, 0xdf, 0x93, 0x84,
+	0xef, 0x51, 0x7c, 0xa4, 0x16, 0x5f, 0x89, 0xee, 0x4f, 0x14, 0x6d, 0x80, 0x04, 0x99, 0xc8, 0x8a,
+	0xd7, 0xc9, 0xd3, 0xe4, 0x06, 0xfe, 0x16, 0xc1, 0xf6, 0xaa, 0x43, 0x6e, 0x3c, 0xed, 0xcb, 0xc5,
+	0x35, 0x54, 0xe7, 0x66, 0x1a, 0xb4, 0x66, 0xc4, 0x4f, 0x10, 0xe2, 0xcf, 0xe1, 0xc3, 0x8d, 0x11,
+	0x37, 0xdb, 0xa3, 0x93, 0xf7, 0x97, 0x08, 0xb0, 0x77, 0xa6, 0xed, 0xad, 0x8b, 0x9a, 0xc3, 0x73,
+	0x6e, 0xaa, 0x11, 0x53, 0x46, 0x77, 0x8e, 0xd0, 0x3d, 0x84, 0x0f, 0xd6, 0xa3, 0xcb, 0x0a, 0xa3,
+	0x66, 0x8c, 0x9d, 0xd3, 0x92, 0x9a, 0x31, 0xae, 0x3a, 0x24, 0xe7, 0x66, 0x1a, 0xb4, 0x0e, 0x1a,
+	0x63, 0x46, 0x3a, 0x2f, 0xe9, 0x86, 0xf9, 0xa4, 0xb3, 0x79, 0x3f, 0x46, 0xb0, 0xa7, 0xa1, 0x81,
+	0x29, 0x9e, 0x6b, 0x88, 0x59, 0x8d, 0x97, 0x08, 0xee, 0x58, 0x93, 0xbb, 0x99, 0xce, 0x38, 0xd1,
+	0xb9, 0x84, 0x9f, 0x0f, 0xa8, 0x33, 0xa1, 0x6a, 0x95, 0xf5, 0xa5, 0xa9, 0xd9, 0x92, 0x2d, 0xfd,
+	0x07, 0x64, 0x7f, 0x77, 0xf1, 0xce, 0x22, 0xf1, 0x7e, 0xdf, 0x62, 0xaf, 0x32, 0xa3, 0xe5, 0x66,
+	0x03, 0xec, 0x60, 0xb2, 0xe6, 0x89, 0xac, 0xe3, 0x78, 0xae, 0xb1, 0x5b, 0x44, 0x4e, 0x27, 0x92,
+	0x04, 0x24, 0xe1, 0xc8, 0xe1, 0x8f, 0x08, 0xb8, 0xaa, 0xe1, 0x24, 0xe7, 0x62, 0x3c, 0xdb, 0x50,
+	0xe8, 0x2b, 0xdf, 0x24, 0xb8, 0x68, 0x90, 0x2d, 0x4c, 0xcb, 0x19, 0xa2, 0xe5, 0x04, 0x3e, 0x16,
+	0x34, 0x45, 0xe4, 0x74, 0x6b, 0x8b, 0x79, 0x0f, 0x41, 0x4f, 0xc5, 0x3c, 0x0d, 0xf3, 0x6e, 0x2a,
+	0xde, 0x61, 0x1f, 0xb7, 0xcb, 0xd7, 0x86, 0xf1, 0x9b, 0x26, 0xfc, 0x26, 0xf1, 0xee, 0x5a, 0xfc,
+	0x18, 0x2f, 0x7a, 0xd8, 0xbe, 0x8d, 0x00, 0x28, 0x4a, 0xac, 0xb4, 0x38, 0x8f, 0xc7, 0xab, 0x7b,
+	0xb0, 0x08, 0x84, 0x6a, 0x2d, 0x33, 0xdf, 0x87, 0x88, 0xef, 0xfd, 0x58, 0xa8, 0xe3, 0x3b, 0x59,
+	0x4a, 0x28, 0x69, 0xf1, 0x3a, 0x1b, 0xa7, 0xdd, 0xc0, 0x3f, 0x21, 0xe0, 0x6a, 0x8f, 0xd0, 0xbc,
+	0x99, 0xad, 0x3b, 0xac, 0xe3, 0xa2, 0x41, 0xb6, 0x30, 0xf6, 0x0b, 0x84, 0xfd, 0x49, 0x7c, 0xbc,
+	0x16, 0x7b, 0xe7, 0xfc, 0xae, 0x98, 0xd7, 0x4d, 0x21, 0x4c, 0x44, 0x85, 0x9a, 0x9f, 0x11, 0x8c,
+	0xfa, 0xbc, 0xc5, 0x61, 0xff, 0xaa, 0xab, 0x3a, 0xc8, 0xe3, 0x0e, 0x04, 0xda, 0xd3, 0xa8, 0x20,
+	0x57, 0xa9, 0x66, 0x09, 0x8c, 0xfd, 0x76, 0x53, 0xbb, 0xe9, 0xdb, 0x52, 0xfc, 0x9b, 0xbe, 0x5b,
+	0xc4, 0x4c, 0x83, 0xd6, 0x4d, 0x36, 0x7d, 0x0f, 0xef, 0x0f, 0xdb, 0xe1, 0xff, 0x01, 0xa6, 0x21,
+	0x38, 0x16, 0x20, 0xc8, 0xb5, 0x1e, 0x00, 0xa7, 0x9f, 0x08, 0x83, 0x29, 0xbf, 0x48, 0x94, 0x9f,
+	0xc7, 0xe7, 0x9a, 0x4b, 0x9c, 0xdf, 0xd3, 0x60, 0xbd, 0xfc, 0x19, 0xae, 0xe6, 0x88, 0x01, 0x1f,
+	0x0e, 0x20, 0xc2, 0xd1, 0xa1, 0x8e, 0x04, 0xdf, 0xc8, 0x24, 0x2f, 0x11, 0xc9, 0x0b, 0x78, 0xbe,
+	0x49, 0xc9, 0xce, 0xee, 0xfa, 0x01, 0x82, 0x5e, 0xc7, 0x5b, 0x9b, 0xf7, 0xe4, 0x5f, 0x6d, 0x72,
+	0xc1, 0xed, 0xa9, 0x63, 0xc5, 0xc8, 0x8a, 0x84, 0xec, 0x3e, 0xbc, 0xd7, 0xaf, 0xcf, 0x91, 0xee,
+	0x70, 0x89, 0x7a, 0x2f, 0x41, 0x17, 0x7d, 0xbf, 0xf2, 0xf6, 0x79, 0xef, 0x2b, 0x1c, 0xb7, 0xcb,
+	0xd7, 0x86, 0x71, 0x98, 0x24, 0x1c, 0x26, 0x70, 0xa8, 0x16, 0x07, 0xfa, 0x0a, 0x17, 0x5b, 0xba,
+	0xbf, 0x16, 0x42, 0x0f, 0xd6, 0x42, 0xe8, 0xf7, 0xb5, 0x10, 0xba, 0xbb, 0x1e, 0x6a, 0x7b, 0xb0,
+	0x1e, 0x6a, 0xfb, 0x75, 0x3d, 0xd4, 0xf6, 0x46, 0xb4, 0xe2, 0x13, 0x0d, 0xc3, 0x98, 0xc9, 0x4a,
+	0x49, 0xdd, 0x06, 0xbc, 0x36, 0x1b, 0x15, 0x57, 0x2c, 0x58, 0xf2, 0xc9, 0x26, 0xd9, 0x45, 0xa6,
+	0x0c, 0x07, 0xfe, 0x1d, 0x00, 0xd4, 0xff, 0x90, 0xe2, 0xbf, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1791,6 +2195,8 @@ type QueryClient interface {
 	AccountLockedLongerDurationNotUnlockingOnly(ctx context.Context, in *AccountLockedLongerDurationNotUnlockingOnlyRequest, opts ...grpc.CallOption) (*AccountLockedLongerDurationNotUnlockingOnlyResponse, error)
 	// Returns account's locked records for a denom with longer duration
 	AccountLockedLongerDurationDenom(ctx context.Context, in *AccountLockedLongerDurationDenomRequest, opts ...grpc.CallOption) (*AccountLockedLongerDurationDenomResponse, error)
+	// Returns locks matching the filter
+	LocksByFilter(ctx context.Context, in *LocksByFilterRequest, opts ...grpc.CallOption) (*LocksByFilterResponse, error)
 	// Params returns lockup params.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -1947,6 +2353,15 @@ func (c *queryClient) AccountLockedLongerDurationDenom(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *queryClient) LocksByFilter(ctx context.Context, in *LocksByFilterRequest, opts ...grpc.CallOption) (*LocksByFilterResponse, error) {
+	out := new(LocksByFilterResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Query/LocksByFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.lockup.Query/Params", in, out, opts...)
@@ -1992,6 +2407,8 @@ type QueryServer interface {
 	AccountLockedLongerDurationNotUnlockingOnly(context.Context, *AccountLockedLongerDurationNotUnlockingOnlyRequest) (*AccountLockedLongerDurationNotUnlockingOnlyResponse, error)
 	// Returns account's locked records for a denom with longer duration
 	AccountLockedLongerDurationDenom(context.Context, *AccountLockedLongerDurationDenomRequest) (*AccountLockedLongerDurationDenomResponse, error)
+	// Returns locks matching the filter
+	LocksByFilter(context.Context, *LocksByFilterRequest) (*LocksByFilterResponse, error)
 	// Params returns lockup params.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -2048,6 +2465,9 @@ func (*UnimplementedQueryServer) AccountLockedLongerDurationNotUnlockingOnly(ctx
 func (*UnimplementedQueryServer) AccountLockedLongerDurationDenom(ctx context.Context, req *AccountLockedLongerDurationDenomRequest) (*AccountLockedLongerDurationDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountLockedLongerDurationDenom not implemented")
 }
+func (*UnimplementedQueryServer) LocksByFilter(ctx context.Context, req *LocksByFilterRequest) (*LocksByFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocksByFilter not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -2344,6 +2764,24 @@ func _Query_AccountLockedLongerDurationDenom_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LocksByFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocksByFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LocksByFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Query/LocksByFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LocksByFilter(ctx, req.(*LocksByFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -2430,6 +2868,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountLockedLongerDurationDenom",
 			Handler:    _Query_AccountLockedLongerDurationDenom_Handler,
 		},
+		{
+			MethodName: "LocksByFilter",
+			Handler:    _Query_LocksByFilter_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -2780,12 +3222,24 @@ func (m *AccountLockedPastTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err1 != nil {
-		return 0, err1
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -2818,6 +3272,18 @@ func (m *AccountLockedPastTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2855,12 +3321,24 @@ func (m *AccountLockedPastTimeNotUnlockingOnlyRequest) MarshalToSizedBuffer(dAtA
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -2893,6 +3371,18 @@ func (m *AccountLockedPastTimeNotUnlockingOnlyResponse) MarshalToSizedBuffer(dAt
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2930,12 +3420,24 @@ func (m *AccountUnlockedBeforeTimeRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err3 != nil {
-		return 0, err3
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n3
-	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -2968,6 +3470,18 @@ func (m *AccountUnlockedBeforeTimeResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3005,6 +3519,18 @@ func (m *AccountLockedPastTimeDenomRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -3012,12 +3538,12 @@ func (m *AccountLockedPastTimeDenomRequest) MarshalToSizedBuffer(dAtA []byte) (i
 		i--
 		dAtA[i] = 0x1a
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err4 != nil {
-		return 0, err4
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n4
-	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -3050,6 +3576,18 @@ func (m *AccountLockedPastTimeDenomResponse) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3087,12 +3625,12 @@ func (m *LockedDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err5 != nil {
-		return 0, err5
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n5
-	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Denom) > 0 {
@@ -3286,12 +3824,24 @@ func (m *AccountLockedLongerDurationRequest) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
-	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err7 != nil {
-		return 0, err7
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	i -= n7
-	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintQuery(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -3324,6 +3874,18 @@ func (m *AccountLockedLongerDurationResponse) MarshalToSizedBuffer(dAtA []byte)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3361,12 +3923,24 @@ func (m *AccountLockedDurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err8 != nil {
-		return 0, err8
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	i -= n8
-	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	n19, err19 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintQuery(dAtA, i, uint64(n19))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -3399,6 +3973,18 @@ func (m *AccountLockedDurationResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3436,12 +4022,24 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyRequest) MarshalToSizedBuffe
 	_ = i
 	var l int
 	_ = l
-	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err9 != nil {
-		return 0, err9
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	i -= n9
-	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	n22, err22 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintQuery(dAtA, i, uint64(n22))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -3474,6 +4072,18 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyResponse) MarshalToSizedBuff
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3511,6 +4121,18 @@ func (m *AccountLockedLongerDurationDenomRequest) MarshalToSizedBuffer(dAtA []by
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -3518,12 +4140,12 @@ func (m *AccountLockedLongerDurationDenomRequest) MarshalToSizedBuffer(dAtA []by
 		i--
 		dAtA[i] = 0x1a
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err10 != nil {
-		return 0, err10
+	n25, err25 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err25 != nil {
+		return 0, err25
 	}
-	i -= n10
-	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i -= n25
+	i = encodeVarintQuery(dAtA, i, uint64(n25))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -3556,6 +4178,162 @@ func (m *AccountLockedLongerDurationDenomResponse) MarshalToSizedBuffer(dAtA []b
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LocksByFilterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LocksByFilterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LocksByFilterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.SyntheticStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SyntheticStatus))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.UnlockingStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnlockingStatus))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxEndTime != nil {
+		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.MaxEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.MaxEndTime):])
+		if err28 != nil {
+			return 0, err28
+		}
+		i -= n28
+		i = encodeVarintQuery(dAtA, i, uint64(n28))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MinEndTime != nil {
+		n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.MinEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.MinEndTime):])
+		if err29 != nil {
+			return 0, err29
+		}
+		i -= n29
+		i = encodeVarintQuery(dAtA, i, uint64(n29))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n30, err30 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDuration):])
+	if err30 != nil {
+		return 0, err30
+	}
+	i -= n30
+	i = encodeVarintQuery(dAtA, i, uint64(n30))
+	i--
+	dAtA[i] = 0x22
+	n31, err31 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinDuration):])
+	if err31 != nil {
+		return 0, err31
+	}
+	i -= n31
+	i = encodeVarintQuery(dAtA, i, uint64(n31))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LocksByFilterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LocksByFilterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LocksByFilterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3784,6 +4562,10 @@ func (m *AccountLockedPastTimeRequest) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3799,6 +4581,10 @@ func (m *AccountLockedPastTimeResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3814,6 +4600,10 @@ func (m *AccountLockedPastTimeNotUnlockingOnlyRequest) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3829,6 +4619,10 @@ func (m *AccountLockedPastTimeNotUnlockingOnlyResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3844,7 +4638,11 @@ func (m *AccountUnlockedBeforeTimeRequest) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
 	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *AccountUnlockedBeforeTimeResponse) Size() (n int) {
@@ -3859,6 +4657,10 @@ func (m *AccountUnlockedBeforeTimeResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3878,6 +4680,10 @@ func (m *AccountLockedPastTimeDenomRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3893,6 +4699,10 @@ func (m *AccountLockedPastTimeDenomResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3986,6 +4796,10 @@ func (m *AccountLockedLongerDurationRequest) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4001,6 +4815,10 @@ func (m *AccountLockedLongerDurationResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4016,6 +4834,10 @@ func (m *AccountLockedDurationRequest) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4031,6 +4853,10 @@ func (m *AccountLockedDurationResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4046,6 +4872,10 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyRequest) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4061,6 +4891,10 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4080,6 +4914,10 @@ func (m *AccountLockedLongerDurationDenomRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4095,6 +4933,68 @@ func (m *AccountLockedLongerDurationDenomResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LocksByFilterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MinEndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.MinEndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxEndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.MaxEndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnlockingStatus != 0 {
+		n += 1 + sovQuery(uint64(m.UnlockingStatus))
+	}
+	if m.SyntheticStatus != 0 {
+		n += 1 + sovQuery(uint64(m.SyntheticStatus))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LocksByFilterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4984,6 +5884,42 @@ func (m *AccountLockedPastTimeRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5068,6 +6004,42 @@ func (m *AccountLockedPastTimeResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5183,6 +6155,42 @@ func (m *AccountLockedPastTimeNotUnlockingOnlyRequest) Unmarshal(dAtA []byte) er
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5267,6 +6275,42 @@ func (m *AccountLockedPastTimeNotUnlockingOnlyResponse) Unmarshal(dAtA []byte) e
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5382,6 +6426,42 @@ func (m *AccountUnlockedBeforeTimeRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5466,17 +6546,53 @@ func (m *AccountUnlockedBeforeTimeResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -5613,6 +6729,42 @@ func (m *AccountLockedPastTimeDenomRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5697,6 +6849,42 @@ func (m *AccountLockedPastTimeDenomResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6319,6 +7507,42 @@ func (m *AccountLockedLongerDurationRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6403,6 +7627,42 @@ func (m *AccountLockedLongerDurationResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6518,6 +7778,42 @@ func (m *AccountLockedDurationRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6602,6 +7898,42 @@ func (m *AccountLockedDurationResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6717,6 +8049,42 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyRequest) Unmarshal(dAtA []by
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6801,17 +8169,53 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyResponse) Unmarshal(dAtA []b
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -6948,6 +8352,42 @@ func (m *AccountLockedLongerDurationDenomRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7032,6 +8472,488 @@ func (m *AccountLockedLongerDurationDenomResponse) Unmarshal(dAtA []byte) error
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocksByFilterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocksByFilterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocksByFilterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinEndTime == nil {
+				m.MinEndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.MinEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxEndTime == nil {
+				m.MaxEndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.MaxEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockingStatus", wireType)
+			}
+			m.UnlockingStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockingStatus |= UnlockingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyntheticStatus", wireType)
+			}
+			m.SyntheticStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyntheticStatus |= SyntheticStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocksByFilterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocksByFilterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocksByFilterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, PeriodLock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
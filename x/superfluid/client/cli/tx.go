@@ -30,7 +30,7 @@ func GetTxCmd() *cobra.Command {
 		NewSuperfluidDelegateCmd(),
 		NewSuperfluidUndelegateCmd(),
 		NewSuperfluidUnbondLockCmd(),
-		// NewSuperfluidRedelegateCmd(),
+		NewSuperfluidRedelegateCmd(),
 		NewCmdLockAndSuperfluidDelegate(),
 		NewCmdUnPoolWhitelistedPool(),
 	)
@@ -140,43 +140,43 @@ func NewSuperfluidUnbondLockCmd() *cobra.Command {
 	return cmd
 }
 
-// NewSuperfluidRedelegateCmd broadcast MsgSuperfluidRedelegate
-// func NewSuperfluidRedelegateCmd() *cobra.Command {
-// 	cmd := &cobra.Command{
-// 		Use:   "redelegate [lock_id] [val_addr] [flags]",
-// 		Short: "superfluid redelegate a lock to a new validator",
-// 		Args:  cobra.ExactArgs(2),
-// 		RunE: func(cmd *cobra.Command, args []string) error {
-// 			clientCtx, err := client.GetClientTxContext(cmd)
-// 			if err != nil {
-// 				return err
-// 			}
-
-// 			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
-
-// 			lockId, err := strconv.Atoi(args[0])
-// 			if err != nil {
-// 				return err
-// 			}
-
-// 			valAddr, err := sdk.ValAddressFromBech32(args[1])
-// 			if err != nil {
-// 				return err
-// 			}
-
-// 			msg := types.NewMsgSuperfluidRedelegate(
-// 				clientCtx.GetFromAddress(),
-// 				uint64(lockId),
-// 				valAddr,
-// 			)
-
-// 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
-// 		},
-// 	}
-
-// 	flags.AddTxFlagsToCmd(cmd)
-// 	return cmd
-// }
+// NewSuperfluidRedelegateCmd broadcast MsgSuperfluidRedelegate.
+func NewSuperfluidRedelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegate [lock_id] [val_addr] [flags]",
+		Short: "superfluid redelegate a lock to a new validator",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			lockId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSuperfluidRedelegate(
+				clientCtx.GetFromAddress(),
+				uint64(lockId),
+				valAddr,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
 
 // NewCmdSubmitSetSuperfluidAssetsProposal implements a command handler for submitting a superfluid asset set proposal transaction.
 func NewCmdSubmitSetSuperfluidAssetsProposal() *cobra.Command {
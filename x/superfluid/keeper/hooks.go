@@ -63,6 +63,17 @@ func (h Hooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 func (h Hooks) OnLockupExtend(ctx sdk.Context, lockID uint64, oldDuration, newDuration time.Duration) {
 }
 
+func (h Hooks) OnLockSplit(ctx sdk.Context, lockID uint64, splitLockID uint64, amount sdk.Coins) {
+}
+
+// OnLockMerge is a no-op, as locks with synthetic lockups cannot be merged.
+func (h Hooks) OnLockMerge(ctx sdk.Context, lockID uint64, mergedLockID uint64, amount sdk.Coins) {
+}
+
+// OnLockTransfer is a no-op, as locks with synthetic lockups cannot be transferred.
+func (h Hooks) OnLockTransfer(ctx sdk.Context, lockID uint64, prevOwner, newOwner sdk.AccAddress) {
+}
+
 // staking hooks.
 func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)   {}
 func (h Hooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) {}
@@ -97,6 +97,24 @@ func newSuperfluidUndelegateEvent(lockId uint64) sdk.Event {
 	)
 }
 
+func EmitSuperfluidRedelegateEvent(ctx sdk.Context, lockId uint64, newValAddress string) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newSuperfluidRedelegateEvent(lockId, newValAddress),
+	})
+}
+
+func newSuperfluidRedelegateEvent(lockId uint64, newValAddress string) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtSuperfluidRedelegate,
+		sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", lockId)),
+		sdk.NewAttribute(types.AttributeValidator, newValAddress),
+	)
+}
+
 func EmitSuperfluidUnbondLockEvent(ctx sdk.Context, lockId uint64) {
 	if ctx.EventManager() == nil {
 		return
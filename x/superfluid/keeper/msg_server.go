@@ -67,14 +67,20 @@ func (server msgServer) SuperfluidUndelegate(goCtx context.Context, msg *types.M
 	return &types.MsgSuperfluidUndelegateResponse{}, err
 }
 
-// SuperfluidRedelegate is a method to redelegate superfluid staked asset into a different validator.
-// Currently this feature is not supported.
-// func (server msgServer) SuperfluidRedelegate(goCtx context.Context, msg *types.MsgSuperfluidRedelegate) (*types.MsgSuperfluidRedelegateResponse, error) {
-// 	ctx := sdk.UnwrapSDKContext(goCtx)
-
-// 	err := server.keeper.SuperfluidRedelegate(ctx, msg.Sender, msg.LockId, msg.NewValAddr)
-// 	return &types.MsgSuperfluidRedelegateResponse{}, err
-// }
+// SuperfluidRedelegate moves a currently superfluid delegated position to a different validator.
+// The synthetic lock is moved to the intermediary account of the new validator and the delegation
+// is moved along with it in the same block, without going through the unbonding period.
+// The lock remains liable for slashes of the previous validator until its unbonding synthetic lock
+// matures, and it can not be redelegated again in the meantime.
+func (server msgServer) SuperfluidRedelegate(goCtx context.Context, msg *types.MsgSuperfluidRedelegate) (*types.MsgSuperfluidRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.keeper.SuperfluidRedelegate(ctx, msg.Sender, msg.LockId, msg.NewValAddr)
+	if err == nil {
+		events.EmitSuperfluidRedelegateEvent(ctx, msg.LockId, msg.NewValAddr)
+	}
+	return &types.MsgSuperfluidRedelegateResponse{}, err
+}
 
 // SuperfluidUnbondLock starts unbonding for currently superfluid undelegating lock.
 // This method would return an error when the underlying lock is not in an superfluid undelegating state,
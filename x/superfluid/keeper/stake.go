@@ -266,6 +266,96 @@ func (k Keeper) SuperfluidUndelegate(ctx sdk.Context, sender string, lockID uint
 	return k.createSyntheticLockup(ctx, lockID, intermediaryAcc, unlockingStatus)
 }
 
+// ForceUndelegateAndUnlock instantly undelegates the superfluid delegation of the given lock, if any,
+// and force unlocks the lock, sending the locked tokens back to its owner.
+// It is used by the gamm module to unlock the superfluid staked shares of sunset pools.
+func (k Keeper) ForceUndelegateAndUnlock(ctx sdk.Context, lockID uint64) error {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	err = k.unbondSuperfluidIfExists(ctx, lock.OwnerAddress(), lockID)
+	if err != nil {
+		return err
+	}
+	// this also deletes the unbonding synthetic lockup created by the undelegation
+	return k.lk.ForceUnlock(ctx, *lock)
+}
+
+// SuperfluidRedelegate moves the superfluid delegation of the given lock to newValAddr.
+// The lock is re-pointed to the intermediary account of the (denom, newValAddr) pair and the
+// osmo backing it is undelegated from the old validator and delegated to the new one within
+// the same block, so the position never goes through an unbonding gap.
+// Mirroring x/staking redelegations, the lock stays liable for slashes of the old validator
+// for the unbonding period through an unbonding synthetic lockup on the old validator, and
+// a lock cannot be redelegated again until that unbonding synthetic lockup has matured.
+func (k Keeper) SuperfluidRedelegate(ctx sdk.Context, sender string, lockID uint64, newValAddr string) error {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	err = k.validateLockForSF(ctx, lock, sender)
+	if err != nil {
+		return err
+	}
+	if lock.IsUnlocking() {
+		return sdkerrors.Wrapf(types.ErrUnbondingLockupNotSupported, "lock id : %d", lock.ID)
+	}
+	lockedCoin := lock.Coins[0]
+
+	oldIntermediaryAcc, found := k.GetIntermediaryAccountFromLockId(ctx, lockID)
+	if !found {
+		return types.ErrNotSuperfluidUsedLockup
+	}
+	if oldIntermediaryAcc.ValAddr == newValAddr {
+		return types.ErrSameValidatorRedelegation
+	}
+	if _, err := k.validateValAddrForDelegate(ctx, newValAddr); err != nil {
+		return err
+	}
+
+	// A lock that is still unbonding from a previous validator can not be moved again,
+	// the same way x/staking rejects transitive redelegations.
+	for _, synthLock := range k.lk.GetAllSyntheticLockupsByLockup(ctx, lockID) {
+		if synthLock.IsUnlocking() {
+			return sdkerrors.Wrapf(types.ErrUnbondingSyntheticLockupExists, "lock id : %d", lock.ID)
+		}
+	}
+
+	// Delete the synthetic lockup on the old validator, and replace it with one representing
+	// the slashing liability the lock keeps towards it for the unbonding period.
+	k.DeleteLockIdIntermediaryAccountConnection(ctx, lockID)
+	err = k.lk.DeleteSyntheticLockup(ctx, lockID, stakingSyntheticDenom(lockedCoin.Denom, oldIntermediaryAcc.ValAddr))
+	if err != nil {
+		return err
+	}
+	err = k.createSyntheticLockup(ctx, lockID, oldIntermediaryAcc, unlockingStatus)
+	if err != nil {
+		return err
+	}
+
+	newIntermediaryAcc, err := k.GetOrCreateIntermediaryAccount(ctx, lockedCoin.Denom, newValAddr)
+	if err != nil {
+		return err
+	}
+	k.SetLockIdIntermediaryAccountConnection(ctx, lockID, newIntermediaryAcc)
+	err = k.createSyntheticLockup(ctx, lockID, newIntermediaryAcc, bondedStatus)
+	if err != nil {
+		return err
+	}
+
+	// Move the osmo backing this lock from the old validator to the new one.
+	amount := k.GetSuperfluidOSMOTokens(ctx, lockedCoin.Denom, lockedCoin.Amount)
+	if amount.IsZero() {
+		return types.ErrOsmoEquivalentZeroNotAllowed
+	}
+	err = k.forceUndelegateAndBurnOsmoTokens(ctx, amount, oldIntermediaryAcc)
+	if err != nil {
+		return err
+	}
+	return k.mintOsmoTokensAndDelegate(ctx, amount, newIntermediaryAcc)
+}
+
 // SuperfluidUnbondLock unbonds the lock that has been used for superfluid staking.
 // This method would return an error if the underlying lock is not superfluid undelegating.
 func (k Keeper) SuperfluidUnbondLock(ctx sdk.Context, underlyingLockId uint64, sender string) error {
@@ -278,11 +368,15 @@ func (k Keeper) SuperfluidUnbondLock(ctx sdk.Context, underlyingLockId uint64, s
 		return err
 	}
 	synthLocks := k.lk.GetAllSyntheticLockupsByLockup(ctx, underlyingLockId)
-	if len(synthLocks) != 1 {
+	if len(synthLocks) == 0 {
 		return types.ErrNotSuperfluidUsedLockup
 	}
-	if !synthLocks[0].IsUnlocking() {
-		return types.ErrBondingLockupNotSupported
+	// A redelegated lock also holds the unbonding synthetic lockup of its previous validator,
+	// so every synthetic lockup has to be unbonding before the lock itself can unbond.
+	for _, synthLock := range synthLocks {
+		if !synthLock.IsUnlocking() {
+			return types.ErrBondingLockupNotSupported
+		}
 	}
 	return k.lk.BeginForceUnlock(ctx, underlyingLockId, sdk.Coins{})
 }
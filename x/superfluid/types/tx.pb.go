@@ -303,6 +303,104 @@ func (m *MsgSuperfluidUnbondLockResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSuperfluidUnbondLockResponse proto.InternalMessageInfo
 
+// MsgSuperfluidRedelegate moves the superfluid delegation of a lock to a new
+// validator without waiting for the unbonding period.
+type MsgSuperfluidRedelegate struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId     uint64 `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	NewValAddr string `protobuf:"bytes,3,opt,name=new_val_addr,json=newValAddr,proto3" json:"new_val_addr,omitempty"`
+}
+
+func (m *MsgSuperfluidRedelegate) Reset()         { *m = MsgSuperfluidRedelegate{} }
+func (m *MsgSuperfluidRedelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidRedelegate) ProtoMessage()    {}
+func (*MsgSuperfluidRedelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{6}
+}
+func (m *MsgSuperfluidRedelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidRedelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidRedelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidRedelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidRedelegate.Merge(m, src)
+}
+func (m *MsgSuperfluidRedelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidRedelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidRedelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidRedelegate proto.InternalMessageInfo
+
+func (m *MsgSuperfluidRedelegate) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidRedelegate) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgSuperfluidRedelegate) GetNewValAddr() string {
+	if m != nil {
+		return m.NewValAddr
+	}
+	return ""
+}
+
+type MsgSuperfluidRedelegateResponse struct {
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Reset()         { *m = MsgSuperfluidRedelegateResponse{} }
+func (m *MsgSuperfluidRedelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidRedelegateResponse) ProtoMessage()    {}
+func (*MsgSuperfluidRedelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{7}
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidRedelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidRedelegateResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidRedelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidRedelegateResponse proto.InternalMessageInfo
+
 // MsgLockAndSuperfluidDelegate locks coins with the unbonding period duration,
 // and then does a superfluid lock from the newly created lockup, to the
 // specified validator addr.
@@ -316,7 +414,7 @@ func (m *MsgLockAndSuperfluidDelegate) Reset()         { *m = MsgLockAndSuperflu
 func (m *MsgLockAndSuperfluidDelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgLockAndSuperfluidDelegate) ProtoMessage()    {}
 func (*MsgLockAndSuperfluidDelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{6}
+	return fileDescriptor_55b645f187d22814, []int{8}
 }
 func (m *MsgLockAndSuperfluidDelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -374,7 +472,7 @@ func (m *MsgLockAndSuperfluidDelegateResponse) Reset()         { *m = MsgLockAnd
 func (m *MsgLockAndSuperfluidDelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLockAndSuperfluidDelegateResponse) ProtoMessage()    {}
 func (*MsgLockAndSuperfluidDelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{7}
+	return fileDescriptor_55b645f187d22814, []int{9}
 }
 func (m *MsgLockAndSuperfluidDelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -427,7 +525,7 @@ func (m *MsgUnPoolWhitelistedPool) Reset()         { *m = MsgUnPoolWhitelistedPo
 func (m *MsgUnPoolWhitelistedPool) String() string { return proto.CompactTextString(m) }
 func (*MsgUnPoolWhitelistedPool) ProtoMessage()    {}
 func (*MsgUnPoolWhitelistedPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{8}
+	return fileDescriptor_55b645f187d22814, []int{10}
 }
 func (m *MsgUnPoolWhitelistedPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,7 +576,7 @@ func (m *MsgUnPoolWhitelistedPoolResponse) Reset()         { *m = MsgUnPoolWhite
 func (m *MsgUnPoolWhitelistedPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnPoolWhitelistedPoolResponse) ProtoMessage()    {}
 func (*MsgUnPoolWhitelistedPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{9}
+	return fileDescriptor_55b645f187d22814, []int{11}
 }
 func (m *MsgUnPoolWhitelistedPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,6 +619,8 @@ func init() {
 	proto.RegisterType((*MsgSuperfluidUndelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidUndelegateResponse")
 	proto.RegisterType((*MsgSuperfluidUnbondLock)(nil), "osmosis.superfluid.MsgSuperfluidUnbondLock")
 	proto.RegisterType((*MsgSuperfluidUnbondLockResponse)(nil), "osmosis.superfluid.MsgSuperfluidUnbondLockResponse")
+	proto.RegisterType((*MsgSuperfluidRedelegate)(nil), "osmosis.superfluid.MsgSuperfluidRedelegate")
+	proto.RegisterType((*MsgSuperfluidRedelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidRedelegateResponse")
 	proto.RegisterType((*MsgLockAndSuperfluidDelegate)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegate")
 	proto.RegisterType((*MsgLockAndSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegateResponse")
 	proto.RegisterType((*MsgUnPoolWhitelistedPool)(nil), "osmosis.superfluid.MsgUnPoolWhitelistedPool")
@@ -530,45 +630,48 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/tx.proto", fileDescriptor_55b645f187d22814) }
 
 var fileDescriptor_55b645f187d22814 = []byte{
-	// 608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x93, 0x90, 0xc0, 0xa2, 0x16, 0x61, 0xb5, 0xaa, 0x63, 0xc0, 0x0e, 0x06, 0xa1, 0xa0,
-	0x52, 0x6f, 0x93, 0xa2, 0x0a, 0x71, 0x6b, 0xc8, 0x25, 0xa8, 0x91, 0x2a, 0xa3, 0x0a, 0x09, 0x09,
-	0x45, 0x76, 0x76, 0xeb, 0x5a, 0xd9, 0x78, 0x23, 0xaf, 0x63, 0xa5, 0xe2, 0x01, 0xb8, 0xf2, 0x1c,
-	0xbc, 0x08, 0x3d, 0xf6, 0xc8, 0x29, 0xa0, 0xe4, 0x0d, 0x2a, 0x1e, 0x00, 0xf9, 0x37, 0x14, 0xec,
-	0x90, 0x08, 0x7a, 0xf2, 0xce, 0xcc, 0xb7, 0xdf, 0x7c, 0xa3, 0x99, 0xf1, 0x82, 0x7b, 0x94, 0x0d,
-	0x28, 0xb3, 0x18, 0x64, 0xa3, 0x21, 0x76, 0x4e, 0xc8, 0xc8, 0x42, 0xd0, 0x1d, 0xab, 0x43, 0x87,
-	0xba, 0x94, 0xe7, 0xa3, 0xa0, 0x3a, 0x0f, 0x8a, 0x1b, 0x26, 0x35, 0x69, 0x10, 0x86, 0xfe, 0x29,
-	0x44, 0x8a, 0x92, 0x49, 0xa9, 0x49, 0x30, 0x0c, 0x2c, 0x63, 0x74, 0x02, 0xd1, 0xc8, 0xd1, 0x5d,
-	0x8b, 0xda, 0x71, 0xbc, 0x17, 0x50, 0x41, 0x43, 0x67, 0x18, 0x7a, 0x75, 0x03, 0xbb, 0x7a, 0x1d,
-	0xf6, 0xa8, 0x15, 0xc7, 0x1f, 0xa5, 0xc8, 0x98, 0x1f, 0x43, 0x90, 0xe2, 0x81, 0xcd, 0x0e, 0x33,
-	0xdf, 0x24, 0xee, 0x16, 0x26, 0xd8, 0xd4, 0x5d, 0xcc, 0x3f, 0x05, 0x25, 0x86, 0x6d, 0x84, 0x1d,
-	0x81, 0xab, 0x72, 0xb5, 0x5b, 0xcd, 0xbb, 0x97, 0x13, 0x79, 0xed, 0x4c, 0x1f, 0x90, 0x97, 0x4a,
-	0xe8, 0x57, 0xb4, 0x08, 0xc0, 0x6f, 0x81, 0x32, 0xa1, 0xbd, 0x7e, 0xd7, 0x42, 0x42, 0xbe, 0xca,
-	0xd5, 0x8a, 0x5a, 0xc9, 0x37, 0xdb, 0x88, 0xaf, 0x80, 0x9b, 0x9e, 0x4e, 0xba, 0x3a, 0x42, 0x8e,
-	0x50, 0xf0, 0x59, 0xb4, 0xb2, 0xa7, 0x93, 0x03, 0x84, 0x1c, 0x45, 0x06, 0x0f, 0x52, 0xf3, 0x6a,
-	0x98, 0x0d, 0xa9, 0xcd, 0xb0, 0xf2, 0x1e, 0x6c, 0x5d, 0x01, 0x1c, 0xdb, 0xe8, 0x3f, 0x4a, 0x53,
-	0x1e, 0x02, 0x39, 0x83, 0x7e, 0x81, 0x02, 0x83, 0xda, 0xe8, 0x90, 0xf6, 0xfa, 0xd7, 0xa4, 0x20,
-	0xa6, 0x4f, 0x14, 0x7c, 0xe1, 0xc0, 0xfd, 0x0e, 0x33, 0x7d, 0xdf, 0x81, 0x8d, 0xfe, 0xad, 0x49,
-	0x3a, 0xb8, 0xe1, 0xcf, 0x06, 0x13, 0xf2, 0xd5, 0x42, 0xed, 0x76, 0xa3, 0xa2, 0x86, 0xd3, 0xa3,
-	0xfa, 0xd3, 0xa3, 0x46, 0xd3, 0xa3, 0xbe, 0xa2, 0x96, 0xdd, 0xdc, 0x3d, 0x9f, 0xc8, 0xb9, 0xcf,
-	0xdf, 0xe4, 0x9a, 0x69, 0xb9, 0xa7, 0x23, 0x43, 0xed, 0xd1, 0x01, 0x8c, 0x46, 0x2d, 0xfc, 0xec,
-	0x30, 0xd4, 0x87, 0xee, 0xd9, 0x10, 0xb3, 0xe0, 0x02, 0xd3, 0x42, 0xe6, 0x45, 0xed, 0xde, 0x07,
-	0x8f, 0x17, 0x15, 0x12, 0x57, 0xcc, 0xaf, 0x83, 0x7c, 0xbb, 0x15, 0x14, 0x53, 0xd4, 0xf2, 0xed,
-	0x96, 0xe2, 0x00, 0xa1, 0xc3, 0xcc, 0x63, 0xfb, 0x88, 0x52, 0xf2, 0xf6, 0xd4, 0x72, 0x31, 0xb1,
-	0x98, 0x8b, 0x91, 0x6f, 0xae, 0x52, 0xfc, 0x36, 0x28, 0x0f, 0x29, 0x25, 0x49, 0x13, 0x9a, 0xfc,
-	0xe5, 0x44, 0x5e, 0x0f, 0xb1, 0x51, 0x40, 0xd1, 0x4a, 0xfe, 0xa9, 0x8d, 0x94, 0xd7, 0xa0, 0x9a,
-	0x95, 0x33, 0xd1, 0xf9, 0x04, 0xdc, 0xc1, 0x63, 0xcb, 0xc5, 0xa8, 0x1b, 0x35, 0x97, 0x09, 0x5c,
-	0xb5, 0x50, 0x2b, 0x6a, 0x6b, 0xa1, 0xfb, 0x30, 0xe8, 0x31, 0x6b, 0xfc, 0x28, 0x82, 0x42, 0x87,
-	0x99, 0xbc, 0x03, 0xf8, 0xb4, 0xf6, 0xa9, 0x7f, 0xfe, 0x0c, 0xd4, 0xd4, 0xb5, 0x10, 0xeb, 0x4b,
-	0x43, 0x13, 0x8d, 0x63, 0xb0, 0x91, 0xba, 0x3e, 0xdb, 0x7f, 0xa5, 0x9a, 0x83, 0xc5, 0xbd, 0x15,
-	0xc0, 0x59, 0x99, 0x93, 0xb5, 0x59, 0x26, 0x73, 0x0c, 0x5e, 0x2a, 0xf3, 0xef, 0x1b, 0xc3, 0x7f,
-	0xe4, 0x40, 0x25, 0x7b, 0x5d, 0x76, 0x33, 0x28, 0x33, 0x6f, 0x88, 0x2f, 0x56, 0xbd, 0x91, 0x28,
-	0xf9, 0x00, 0x36, 0xd3, 0xc7, 0xf6, 0x59, 0x06, 0x65, 0x2a, 0x5a, 0x7c, 0xbe, 0x0a, 0x3a, 0x4e,
-	0xde, 0x3c, 0x3a, 0x9f, 0x4a, 0xdc, 0xc5, 0x54, 0xe2, 0xbe, 0x4f, 0x25, 0xee, 0xd3, 0x4c, 0xca,
-	0x5d, 0xcc, 0xa4, 0xdc, 0xd7, 0x99, 0x94, 0x7b, 0xb7, 0xff, 0xcb, 0x52, 0x47, 0xcc, 0x3b, 0x44,
-	0x37, 0x58, 0x6c, 0x40, 0xaf, 0xde, 0x80, 0xe3, 0x2b, 0x2f, 0x97, 0xbf, 0xe8, 0x46, 0x29, 0x78,
-	0x2e, 0xf6, 0x7e, 0x06, 0x00, 0x00, 0xff, 0xff, 0x79, 0x4e, 0xaf, 0x04, 0xdc, 0x06, 0x00, 0x00,
+	// 649 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0x93, 0x36, 0x85, 0x85, 0x16, 0x61, 0xb5, 0xaa, 0x6b, 0xc0, 0x36, 0x06, 0xa1, 0xa0,
+	0x52, 0x6f, 0xd3, 0xa2, 0x0a, 0x71, 0x6b, 0xe8, 0x25, 0xa8, 0x91, 0xaa, 0x45, 0x05, 0x09, 0x09,
+	0x45, 0x76, 0x76, 0xeb, 0x5a, 0x75, 0xbc, 0x91, 0xd7, 0x49, 0x53, 0x71, 0xe0, 0xc8, 0x95, 0xdf,
+	0xc1, 0x1f, 0xa1, 0xc7, 0x1e, 0x39, 0x05, 0x94, 0xdc, 0x39, 0xf4, 0x17, 0x20, 0x7f, 0xa6, 0x0d,
+	0x76, 0xa8, 0x45, 0x39, 0x65, 0x77, 0xe7, 0xed, 0x7b, 0x6f, 0x32, 0x33, 0x6b, 0x70, 0x8f, 0xb2,
+	0x36, 0x65, 0x16, 0x83, 0xac, 0xdb, 0x21, 0xee, 0x81, 0xdd, 0xb5, 0x30, 0xf4, 0xfa, 0x5a, 0xc7,
+	0xa5, 0x1e, 0xe5, 0xf9, 0x28, 0xa8, 0x8d, 0x83, 0xe2, 0xa2, 0x49, 0x4d, 0x1a, 0x84, 0xa1, 0xbf,
+	0x0a, 0x91, 0xa2, 0x64, 0x52, 0x6a, 0xda, 0x04, 0x06, 0x3b, 0xa3, 0x7b, 0x00, 0x71, 0xd7, 0xd5,
+	0x3d, 0x8b, 0x3a, 0x71, 0xbc, 0x15, 0x50, 0x41, 0x43, 0x67, 0x04, 0xf6, 0xaa, 0x06, 0xf1, 0xf4,
+	0x2a, 0x6c, 0x51, 0x2b, 0x8e, 0x3f, 0x4a, 0xb1, 0x31, 0x5e, 0x86, 0x20, 0xb5, 0x07, 0x96, 0x1a,
+	0xcc, 0x7c, 0x93, 0x1c, 0xef, 0x10, 0x9b, 0x98, 0xba, 0x47, 0xf8, 0xa7, 0xa0, 0xcc, 0x88, 0x83,
+	0x89, 0x2b, 0x70, 0x0a, 0x57, 0xb9, 0x59, 0xbb, 0x7b, 0x3e, 0x90, 0xe7, 0x4f, 0xf4, 0xb6, 0xfd,
+	0x52, 0x0d, 0xcf, 0x55, 0x14, 0x01, 0xf8, 0x65, 0x30, 0x67, 0xd3, 0xd6, 0x51, 0xd3, 0xc2, 0x42,
+	0x51, 0xe1, 0x2a, 0x33, 0xa8, 0xec, 0x6f, 0xeb, 0x98, 0x5f, 0x01, 0x37, 0x7a, 0xba, 0xdd, 0xd4,
+	0x31, 0x76, 0x85, 0x92, 0xcf, 0x82, 0xe6, 0x7a, 0xba, 0xbd, 0x8d, 0xb1, 0xab, 0xca, 0xe0, 0x41,
+	0xaa, 0x2e, 0x22, 0xac, 0x43, 0x1d, 0x46, 0xd4, 0x0f, 0x60, 0xf9, 0x12, 0x60, 0xdf, 0xc1, 0xd7,
+	0x68, 0x4d, 0x7d, 0x08, 0xe4, 0x0c, 0xfa, 0x29, 0x0e, 0x0c, 0xea, 0xe0, 0x5d, 0xda, 0x3a, 0xfa,
+	0x4f, 0x0e, 0x62, 0xfa, 0xc4, 0xc1, 0xa7, 0x09, 0x07, 0x88, 0x5c, 0xe7, 0x7f, 0xc0, 0x2b, 0xe0,
+	0xb6, 0x43, 0x8e, 0x9b, 0x13, 0x25, 0x02, 0x0e, 0x39, 0x7e, 0x1b, 0x55, 0x69, 0xd2, 0xe3, 0xd8,
+	0x40, 0xe2, 0xf1, 0x1b, 0x07, 0xee, 0x37, 0x98, 0xe9, 0xfb, 0xde, 0x76, 0xf0, 0xbf, 0x35, 0x92,
+	0x0e, 0x66, 0xfd, 0xfe, 0x65, 0x42, 0x51, 0x29, 0x55, 0x6e, 0x6d, 0xac, 0x68, 0x61, 0x87, 0x6b,
+	0x7e, 0x87, 0x6b, 0x51, 0x87, 0x6b, 0xaf, 0xa8, 0xe5, 0xd4, 0xd6, 0x4f, 0x07, 0x72, 0xe1, 0xeb,
+	0x0f, 0xb9, 0x62, 0x5a, 0xde, 0x61, 0xd7, 0xd0, 0x5a, 0xb4, 0x0d, 0xa3, 0x71, 0x08, 0x7f, 0xd6,
+	0x18, 0x3e, 0x82, 0xde, 0x49, 0x87, 0xb0, 0xe0, 0x02, 0x43, 0x21, 0xf3, 0xb4, 0x96, 0xdc, 0x02,
+	0x8f, 0xa7, 0x25, 0x12, 0x67, 0xcc, 0x2f, 0x80, 0x62, 0x7d, 0x27, 0x48, 0x66, 0x06, 0x15, 0xeb,
+	0x3b, 0xaa, 0x0b, 0x84, 0x06, 0x33, 0xf7, 0x9d, 0x3d, 0x4a, 0xed, 0x77, 0x87, 0x96, 0x47, 0x6c,
+	0x8b, 0x79, 0x04, 0xfb, 0xdb, 0x3c, 0xc9, 0xaf, 0x82, 0xb9, 0x0e, 0xa5, 0x76, 0x52, 0xa6, 0x1a,
+	0x7f, 0x3e, 0x90, 0x17, 0x42, 0x6c, 0x14, 0x50, 0x51, 0xd9, 0x5f, 0xd5, 0xb1, 0xfa, 0x1a, 0x28,
+	0x59, 0x9a, 0x89, 0xcf, 0x27, 0xe0, 0x0e, 0xe9, 0x5b, 0x1e, 0xc1, 0xcd, 0xa8, 0xfc, 0x4c, 0xe0,
+	0x94, 0x52, 0x65, 0x06, 0xcd, 0x87, 0xc7, 0xbb, 0x41, 0x17, 0xb0, 0x8d, 0x5f, 0xb3, 0xa0, 0xd4,
+	0x60, 0x26, 0xef, 0x02, 0x3e, 0xad, 0x7c, 0xda, 0x9f, 0x0f, 0x96, 0x96, 0x3a, 0xba, 0x62, 0xf5,
+	0xca, 0xd0, 0xc4, 0x63, 0x1f, 0x2c, 0xa6, 0x8e, 0xf8, 0xea, 0x5f, 0xa9, 0xc6, 0x60, 0x71, 0x33,
+	0x07, 0x38, 0x5d, 0x19, 0x91, 0x1c, 0xca, 0x88, 0xe4, 0x50, 0x46, 0x64, 0xba, 0xf2, 0x85, 0x47,
+	0xe5, 0x2a, 0x39, 0xc7, 0x60, 0x71, 0x33, 0x07, 0x38, 0x51, 0xfe, 0xcc, 0x81, 0x95, 0xec, 0x41,
+	0x5d, 0xcf, 0xa0, 0xcc, 0xbc, 0x21, 0xbe, 0xc8, 0x7b, 0x23, 0x71, 0xf2, 0x11, 0x2c, 0xa5, 0x0f,
+	0xcc, 0xb3, 0x0c, 0xca, 0x54, 0xb4, 0xf8, 0x3c, 0x0f, 0x3a, 0x16, 0xaf, 0xed, 0x9d, 0x0e, 0x25,
+	0xee, 0x6c, 0x28, 0x71, 0x3f, 0x87, 0x12, 0xf7, 0x65, 0x24, 0x15, 0xce, 0x46, 0x52, 0xe1, 0xfb,
+	0x48, 0x2a, 0xbc, 0xdf, 0xba, 0xf0, 0x9c, 0x44, 0xcc, 0x6b, 0xb6, 0x6e, 0xb0, 0x78, 0x03, 0x7b,
+	0xd5, 0x0d, 0xd8, 0xbf, 0xf4, 0x5d, 0xf7, 0x9f, 0x18, 0xa3, 0x1c, 0x7c, 0x4c, 0x37, 0x7f, 0x0f,
+	0x00, 0x47, 0x17, 0x91, 0x4e, 0xfa, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -587,6 +690,8 @@ type MsgClient interface {
 	SuperfluidDelegate(ctx context.Context, in *MsgSuperfluidDelegate, opts ...grpc.CallOption) (*MsgSuperfluidDelegateResponse, error)
 	// Execute superfluid undelegation for a lockup
 	SuperfluidUndelegate(ctx context.Context, in *MsgSuperfluidUndelegate, opts ...grpc.CallOption) (*MsgSuperfluidUndelegateResponse, error)
+	// Execute superfluid redelegation for a lockup
+	SuperfluidRedelegate(ctx context.Context, in *MsgSuperfluidRedelegate, opts ...grpc.CallOption) (*MsgSuperfluidRedelegateResponse, error)
 	// For a given lock that is being superfluidly undelegated,
 	// also unbond the underlying lock.
 	SuperfluidUnbondLock(ctx context.Context, in *MsgSuperfluidUnbondLock, opts ...grpc.CallOption) (*MsgSuperfluidUnbondLockResponse, error)
@@ -621,6 +726,15 @@ func (c *msgClient) SuperfluidUndelegate(ctx context.Context, in *MsgSuperfluidU
 	return out, nil
 }
 
+func (c *msgClient) SuperfluidRedelegate(ctx context.Context, in *MsgSuperfluidRedelegate, opts ...grpc.CallOption) (*MsgSuperfluidRedelegateResponse, error) {
+	out := new(MsgSuperfluidRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidRedelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SuperfluidUnbondLock(ctx context.Context, in *MsgSuperfluidUnbondLock, opts ...grpc.CallOption) (*MsgSuperfluidUnbondLockResponse, error) {
 	out := new(MsgSuperfluidUnbondLockResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidUnbondLock", in, out, opts...)
@@ -654,6 +768,8 @@ type MsgServer interface {
 	SuperfluidDelegate(context.Context, *MsgSuperfluidDelegate) (*MsgSuperfluidDelegateResponse, error)
 	// Execute superfluid undelegation for a lockup
 	SuperfluidUndelegate(context.Context, *MsgSuperfluidUndelegate) (*MsgSuperfluidUndelegateResponse, error)
+	// Execute superfluid redelegation for a lockup
+	SuperfluidRedelegate(context.Context, *MsgSuperfluidRedelegate) (*MsgSuperfluidRedelegateResponse, error)
 	// For a given lock that is being superfluidly undelegated,
 	// also unbond the underlying lock.
 	SuperfluidUnbondLock(context.Context, *MsgSuperfluidUnbondLock) (*MsgSuperfluidUnbondLockResponse, error)
@@ -672,6 +788,9 @@ func (*UnimplementedMsgServer) SuperfluidDelegate(ctx context.Context, req *MsgS
 func (*UnimplementedMsgServer) SuperfluidUndelegate(ctx context.Context, req *MsgSuperfluidUndelegate) (*MsgSuperfluidUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUndelegate not implemented")
 }
+func (*UnimplementedMsgServer) SuperfluidRedelegate(ctx context.Context, req *MsgSuperfluidRedelegate) (*MsgSuperfluidRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidRedelegate not implemented")
+}
 func (*UnimplementedMsgServer) SuperfluidUnbondLock(ctx context.Context, req *MsgSuperfluidUnbondLock) (*MsgSuperfluidUnbondLockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUnbondLock not implemented")
 }
@@ -722,6 +841,24 @@ func _Msg_SuperfluidUndelegate_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SuperfluidRedelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidRedelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuperfluidRedelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidRedelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuperfluidRedelegate(ctx, req.(*MsgSuperfluidRedelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SuperfluidUnbondLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSuperfluidUnbondLock)
 	if err := dec(in); err != nil {
@@ -788,6 +925,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SuperfluidUndelegate",
 			Handler:    _Msg_SuperfluidUndelegate_Handler,
 		},
+		{
+			MethodName: "SuperfluidRedelegate",
+			Handler:    _Msg_SuperfluidRedelegate_Handler,
+		},
 		{
 			MethodName: "SuperfluidUnbondLock",
 			Handler:    _Msg_SuperfluidUnbondLock_Handler,
@@ -986,6 +1127,71 @@ func (m *MsgSuperfluidUnbondLockResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSuperfluidRedelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidRedelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewValAddr) > 0 {
+		i -= len(m.NewValAddr)
+		copy(dAtA[i:], m.NewValAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewValAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidRedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgLockAndSuperfluidDelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1231,6 +1437,35 @@ func (m *MsgSuperfluidUnbondLockResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSuperfluidRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = len(m.NewValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgLockAndSuperfluidDelegate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1789,6 +2024,189 @@ func (m *MsgSuperfluidUnbondLockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSuperfluidRedelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidRedelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidRedelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidRedelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,27 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagSwapRoutePoolIds = "swap-route-pool-ids"
+	FlagSwapRouteDenoms  = "swap-route-denoms"
+	// FlagSplitRoutesFile is the path to a JSON file holding the split routes of a swap.
+	FlagSplitRoutesFile = "split-routes-file"
+)
+
+func FlagSetSwapRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.StringArray(FlagSwapRoutePoolIds, []string{""}, "swap route pool ids")
+	fs.StringArray(FlagSwapRouteDenoms, []string{""}, "swap route denoms")
+	return fs
+}
+
+func FlagSetSplitRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagSplitRoutesFile, "", "JSON file with the split routes of the swap")
+	return fs
+}
@@ -0,0 +1,233 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	// Group swaprouter queries under a subcommand
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdEstimateSwapExactAmountIn(),
+		GetCmdEstimateSwapExactAmountOut(),
+		GetCmdEstimateSplitRouteSwapExactAmountIn(),
+		GetCmdEstimateSplitRouteSwapExactAmountOut(),
+		GetCmdPoolRoute(),
+	)
+
+	return cmd
+}
+
+// GetCmdEstimateSwapExactAmountIn returns the estimated token out amount of an exact amount in swap.
+func GetCmdEstimateSwapExactAmountIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-swap-exact-amount-in [sender] [token-in]",
+		Short: "Query estimate-swap-exact-amount-in",
+		Example: fmt.Sprintf(`$ %s query swaprouter estimate-swap-exact-amount-in osm11vmx8jtggpd9u7qr0t8vxclycz85u925sazglr7 stake --swap-route-pool-ids=2 --swap-route-denoms=uosmo`,
+			version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := queryproto.NewQueryClient(clientCtx)
+
+			routes, err := swapAmountInRoutes(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateSwapExactAmountIn(cmd.Context(), &queryproto.EstimateSwapExactAmountInRequest{
+				Sender:  args[0],
+				TokenIn: args[1],
+				Routes:  routes,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSwapRoutes())
+	flags.AddQueryFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSwapRoutePoolIds)
+	_ = cmd.MarkFlagRequired(FlagSwapRouteDenoms)
+
+	return cmd
+}
+
+// GetCmdEstimateSwapExactAmountOut returns the estimated token in amount of an exact amount out swap.
+func GetCmdEstimateSwapExactAmountOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-swap-exact-amount-out [sender] [token-out]",
+		Short: "Query estimate-swap-exact-amount-out",
+		Example: fmt.Sprintf(`$ %s query swaprouter estimate-swap-exact-amount-out osm11vmx8jtggpd9u7qr0t8vxclycz85u925sazglr7 1000uosmo --swap-route-pool-ids=2 --swap-route-denoms=stake`,
+			version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := queryproto.NewQueryClient(clientCtx)
+
+			routes, err := swapAmountOutRoutes(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateSwapExactAmountOut(cmd.Context(), &queryproto.EstimateSwapExactAmountOutRequest{
+				Sender:   args[0],
+				Routes:   routes,
+				TokenOut: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSwapRoutes())
+	flags.AddQueryFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSwapRoutePoolIds)
+	_ = cmd.MarkFlagRequired(FlagSwapRouteDenoms)
+
+	return cmd
+}
+
+// GetCmdEstimateSplitRouteSwapExactAmountIn returns the estimated token out amount of a split route
+// exact amount in swap.
+func GetCmdEstimateSplitRouteSwapExactAmountIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-split-route-swap-exact-amount-in [sender] [token-in-denom]",
+		Short: "Query estimate-split-route-swap-exact-amount-in",
+		Example: fmt.Sprintf(`$ %s query swaprouter estimate-split-route-swap-exact-amount-in osm11vmx8jtggpd9u7qr0t8vxclycz85u925sazglr7 stake --split-routes-file=routes.json`,
+			version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := queryproto.NewQueryClient(clientCtx)
+
+			routes, err := splitRoutes(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateSplitRouteSwapExactAmountIn(cmd.Context(), &queryproto.EstimateSplitRouteSwapExactAmountInRequest{
+				Sender:       args[0],
+				Routes:       routes,
+				TokenInDenom: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSplitRoutes())
+	flags.AddQueryFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSplitRoutesFile)
+
+	return cmd
+}
+
+// GetCmdEstimateSplitRouteSwapExactAmountOut returns the estimated allocation and token in amount
+// of a split route exact amount out swap.
+func GetCmdEstimateSplitRouteSwapExactAmountOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-split-route-swap-exact-amount-out [token-out]",
+		Short: "Query estimate-split-route-swap-exact-amount-out",
+		Example: fmt.Sprintf(`$ %s query swaprouter estimate-split-route-swap-exact-amount-out 1000stake --split-routes-file=routes.json`,
+			version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := queryproto.NewQueryClient(clientCtx)
+
+			routes, err := splitAmountOutRoutes(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateSplitRouteSwapExactAmountOut(cmd.Context(), &queryproto.EstimateSplitRouteSwapExactAmountOutRequest{
+				Routes:   routes,
+				TokenOut: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSplitRoutes())
+	flags.AddQueryFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSplitRoutesFile)
+
+	return cmd
+}
+
+// GetCmdPoolRoute returns the module that a pool's swaps are routed to.
+func GetCmdPoolRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pool-route [pool-id]",
+		Short:   "Query the module that a pool's swaps are routed to",
+		Example: fmt.Sprintf(`$ %s query swaprouter pool-route 1`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := queryproto.NewQueryClient(clientCtx)
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolRoute(cmd.Context(), &queryproto.PoolRouteRequest{PoolId: poolId})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
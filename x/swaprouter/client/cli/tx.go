@@ -0,0 +1,330 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+// NewTxCmd returns the transaction commands for this module
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewSwapExactAmountInCmd(),
+		NewSwapExactAmountOutCmd(),
+		NewSplitRouteSwapExactAmountInCmd(),
+		NewSplitRouteSwapExactAmountOutCmd(),
+	)
+
+	return txCmd
+}
+
+func NewSwapExactAmountInCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-amount-in [token-in] [token-out-min-amount]",
+		Short: "swap exact amount in",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			routes, err := swapAmountInRoutes(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			tokenIn, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			tokenOutMinAmt, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return errors.New("invalid token out min amount")
+			}
+
+			msg := &types.MsgSwapExactAmountIn{
+				Sender:            clientCtx.GetFromAddress().String(),
+				Routes:            routes,
+				TokenIn:           tokenIn,
+				TokenOutMinAmount: tokenOutMinAmt,
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSwapRoutes())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSwapRoutePoolIds)
+	_ = cmd.MarkFlagRequired(FlagSwapRouteDenoms)
+
+	return cmd
+}
+
+func NewSwapExactAmountOutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-amount-out [token-out] [token-in-max-amount]",
+		Short: "swap exact amount out",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			routes, err := swapAmountOutRoutes(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			tokenOut, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			tokenInMaxAmount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return errors.New("invalid token in max amount")
+			}
+
+			msg := &types.MsgSwapExactAmountOut{
+				Sender:           clientCtx.GetFromAddress().String(),
+				Routes:           routes,
+				TokenInMaxAmount: tokenInMaxAmount,
+				TokenOut:         tokenOut,
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSwapRoutes())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSwapRoutePoolIds)
+	_ = cmd.MarkFlagRequired(FlagSwapRouteDenoms)
+
+	return cmd
+}
+
+func NewSplitRouteSwapExactAmountInCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split-route-swap-exact-amount-in [token-in-denom] [token-out-min-amount]",
+		Short: "swap exact amount in, split across multiple routes",
+		Long: `swap exact amount in, split across multiple routes.
+The routes are read from a JSON file, e.g.:
+[
+  {"pools": [{"pool_id": "1", "token_out_denom": "uosmo"}], "token_in_amount": "1000"},
+  {"pools": [{"pool_id": "2", "token_out_denom": "uatom"}, {"pool_id": "3", "token_out_denom": "uosmo"}], "token_in_amount": "2000"}
+]`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			routes, err := splitRoutes(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			tokenOutMinAmt, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return errors.New("invalid token out min amount")
+			}
+
+			msg := &types.MsgSplitRouteSwapExactAmountIn{
+				Sender:            clientCtx.GetFromAddress().String(),
+				Routes:            routes,
+				TokenInDenom:      args[0],
+				TokenOutMinAmount: tokenOutMinAmt,
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSplitRoutes())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSplitRoutesFile)
+
+	return cmd
+}
+
+func NewSplitRouteSwapExactAmountOutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split-route-swap-exact-amount-out [token-out] [token-in-max-amount]",
+		Short: "swap exact amount out, split across multiple routes",
+		Long: `swap exact amount out, split across multiple routes.
+The token out is allocated to the routes so that the total token in is the lowest.
+The routes are read from a JSON file, e.g.:
+[
+  {"pools": [{"pool_id": "1", "token_in_denom": "uatom"}]},
+  {"pools": [{"pool_id": "2", "token_in_denom": "uatom"}, {"pool_id": "3", "token_in_denom": "uion"}]}
+]`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			routes, err := splitAmountOutRoutes(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			tokenOut, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			tokenInMaxAmount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return errors.New("invalid token in max amount")
+			}
+
+			msg := &types.MsgSplitRouteSwapExactAmountOut{
+				Sender:           clientCtx.GetFromAddress().String(),
+				Routes:           routes,
+				TokenOut:         tokenOut,
+				TokenInMaxAmount: tokenInMaxAmount,
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSplitRoutes())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSplitRoutesFile)
+
+	return cmd
+}
+
+func swapAmountInRoutes(fs *flag.FlagSet) ([]types.SwapAmountInRoute, error) {
+	swapRoutePoolIds, err := fs.GetStringArray(FlagSwapRoutePoolIds)
+	if err != nil {
+		return nil, err
+	}
+
+	swapRouteDenoms, err := fs.GetStringArray(FlagSwapRouteDenoms)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(swapRoutePoolIds) != len(swapRouteDenoms) {
+		return nil, errors.New("swap route pool ids and denoms mismatch")
+	}
+
+	routes := []types.SwapAmountInRoute{}
+	for index, poolIDStr := range swapRoutePoolIds {
+		pID, err := strconv.ParseUint(poolIDStr, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, types.SwapAmountInRoute{
+			PoolId:        pID,
+			TokenOutDenom: swapRouteDenoms[index],
+		})
+	}
+	return routes, nil
+}
+
+func swapAmountOutRoutes(fs *flag.FlagSet) ([]types.SwapAmountOutRoute, error) {
+	swapRoutePoolIds, err := fs.GetStringArray(FlagSwapRoutePoolIds)
+	if err != nil {
+		return nil, err
+	}
+
+	swapRouteDenoms, err := fs.GetStringArray(FlagSwapRouteDenoms)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(swapRoutePoolIds) != len(swapRouteDenoms) {
+		return nil, errors.New("swap route pool ids and denoms mismatch")
+	}
+
+	routes := []types.SwapAmountOutRoute{}
+	for index, poolIDStr := range swapRoutePoolIds {
+		pID, err := strconv.ParseUint(poolIDStr, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, types.SwapAmountOutRoute{
+			PoolId:       pID,
+			TokenInDenom: swapRouteDenoms[index],
+		})
+	}
+	return routes, nil
+}
+
+func splitRoutes(clientCtx client.Context, fs *flag.FlagSet) ([]types.SwapAmountInSplitRoute, error) {
+	routesFile, err := fs.GetString(FlagSplitRoutesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := os.ReadFile(routesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	// wrap the routes in a message so they can be decoded with the proto JSON codec.
+	msg := &types.MsgSplitRouteSwapExactAmountIn{}
+	wrapped := fmt.Sprintf(`{"routes": %s, "token_out_min_amount": "0"}`, contents)
+	if err := clientCtx.Codec.UnmarshalJSON([]byte(wrapped), msg); err != nil {
+		return nil, err
+	}
+	return msg.Routes, nil
+}
+
+func splitAmountOutRoutes(clientCtx client.Context, fs *flag.FlagSet) ([]types.SwapAmountOutSplitRoute, error) {
+	routesFile, err := fs.GetString(FlagSplitRoutesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := os.ReadFile(routesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	// wrap the routes in a message so they can be decoded with the proto JSON codec.
+	msg := &types.MsgSplitRouteSwapExactAmountOut{}
+	wrapped := fmt.Sprintf(`{"routes": %s, "token_in_max_amount": "0"}`, contents)
+	if err := clientCtx.Codec.UnmarshalJSON([]byte(wrapped), msg); err != nil {
+		return nil, err
+	}
+	return msg.Routes, nil
+}
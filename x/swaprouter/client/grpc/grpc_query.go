@@ -0,0 +1,72 @@
+package grpc 
+
+// THIS FILE IS GENERATED CODE, DO NOT EDIT
+// SOURCE AT `proto/osmosis/swaprouter/v1beta1/query.yml`
+
+import (
+	context "context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/client"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/client/queryproto"
+)
+
+type Querier struct {
+	Q client.Querier
+}
+
+var _ queryproto.QueryServer = Querier{}
+
+func (q Querier) PoolRoute(grpcCtx context.Context,
+	req *queryproto.PoolRouteRequest,
+) (*queryproto.PoolRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PoolRoute(ctx, *req)
+}
+
+func (q Querier) EstimateSwapExactAmountOut(grpcCtx context.Context,
+	req *queryproto.EstimateSwapExactAmountOutRequest,
+) (*queryproto.EstimateSwapExactAmountOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EstimateSwapExactAmountOut(ctx, *req)
+}
+
+func (q Querier) EstimateSwapExactAmountIn(grpcCtx context.Context,
+	req *queryproto.EstimateSwapExactAmountInRequest,
+) (*queryproto.EstimateSwapExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EstimateSwapExactAmountIn(ctx, *req)
+}
+
+func (q Querier) EstimateSplitRouteSwapExactAmountIn(grpcCtx context.Context,
+	req *queryproto.EstimateSplitRouteSwapExactAmountInRequest,
+) (*queryproto.EstimateSplitRouteSwapExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EstimateSplitRouteSwapExactAmountIn(ctx, *req)
+}
+
+func (q Querier) EstimateSplitRouteSwapExactAmountOut(grpcCtx context.Context,
+	req *queryproto.EstimateSplitRouteSwapExactAmountOutRequest,
+) (*queryproto.EstimateSplitRouteSwapExactAmountOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EstimateSplitRouteSwapExactAmountOut(ctx, *req)
+}
+
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+// This file should evolve to being code gen'd, off of `proto/swaprouter/v1beta/query.yml`
+
+// sdkIntMaxValue is the max token in amount used to estimate exact amount out swaps.
+var sdkIntMaxValue = sdk.NewInt(0)
+
+func init() {
+	maxInt := big.NewInt(2)
+	maxInt = maxInt.Exp(maxInt, big.NewInt(256), nil)
+
+	_sdkIntMaxValue, ok := sdk.NewIntFromString(maxInt.Sub(maxInt, big.NewInt(1)).String())
+	if !ok {
+		panic("Failed to calculate the max value of sdk.Int")
+	}
+
+	sdkIntMaxValue = _sdkIntMaxValue
+}
+
+type Querier struct {
+	K swaprouter.Keeper
+}
+
+// EstimateSwapExactAmountIn estimates the token out amount of an exact amount in swap,
+// by executing it on behalf of the sender.
+func (q Querier) EstimateSwapExactAmountIn(ctx sdk.Context,
+	req queryproto.EstimateSwapExactAmountInRequest,
+) (*queryproto.EstimateSwapExactAmountInResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid token in (%s)", err)
+	}
+
+	if err := types.SwapAmountInRoutes(req.Routes).Validate(); err != nil {
+		return nil, err
+	}
+
+	tokenOutAmount, err := q.K.RouteExactAmountIn(ctx, sender, req.Routes, tokenIn, sdk.OneInt())
+	return &queryproto.EstimateSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount}, err
+}
+
+// EstimateSwapExactAmountOut estimates the token in amount of an exact amount out swap,
+// by executing it on behalf of the sender.
+func (q Querier) EstimateSwapExactAmountOut(ctx sdk.Context,
+	req queryproto.EstimateSwapExactAmountOutRequest,
+) (*queryproto.EstimateSwapExactAmountOutResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	tokenOut, err := sdk.ParseCoinNormalized(req.TokenOut)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid token out (%s)", err)
+	}
+
+	if err := types.SwapAmountOutRoutes(req.Routes).Validate(); err != nil {
+		return nil, err
+	}
+
+	tokenInAmount, err := q.K.RouteExactAmountOut(ctx, sender, req.Routes, sdkIntMaxValue, tokenOut)
+	return &queryproto.EstimateSwapExactAmountOutResponse{TokenInAmount: tokenInAmount}, err
+}
+
+// EstimateSplitRouteSwapExactAmountIn estimates the summed token out amount of a split
+// route exact amount in swap, by executing it on behalf of the sender.
+func (q Querier) EstimateSplitRouteSwapExactAmountIn(ctx sdk.Context,
+	req queryproto.EstimateSplitRouteSwapExactAmountInRequest,
+) (*queryproto.EstimateSplitRouteSwapExactAmountInResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(req.TokenInDenom); err != nil {
+		return nil, err
+	}
+
+	tokenOutAmount, err := q.K.SplitRouteExactAmountIn(ctx, sender, req.Routes, req.TokenInDenom, sdk.OneInt())
+	return &queryproto.EstimateSplitRouteSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount}, err
+}
+
+// EstimateSplitRouteSwapExactAmountOut estimates the cheapest allocation of the token out of a
+// split route exact amount out swap to its routes, and the summed token in amount it requires.
+func (q Querier) EstimateSplitRouteSwapExactAmountOut(ctx sdk.Context,
+	req queryproto.EstimateSplitRouteSwapExactAmountOutRequest,
+) (*queryproto.EstimateSplitRouteSwapExactAmountOutResponse, error) {
+	tokenOut, err := sdk.ParseCoinNormalized(req.TokenOut)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid token out (%s)", err)
+	}
+
+	tokenInAmount, tokenOutAmounts, err := q.K.EstimateSplitRouteExactAmountOut(ctx, req.Routes, tokenOut)
+	return &queryproto.EstimateSplitRouteSwapExactAmountOutResponse{TokenInAmount: tokenInAmount, TokenOutAmounts: tokenOutAmounts}, err
+}
+
+func (q Querier) PoolRoute(ctx sdk.Context,
+	req queryproto.PoolRouteRequest,
+) (*queryproto.PoolRouteResponse, error) {
+	route, err := q.K.GetPoolRoute(ctx, req.PoolId)
+	return &queryproto.PoolRouteResponse{Route: route}, err
+}
@@ -0,0 +1,40 @@
+package swaprouter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+var _ gammtypes.SwapRouter = Keeper{}
+
+// MultihopSwapExactAmountIn executes an x/gamm multihop exact amount in swap through RouteExactAmountIn.
+func (k Keeper) MultihopSwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []gammtypes.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	tokenOutMinAmount sdk.Int,
+) (sdk.Int, error) {
+	routerRoutes := make([]types.SwapAmountInRoute, len(routes))
+	for i, route := range routes {
+		routerRoutes[i] = types.SwapAmountInRoute{PoolId: route.PoolId, TokenOutDenom: route.TokenOutDenom}
+	}
+	return k.RouteExactAmountIn(ctx, sender, routerRoutes, tokenIn, tokenOutMinAmount)
+}
+
+// MultihopSwapExactAmountOut executes an x/gamm multihop exact amount out swap through RouteExactAmountOut.
+func (k Keeper) MultihopSwapExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []gammtypes.SwapAmountOutRoute,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+) (sdk.Int, error) {
+	routerRoutes := make([]types.SwapAmountOutRoute, len(routes))
+	for i, route := range routes {
+		routerRoutes[i] = types.SwapAmountOutRoute{PoolId: route.PoolId, TokenInDenom: route.TokenInDenom}
+	}
+	return k.RouteExactAmountOut(ctx, sender, routerRoutes, tokenInMaxAmount, tokenOut)
+}
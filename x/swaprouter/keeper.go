@@ -0,0 +1,56 @@
+package swaprouter
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	bankKeeper types.BankKeeper
+
+	// poolModules maps the name of every module owning pools to its swap
+	// implementation.
+	poolModules map[string]types.SwapI
+}
+
+// NewKeeper returns a new swaprouter keeper, routing swaps to the given pool
+// modules, keyed by module name.
+func NewKeeper(storeKey sdk.StoreKey, bankKeeper types.BankKeeper, poolModules map[string]types.SwapI) *Keeper {
+	return &Keeper{
+		storeKey:    storeKey,
+		bankKeeper:  bankKeeper,
+		poolModules: poolModules,
+	}
+}
+
+// Logger returns a logger for the x/swaprouter module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// InitGenesis initializes the swaprouter module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	for _, route := range genState.PoolRoutes {
+		if err := k.SetPoolRoute(ctx, route.PoolId, route.ModuleName); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the swaprouter module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	routes, err := k.GetAllPoolRoutes(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return &types.GenesisState{
+		PoolRoutes: routes,
+	}
+}
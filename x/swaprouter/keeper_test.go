@@ -0,0 +1,65 @@
+package swaprouter_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v12/app/apptesting"
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.Setup()
+}
+
+func (suite *KeeperTestSuite) TestInitExportGenesis() {
+	genesis := &types.GenesisState{
+		PoolRoutes: []types.ModuleRoute{
+			{PoolId: 1, ModuleName: gammtypes.ModuleName},
+			{PoolId: 2, ModuleName: gammtypes.ModuleName},
+		},
+	}
+
+	suite.App.SwapRouterKeeper.InitGenesis(suite.Ctx, genesis)
+	suite.Require().Equal(genesis, suite.App.SwapRouterKeeper.ExportGenesis(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestPoolRoutes() {
+	// pools created through x/gamm are routed to x/gamm.
+	poolId := suite.PrepareBalancerPool()
+	route, err := suite.App.SwapRouterKeeper.GetPoolRoute(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ModuleRoute{PoolId: poolId, ModuleName: gammtypes.ModuleName}, route)
+
+	_, err = suite.App.SwapRouterKeeper.GetPoolRoute(suite.Ctx, poolId+1)
+	suite.Require().ErrorIs(err, types.PoolRouteNotFoundError{PoolId: poolId + 1})
+
+	err = suite.App.SwapRouterKeeper.SetPoolRoute(suite.Ctx, poolId+1, "unknown")
+	suite.Require().ErrorIs(err, types.UnknownPoolModuleError{ModuleName: "unknown"})
+
+	routes, err := suite.App.SwapRouterKeeper.GetAllPoolRoutes(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ModuleRoute{route}, routes)
+}
+
+// prepareSwapPools creates two pools, foo/bar/baz/uosmo and bar/baz.
+func (suite *KeeperTestSuite) prepareSwapPools() (uint64, uint64) {
+	firstPoolId := suite.PrepareBalancerPool()
+	secondPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("bar", 1_000_000), sdk.NewInt64Coin("baz", 1_000_000))
+	suite.FundAcc(suite.TestAccs[1], sdk.NewCoins(
+		sdk.NewInt64Coin("foo", 10_000_000),
+		sdk.NewInt64Coin("bar", 10_000_000),
+	))
+	return firstPoolId, secondPoolId
+}
@@ -0,0 +1,36 @@
+package swaprouter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+var _ gammtypes.GammHooks = &gammhook{}
+
+type gammhook struct {
+	k Keeper
+}
+
+// GammHooks returns the hooks routing every newly created x/gamm pool to x/gamm.
+func (k Keeper) GammHooks() gammtypes.GammHooks {
+	return &gammhook{k}
+}
+
+// AfterPoolCreated is called after CreatePool
+func (hook *gammhook) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	err := hook.k.SetPoolRoute(ctx, poolId, gammtypes.ModuleName)
+	// Will halt pool creation
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (hook *gammhook) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+}
+
+func (hook *gammhook) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
+}
+
+func (hook *gammhook) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+}
@@ -0,0 +1,66 @@
+package swaprouter_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+func (suite *KeeperTestSuite) TestSplitRouteSwapExactAmountInMsg() {
+	suite.prepareSwapPools()
+	sender := suite.TestAccs[1]
+	msgServer := swaprouter.NewMsgServerImpl(suite.App.SwapRouterKeeper)
+
+	msg := &types.MsgSplitRouteSwapExactAmountIn{
+		Sender: sender.String(),
+		Routes: []types.SwapAmountInSplitRoute{
+			{Pools: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}}, TokenInAmount: sdk.NewInt(100_000)},
+			{Pools: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "baz"}, {PoolId: 2, TokenOutDenom: "bar"}}, TokenInAmount: sdk.NewInt(50_000)},
+		},
+		TokenInDenom:      "foo",
+		TokenOutMinAmount: sdk.OneInt(),
+	}
+
+	balanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "bar")
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	res, err := msgServer.SplitRouteSwapExactAmountIn(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+
+	balanceAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "bar")
+	suite.Require().Equal(res.TokenOutAmount, balanceAfter.Amount.Sub(balanceBefore.Amount))
+	// one swap event per hop across both routes
+	suite.AssertEventEmitted(suite.Ctx, gammtypes.TypeEvtTokenSwapped, 3)
+}
+
+func (suite *KeeperTestSuite) TestSplitRouteSwapExactAmountOutMsg() {
+	suite.prepareSwapPools()
+	suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 2_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+	sender := suite.TestAccs[1]
+	msgServer := swaprouter.NewMsgServerImpl(suite.App.SwapRouterKeeper)
+
+	msg := &types.MsgSplitRouteSwapExactAmountOut{
+		Sender: sender.String(),
+		Routes: []types.SwapAmountOutSplitRoute{
+			{Pools: []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "foo"}}},
+			{Pools: []types.SwapAmountOutRoute{{PoolId: 3, TokenInDenom: "foo"}}},
+		},
+		TokenOut:         sdk.NewInt64Coin("bar", 100_000),
+		TokenInMaxAmount: sdk.NewInt(1_000_000),
+	}
+
+	fooBalanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "foo")
+	barBalanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "bar")
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	res, err := msgServer.SplitRouteSwapExactAmountOut(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+
+	fooBalanceAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "foo")
+	barBalanceAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "bar")
+	suite.Require().Equal(res.TokenInAmount, fooBalanceBefore.Amount.Sub(fooBalanceAfter.Amount))
+	suite.Require().Equal(msg.TokenOut.Amount, barBalanceAfter.Amount.Sub(barBalanceBefore.Amount))
+	suite.Require().Len(res.TokenOutAmounts, 2)
+	// one swap event per route
+	suite.AssertEventEmitted(suite.Ctx, gammtypes.TypeEvtTokenSwapped, 2)
+}
@@ -0,0 +1,70 @@
+package swaprouter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v12/osmoutils"
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+// SetPoolRoute routes all swaps against the given pool to the given pool module.
+// Returns an error if the module is not a registered pool module.
+func (k Keeper) SetPoolRoute(ctx sdk.Context, poolId uint64, moduleName string) error {
+	if _, ok := k.poolModules[moduleName]; !ok {
+		return types.UnknownPoolModuleError{ModuleName: moduleName}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.FormatPoolRouteKey(poolId), &types.ModuleRoute{
+		PoolId:     poolId,
+		ModuleName: moduleName,
+	})
+	return nil
+}
+
+// GetPoolRoute returns the module route of the given pool.
+func (k Keeper) GetPoolRoute(ctx sdk.Context, poolId uint64) (types.ModuleRoute, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FormatPoolRouteKey(poolId)
+	if !store.Has(key) {
+		return types.ModuleRoute{}, types.PoolRouteNotFoundError{PoolId: poolId}
+	}
+
+	route := types.ModuleRoute{}
+	osmoutils.MustGet(store, key, &route)
+	return route, nil
+}
+
+// GetAllPoolRoutes returns the module routes of all pools, ordered by pool id.
+func (k Keeper) GetAllPoolRoutes(ctx sdk.Context) ([]types.ModuleRoute, error) {
+	store := ctx.KVStore(k.storeKey)
+	return osmoutils.GatherValuesFromStorePrefix(store, types.PoolRoutePrefix, parseModuleRoute)
+}
+
+// getPoolForSwap returns the pool with the given id, along with the pool module
+// that swaps against it are routed to.
+func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.SwapI, gammtypes.PoolI, error) {
+	route, err := k.GetPoolRoute(ctx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	poolModule, ok := k.poolModules[route.ModuleName]
+	if !ok {
+		return nil, nil, types.UnknownPoolModuleError{ModuleName: route.ModuleName}
+	}
+
+	pool, err := poolModule.GetPoolForSwap(ctx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return poolModule, pool, nil
+}
+
+func parseModuleRoute(bz []byte) (types.ModuleRoute, error) {
+	route := types.ModuleRoute{}
+	err := proto.Unmarshal(bz, &route)
+	return route, err
+}
@@ -0,0 +1,351 @@
+package swaprouter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+// splitRouteExactAmountOutSteps is the number of parts the token out of a split route
+// exact amount out swap is divided into when allocating it to the routes.
+const splitRouteExactAmountOutSteps = 20
+
+// RouteExactAmountIn defines the input denom and input amount for the first pool,
+// the output of the first pool is chained as the input for the next routed pool
+// transaction succeeds when final amount out is greater than tokenOutMinAmount defined.
+// Every hop is executed by the pool module that owns the routed pool.
+func (k Keeper) RouteExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	tokenOutMinAmount sdk.Int,
+) (tokenOutAmount sdk.Int, err error) {
+	swapFeeMultiplier := sdk.OneDec()
+	if types.SwapAmountInRoutes(routes).IsOsmoRoutedMultihop() {
+		swapFeeMultiplier = gammtypes.MultihopSwapFeeMultiplierForOsmoPools.Clone()
+	}
+
+	for i, route := range routes {
+		// To prevent the multihop swap from being interrupted prematurely, we keep
+		// the minimum expected output at a very low number until the last pool
+		_outMinAmount := sdk.NewInt(1)
+		if len(routes)-1 == i {
+			_outMinAmount = tokenOutMinAmount
+		}
+
+		// Execute the expected swap on the current routed pool
+		poolModule, pool, err := k.getPoolForSwap(ctx, route.PoolId)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		swapFee := pool.GetSwapFee(ctx).Mul(swapFeeMultiplier)
+		tokenOutAmount, err = poolModule.RouteExactAmountIn(ctx, sender, pool, tokenIn, route.TokenOutDenom, _outMinAmount, swapFee)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		// Chain output of current pool as the input for the next routed pool
+		tokenIn = sdk.NewCoin(route.TokenOutDenom, tokenOutAmount)
+	}
+	return tokenOutAmount, nil
+}
+
+// RouteExactAmountOut defines the output denom and output amount for the last pool.
+// Calculation starts by providing the tokenOutAmount of the final pool to calculate the required tokenInAmount
+// the calculated tokenInAmount is used as defined tokenOutAmount of the previous pool, calculating in reverse order of the swap
+// Transaction succeeds if the calculated tokenInAmount of the first pool is less than the defined tokenInMaxAmount defined.
+// Every hop is executed by the pool module that owns the routed pool.
+func (k Keeper) RouteExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountOutRoute,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+) (tokenInAmount sdk.Int, err error) {
+	swapFeeMultiplier := sdk.OneDec()
+	if types.SwapAmountOutRoutes(routes).IsOsmoRoutedMultihop() {
+		swapFeeMultiplier = gammtypes.MultihopSwapFeeMultiplierForOsmoPools.Clone()
+	}
+
+	// Determine what the estimated input would be for each pool along the multihop route
+	insExpected, err := k.createMultihopExpectedSwapOuts(ctx, routes, tokenOut, swapFeeMultiplier)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if len(insExpected) == 0 {
+		return sdk.Int{}, nil
+	}
+
+	insExpected[0] = tokenInMaxAmount
+
+	// Iterates through each routed pool and executes their respective swaps. Note that all of the work to get the return
+	// value of this method is done when we calculate insExpected – this for loop primarily serves to execute the actual
+	// swaps on each pool.
+	for i, route := range routes {
+		_tokenOut := tokenOut
+
+		// If there is one pool left in the route, set the expected output of the current swap
+		// to the estimated input of the final pool.
+		if i != len(routes)-1 {
+			_tokenOut = sdk.NewCoin(routes[i+1].TokenInDenom, insExpected[i+1])
+		}
+
+		// Execute the expected swap on the current routed pool
+		poolModule, pool, err := k.getPoolForSwap(ctx, route.PoolId)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		swapFee := pool.GetSwapFee(ctx).Mul(swapFeeMultiplier)
+		_tokenInAmount, err := poolModule.RouteExactAmountOut(ctx, sender, pool, route.TokenInDenom, insExpected[i], _tokenOut, swapFee)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		// Sets the final amount of tokens that need to be input into the first pool. Even though this is the final return value for the
+		// whole method and will not change after the first iteration, we still iterate through the rest of the pools to execute their respective
+		// swaps.
+		if i == 0 {
+			tokenInAmount = _tokenInAmount
+		}
+	}
+
+	return tokenInAmount, nil
+}
+
+// SplitRouteExactAmountIn swaps tokenInDenom across multiple routes, each
+// swapping its own token in amount. All routes must end in the same denom.
+// The transaction succeeds when the summed amount out of all routes is at least
+// tokenOutMinAmount, no minimum is enforced on the individual routes.
+func (k Keeper) SplitRouteExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+	tokenOutMinAmount sdk.Int,
+) (sdk.Int, error) {
+	if err := types.SwapAmountInSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	totalTokenOutAmount := sdk.ZeroInt()
+	for _, route := range routes {
+		tokenIn := sdk.NewCoin(tokenInDenom, route.TokenInAmount)
+		tokenOutAmount, err := k.RouteExactAmountIn(ctx, sender, route.Pools, tokenIn, sdk.OneInt())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		totalTokenOutAmount = totalTokenOutAmount.Add(tokenOutAmount)
+	}
+
+	if totalTokenOutAmount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, types.ErrLimitMinAmount.Wrapf("%s token is lesser than min amount %s", totalTokenOutAmount, tokenOutMinAmount)
+	}
+
+	return totalTokenOutAmount, nil
+}
+
+// SplitRouteExactAmountOut swaps for tokenOut across multiple routes, all starting in the
+// same denom. The token out is allocated to the routes by EstimateSplitRouteExactAmountOut,
+// and the transaction succeeds when the summed amount in of all routes is at most tokenInMaxAmount.
+// As routes never share a pool, every route is swapped through at most for its estimated amount in.
+// tokenInMaxAmount is escrowed in the module account while the routes are swapped through,
+// and the unused amount is refunded to the sender along with tokenOut.
+func (k Keeper) SplitRouteExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountOutSplitRoute,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+) (tokenInAmount sdk.Int, tokenOutAmounts []sdk.Int, err error) {
+	estimatedTokenInAmounts, tokenOutAmounts, err := k.estimateSplitRouteExactAmountOut(ctx, routes, tokenOut)
+	if err != nil {
+		return sdk.Int{}, nil, err
+	}
+	estimatedTokenInAmount := sumInts(estimatedTokenInAmounts)
+	if estimatedTokenInAmount.GT(tokenInMaxAmount) {
+		return sdk.Int{}, nil, types.ErrLimitMaxAmount.Wrapf("%s token is larger than max amount %s", estimatedTokenInAmount, tokenInMaxAmount)
+	}
+
+	tokenInDenom := routes[0].TokenInDenom()
+	escrow := sdk.NewCoins(sdk.NewCoin(tokenInDenom, tokenInMaxAmount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, escrow); err != nil {
+		return sdk.Int{}, nil, err
+	}
+
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+	tokenInAmount = sdk.ZeroInt()
+	for i, route := range routes {
+		if tokenOutAmounts[i].IsZero() {
+			continue
+		}
+
+		routeTokenInAmount, err := k.RouteExactAmountOut(ctx, moduleAddress, route.Pools, estimatedTokenInAmounts[i], sdk.NewCoin(tokenOut.Denom, tokenOutAmounts[i]))
+		if err != nil {
+			return sdk.Int{}, nil, err
+		}
+		tokenInAmount = tokenInAmount.Add(routeTokenInAmount)
+	}
+
+	refund := sdk.NewCoin(tokenInDenom, tokenInMaxAmount.Sub(tokenInAmount))
+	// routes may start in the token out denom, e.g. arbitrage routes, so the refund is added to it
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(tokenOut).Add(refund)); err != nil {
+		return sdk.Int{}, nil, err
+	}
+
+	return tokenInAmount, tokenOutAmounts, nil
+}
+
+// EstimateSplitRouteExactAmountOut allocates tokenOut to the given split routes so that the summed
+// amount in of all routes is the lowest, and returns that amount in along with the token out
+// allocated to every route. The token out is allocated in splitRouteExactAmountOutSteps equal parts,
+// each to the route that requires the least additional token in for it. As the price of every route
+// only moves against the swapper, this converges on the cheapest allocation. Routes that lack the
+// liquidity to swap for a part are skipped for it. Errors if a pool of a route cannot be swapped on
+// or does not hold the denoms swapped through it.
+func (k Keeper) EstimateSplitRouteExactAmountOut(
+	ctx sdk.Context,
+	routes []types.SwapAmountOutSplitRoute,
+	tokenOut sdk.Coin,
+) (tokenInAmount sdk.Int, tokenOutAmounts []sdk.Int, err error) {
+	tokenInAmounts, tokenOutAmounts, err := k.estimateSplitRouteExactAmountOut(ctx, routes, tokenOut)
+	if err != nil {
+		return sdk.Int{}, nil, err
+	}
+	return sumInts(tokenInAmounts), tokenOutAmounts, nil
+}
+
+// estimateSplitRouteExactAmountOut returns the amount in and the token out allocated to every route,
+// as estimated by EstimateSplitRouteExactAmountOut.
+func (k Keeper) estimateSplitRouteExactAmountOut(
+	ctx sdk.Context,
+	routes []types.SwapAmountOutSplitRoute,
+	tokenOut sdk.Coin,
+) (tokenInAmounts []sdk.Int, tokenOutAmounts []sdk.Int, err error) {
+	if err := types.SwapAmountOutSplitRoutes(routes).Validate(); err != nil {
+		return nil, nil, err
+	}
+	if !tokenOut.IsValid() || !tokenOut.IsPositive() {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, tokenOut.String())
+	}
+
+	tokenOutAmounts = make([]sdk.Int, len(routes))
+	tokenInAmounts = make([]sdk.Int, len(routes))
+	for i, route := range routes {
+		if err := k.validateSplitRoute(ctx, route.Pools, tokenOut.Denom); err != nil {
+			return nil, nil, err
+		}
+		tokenOutAmounts[i] = sdk.ZeroInt()
+		tokenInAmounts[i] = sdk.ZeroInt()
+	}
+
+	step := tokenOut.Amount.QuoRaw(splitRouteExactAmountOutSteps)
+	if step.IsZero() {
+		step = sdk.OneInt()
+	}
+	for remaining := tokenOut.Amount; remaining.IsPositive(); {
+		stepAmount := sdk.MinInt(step, remaining)
+
+		cheapestRoute, cheapestTokenInAmount := -1, sdk.Int{}
+		for i, route := range routes {
+			routeTokenOut := sdk.NewCoin(tokenOut.Denom, tokenOutAmounts[i].Add(stepAmount))
+			routeTokenInAmount, err := k.estimateRouteExactAmountOut(ctx, route.Pools, routeTokenOut)
+			if err != nil {
+				// the route is valid, so it lacks the liquidity to swap for the part
+				continue
+			}
+			if cheapestRoute == -1 || routeTokenInAmount.Sub(tokenInAmounts[i]).LT(cheapestTokenInAmount.Sub(tokenInAmounts[cheapestRoute])) {
+				cheapestRoute, cheapestTokenInAmount = i, routeTokenInAmount
+			}
+		}
+		if cheapestRoute == -1 {
+			return nil, nil, types.ErrInvalidSplitRoutes.Wrapf("no route can swap for %s of the remaining %s%s", stepAmount, remaining, tokenOut.Denom)
+		}
+
+		tokenOutAmounts[cheapestRoute] = tokenOutAmounts[cheapestRoute].Add(stepAmount)
+		tokenInAmounts[cheapestRoute] = cheapestTokenInAmount
+		remaining = remaining.Sub(stepAmount)
+	}
+
+	return tokenInAmounts, tokenOutAmounts, nil
+}
+
+// sumInts returns the sum of the given amounts.
+func sumInts(amounts []sdk.Int) sdk.Int {
+	sum := sdk.ZeroInt()
+	for _, amount := range amounts {
+		sum = sum.Add(amount)
+	}
+	return sum
+}
+
+// validateSplitRoute returns an error if a pool of the route cannot be swapped on, or does not
+// hold the denoms swapped through it.
+func (k Keeper) validateSplitRoute(ctx sdk.Context, routes []types.SwapAmountOutRoute, tokenOutDenom string) error {
+	for i := len(routes) - 1; i >= 0; i-- {
+		route := routes[i]
+		_, pool, err := k.getPoolForSwap(ctx, route.PoolId)
+		if err != nil {
+			return err
+		}
+		liquidity := pool.GetTotalPoolLiquidity(ctx)
+		for _, denom := range []string{route.TokenInDenom, tokenOutDenom} {
+			if !liquidity.AmountOf(denom).IsPositive() {
+				return types.ErrInvalidSplitRoutes.Wrapf("pool %d does not hold %s", route.PoolId, denom)
+			}
+		}
+		tokenOutDenom = route.TokenInDenom
+	}
+	return nil
+}
+
+// estimateRouteExactAmountOut returns the token in amount RouteExactAmountOut would require to swap
+// for tokenOut through the given route, without executing the swap.
+func (k Keeper) estimateRouteExactAmountOut(ctx sdk.Context, routes []types.SwapAmountOutRoute, tokenOut sdk.Coin) (sdk.Int, error) {
+	swapFeeMultiplier := sdk.OneDec()
+	if types.SwapAmountOutRoutes(routes).IsOsmoRoutedMultihop() {
+		swapFeeMultiplier = gammtypes.MultihopSwapFeeMultiplierForOsmoPools.Clone()
+	}
+
+	insExpected, err := k.createMultihopExpectedSwapOuts(ctx, routes, tokenOut, swapFeeMultiplier)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return insExpected[0], nil
+}
+
+// createMultihopExpectedSwapOuts defines the output denom and output amount for the last pool in
+// the route of pools the caller is intending to hop through in a fixed-output multihop tx. It estimates the input
+// amount for this last pool and then chains that input as the output of the previous pool in the route, repeating
+// until the first pool is reached. It returns an array of inputs, each of which correspond to a pool ID in the
+// route of pools for the original multihop transaction.
+func (k Keeper) createMultihopExpectedSwapOuts(
+	ctx sdk.Context,
+	routes []types.SwapAmountOutRoute,
+	tokenOut sdk.Coin, swapFeeMultiplier sdk.Dec,
+) ([]sdk.Int, error) {
+	insExpected := make([]sdk.Int, len(routes))
+	for i := len(routes) - 1; i >= 0; i-- {
+		route := routes[i]
+
+		_, pool, err := k.getPoolForSwap(ctx, route.PoolId)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenIn, err := pool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), route.TokenInDenom, pool.GetSwapFee(ctx).Mul(swapFeeMultiplier))
+		if err != nil {
+			return nil, err
+		}
+
+		insExpected[i] = tokenIn.Amount
+		tokenOut = tokenIn
+	}
+
+	return insExpected, nil
+}
@@ -0,0 +1,407 @@
+package swaprouter_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
+)
+
+// osmoRoutedSwapFeeMultiplier is the share of the swap fee paid by both pools of a two hop
+// route through OSMO.
+var osmoRoutedSwapFeeMultiplier = sdk.NewDecWithPrec(5, 1)
+
+// prepareOsmoRoutedPools creates two pools charging a swap fee, foo/bar/baz/uosmo and uosmo/baz,
+// through which foo can be swapped for baz via OSMO.
+func (suite *KeeperTestSuite) prepareOsmoRoutedPools() {
+	poolParams := balancer.PoolParams{SwapFee: sdk.NewDecWithPrec(1, 2), ExitFee: sdk.ZeroDec()}
+	suite.PrepareBalancerPoolWithPoolParams(poolParams)
+
+	poolAssets := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("uosmo", 1_000_000)},
+		{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("baz", 1_000_000)},
+	}
+	msg := balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], poolParams, poolAssets, "")
+	_, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, msg)
+	suite.Require().NoError(err)
+}
+
+// TestRouteExactAmountIn checks that routed swaps give the same results as swapping
+// through every pool of the route in turn, at a discounted swap fee for routes through OSMO.
+func (suite *KeeperTestSuite) TestRouteExactAmountIn() {
+	tests := map[string]struct {
+		// osmoRoutedPools creates the pools of prepareOsmoRoutedPools after the swap pools.
+		osmoRoutedPools   bool
+		routes            []types.SwapAmountInRoute
+		tokenIn           sdk.Coin
+		tokenOutMinAmount sdk.Int
+		expectErr         bool
+	}{
+		"single hop": {
+			routes:            []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}},
+			tokenIn:           sdk.NewInt64Coin("foo", 100_000),
+			tokenOutMinAmount: sdk.OneInt(),
+		},
+		"two hops": {
+			routes:            []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}, {PoolId: 2, TokenOutDenom: "baz"}},
+			tokenIn:           sdk.NewInt64Coin("foo", 100_000),
+			tokenOutMinAmount: sdk.OneInt(),
+		},
+		"two hops through osmo": {
+			osmoRoutedPools:   true,
+			routes:            []types.SwapAmountInRoute{{PoolId: 3, TokenOutDenom: "uosmo"}, {PoolId: 4, TokenOutDenom: "baz"}},
+			tokenIn:           sdk.NewInt64Coin("foo", 100_000),
+			tokenOutMinAmount: sdk.OneInt(),
+		},
+		"token out below min amount": {
+			routes:            []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}},
+			tokenIn:           sdk.NewInt64Coin("foo", 100_000),
+			tokenOutMinAmount: sdk.NewInt(1_000_000),
+			expectErr:         true,
+		},
+		"pool without route": {
+			routes:            []types.SwapAmountInRoute{{PoolId: 3, TokenOutDenom: "bar"}},
+			tokenIn:           sdk.NewInt64Coin("foo", 100_000),
+			tokenOutMinAmount: sdk.OneInt(),
+			expectErr:         true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.prepareSwapPools()
+			sender := suite.TestAccs[1]
+
+			swapFeeMultiplier := sdk.OneDec()
+			if tc.osmoRoutedPools {
+				suite.prepareOsmoRoutedPools()
+				swapFeeMultiplier = osmoRoutedSwapFeeMultiplier
+			}
+
+			// Every hop swaps the output of the previous one through a different pool,
+			// so the expected output can be computed from the pools before the swap.
+			expectedOut, expectedErr := sdk.Int{}, error(nil)
+			hopTokenIn := tc.tokenIn
+			for _, route := range tc.routes {
+				pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, route.PoolId)
+				if err != nil {
+					expectedErr = err
+					break
+				}
+				swapFee := pool.GetSwapFee(suite.Ctx).Mul(swapFeeMultiplier)
+				hopTokenOut, err := pool.CalcOutAmtGivenIn(suite.Ctx, sdk.NewCoins(hopTokenIn), route.TokenOutDenom, swapFee)
+				if err != nil {
+					expectedErr = err
+					break
+				}
+				expectedOut, hopTokenIn = hopTokenOut.Amount, hopTokenOut
+			}
+
+			tokenOutDenom := tc.routes[len(tc.routes)-1].TokenOutDenom
+			balanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, tokenOutDenom)
+
+			tokenOutAmount, err := suite.App.SwapRouterKeeper.RouteExactAmountIn(suite.Ctx, sender, tc.routes, tc.tokenIn, tc.tokenOutMinAmount)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(expectedErr)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedOut, tokenOutAmount)
+
+			balanceAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, tokenOutDenom)
+			suite.Require().Equal(tokenOutAmount, balanceAfter.Amount.Sub(balanceBefore.Amount))
+		})
+	}
+}
+
+// TestRouteExactAmountOut checks that routed swaps give the same results as swapping
+// through every pool of the route in turn, starting from the last pool, at a discounted
+// swap fee for routes through OSMO.
+func (suite *KeeperTestSuite) TestRouteExactAmountOut() {
+	tests := map[string]struct {
+		// osmoRoutedPools creates the pools of prepareOsmoRoutedPools after the swap pools.
+		osmoRoutedPools  bool
+		routes           []types.SwapAmountOutRoute
+		tokenInMaxAmount sdk.Int
+		tokenOut         sdk.Coin
+		expectErr        bool
+	}{
+		"single hop": {
+			routes:           []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "foo"}},
+			tokenInMaxAmount: sdk.NewInt(1_000_000),
+			tokenOut:         sdk.NewInt64Coin("bar", 100_000),
+		},
+		"two hops": {
+			routes:           []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "foo"}, {PoolId: 2, TokenInDenom: "bar"}},
+			tokenInMaxAmount: sdk.NewInt(1_000_000),
+			tokenOut:         sdk.NewInt64Coin("baz", 100_000),
+		},
+		"two hops through osmo": {
+			osmoRoutedPools:  true,
+			routes:           []types.SwapAmountOutRoute{{PoolId: 3, TokenInDenom: "foo"}, {PoolId: 4, TokenInDenom: "uosmo"}},
+			tokenInMaxAmount: sdk.NewInt(1_000_000),
+			tokenOut:         sdk.NewInt64Coin("baz", 100_000),
+		},
+		"token in above max amount": {
+			routes:           []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "foo"}},
+			tokenInMaxAmount: sdk.NewInt(1),
+			tokenOut:         sdk.NewInt64Coin("bar", 100_000),
+			expectErr:        true,
+		},
+		"pool without route": {
+			routes:           []types.SwapAmountOutRoute{{PoolId: 3, TokenInDenom: "foo"}},
+			tokenInMaxAmount: sdk.NewInt(1_000_000),
+			tokenOut:         sdk.NewInt64Coin("bar", 100_000),
+			expectErr:        true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.prepareSwapPools()
+			sender := suite.TestAccs[1]
+
+			swapFeeMultiplier := sdk.OneDec()
+			if tc.osmoRoutedPools {
+				suite.prepareOsmoRoutedPools()
+				swapFeeMultiplier = osmoRoutedSwapFeeMultiplier
+			}
+
+			// Every hop swaps for the input of the next one through a different pool,
+			// so the expected input can be computed from the pools before the swap.
+			expectedIn, expectedErr := sdk.Int{}, error(nil)
+			hopTokenOut := tc.tokenOut
+			for i := len(tc.routes) - 1; i >= 0; i-- {
+				route := tc.routes[i]
+				pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, route.PoolId)
+				if err != nil {
+					expectedErr = err
+					break
+				}
+				swapFee := pool.GetSwapFee(suite.Ctx).Mul(swapFeeMultiplier)
+				hopTokenIn, err := pool.CalcInAmtGivenOut(suite.Ctx, sdk.NewCoins(hopTokenOut), route.TokenInDenom, swapFee)
+				if err != nil {
+					expectedErr = err
+					break
+				}
+				expectedIn, hopTokenOut = hopTokenIn.Amount, hopTokenIn
+			}
+
+			tokenInAmount, err := suite.App.SwapRouterKeeper.RouteExactAmountOut(suite.Ctx, sender, tc.routes, tc.tokenInMaxAmount, tc.tokenOut)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(expectedErr)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedIn, tokenInAmount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSplitRouteExactAmountIn() {
+	fooToBar := []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}}
+	fooToBarViaBaz := []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "baz"}, {PoolId: 2, TokenOutDenom: "bar"}}
+
+	tests := map[string]struct {
+		routes            []types.SwapAmountInSplitRoute
+		tokenOutMinAmount sdk.Int
+		expectErr         error
+	}{
+		"two routes": {
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: fooToBar, TokenInAmount: sdk.NewInt(100_000)},
+				{Pools: fooToBarViaBaz, TokenInAmount: sdk.NewInt(50_000)},
+			},
+			tokenOutMinAmount: sdk.OneInt(),
+		},
+		"summed token out below min amount": {
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: fooToBar, TokenInAmount: sdk.NewInt(100_000)},
+				{Pools: fooToBarViaBaz, TokenInAmount: sdk.NewInt(50_000)},
+			},
+			tokenOutMinAmount: sdk.NewInt(1_000_000),
+			expectErr:         types.ErrLimitMinAmount,
+		},
+		"routes ending in different denoms": {
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: fooToBar, TokenInAmount: sdk.NewInt(100_000)},
+				{Pools: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "baz"}}, TokenInAmount: sdk.NewInt(50_000)},
+			},
+			tokenOutMinAmount: sdk.OneInt(),
+			expectErr:         types.ErrInvalidSplitRoutes,
+		},
+		"no routes": {
+			routes:            []types.SwapAmountInSplitRoute{},
+			tokenOutMinAmount: sdk.OneInt(),
+			expectErr:         types.ErrEmptyRoutes,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.prepareSwapPools()
+			sender := suite.TestAccs[1]
+
+			// The split route must give the sum of swapping each route separately, in order.
+			cacheCtx, _ := suite.Ctx.CacheContext()
+			expectedOut := sdk.ZeroInt()
+			for _, route := range tc.routes {
+				out, err := suite.App.SwapRouterKeeper.RouteExactAmountIn(cacheCtx, sender, route.Pools, sdk.NewCoin("foo", route.TokenInAmount), sdk.OneInt())
+				if err == nil {
+					expectedOut = expectedOut.Add(out)
+				}
+			}
+
+			tokenOutAmount, err := suite.App.SwapRouterKeeper.SplitRouteExactAmountIn(suite.Ctx, sender, tc.routes, "foo", tc.tokenOutMinAmount)
+			if tc.expectErr != nil {
+				suite.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedOut, tokenOutAmount)
+			suite.Require().Equal(sdk.NewInt(10_000_000).Sub(sdk.NewInt(150_000)).String(),
+				suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "foo").Amount.String())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSplitRouteExactAmountOut() {
+	fooToBar := types.SwapAmountOutSplitRoute{Pools: []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "foo"}}}
+	// pool 3 is a shallower foo/bar pool created by the test, starting at the same price as pool 1.
+	fooToBarThroughPool3 := types.SwapAmountOutSplitRoute{Pools: []types.SwapAmountOutRoute{{PoolId: 3, TokenInDenom: "foo"}}}
+
+	tests := map[string]struct {
+		routes           []types.SwapAmountOutSplitRoute
+		tokenInMaxAmount sdk.Int
+		tokenOut         sdk.Coin
+		// expectedUsedRoutes is the number of routes expected to swap part of the token out.
+		expectedUsedRoutes int
+		expectErr          error
+	}{
+		"single route": {
+			routes:             []types.SwapAmountOutSplitRoute{fooToBar},
+			tokenInMaxAmount:   sdk.NewInt(1_000_000),
+			tokenOut:           sdk.NewInt64Coin("bar", 100_000),
+			expectedUsedRoutes: 1,
+		},
+		"two routes": {
+			routes:             []types.SwapAmountOutSplitRoute{fooToBar, fooToBarThroughPool3},
+			tokenInMaxAmount:   sdk.NewInt(1_000_000),
+			tokenOut:           sdk.NewInt64Coin("bar", 100_000),
+			expectedUsedRoutes: 2,
+		},
+		"token out below the number of steps": {
+			routes:           []types.SwapAmountOutSplitRoute{fooToBar, fooToBarThroughPool3},
+			tokenInMaxAmount: sdk.NewInt(1_000_000),
+			tokenOut:         sdk.NewInt64Coin("bar", 7),
+			// ties in token in go to the first route
+			expectedUsedRoutes: 1,
+		},
+		"cyclic route refunding the token out denom": {
+			routes: []types.SwapAmountOutSplitRoute{{Pools: []types.SwapAmountOutRoute{
+				{PoolId: 1, TokenInDenom: "foo"},
+				{PoolId: 3, TokenInDenom: "bar"},
+			}}},
+			tokenInMaxAmount:   sdk.NewInt(1_000_000),
+			tokenOut:           sdk.NewInt64Coin("foo", 100_000),
+			expectedUsedRoutes: 1,
+		},
+		"route through a nonexistent pool": {
+			routes:           []types.SwapAmountOutSplitRoute{fooToBar, {Pools: []types.SwapAmountOutRoute{{PoolId: 10, TokenInDenom: "foo"}}}},
+			tokenInMaxAmount: sdk.NewInt(1_000_000),
+			tokenOut:         sdk.NewInt64Coin("bar", 100_000),
+			expectErr:        types.PoolRouteNotFoundError{PoolId: 10},
+		},
+		"route through a pool without the token in denom": {
+			routes:           []types.SwapAmountOutSplitRoute{fooToBar, {Pools: []types.SwapAmountOutRoute{{PoolId: 2, TokenInDenom: "foo"}}}},
+			tokenInMaxAmount: sdk.NewInt(1_000_000),
+			tokenOut:         sdk.NewInt64Coin("bar", 100_000),
+			expectErr:        types.ErrInvalidSplitRoutes,
+		},
+		"summed token in above max amount": {
+			routes:           []types.SwapAmountOutSplitRoute{fooToBar, fooToBarThroughPool3},
+			tokenInMaxAmount: sdk.NewInt(1_000),
+			tokenOut:         sdk.NewInt64Coin("bar", 100_000),
+			expectErr:        types.ErrLimitMaxAmount,
+		},
+		"routes sharing a pool": {
+			routes:           []types.SwapAmountOutSplitRoute{fooToBar, fooToBar},
+			tokenInMaxAmount: sdk.NewInt(1_000_000),
+			tokenOut:         sdk.NewInt64Coin("bar", 100_000),
+			expectErr:        types.ErrInvalidSplitRoutes,
+		},
+		"routes overlapping in a pool": {
+			routes: []types.SwapAmountOutSplitRoute{fooToBar, {Pools: []types.SwapAmountOutRoute{
+				{PoolId: 1, TokenInDenom: "foo"},
+				{PoolId: 2, TokenInDenom: "baz"},
+			}}},
+			tokenInMaxAmount: sdk.NewInt(1_000_000),
+			tokenOut:         sdk.NewInt64Coin("bar", 100_000),
+			expectErr:        types.ErrInvalidSplitRoutes,
+		},
+		"no routes": {
+			routes:           []types.SwapAmountOutSplitRoute{},
+			tokenInMaxAmount: sdk.NewInt(1_000_000),
+			tokenOut:         sdk.NewInt64Coin("bar", 100_000),
+			expectErr:        types.ErrEmptyRoutes,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.prepareSwapPools()
+			suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 2_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+			sender := suite.TestAccs[1]
+			balances := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+
+			estimatedIn, estimatedOuts, estimateErr := suite.App.SwapRouterKeeper.EstimateSplitRouteExactAmountOut(suite.Ctx, tc.routes, tc.tokenOut)
+
+			tokenInAmount, tokenOutAmounts, err := suite.App.SwapRouterKeeper.SplitRouteExactAmountOut(suite.Ctx, sender, tc.routes, tc.tokenInMaxAmount, tc.tokenOut)
+			if tc.expectErr != nil {
+				suite.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			suite.Require().NoError(estimateErr)
+			suite.Require().NoError(err)
+			suite.Require().Equal(estimatedIn, tokenInAmount)
+			suite.Require().Equal(estimatedOuts, tokenOutAmounts)
+
+			// The token out is fully allocated, and split across the routes when cheaper.
+			totalOut, usedRoutes := sdk.ZeroInt(), 0
+			for _, tokenOutAmount := range tokenOutAmounts {
+				suite.Require().False(tokenOutAmount.IsNegative())
+				if tokenOutAmount.IsPositive() {
+					usedRoutes++
+				}
+				totalOut = totalOut.Add(tokenOutAmount)
+			}
+			suite.Require().Equal(tc.tokenOut.Amount, totalOut)
+			suite.Require().Equal(tc.expectedUsedRoutes, usedRoutes)
+
+			// The sender only pays the token in of the swap, the rest of the escrow is refunded.
+			tokenIn := sdk.NewCoin(tc.routes[0].TokenInDenom(), tokenInAmount)
+			suite.Require().Equal(balances.Sub(sdk.NewCoins(tokenIn)).Add(tc.tokenOut), suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender))
+			suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, authtypes.NewModuleAddress(types.ModuleName)).IsZero())
+		})
+	}
+
+	// Splitting across both routes is cheaper than swapping through any of them alone.
+	suite.SetupTest()
+	suite.prepareSwapPools()
+	suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 2_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+	tokenOut := sdk.NewInt64Coin("bar", 100_000)
+	splitIn, _, err := suite.App.SwapRouterKeeper.EstimateSplitRouteExactAmountOut(suite.Ctx, []types.SwapAmountOutSplitRoute{fooToBar, fooToBarThroughPool3}, tokenOut)
+	suite.Require().NoError(err)
+	for _, route := range []types.SwapAmountOutSplitRoute{fooToBar, fooToBarThroughPool3} {
+		routeIn, _, err := suite.App.SwapRouterKeeper.EstimateSplitRouteExactAmountOut(suite.Ctx, []types.SwapAmountOutSplitRoute{route}, tokenOut)
+		suite.Require().NoError(err)
+		suite.Require().True(splitIn.LT(routeIn), "split %s, single route %s", splitIn, routeIn)
+	}
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	fmt "fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type PoolRouteNotFoundError struct {
+	PoolId uint64
+}
+
+func (e PoolRouteNotFoundError) Error() string {
+	return fmt.Sprintf("no module route found for pool with ID %d", e.PoolId)
+}
+
+type UnknownPoolModuleError struct {
+	ModuleName string
+}
+
+func (e UnknownPoolModuleError) Error() string {
+	return fmt.Sprintf("module %s is not registered as a swap router pool module", e.ModuleName)
+}
+
+// x/swaprouter module sentinel errors.
+var (
+	ErrEmptyRoutes         = sdkerrors.Register(ModuleName, 2, "routes not defined")
+	ErrLimitMinAmount      = sdkerrors.Register(ModuleName, 3, "calculated amount is lesser than min amount")
+	ErrNotPositiveCriteria = sdkerrors.Register(ModuleName, 4, "min out token amount or max in token amount should be positive")
+	ErrInvalidSplitRoutes  = sdkerrors.Register(ModuleName, 5, "invalid split routes")
+	ErrLimitMaxAmount      = sdkerrors.Register(ModuleName, 6, "calculated amount is larger than max amount")
+)
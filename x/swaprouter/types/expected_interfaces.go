@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+)
+
+// SwapI is the interface a pool module must implement for x/swaprouter to
+// route swaps through its pools. Any module whose pools implement
+// gammtypes.PoolI can register itself as a pool module.
+type SwapI interface {
+	// GetPoolForSwap returns the pool with the given id, erroring if it
+	// does not exist or cannot be swapped against.
+	GetPoolForSwap(ctx sdk.Context, poolId uint64) (gammtypes.PoolI, error)
+
+	// RouteExactAmountIn swaps tokenIn for at least tokenOutMinAmount of
+	// tokenOutDenom through the given pool, charging the given swap fee.
+	RouteExactAmountIn(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		pool gammtypes.PoolI,
+		tokenIn sdk.Coin,
+		tokenOutDenom string,
+		tokenOutMinAmount sdk.Int,
+		swapFee sdk.Dec,
+	) (sdk.Int, error)
+
+	// RouteExactAmountOut swaps at most tokenInMaxAmount of tokenInDenom for
+	// tokenOut through the given pool, charging the given swap fee.
+	RouteExactAmountOut(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		pool gammtypes.PoolI,
+		tokenInDenom string,
+		tokenInMaxAmount sdk.Int,
+		tokenOut sdk.Coin,
+		swapFee sdk.Dec,
+	) (sdk.Int, error)
+}
+
+// BankKeeper defines the contract needed to escrow the token in of split route
+// exact amount out swaps.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default swaprouter genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		PoolRoutes: []ModuleRoute{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenPoolIds := make(map[uint64]bool, len(gs.PoolRoutes))
+	for _, route := range gs.PoolRoutes {
+		if route.ModuleName == "" {
+			return fmt.Errorf("module route for pool %d has an empty module name", route.PoolId)
+		}
+		if seenPoolIds[route.PoolId] {
+			return fmt.Errorf("duplicate module route for pool %d", route.PoolId)
+		}
+		seenPoolIds[route.PoolId] = true
+	}
+	return nil
+}
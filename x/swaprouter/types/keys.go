@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName = "swaprouter"
+
+	StoreKey = ModuleName
+
+	RouterKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
+
+var (
+	// PoolRoutePrefix defines prefix key for the module route of a pool.
+	PoolRoutePrefix = []byte{0x01}
+)
+
+// FormatPoolRouteKey returns the key of the module route of the given pool.
+func FormatPoolRouteKey(poolId uint64) []byte {
+	return append(PoolRoutePrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
@@ -0,0 +1,134 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/osmosis-labs/osmosis/v12/app/params"
+)
+
+type SwapAmountInRoutes []SwapAmountInRoute
+
+// IsOsmoRoutedMultihop returns true if the route is a two hop route through OSMO,
+// in which case the swap fee of both pools is discounted.
+func (routes SwapAmountInRoutes) IsOsmoRoutedMultihop() bool {
+	return len(routes) == 2 && (routes[0].TokenOutDenom == appparams.BaseCoinUnit)
+}
+
+func (routes SwapAmountInRoutes) Validate() error {
+	if len(routes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	for _, route := range routes {
+		err := sdk.ValidateDenom(route.TokenOutDenom)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type SwapAmountOutRoutes []SwapAmountOutRoute
+
+// IsOsmoRoutedMultihop returns true if the route is a two hop route through OSMO,
+// in which case the swap fee of both pools is discounted.
+func (routes SwapAmountOutRoutes) IsOsmoRoutedMultihop() bool {
+	return len(routes) == 2 && (routes[1].TokenInDenom == appparams.BaseCoinUnit)
+}
+
+func (routes SwapAmountOutRoutes) Validate() error {
+	if len(routes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	for _, route := range routes {
+		err := sdk.ValidateDenom(route.TokenInDenom)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type SwapAmountInSplitRoutes []SwapAmountInSplitRoute
+
+// Validate checks that every split route is a valid route with a positive
+// input amount, and that all routes end in the same denom.
+func (routes SwapAmountInSplitRoutes) Validate() error {
+	if len(routes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	tokenOutDenom := ""
+	for i, route := range routes {
+		if err := SwapAmountInRoutes(route.Pools).Validate(); err != nil {
+			return err
+		}
+
+		if route.TokenInAmount.IsNil() || !route.TokenInAmount.IsPositive() {
+			return ErrInvalidSplitRoutes.Wrapf("route %d has a non-positive token in amount", i)
+		}
+
+		routeTokenOutDenom := route.TokenOutDenom()
+		if tokenOutDenom != "" && routeTokenOutDenom != tokenOutDenom {
+			return ErrInvalidSplitRoutes.Wrapf("route %d ends in %s, expected %s", i, routeTokenOutDenom, tokenOutDenom)
+		}
+		tokenOutDenom = routeTokenOutDenom
+	}
+
+	return nil
+}
+
+// TokenOutDenom returns the denom the split route ends in.
+func (route SwapAmountInSplitRoute) TokenOutDenom() string {
+	return route.Pools[len(route.Pools)-1].TokenOutDenom
+}
+
+// TotalTokenInAmount returns the sum of the token in amounts of all split routes.
+func (routes SwapAmountInSplitRoutes) TotalTokenInAmount() sdk.Int {
+	total := sdk.ZeroInt()
+	for _, route := range routes {
+		total = total.Add(route.TokenInAmount)
+	}
+	return total
+}
+
+type SwapAmountOutSplitRoutes []SwapAmountOutSplitRoute
+
+// Validate checks that every split route is a valid route, that all routes start
+// in the same denom, and that no pool is part of more than one route.
+func (routes SwapAmountOutSplitRoutes) Validate() error {
+	if len(routes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	tokenInDenom := ""
+	routedPools := make(map[uint64]bool)
+	for i, route := range routes {
+		if err := SwapAmountOutRoutes(route.Pools).Validate(); err != nil {
+			return err
+		}
+
+		routeTokenInDenom := route.TokenInDenom()
+		if tokenInDenom != "" && routeTokenInDenom != tokenInDenom {
+			return ErrInvalidSplitRoutes.Wrapf("route %d starts in %s, expected %s", i, routeTokenInDenom, tokenInDenom)
+		}
+		tokenInDenom = routeTokenInDenom
+
+		for _, pool := range route.Pools {
+			if routedPools[pool.PoolId] {
+				return ErrInvalidSplitRoutes.Wrapf("pool %d is part of more than one route", pool.PoolId)
+			}
+			routedPools[pool.PoolId] = true
+		}
+	}
+
+	return nil
+}
+
+// TokenInDenom returns the denom the split route starts in.
+func (route SwapAmountOutSplitRoute) TokenInDenom() string {
+	return route.Pools[0].TokenInDenom
+}
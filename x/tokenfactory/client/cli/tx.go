@@ -28,7 +28,7 @@ func GetTxCmd() *cobra.Command {
 		NewCreateDenomCmd(),
 		NewMintCmd(),
 		NewBurnCmd(),
-		// NewForceTransferCmd(),
+		NewForceTransferCmd(),
 		NewChangeAdminCmd(),
 	)
 
@@ -97,9 +97,12 @@ func NewMintCmd() *cobra.Command {
 // NewBurnCmd broadcast MsgBurn
 func NewBurnCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "burn [amount] [flags]",
+		Use:   "burn [amount] [burn-from-address] [flags]",
 		Short: "Burn tokens from an address. Must have admin authority to do so.",
-		Args:  cobra.ExactArgs(1),
+		Long: `Burn tokens from an address. Must have admin authority to do so.
+If burn-from-address is omitted, the tokens are burned from the sender's account.
+Burning from any other address requires the force transfer and burn from param to be enabled.`,
+		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -113,9 +116,15 @@ func NewBurnCmd() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgBurn(
+			burnFromAddress := ""
+			if len(args) > 1 {
+				burnFromAddress = args[1]
+			}
+
+			msg := types.NewMsgBurnFrom(
 				clientCtx.GetFromAddress().String(),
 				amount,
+				burnFromAddress,
 			)
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
@@ -126,39 +135,39 @@ func NewBurnCmd() *cobra.Command {
 	return cmd
 }
 
-// // NewForceTransferCmd broadcast MsgForceTransfer
-// func NewForceTransferCmd() *cobra.Command {
-// 	cmd := &cobra.Command{
-// 		Use:   "force-transfer [amount] [transfer-from-address] [transfer-to-address] [flags]",
-// 		Short: "Force transfer tokens from one address to another address. Must have admin authority to do so.",
-// 		Args:  cobra.ExactArgs(3),
-// 		RunE: func(cmd *cobra.Command, args []string) error {
-// 			clientCtx, err := client.GetClientTxContext(cmd)
-// 			if err != nil {
-// 				return err
-// 			}
-
-// 			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
-
-// 			amount, err := sdk.ParseCoinNormalized(args[0])
-// 			if err != nil {
-// 				return err
-// 			}
-
-// 			msg := types.NewMsgForceTransfer(
-// 				clientCtx.GetFromAddress().String(),
-// 				amount,
-// 				args[1],
-// 				args[2],
-// 			)
-
-// 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
-// 		},
-// 	}
-
-// 	flags.AddTxFlagsToCmd(cmd)
-// 	return cmd
-// }
+// NewForceTransferCmd broadcast MsgForceTransfer
+func NewForceTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-transfer [amount] [transfer-from-address] [transfer-to-address] [flags]",
+		Short: "Force transfer tokens from one address to another address. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgForceTransfer(
+				clientCtx.GetFromAddress().String(),
+				amount,
+				args[1],
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
 
 // NewChangeAdminCmd broadcast MsgChangeAdmin
 func NewChangeAdminCmd() *cobra.Command {
@@ -87,7 +87,16 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 		return nil, types.ErrUnauthorized
 	}
 
-	err = server.Keeper.burnFrom(ctx, msg.Amount, msg.Sender)
+	// burning from any account other than the sender's is an admin privilege
+	// that has to be enabled via params.
+	burnFromAddress := msg.BurnFromAddress
+	if burnFromAddress == "" {
+		burnFromAddress = msg.Sender
+	} else if burnFromAddress != msg.Sender && !server.Keeper.GetParams(ctx).EnableForceTransferAndBurnFrom {
+		return nil, types.ErrForceTransferDisabled
+	}
+
+	err = server.Keeper.burnFrom(ctx, msg.Amount, burnFromAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +104,7 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeMsgBurn,
-			sdk.NewAttribute(types.AttributeBurnFromAddress, msg.Sender),
+			sdk.NewAttribute(types.AttributeBurnFromAddress, burnFromAddress),
 			sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
 		),
 	})
@@ -103,34 +112,38 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 	return &types.MsgBurnResponse{}, nil
 }
 
-// func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
-// 	ctx := sdk.UnwrapSDKContext(goCtx)
-
-// 	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Amount.GetDenom())
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	if msg.Sender != authorityMetadata.GetAdmin() {
-// 		return nil, types.ErrUnauthorized
-// 	}
-
-// 	err = server.Keeper.forceTransfer(ctx, msg.Amount, msg.TransferFromAddress, msg.TransferToAddress)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	ctx.EventManager().EmitEvents(sdk.Events{
-// 		sdk.NewEvent(
-// 			types.TypeMsgForceTransfer,
-// 			sdk.NewAttribute(types.AttributeTransferFromAddress, msg.TransferFromAddress),
-// 			sdk.NewAttribute(types.AttributeTransferToAddress, msg.TransferToAddress),
-// 			sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
-// 		),
-// 	})
-
-// 	return &types.MsgForceTransferResponse{}, nil
-// }
+func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !server.Keeper.GetParams(ctx).EnableForceTransferAndBurnFrom {
+		return nil, types.ErrForceTransferDisabled
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Amount.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.forceTransfer(ctx, msg.Amount, msg.TransferFromAddress, msg.TransferToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgForceTransfer,
+			sdk.NewAttribute(types.AttributeTransferFromAddress, msg.TransferFromAddress),
+			sdk.NewAttribute(types.AttributeTransferToAddress, msg.TransferToAddress),
+			sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
+		),
+	})
+
+	return &types.MsgForceTransferResponse{}, nil
+}
 
 func (server msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -89,6 +89,154 @@ func (suite *KeeperTestSuite) TestBurnDenomMsg() {
 	}
 }
 
+// TestBurnFromMsg tests burning from accounts other than the admin's own
+func (suite *KeeperTestSuite) TestBurnFromMsg() {
+	for _, tc := range []struct {
+		desc              string
+		enableBurnFrom    bool
+		adminIndex        int
+		burnFromIndex     int
+		fromModuleAccount bool
+		expectedErr       error
+	}{
+		{
+			desc:           "burn from own account with burn from disabled",
+			enableBurnFrom: false,
+			adminIndex:     0,
+			burnFromIndex:  0,
+		},
+		{
+			desc:           "burn from other account with burn from disabled",
+			enableBurnFrom: false,
+			adminIndex:     0,
+			burnFromIndex:  1,
+			expectedErr:    types.ErrForceTransferDisabled,
+		},
+		{
+			desc:           "burn from other account with burn from enabled",
+			enableBurnFrom: true,
+			adminIndex:     0,
+			burnFromIndex:  1,
+		},
+		{
+			desc:           "non-admins can't burn from other accounts",
+			enableBurnFrom: true,
+			adminIndex:     1,
+			burnFromIndex:  1,
+			expectedErr:    types.ErrUnauthorized,
+		},
+		{
+			desc:              "burn from module account",
+			enableBurnFrom:    true,
+			adminIndex:        0,
+			fromModuleAccount: true,
+			expectedErr:       types.ErrBurnFromModuleAccount,
+		},
+	} {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			suite.SetupTest()
+			suite.CreateDefaultDenom()
+			params := suite.App.TokenFactoryKeeper.GetParams(suite.Ctx)
+			params.EnableForceTransferAndBurnFrom = tc.enableBurnFrom
+			suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params)
+
+			// fund the account to burn from with 10 default token
+			burnFromAddr := suite.TestAccs[tc.burnFromIndex]
+			coins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+			if tc.fromModuleAccount {
+				burnFromAddr = suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+				suite.FundModuleAcc(types.ModuleName, coins)
+			} else {
+				suite.FundAcc(burnFromAddr, coins)
+			}
+
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			msg := types.NewMsgBurnFrom(suite.TestAccs[tc.adminIndex].String(), sdk.NewInt64Coin(suite.defaultDenom, 10), burnFromAddr.String())
+			_, err := suite.msgServer.Burn(sdk.WrapSDKContext(ctx), msg)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				suite.Require().Equal(coins, suite.App.BankKeeper.GetAllBalances(suite.Ctx, burnFromAddr).FilterDenoms([]string{suite.defaultDenom}))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, burnFromAddr, suite.defaultDenom).IsZero())
+			suite.AssertEventEmitted(ctx, types.TypeMsgBurn, 1)
+		})
+	}
+}
+
+// TestForceTransferMsg tests TypeMsgForceTransfer message is emitted on a successful force transfer
+func (suite *KeeperTestSuite) TestForceTransferMsg() {
+	for _, tc := range []struct {
+		desc                string
+		enableForceTransfer bool
+		adminIndex          int
+		fromIndex           int
+		fromModuleAccount   bool
+		expectedErr         error
+	}{
+		{
+			desc:                "force transfer disabled",
+			enableForceTransfer: false,
+			adminIndex:          0,
+			fromIndex:           1,
+			expectedErr:         types.ErrForceTransferDisabled,
+		},
+		{
+			desc:                "success case",
+			enableForceTransfer: true,
+			adminIndex:          0,
+			fromIndex:           1,
+		},
+		{
+			desc:                "non-admins can't force transfer",
+			enableForceTransfer: true,
+			adminIndex:          1,
+			fromIndex:           1,
+			expectedErr:         types.ErrUnauthorized,
+		},
+		{
+			desc:                "force transfer from module account",
+			enableForceTransfer: true,
+			adminIndex:          0,
+			fromModuleAccount:   true,
+			expectedErr:         types.ErrBurnFromModuleAccount,
+		},
+	} {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			suite.SetupTest()
+			suite.CreateDefaultDenom()
+			params := suite.App.TokenFactoryKeeper.GetParams(suite.Ctx)
+			params.EnableForceTransferAndBurnFrom = tc.enableForceTransfer
+			suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params)
+
+			// fund the account to transfer from with 10 default token
+			fromAddr := suite.TestAccs[tc.fromIndex]
+			toAddr := suite.TestAccs[2]
+			coins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+			if tc.fromModuleAccount {
+				fromAddr = suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+				suite.FundModuleAcc(types.ModuleName, coins)
+			} else {
+				suite.FundAcc(fromAddr, coins)
+			}
+
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			msg := types.NewMsgForceTransfer(suite.TestAccs[tc.adminIndex].String(), sdk.NewInt64Coin(suite.defaultDenom, 10), fromAddr.String(), toAddr.String())
+			_, err := suite.msgServer.ForceTransfer(sdk.WrapSDKContext(ctx), msg)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, toAddr, suite.defaultDenom).IsZero())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, fromAddr, suite.defaultDenom).IsZero())
+			suite.Require().Equal(int64(10), suite.App.BankKeeper.GetBalance(suite.Ctx, toAddr, suite.defaultDenom).Amount.Int64())
+			suite.AssertEventEmitted(ctx, types.TypeMsgForceTransfer, 1)
+		})
+	}
+}
+
 // TestCreateDenomMsg tests TypeMsgCreateDenom message is emitted on a successful denom creation
 func (suite *KeeperTestSuite) TestCreateDenomMsg() {
 	defaultDenomCreationFee := types.Params{DenomCreationFee: sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(50000000)))}
@@ -19,4 +19,6 @@ var (
 	ErrSubdenomTooLong          = sdkerrors.Register(ModuleName, 8, fmt.Sprintf("subdenom too long, max length is %d bytes", MaxSubdenomLength))
 	ErrCreatorTooLong           = sdkerrors.Register(ModuleName, 9, fmt.Sprintf("creator too long, max length is %d bytes", MaxCreatorLength))
 	ErrDenomDoesNotExist        = sdkerrors.Register(ModuleName, 10, "denom does not exist")
+	ErrForceTransferDisabled    = sdkerrors.Register(ModuleName, 11, "force transfer and burn from other accounts are disabled")
+	ErrBurnFromModuleAccount    = sdkerrors.Register(ModuleName, 12, "force transfer and burn from module accounts are not allowed")
 )
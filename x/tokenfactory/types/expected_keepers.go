@@ -24,6 +24,7 @@ type BankKeeper interface {
 
 type AccountKeeper interface {
 	SetModuleAccount(ctx sdk.Context, macc authtypes.ModuleAccountI)
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 }
 
 // CommunityPoolKeeper defines the contract needed to be fulfilled for community pool interactions.
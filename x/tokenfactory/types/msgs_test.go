@@ -50,6 +50,15 @@ func TestAuthzMsg(t *testing.T) {
 				Amount: coin,
 			},
 		},
+		{
+			name: "MsgForceTransfer",
+			msg: &types.MsgForceTransfer{
+				Sender:              addr1,
+				Amount:              coin,
+				TransferFromAddress: "osmo1q8tq5qhrhw6t970egemuuwywhlhpnmdmts6xnu",
+				TransferToAddress:   addr1,
+			},
+		},
 		{
 			name: "MsgChangeAdmin",
 			msg: &types.MsgChangeAdmin{
@@ -274,6 +283,136 @@ func TestMsgBurn(t *testing.T) {
 	}
 }
 
+// TestMsgBurnFrom tests if valid/invalid burn from messages are properly validated/invalidated
+func TestMsgBurnFrom(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+
+	tests := []struct {
+		name            string
+		burnFromAddress string
+		expectPass      bool
+	}{
+		{
+			name:            "empty burn from address",
+			burnFromAddress: "",
+			expectPass:      true,
+		},
+		{
+			name:            "burn from sender",
+			burnFromAddress: addr1.String(),
+			expectPass:      true,
+		},
+		{
+			name:            "burn from other account",
+			burnFromAddress: addr2.String(),
+			expectPass:      true,
+		},
+		{
+			name:            "invalid burn from address",
+			burnFromAddress: "invalid",
+			expectPass:      false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := types.NewMsgBurnFrom(addr1.String(), sdk.NewCoin("bitcoin", sdk.NewInt(500000000)), test.burnFromAddress)
+		if test.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgForceTransfer tests if valid/invalid force transfer messages are properly validated/invalidated
+func TestMsgForceTransfer(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	pk3 := ed25519.GenPrivKey().PubKey()
+	addr3 := sdk.AccAddress(pk3.Address())
+
+	// make a proper force transfer message
+	baseMsg := types.NewMsgForceTransfer(
+		addr1.String(),
+		sdk.NewCoin("bitcoin", sdk.NewInt(500000000)),
+		addr2.String(),
+		addr3.String(),
+	)
+
+	// validate force transfer message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "force_transfer")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgForceTransfer
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgForceTransfer {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgForceTransfer {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid transfer from address",
+			msg: func() *types.MsgForceTransfer {
+				msg := *baseMsg
+				msg.TransferFromAddress = "invalid"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid transfer to address",
+			msg: func() *types.MsgForceTransfer {
+				msg := *baseMsg
+				msg.TransferToAddress = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: func() *types.MsgForceTransfer {
+				msg := *baseMsg
+				msg.Amount = sdk.NewCoin("bitcoin", sdk.ZeroInt())
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 // TestMsgChangeAdmin tests if valid/invalid create denom messages are properly validated/invalidated
 func TestMsgChangeAdmin(t *testing.T) {
 	// generate a private/public key pair and get the respective address
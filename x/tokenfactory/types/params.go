@@ -11,7 +11,8 @@ import (
 
 // Parameter store keys.
 var (
-	KeyDenomCreationFee = []byte("DenomCreationFee")
+	KeyDenomCreationFee               = []byte("DenomCreationFee")
+	KeyEnableForceTransferAndBurnFrom = []byte("EnableForceTransferAndBurnFrom")
 )
 
 // ParamTable for gamm module.
@@ -19,16 +20,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(denomCreationFee sdk.Coins) Params {
+func NewParams(denomCreationFee sdk.Coins, enableForceTransferAndBurnFrom bool) Params {
 	return Params{
-		DenomCreationFee: denomCreationFee,
+		DenomCreationFee:               denomCreationFee,
+		EnableForceTransferAndBurnFrom: enableForceTransferAndBurnFrom,
 	}
 }
 
 // default gamm module parameters.
 func DefaultParams() Params {
 	return Params{
-		DenomCreationFee: sdk.NewCoins(sdk.NewInt64Coin(appparams.BaseCoinUnit, 10_000_000)), // 10 OSMO
+		DenomCreationFee:               sdk.NewCoins(sdk.NewInt64Coin(appparams.BaseCoinUnit, 10_000_000)), // 10 OSMO
+		EnableForceTransferAndBurnFrom: false,
 	}
 }
 
@@ -38,6 +41,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateEnableForceTransferAndBurnFrom(p.EnableForceTransferAndBurnFrom); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -45,6 +52,7 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyDenomCreationFee, &p.DenomCreationFee, validateDenomCreationFee),
+		paramtypes.NewParamSetPair(KeyEnableForceTransferAndBurnFrom, &p.EnableForceTransferAndBurnFrom, validateEnableForceTransferAndBurnFrom),
 	}
 }
 
@@ -60,3 +68,12 @@ func validateDenomCreationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateEnableForceTransferAndBurnFrom(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
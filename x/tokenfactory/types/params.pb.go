@@ -29,6 +29,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the tokenfactory module.
 type Params struct {
 	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee" yaml:"denom_creation_fee"`
+	// enable_force_transfer_and_burn_from allows denom admins to transfer and
+	// burn their denom from arbitrary accounts via MsgForceTransfer and MsgBurn.
+	EnableForceTransferAndBurnFrom bool `protobuf:"varint,2,opt,name=enable_force_transfer_and_burn_from,json=enableForceTransferAndBurnFrom,proto3" json:"enable_force_transfer_and_burn_from,omitempty" yaml:"enable_force_transfer_and_burn_from"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -71,6 +74,13 @@ func (m *Params) GetDenomCreationFee() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
+func (m *Params) GetEnableForceTransferAndBurnFrom() bool {
+	if m != nil {
+		return m.EnableForceTransferAndBurnFrom
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.tokenfactory.v1beta1.Params")
 }
@@ -80,27 +90,31 @@ func init() {
 }
 
 var fileDescriptor_cc8299d306f3ff47 = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0x63, 0x7d, 0x52, 0x87, 0x7e, 0x0b, 0xaa, 0x18, 0x68, 0x85, 0x1c, 0x94, 0xa9, 0x0c,
-	0xb5, 0x95, 0xc2, 0x80, 0x18, 0x5b, 0x89, 0xad, 0x12, 0xea, 0xc8, 0x12, 0xdd, 0x24, 0x6e, 0x6a,
-	0xb5, 0xc9, 0x8d, 0x62, 0xb7, 0x22, 0x6f, 0xc1, 0xc4, 0xce, 0xca, 0x93, 0x74, 0xec, 0xc8, 0x54,
-	0x50, 0xf2, 0x06, 0x3c, 0x01, 0xaa, 0xe3, 0xa2, 0x22, 0x24, 0x26, 0xfb, 0xe8, 0x9e, 0xf3, 0xbb,
-	0x7f, 0xda, 0x97, 0xa8, 0x52, 0x54, 0x52, 0x71, 0x8d, 0x0b, 0x91, 0xcd, 0x20, 0xd2, 0x58, 0x94,
-	0x7c, 0xed, 0x87, 0x42, 0x83, 0xcf, 0x73, 0x28, 0x20, 0x55, 0x2c, 0x2f, 0x50, 0x63, 0xe7, 0xdc,
-	0x5a, 0xd9, 0xb1, 0x95, 0x59, 0x6b, 0xef, 0x34, 0xc1, 0x04, 0x8d, 0x91, 0xef, 0x7f, 0x4d, 0xa6,
-	0x77, 0xfd, 0x27, 0x1e, 0x56, 0x7a, 0x8e, 0x85, 0xd4, 0xe5, 0x44, 0x68, 0x88, 0x41, 0x83, 0x4d,
-	0x75, 0x23, 0x13, 0x0b, 0x1a, 0x5c, 0x23, 0x6c, 0x89, 0x36, 0x8a, 0x87, 0xa0, 0xc4, 0x37, 0x27,
-	0x42, 0x99, 0x35, 0x75, 0xef, 0x85, 0xb4, 0x5b, 0xf7, 0x66, 0xea, 0xce, 0x33, 0x69, 0x77, 0x62,
-	0x91, 0x61, 0x1a, 0x44, 0x85, 0x00, 0x2d, 0x31, 0x0b, 0x66, 0x42, 0x9c, 0x91, 0x8b, 0x7f, 0xfd,
-	0xff, 0xc3, 0x2e, 0xb3, 0xd8, 0x3d, 0xe8, 0xb0, 0x04, 0x1b, 0xa3, 0xcc, 0x46, 0x93, 0xcd, 0xce,
-	0x75, 0x3e, 0x77, 0x6e, 0xb7, 0x84, 0x74, 0x79, 0xeb, 0xfd, 0x46, 0x78, 0xaf, 0xef, 0x6e, 0x3f,
-	0x91, 0x7a, 0xbe, 0x0a, 0x59, 0x84, 0xa9, 0x1d, 0xd0, 0x3e, 0x03, 0x15, 0x2f, 0xb8, 0x2e, 0x73,
-	0xa1, 0x0c, 0x4d, 0x4d, 0x4f, 0x0c, 0x60, 0x6c, 0xf3, 0x77, 0x42, 0x8c, 0xa6, 0x9b, 0x8a, 0x92,
-	0x6d, 0x45, 0xc9, 0x47, 0x45, 0xc9, 0x53, 0x4d, 0x9d, 0x6d, 0x4d, 0x9d, 0xb7, 0x9a, 0x3a, 0x0f,
-	0x37, 0x47, 0x54, 0x7b, 0xb9, 0xc1, 0x12, 0x42, 0x75, 0x10, 0x7c, 0xed, 0x0f, 0xf9, 0xe3, 0xcf,
-	0x63, 0x9a, 0x5e, 0x61, 0xcb, 0xac, 0x7f, 0xf5, 0x15, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x89, 0xb8,
-	0xda, 0xd0, 0x01, 0x00, 0x00,
+	// 372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x6a, 0xea, 0x40,
+	0x14, 0x86, 0x13, 0x2f, 0xc8, 0x25, 0x77, 0x73, 0x09, 0x5d, 0xa8, 0x94, 0x89, 0xa4, 0x1b, 0x5b,
+	0x30, 0x83, 0xb6, 0x8b, 0xd2, 0x5d, 0x23, 0xb8, 0x13, 0x8a, 0x74, 0xd5, 0xcd, 0x30, 0x49, 0x26,
+	0x1a, 0x34, 0x73, 0x64, 0x66, 0x22, 0x0d, 0x7d, 0x89, 0xae, 0xba, 0xec, 0x03, 0xf4, 0x49, 0x5c,
+	0xba, 0xec, 0xca, 0x16, 0x7d, 0x03, 0x9f, 0xa0, 0x98, 0x8c, 0xc5, 0x52, 0x28, 0x5d, 0x25, 0x87,
+	0xf3, 0xfd, 0x1f, 0xe7, 0xcc, 0xb1, 0x4e, 0x41, 0xa6, 0x20, 0x13, 0x89, 0x15, 0x4c, 0x18, 0x8f,
+	0x69, 0xa8, 0x40, 0xe4, 0x78, 0xde, 0x09, 0x98, 0xa2, 0x1d, 0x3c, 0xa3, 0x82, 0xa6, 0xd2, 0x9b,
+	0x09, 0x50, 0x60, 0x1f, 0x6b, 0xd4, 0x3b, 0x44, 0x3d, 0x8d, 0x36, 0x8e, 0x46, 0x30, 0x82, 0x02,
+	0xc4, 0xbb, 0xbf, 0x32, 0xd3, 0xb8, 0xf8, 0x51, 0x4f, 0x33, 0x35, 0x06, 0x91, 0xa8, 0x7c, 0xc0,
+	0x14, 0x8d, 0xa8, 0xa2, 0x3a, 0x55, 0x0f, 0x8b, 0x18, 0x29, 0x75, 0x65, 0xa1, 0x5b, 0xa8, 0xac,
+	0x70, 0x40, 0x25, 0xfb, 0xf4, 0x84, 0x90, 0xf0, 0xb2, 0xef, 0x3e, 0x57, 0xac, 0xea, 0x4d, 0x31,
+	0xb5, 0xfd, 0x64, 0x5a, 0x76, 0xc4, 0x38, 0xa4, 0x24, 0x14, 0x8c, 0xaa, 0x04, 0x38, 0x89, 0x19,
+	0xab, 0x99, 0xcd, 0x3f, 0xad, 0x7f, 0xdd, 0xba, 0xa7, 0xb5, 0x3b, 0xd1, 0x7e, 0x09, 0xaf, 0x07,
+	0x09, 0xf7, 0x07, 0x8b, 0x95, 0x63, 0x6c, 0x57, 0x4e, 0x3d, 0xa7, 0xe9, 0xf4, 0xca, 0xfd, 0xae,
+	0x70, 0x5f, 0xde, 0x9c, 0xd6, 0x28, 0x51, 0xe3, 0x2c, 0xf0, 0x42, 0x48, 0xf5, 0x80, 0xfa, 0xd3,
+	0x96, 0xd1, 0x04, 0xab, 0x7c, 0xc6, 0x64, 0x61, 0x93, 0xc3, 0xff, 0x85, 0xa0, 0xa7, 0xf3, 0x7d,
+	0xc6, 0xec, 0x07, 0xeb, 0x84, 0x71, 0x1a, 0x4c, 0x19, 0x89, 0x41, 0x84, 0x8c, 0x28, 0x41, 0xb9,
+	0x8c, 0x99, 0x20, 0x94, 0x47, 0x24, 0xc8, 0x04, 0x27, 0xb1, 0x80, 0xb4, 0x56, 0x69, 0x9a, 0xad,
+	0xbf, 0xbe, 0xb7, 0x5d, 0x39, 0x67, 0xe5, 0x24, 0xbf, 0x08, 0xb9, 0x43, 0x54, 0x52, 0xfd, 0x1d,
+	0x74, 0xab, 0x99, 0x6b, 0x1e, 0xf9, 0x99, 0xe0, 0x7d, 0x01, 0xa9, 0x3f, 0x5c, 0xac, 0x91, 0xb9,
+	0x5c, 0x23, 0xf3, 0x7d, 0x8d, 0xcc, 0xc7, 0x0d, 0x32, 0x96, 0x1b, 0x64, 0xbc, 0x6e, 0x90, 0x71,
+	0x77, 0x79, 0xb0, 0x92, 0x3e, 0x5b, 0x7b, 0x4a, 0x03, 0xb9, 0x2f, 0xf0, 0xbc, 0xd3, 0xc5, 0xf7,
+	0x5f, 0x2f, 0x59, 0x2c, 0x1a, 0x54, 0x8b, 0xb7, 0x3f, 0xff, 0x18, 0x00, 0x01, 0xd2, 0x90, 0x5e,
+	0x4d, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -123,6 +137,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EnableForceTransferAndBurnFrom {
+		i--
+		if m.EnableForceTransferAndBurnFrom {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.DenomCreationFee) > 0 {
 		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -163,6 +187,9 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.EnableForceTransferAndBurnFrom {
+		n += 2
+	}
 	return n
 }
 
@@ -235,6 +262,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableForceTransferAndBurnFrom", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableForceTransferAndBurnFrom = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
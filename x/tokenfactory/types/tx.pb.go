@@ -229,10 +229,13 @@ func (m *MsgMintResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgMintResponse proto.InternalMessageInfo
 
 // MsgBurn is the sdk.Msg type for allowing an admin account to burn
-// a token.  For now, we only support burning from the sender account.
+// a token. If burn_from_address is empty or equal to the sender, the tokens are
+// burned from the sender account. Burning from any other account is only
+// allowed if enable_force_transfer_and_burn_from is set in the module params.
 type MsgBurn struct {
-	Sender string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+	Sender          string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Amount          types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+	BurnFromAddress string     `protobuf:"bytes,3,opt,name=burn_from_address,json=burnFromAddress,proto3" json:"burn_from_address,omitempty" yaml:"burn_from_address"`
 }
 
 func (m *MsgBurn) Reset()         { *m = MsgBurn{} }
@@ -282,6 +285,13 @@ func (m *MsgBurn) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgBurn) GetBurnFromAddress() string {
+	if m != nil {
+		return m.BurnFromAddress
+	}
+	return ""
+}
+
 type MsgBurnResponse struct {
 }
 
@@ -418,6 +428,115 @@ func (m *MsgChangeAdminResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgChangeAdminResponse proto.InternalMessageInfo
 
+// MsgForceTransfer is the sdk.Msg type for allowing an admin account to
+// transfer a token from one account to another. It is only allowed if
+// enable_force_transfer_and_burn_from is set in the module params.
+type MsgForceTransfer struct {
+	Sender              string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Amount              types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+	TransferFromAddress string     `protobuf:"bytes,3,opt,name=transferFromAddress,proto3" json:"transferFromAddress,omitempty" yaml:"transfer_from_address"`
+	TransferToAddress   string     `protobuf:"bytes,4,opt,name=transferToAddress,proto3" json:"transferToAddress,omitempty" yaml:"transfer_to_address"`
+}
+
+func (m *MsgForceTransfer) Reset()         { *m = MsgForceTransfer{} }
+func (m *MsgForceTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgForceTransfer) ProtoMessage()    {}
+func (*MsgForceTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{8}
+}
+func (m *MsgForceTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceTransfer.Merge(m, src)
+}
+func (m *MsgForceTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceTransfer proto.InternalMessageInfo
+
+func (m *MsgForceTransfer) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgForceTransfer) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgForceTransfer) GetTransferFromAddress() string {
+	if m != nil {
+		return m.TransferFromAddress
+	}
+	return ""
+}
+
+func (m *MsgForceTransfer) GetTransferToAddress() string {
+	if m != nil {
+		return m.TransferToAddress
+	}
+	return ""
+}
+
+// MsgForceTransferResponse defines the response structure for an executed
+// MsgForceTransfer message.
+type MsgForceTransferResponse struct {
+}
+
+func (m *MsgForceTransferResponse) Reset()         { *m = MsgForceTransferResponse{} }
+func (m *MsgForceTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForceTransferResponse) ProtoMessage()    {}
+func (*MsgForceTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{9}
+}
+func (m *MsgForceTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceTransferResponse.Merge(m, src)
+}
+func (m *MsgForceTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceTransferResponse proto.InternalMessageInfo
+
 // MsgSetDenomMetadata is the sdk.Msg type for allowing an admin account to set
 // the denom's bank metadata
 type MsgSetDenomMetadata struct {
@@ -429,7 +548,7 @@ func (m *MsgSetDenomMetadata) Reset()         { *m = MsgSetDenomMetadata{} }
 func (m *MsgSetDenomMetadata) String() string { return proto.CompactTextString(m) }
 func (*MsgSetDenomMetadata) ProtoMessage()    {}
 func (*MsgSetDenomMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_283b6c9a90a846b4, []int{8}
+	return fileDescriptor_283b6c9a90a846b4, []int{10}
 }
 func (m *MsgSetDenomMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,7 +600,7 @@ func (m *MsgSetDenomMetadataResponse) Reset()         { *m = MsgSetDenomMetadata
 func (m *MsgSetDenomMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetDenomMetadataResponse) ProtoMessage()    {}
 func (*MsgSetDenomMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_283b6c9a90a846b4, []int{9}
+	return fileDescriptor_283b6c9a90a846b4, []int{11}
 }
 func (m *MsgSetDenomMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,6 +638,8 @@ func init() {
 	proto.RegisterType((*MsgBurnResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgBurnResponse")
 	proto.RegisterType((*MsgChangeAdmin)(nil), "osmosis.tokenfactory.v1beta1.MsgChangeAdmin")
 	proto.RegisterType((*MsgChangeAdminResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgChangeAdminResponse")
+	proto.RegisterType((*MsgForceTransfer)(nil), "osmosis.tokenfactory.v1beta1.MsgForceTransfer")
+	proto.RegisterType((*MsgForceTransferResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgForceTransferResponse")
 	proto.RegisterType((*MsgSetDenomMetadata)(nil), "osmosis.tokenfactory.v1beta1.MsgSetDenomMetadata")
 	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgSetDenomMetadataResponse")
 }
@@ -528,44 +649,52 @@ func init() {
 }
 
 var fileDescriptor_283b6c9a90a846b4 = []byte{
-	// 593 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x41, 0x6e, 0xd3, 0x40,
-	0x14, 0x8d, 0x69, 0x29, 0xe9, 0x94, 0x92, 0xd4, 0x2d, 0x25, 0x18, 0x6a, 0xa3, 0x91, 0x8a, 0x40,
-	0xa2, 0xb6, 0x12, 0x58, 0x00, 0x3b, 0x5c, 0x16, 0x6c, 0xbc, 0x31, 0xac, 0x50, 0xa5, 0x6a, 0x9c,
-	0x0c, 0xae, 0x95, 0x78, 0x26, 0x64, 0x26, 0x4d, 0xb3, 0x41, 0x1c, 0x81, 0x05, 0xe2, 0x10, 0x9c,
-	0xa4, 0xcb, 0x2e, 0x59, 0x59, 0x28, 0xb9, 0x41, 0x4e, 0x80, 0x3c, 0x33, 0x76, 0x9c, 0x06, 0x91,
-	0x64, 0xc5, 0x2e, 0xf6, 0x7f, 0xef, 0xcd, 0x9b, 0xf7, 0xff, 0x8f, 0xc1, 0x21, 0x65, 0x31, 0x65,
-	0x11, 0x73, 0x38, 0x6d, 0x63, 0xf2, 0x09, 0x35, 0x39, 0xed, 0x0d, 0x9d, 0xf3, 0x7a, 0x80, 0x39,
-	0xaa, 0x3b, 0xfc, 0xc2, 0xee, 0xf6, 0x28, 0xa7, 0xfa, 0x43, 0x05, 0xb3, 0x8b, 0x30, 0x5b, 0xc1,
-	0x8c, 0xbd, 0x90, 0x86, 0x54, 0x00, 0x9d, 0xf4, 0x97, 0xe4, 0x18, 0x66, 0x53, 0x90, 0x9c, 0x00,
-	0x31, 0x9c, 0x2b, 0x36, 0x69, 0x44, 0xe6, 0xea, 0xa4, 0x9d, 0xd7, 0xd3, 0x07, 0x59, 0x87, 0x1d,
-	0x70, 0xc7, 0x63, 0xe1, 0x71, 0x0f, 0x23, 0x8e, 0xdf, 0x62, 0x42, 0x63, 0xfd, 0x29, 0xd8, 0x60,
-	0x98, 0xb4, 0x70, 0xaf, 0xa6, 0x3d, 0xd2, 0x9e, 0x6c, 0xba, 0x3b, 0x93, 0xc4, 0xda, 0x1e, 0xa2,
-	0xb8, 0xf3, 0x1a, 0xca, 0xf7, 0xd0, 0x57, 0x00, 0xdd, 0x01, 0x65, 0xd6, 0x0f, 0x5a, 0x29, 0xad,
-	0x76, 0x43, 0x80, 0x77, 0x27, 0x89, 0x55, 0x51, 0x60, 0x55, 0x81, 0x7e, 0x0e, 0x82, 0x27, 0x60,
-	0x7f, 0xf6, 0x34, 0x1f, 0xb3, 0x2e, 0x25, 0x0c, 0xeb, 0x2e, 0xa8, 0x10, 0x3c, 0x38, 0x15, 0x37,
-	0x3f, 0x95, 0x8a, 0xf2, 0x78, 0x63, 0x92, 0x58, 0xfb, 0x52, 0xf1, 0x1a, 0x00, 0xfa, 0xdb, 0x04,
-	0x0f, 0x3e, 0xa4, 0x2f, 0x84, 0x16, 0xfc, 0x02, 0x6e, 0x79, 0x2c, 0xf4, 0x22, 0xc2, 0x57, 0xb9,
-	0xc4, 0x3b, 0xb0, 0x81, 0x62, 0xda, 0x27, 0x5c, 0x5c, 0x61, 0xab, 0x71, 0xdf, 0x96, 0x91, 0xd9,
-	0x69, 0xa4, 0x59, 0xfa, 0xf6, 0x31, 0x8d, 0x88, 0x7b, 0xf7, 0x32, 0xb1, 0x4a, 0x53, 0x25, 0x49,
-	0x83, 0xbe, 0xe2, 0xc3, 0x1d, 0x50, 0x51, 0xe7, 0x67, 0xd7, 0x52, 0x96, 0xdc, 0x7e, 0x8f, 0xfc,
-	0x4f, 0x4b, 0xe9, 0xf9, 0xb9, 0xa5, 0x1f, 0x9a, 0x6c, 0xf9, 0x19, 0x22, 0x21, 0x7e, 0xd3, 0x8a,
-	0xa3, 0x95, 0xac, 0x3d, 0x06, 0x37, 0x8b, 0xfd, 0xae, 0x4e, 0x12, 0xeb, 0xb6, 0x44, 0xaa, 0x9e,
-	0xc8, 0xb2, 0x5e, 0x07, 0x9b, 0x69, 0xbb, 0x50, 0xaa, 0x5f, 0x5b, 0x13, 0xd8, 0xbd, 0x49, 0x62,
-	0x55, 0xa7, 0x9d, 0x14, 0x25, 0xe8, 0x97, 0x09, 0x1e, 0x08, 0x17, 0xb0, 0x26, 0x87, 0x63, 0xea,
-	0x2b, 0xb7, 0xfc, 0x5d, 0x03, 0xbb, 0x1e, 0x0b, 0xdf, 0x63, 0x2e, 0x1a, 0xed, 0x61, 0x8e, 0x5a,
-	0x88, 0xa3, 0x55, 0x7c, 0xfb, 0xa0, 0x1c, 0x2b, 0x9a, 0x0a, 0xf5, 0x60, 0x1a, 0x2a, 0x69, 0xe7,
-	0xa1, 0x66, 0xda, 0xee, 0x3d, 0x15, 0xac, 0x9a, 0xe6, 0x8c, 0x0c, 0xfd, 0x5c, 0x07, 0x1e, 0x80,
-	0x07, 0x7f, 0x71, 0x95, 0xb9, 0x6e, 0xfc, 0x5c, 0x07, 0x6b, 0x1e, 0x0b, 0xf5, 0xcf, 0x60, 0xab,
-	0xb8, 0x5f, 0xcf, 0xec, 0x7f, 0xad, 0xb9, 0x3d, 0xbb, 0x1f, 0xc6, 0x8b, 0x55, 0xd0, 0xf9, 0x36,
-	0x9d, 0x80, 0x75, 0xb1, 0x06, 0x87, 0x0b, 0xd9, 0x29, 0xcc, 0x38, 0x5a, 0x0a, 0x56, 0x54, 0x17,
-	0x13, 0xbd, 0x58, 0x3d, 0x85, 0x2d, 0xa1, 0x5e, 0x9c, 0x4f, 0x11, 0x57, 0x61, 0x36, 0x97, 0x88,
-	0x6b, 0x8a, 0x5e, 0x26, 0xae, 0xf9, 0xf9, 0xd2, 0xbf, 0x6a, 0xa0, 0x3a, 0x37, 0x5c, 0xf5, 0x85,
-	0x52, 0xd7, 0x29, 0xc6, 0xab, 0x95, 0x29, 0x99, 0x05, 0xd7, 0xbf, 0x1c, 0x99, 0xda, 0xd5, 0xc8,
-	0xd4, 0x7e, 0x8f, 0x4c, 0xed, 0xdb, 0xd8, 0x2c, 0x5d, 0x8d, 0xcd, 0xd2, 0xaf, 0xb1, 0x59, 0xfa,
-	0xf8, 0x32, 0x8c, 0xf8, 0x59, 0x3f, 0xb0, 0x9b, 0x34, 0x76, 0x94, 0xfc, 0x51, 0x07, 0x05, 0x2c,
-	0x7b, 0x70, 0xce, 0xeb, 0x0d, 0xe7, 0x62, 0xf6, 0xd3, 0xc2, 0x87, 0x5d, 0xcc, 0x82, 0x0d, 0xf1,
-	0x17, 0xff, 0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x60, 0x4c, 0x1d, 0x7f, 0x06, 0x00,
+	// 721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x4e, 0xdb, 0x40,
+	0x10, 0x8e, 0xf9, 0x2b, 0x2c, 0xa5, 0x49, 0x0c, 0xa5, 0xa9, 0x0b, 0x36, 0x5a, 0x89, 0xaa, 0x95,
+	0x8a, 0xad, 0x50, 0x54, 0xb5, 0xbd, 0x11, 0x2a, 0xc4, 0xa1, 0xb9, 0xb8, 0x9c, 0x2a, 0xa4, 0x68,
+	0x9d, 0x2c, 0x26, 0x02, 0xef, 0x52, 0xef, 0x86, 0xc0, 0xa5, 0xea, 0x23, 0xf4, 0x50, 0xf5, 0x05,
+	0x7a, 0xea, 0x9b, 0x70, 0xe4, 0xd8, 0x93, 0x55, 0xc1, 0x1b, 0xf8, 0x09, 0x2a, 0xef, 0xae, 0x9d,
+	0x3f, 0xd4, 0xe0, 0x13, 0xb7, 0x78, 0xe6, 0xfb, 0xbe, 0x99, 0xf9, 0x76, 0x3d, 0x31, 0x58, 0xa7,
+	0x2c, 0xa0, 0xac, 0xcd, 0x1c, 0x4e, 0x8f, 0x31, 0x39, 0x44, 0x4d, 0x4e, 0xc3, 0x0b, 0xe7, 0xac,
+	0xea, 0x61, 0x8e, 0xaa, 0x0e, 0x3f, 0xb7, 0x4f, 0x43, 0xca, 0xa9, 0xbe, 0xa2, 0x60, 0x76, 0x3f,
+	0xcc, 0x56, 0x30, 0x63, 0xc9, 0xa7, 0x3e, 0x15, 0x40, 0x27, 0xf9, 0x25, 0x39, 0x86, 0xd9, 0x14,
+	0x24, 0xc7, 0x43, 0x0c, 0x67, 0x8a, 0x4d, 0xda, 0x26, 0x23, 0x79, 0x72, 0x9c, 0xe5, 0x93, 0x07,
+	0x99, 0x87, 0x27, 0xe0, 0x51, 0x9d, 0xf9, 0x3b, 0x21, 0x46, 0x1c, 0x7f, 0xc0, 0x84, 0x06, 0xfa,
+	0x4b, 0x30, 0xc3, 0x30, 0x69, 0xe1, 0xb0, 0xa2, 0xad, 0x69, 0x2f, 0xe6, 0x6a, 0xe5, 0x38, 0xb2,
+	0x16, 0x2e, 0x50, 0x70, 0xf2, 0x1e, 0xca, 0x38, 0x74, 0x15, 0x40, 0x77, 0xc0, 0x2c, 0xeb, 0x78,
+	0xad, 0x84, 0x56, 0x99, 0x10, 0xe0, 0xc5, 0x38, 0xb2, 0x8a, 0x0a, 0xac, 0x32, 0xd0, 0xcd, 0x40,
+	0xf0, 0x00, 0x2c, 0x0f, 0x56, 0x73, 0x31, 0x3b, 0xa5, 0x84, 0x61, 0xbd, 0x06, 0x8a, 0x04, 0x77,
+	0x1b, 0x62, 0xf2, 0x86, 0x54, 0x94, 0xe5, 0x8d, 0x38, 0xb2, 0x96, 0xa5, 0xe2, 0x10, 0x00, 0xba,
+	0x0b, 0x04, 0x77, 0xf7, 0x93, 0x80, 0xd0, 0x82, 0x5f, 0xc1, 0x83, 0x3a, 0xf3, 0xeb, 0x6d, 0xc2,
+	0xf3, 0x0c, 0xb1, 0x07, 0x66, 0x50, 0x40, 0x3b, 0x84, 0x8b, 0x11, 0xe6, 0x37, 0x9f, 0xda, 0xd2,
+	0x32, 0x3b, 0xb1, 0x34, 0x75, 0xdf, 0xde, 0xa1, 0x6d, 0x52, 0x7b, 0x7c, 0x19, 0x59, 0x85, 0x9e,
+	0x92, 0xa4, 0x41, 0x57, 0xf1, 0x61, 0x19, 0x14, 0x55, 0xfd, 0x74, 0x2c, 0x78, 0xa9, 0x89, 0x9e,
+	0x6a, 0x9d, 0x90, 0xdc, 0x4b, 0x4f, 0xfa, 0x1e, 0x28, 0x7b, 0x9d, 0x90, 0x34, 0x0e, 0x43, 0x1a,
+	0x34, 0x50, 0xab, 0x15, 0x62, 0xc6, 0x2a, 0x93, 0xa2, 0xfe, 0x4a, 0x1c, 0x59, 0x15, 0xc9, 0x1a,
+	0x81, 0x40, 0xb7, 0x98, 0xc4, 0x76, 0x43, 0x1a, 0x6c, 0xab, 0x88, 0x9c, 0x2e, 0x99, 0x24, 0x9b,
+	0xee, 0xa7, 0x26, 0x6f, 0xcf, 0x11, 0x22, 0x3e, 0xde, 0x6e, 0x05, 0xed, 0x5c, 0x43, 0x3e, 0x07,
+	0xd3, 0xfd, 0x57, 0xa7, 0x14, 0x47, 0xd6, 0x43, 0x89, 0x54, 0xc7, 0x2b, 0xd3, 0x7a, 0x15, 0xcc,
+	0x25, 0x27, 0x8f, 0x12, 0x7d, 0xd5, 0xfa, 0x52, 0x1c, 0x59, 0xa5, 0xde, 0xa5, 0x10, 0x29, 0xe8,
+	0xce, 0x12, 0xdc, 0x15, 0x5d, 0xc0, 0x0a, 0x58, 0x1e, 0xec, 0x2b, 0x6b, 0xf9, 0xf7, 0x04, 0x28,
+	0xd5, 0x99, 0xbf, 0x4b, 0xc3, 0x26, 0xde, 0x0f, 0x11, 0x61, 0x87, 0x38, 0xbc, 0x9f, 0x93, 0x71,
+	0xc1, 0x22, 0x57, 0x0d, 0xf4, 0xd9, 0xac, 0x06, 0x5c, 0x8b, 0x23, 0x6b, 0x45, 0xf2, 0x52, 0xd0,
+	0xd0, 0xf9, 0xdc, 0x46, 0xd6, 0x3f, 0x82, 0x72, 0x1a, 0xde, 0xa7, 0xa9, 0xe2, 0x94, 0x50, 0x34,
+	0xe3, 0xc8, 0x32, 0x86, 0x14, 0x39, 0xed, 0xe9, 0x8d, 0x12, 0xa1, 0x01, 0x2a, 0xc3, 0x56, 0x65,
+	0x3e, 0xfe, 0xd0, 0xc0, 0x62, 0x9d, 0xf9, 0x9f, 0x30, 0x17, 0xef, 0x5e, 0x1d, 0x73, 0xd4, 0x42,
+	0x1c, 0xe5, 0xb1, 0xd2, 0x05, 0xb3, 0x81, 0xa2, 0x29, 0x33, 0x57, 0x7b, 0x66, 0x92, 0xe3, 0xcc,
+	0xcc, 0x54, 0xbb, 0xf6, 0x44, 0x19, 0xaa, 0x16, 0x4c, 0x4a, 0x86, 0x6e, 0xa6, 0x03, 0x57, 0xc1,
+	0xb3, 0x5b, 0xba, 0x4a, 0xbb, 0xde, 0xfc, 0x35, 0x0d, 0x26, 0xeb, 0xcc, 0xd7, 0xbf, 0x80, 0xf9,
+	0xfe, 0x95, 0xf7, 0xca, 0xfe, 0xdf, 0xe6, 0xb5, 0x07, 0x57, 0x96, 0xb1, 0x95, 0x07, 0x9d, 0x2d,
+	0xb8, 0x03, 0x30, 0x25, 0x36, 0xd3, 0xfa, 0x58, 0x76, 0x02, 0x33, 0x36, 0xee, 0x04, 0xeb, 0x57,
+	0x17, 0x3b, 0x66, 0xbc, 0x7a, 0x02, 0x33, 0x36, 0xee, 0x04, 0xcb, 0xd4, 0x13, 0xbb, 0xfa, 0xde,
+	0xf1, 0x3b, 0xd8, 0xd5, 0x43, 0x1b, 0x5b, 0x79, 0xd0, 0x59, 0xc9, 0x6f, 0x1a, 0x28, 0x8d, 0x5c,
+	0xae, 0xea, 0x58, 0xa9, 0x61, 0x8a, 0xf1, 0x2e, 0x37, 0x25, 0x6b, 0xa1, 0x0b, 0x16, 0x06, 0xd7,
+	0x84, 0x3d, 0x56, 0x6b, 0x00, 0x6f, 0xbc, 0xc9, 0x87, 0x4f, 0x0b, 0xd7, 0xdc, 0xcb, 0x6b, 0x53,
+	0xbb, 0xba, 0x36, 0xb5, 0xbf, 0xd7, 0xa6, 0xf6, 0xfd, 0xc6, 0x2c, 0x5c, 0xdd, 0x98, 0x85, 0x3f,
+	0x37, 0x66, 0xe1, 0xf3, 0x5b, 0xbf, 0xcd, 0x8f, 0x3a, 0x9e, 0xdd, 0xa4, 0x81, 0xa3, 0xb4, 0x37,
+	0x4e, 0x90, 0xc7, 0xd2, 0x07, 0xe7, 0xac, 0xba, 0xe9, 0x9c, 0x0f, 0x7e, 0x66, 0xf0, 0x8b, 0x53,
+	0xcc, 0xbc, 0x19, 0xf1, 0x77, 0xff, 0xfa, 0xdf, 0x00, 0xda, 0xe4, 0x54, 0x89, 0x8b, 0x08, 0x00,
 	0x00,
 }
 
@@ -586,6 +715,7 @@ type MsgClient interface {
 	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
 	ChangeAdmin(ctx context.Context, in *MsgChangeAdmin, opts ...grpc.CallOption) (*MsgChangeAdminResponse, error)
 	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
+	ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error)
 }
 
 type msgClient struct {
@@ -641,6 +771,15 @@ func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadat
 	return out, nil
 }
 
+func (c *msgClient) ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error) {
+	out := new(MsgForceTransferResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/ForceTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateDenom(context.Context, *MsgCreateDenom) (*MsgCreateDenomResponse, error)
@@ -648,6 +787,7 @@ type MsgServer interface {
 	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
 	ChangeAdmin(context.Context, *MsgChangeAdmin) (*MsgChangeAdminResponse, error)
 	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
+	ForceTransfer(context.Context, *MsgForceTransfer) (*MsgForceTransferResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -669,6 +809,9 @@ func (*UnimplementedMsgServer) ChangeAdmin(ctx context.Context, req *MsgChangeAd
 func (*UnimplementedMsgServer) SetDenomMetadata(ctx context.Context, req *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
 }
+func (*UnimplementedMsgServer) ForceTransfer(ctx context.Context, req *MsgForceTransfer) (*MsgForceTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceTransfer not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -764,6 +907,24 @@ func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ForceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/ForceTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceTransfer(ctx, req.(*MsgForceTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.tokenfactory.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -788,6 +949,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetDenomMetadata",
 			Handler:    _Msg_SetDenomMetadata_Handler,
 		},
+		{
+			MethodName: "ForceTransfer",
+			Handler:    _Msg_ForceTransfer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/tokenfactory/v1beta1/tx.proto",
@@ -943,6 +1108,13 @@ func (m *MsgBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BurnFromAddress) > 0 {
+		i -= len(m.BurnFromAddress)
+		copy(dAtA[i:], m.BurnFromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BurnFromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1053,6 +1225,83 @@ func (m *MsgChangeAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgForceTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransferToAddress) > 0 {
+		i -= len(m.TransferToAddress)
+		copy(dAtA[i:], m.TransferToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TransferFromAddress) > 0 {
+		i -= len(m.TransferFromAddress)
+		copy(dAtA[i:], m.TransferFromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferFromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSetDenomMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1193,6 +1442,10 @@ func (m *MsgBurn) Size() (n int) {
 	}
 	l = m.Amount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.BurnFromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1235,6 +1488,38 @@ func (m *MsgChangeAdminResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgForceTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TransferFromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TransferToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgForceTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSetDenomMetadata) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1720,6 +2005,38 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnFromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1987,6 +2304,235 @@ func (m *MsgChangeAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferFromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForceTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,8 +18,10 @@ import (
 // the state machine will interpolate the accumulator values for those times
 // from the latest Twap accumulation record prior to the provided time.
 //
-// startTime must be within 48 hours of ctx.BlockTime(), if you need older TWAPs,
-// you will have to maintain the accumulator yourself.
+// startTime must be within 48 hours of ctx.BlockTime() to be computed from every record.
+// Older startTimes are computed from the records kept by the historical retention tiers,
+// e.g. one per hour for 30 days and one per day afterwards, so the accumulator values
+// are interpolated from a record at most one tier resolution older than the provided time.
 //
 // endTime will be set in the function ArithmeticTwap() to ctx.BlockTime() which calls GetArithmeticTwap function if:
 // * it is not provided externally
@@ -28,12 +30,11 @@ import (
 // This function will error if:
 // * startTime > endTime
 // * endTime in the future
-// * startTime older than 48 hours OR pool creation
+// * startTime older than the oldest record kept by the historical retention tiers OR pool creation
 // * pool with id poolId does not exist, or does not contain quoteAssetDenom, baseAssetDenom
 // * there were some computational errors during computing arithmetic twap within the time range of
 //   startRecord, endRecord - including the exact record times, which indicates that the result returned could be faulty
 
-// N.B. If there is a notable use case, the state machine could maintain more historical records, e.g. at one per hour.
 func (k Keeper) GetArithmeticTwap(
 	ctx sdk.Context,
 	poolId uint64,
@@ -41,12 +42,84 @@ func (k Keeper) GetArithmeticTwap(
 	quoteAssetDenom string,
 	startTime time.Time,
 	endTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, computeArithmeticTwap)
+}
+
+// GetArithmeticTwapToNow returns GetArithmeticTwap on the input, with endTime being fixed to ctx.BlockTime()
+// This function does not mutate records.
+func (k Keeper) GetArithmeticTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, computeArithmeticTwap)
+}
+
+// GetGeometricTwap returns a geometric time weighted average price.
+// The returned twap is the time weighted average price (TWAP), using the geometric mean of:
+// * the base asset, in units of the quote asset (1 unit of base = x units of quote)
+// * from (startTime, endTime),
+// * as determined by prices from AMM pool `poolId`.
+//
+// Unlike the arithmetic TWAP, the geometric TWAP is symmetric in the asset pair,
+// i.e. GetGeometricTwap(base, quote) = 1 / GetGeometricTwap(quote, base),
+// and is less sensitive to short lived price spikes.
+//
+// The time range semantics and error conditions are identical to GetArithmeticTwap.
+func (k Keeper) GetGeometricTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, computeGeometricTwap)
+}
+
+// GetGeometricTwapToNow returns GetGeometricTwap on the input, with endTime being fixed to ctx.BlockTime()
+// This function does not mutate records.
+func (k Keeper) GetGeometricTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, computeGeometricTwap)
+}
+
+// GetBeginBlockAccumulatorRecord returns a TwapRecord struct corresponding to the state of pool `poolId`
+// as of the beginning of the block this is called on.
+// This uses the state of the beginning of the block, as if there were swaps since the block has started,
+// these swaps have had no time to be arbitraged back.
+// This accumulator can be stored, to compute wider ranged twaps.
+func (k Keeper) GetBeginBlockAccumulatorRecord(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
+	return k.getMostRecentRecord(ctx, poolId, asset0Denom, asset1Denom)
+}
+
+// twapComputeFn computes a TWAP between two records given the quote asset.
+type twapComputeFn func(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) (sdk.Dec, error)
+
+// getTwap validates the time range, interpolates the records at startTime
+// and endTime, and computes the TWAP between them with computeTwap.
+func (k Keeper) getTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+	computeTwap twapComputeFn,
 ) (sdk.Dec, error) {
 	if startTime.After(endTime) {
 		return sdk.Dec{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
 	}
 	if endTime.Equal(ctx.BlockTime()) {
-		return k.GetArithmeticTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime)
+		return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, computeTwap)
 	} else if endTime.After(ctx.BlockTime()) {
 		return sdk.Dec{}, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
 	}
@@ -58,17 +131,17 @@ func (k Keeper) GetArithmeticTwap(
 	if err != nil {
 		return sdk.Dec{}, err
 	}
-	return computeArithmeticTwap(startRecord, endRecord, quoteAssetDenom)
+	return computeTwap(startRecord, endRecord, quoteAssetDenom)
 }
 
-// GetArithmeticTwapToNow returns GetArithmeticTwap on the input, with endTime being fixed to ctx.BlockTime()
-// This function does not mutate records.
-func (k Keeper) GetArithmeticTwapToNow(
+// getTwapToNow is getTwap with endTime fixed to ctx.BlockTime().
+func (k Keeper) getTwapToNow(
 	ctx sdk.Context,
 	poolId uint64,
 	baseAssetDenom string,
 	quoteAssetDenom string,
 	startTime time.Time,
+	computeTwap twapComputeFn,
 ) (sdk.Dec, error) {
 	if startTime.After(ctx.BlockTime()) {
 		return sdk.Dec{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: ctx.BlockTime()}
@@ -82,14 +155,5 @@ func (k Keeper) GetArithmeticTwapToNow(
 	if err != nil {
 		return sdk.Dec{}, err
 	}
-	return computeArithmeticTwap(startRecord, endRecord, quoteAssetDenom)
-}
-
-// GetBeginBlockAccumulatorRecord returns a TwapRecord struct corresponding to the state of pool `poolId`
-// as of the beginning of the block this is called on.
-// This uses the state of the beginning of the block, as if there were swaps since the block has started,
-// these swaps have had no time to be arbitraged back.
-// This accumulator can be stored, to compute wider ranged twaps.
-func (k Keeper) GetBeginBlockAccumulatorRecord(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
-	return k.getMostRecentRecord(ctx, poolId, asset0Denom, asset1Denom)
+	return computeTwap(startRecord, endRecord, quoteAssetDenom)
 }
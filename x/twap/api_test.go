@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v12/app/apptesting/osmoassert"
 	"github.com/osmosis-labs/osmosis/v12/x/twap"
 	"github.com/osmosis-labs/osmosis/v12/x/twap/types"
 )
@@ -92,7 +93,7 @@ func (s *TestSuite) TestGetBeginBlockAccumulatorRecord() {
 		"idempotent overwrite2":                             {initStartRecord, recordWithUpdatedAccum(initStartRecord, OneSec, OneSec), tPlusOne, 1, denomA, denomB, nil},
 		"diff spot price": {
 			zeroAccumTenPoint1Record,
-			recordWithUpdatedAccum(zeroAccumTenPoint1Record, OneSec.MulInt64(10), OneSec.QuoInt64(10)),
+			withGeometricAccum(recordWithUpdatedAccum(zeroAccumTenPoint1Record, OneSec.MulInt64(10), OneSec.QuoInt64(10)), OneSec.Mul(logTen)),
 			tPlusOne, 1, denomA, denomB, nil,
 		},
 	}
@@ -358,6 +359,134 @@ func (s *TestSuite) TestGetArithmeticTwap() {
 	}
 }
 
+// TestGetGeometricTwap tests the geometric twap against records with
+// geometric accumulators consistent with their spot prices.
+func (s *TestSuite) TestGetGeometricTwap() {
+	// spot price 10 (0.1) for 10 seconds, then 5 (0.2).
+	tPlus10sp5GeomRecord := withGeometricAccum(tPlus10sp5Record, OneSec.MulInt64(10).Mul(logTen))
+	// geometric mean of 10 and 5, and of 0.1 and 0.2.
+	sqrtFifty := sdk.MustNewDecFromStr("7.071067811865475244")
+	invSqrtFifty := sdk.MustNewDecFromStr("0.141421356237309505")
+
+	tests := map[string]struct {
+		recordsToSet []types.TwapRecord
+		ctxTime      time.Time
+		input        getTwapInput
+		toNow        bool
+		expTwap      sdk.Dec
+		expectError  error
+	}{
+		"(1 record) start and end point to same record": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteAB),
+			expTwap:      sdk.NewDec(10),
+		},
+		"(1 record) start and end point to same record, use sp1": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			expTwap:      sdk.NewDecWithPrec(1, 1),
+		},
+		"(2 record) start exact, end after second record": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5GeomRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteAB),
+			expTwap:      sqrtFifty,
+		},
+		"(2 record) start exact, end after second record, sp1": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5GeomRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteBA),
+			expTwap:      invSqrtFifty,
+		},
+		"(2 record) start and end interpolated": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5GeomRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime.Add(5*time.Second), baseTime.Add(15*time.Second), baseQuoteAB),
+			expTwap:      sqrtFifty,
+		},
+		"(2 record) to now": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5GeomRecord},
+			ctxTime:      baseTime.Add(20 * time.Second),
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteAB),
+			toNow:        true,
+			expTwap:      sqrtFifty,
+		},
+		"(1 record) end time in the future": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      baseTime,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteAB),
+			expectError:  types.EndTimeInFutureError{BlockTime: baseTime, EndTime: tPlusOne},
+		},
+		"(1 record) start time after end time": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(tPlusOne, baseTime, baseQuoteAB),
+			expectError:  types.StartTimeAfterEndTimeError{StartTime: tPlusOne, EndTime: baseTime},
+		},
+		"(1 record) spot price error at start time": {
+			recordsToSet: []types.TwapRecord{withLastErrTime(baseRecord, baseTime)},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteAB),
+			expTwap:      sdk.NewDec(10),
+			expectError:  spotPriceError,
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(test.ctxTime)
+
+			var twap sdk.Dec
+			var err error
+			if test.toNow {
+				twap, err = s.twapkeeper.GetGeometricTwapToNow(s.Ctx, test.input.poolId,
+					test.input.quoteAssetDenom, test.input.baseAssetDenom,
+					test.input.startTime)
+			} else {
+				twap, err = s.twapkeeper.GetGeometricTwap(s.Ctx, test.input.poolId,
+					test.input.quoteAssetDenom, test.input.baseAssetDenom,
+					test.input.startTime, test.input.endTime)
+			}
+
+			if test.expectError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectError, err)
+				if test.expTwap.IsNil() {
+					return
+				}
+			} else {
+				s.Require().NoError(err)
+			}
+			// 2^x is approximated with a relative precision of 10^-8.
+			osmoassert.DecApproxEq(s.T(), test.expTwap, twap, test.expTwap.Mul(sdk.NewDecWithPrec(1, 7)))
+		})
+	}
+}
+
+// TestGeometricTwapSymmetry tests that, unlike the arithmetic twap,
+// the geometric twap of an asset pair is the inverse of the reversed pair's.
+func (s *TestSuite) TestGeometricTwapSymmetry() {
+	tPlus10sp5GeomRecord := withGeometricAccum(tPlus10sp5Record, OneSec.MulInt64(10).Mul(logTen))
+	s.preSetRecords([]types.TwapRecord{baseRecord, tPlus10sp5GeomRecord})
+	s.Ctx = s.Ctx.WithBlockTime(tPlusOneMin)
+
+	endTime := baseTime.Add(20 * time.Second)
+	geomAB, err := s.twapkeeper.GetGeometricTwap(s.Ctx, basePoolId, denom0, denom1, baseTime, endTime)
+	s.Require().NoError(err)
+	geomBA, err := s.twapkeeper.GetGeometricTwap(s.Ctx, basePoolId, denom1, denom0, baseTime, endTime)
+	s.Require().NoError(err)
+	osmoassert.DecApproxEq(s.T(), sdk.OneDec(), geomAB.Mul(geomBA), sdk.NewDecWithPrec(1, 8))
+
+	arithAB, err := s.twapkeeper.GetArithmeticTwap(s.Ctx, basePoolId, denom0, denom1, baseTime, endTime)
+	s.Require().NoError(err)
+	arithBA, err := s.twapkeeper.GetArithmeticTwap(s.Ctx, basePoolId, denom1, denom0, baseTime, endTime)
+	s.Require().NoError(err)
+	s.Require().True(arithAB.Mul(arithBA).GT(sdk.OneDec()))
+}
+
 func (s *TestSuite) TestGetArithmeticTwap_ThreeAsset() {
 	tests := map[string]struct {
 		recordsToSet []types.TwapRecord
@@ -579,6 +708,62 @@ func (s *TestSuite) TestGetArithmeticTwap_PruningRecordKeepPeriod() {
 	}
 }
 
+// TestGetArithmeticTwap_HistoricalRetentionTiers tests that twaps over windows
+// older than the record history keep period can be computed from the records
+// kept by the historical retention tiers, and not without them.
+func (s *TestSuite) TestGetArithmeticTwap_HistoricalRetentionTiers() {
+	var (
+		oneDayMs   = (24 * time.Hour).Milliseconds()
+		halfHourMs = (30 * time.Minute).Milliseconds()
+
+		// t=baseTime+24h, sp0=30, pruned as it shares its hour with tPlusOneDayHalfHourRecord
+		tPlusOneDayRecord = newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(24*time.Hour),
+			sdk.NewDec(30), sdk.NewDec(oneDayMs*10), sdk.ZeroDec())
+		// t=baseTime+24h30m, sp0=10
+		tPlusOneDayHalfHourRecord = newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(24*time.Hour+30*time.Minute),
+			sdk.NewDec(10), sdk.NewDec(oneDayMs*10+halfHourMs*30), sdk.ZeroDec())
+
+		tenDaysLater = baseTime.Add(10 * 24 * time.Hour)
+	)
+
+	tests := map[string]struct {
+		params      types.Params
+		expTwap     sdk.Dec
+		expectError error
+	}{
+		"default retention tiers": {
+			params: types.DefaultParams(),
+			// expTwap: = (10 * 86400s + 30 * 1800s + 10 * (864000s - 88200s)) / 864000s = 10.041666666666666666
+			expTwap: sdk.MustNewDecFromStr("10.041666666666666666"),
+		},
+		"no retention tiers; error": {
+			params:      basicParams,
+			expectError: twap.TimeTooOldError{Time: baseTime},
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.twapkeeper.SetParams(s.Ctx, test.params)
+			s.preSetRecords([]types.TwapRecord{baseRecord, tPlusOneDayRecord, tPlusOneDayHalfHourRecord})
+			s.Ctx = s.Ctx.WithBlockTime(tenDaysLater)
+			s.Require().NoError(s.twapkeeper.PruneRecords(s.Ctx))
+
+			twap, err := s.twapkeeper.GetArithmeticTwapToNow(s.Ctx, baseRecord.PoolId,
+				baseRecord.Asset1Denom, baseRecord.Asset0Denom, baseTime)
+
+			if test.expectError != nil {
+				s.Require().Error(err)
+				s.Require().ErrorIs(err, test.expectError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expTwap, twap)
+		})
+	}
+}
+
 func (s *TestSuite) TestGetArithmeticTwap_PruningRecordKeepPeriod_ThreeAsset() {
 	var (
 		defaultRecordHistoryKeepPeriod = types.DefaultParams().RecordHistoryKeepPeriod
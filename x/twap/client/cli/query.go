@@ -30,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(GetQueryTwapCommand())
 	cmd.AddCommand(GetQueryTwapLegacyCommand())
+	cmd.AddCommand(GetQueryGeometricTwapCommand())
 
 	return cmd
 }
@@ -62,25 +63,64 @@ $ %s q twap 1 uosmo 1667088000 1667174400
 				return err
 			}
 			queryClient := v2queryproto.NewQueryClient(clientCtx)
-			gammClient := gammtypes.NewQueryClient(clientCtx)
-			liquidity, err := gammClient.TotalPoolLiquidity(cmd.Context(), &gammtypes.QueryTotalPoolLiquidityRequest{PoolId: poolId})
+			quoteDenom, err := getQuoteDenom(cmd, clientCtx, poolId, baseDenom)
 			if err != nil {
 				return err
 			}
-			if len(liquidity.Liquidity) != 2 {
-				return fmt.Errorf("pool %d has %d assets of liquidity, CLI support only exists for 2 assets right now.", poolId, len(liquidity.Liquidity))
+
+			res, err := queryClient.ArithmeticTwap(cmd.Context(), &v2queryproto.ArithmeticTwapRequest{
+				PoolId:     poolId,
+				BaseAsset:  baseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  startTime,
+				EndTime:    &endTime,
+			})
+			if err != nil {
+				return err
 			}
-			quoteDenom := ""
-			if liquidity.Liquidity[0].Denom == baseDenom {
-				quoteDenom = liquidity.Liquidity[1].Denom
-			} else if liquidity.Liquidity[1].Denom == baseDenom {
-				quoteDenom = liquidity.Liquidity[0].Denom
-			} else {
-				return fmt.Errorf("pool %d doesn't have provided baseDenom %s, has %s and %s",
-					poolId, baseDenom, liquidity.Liquidity[0], liquidity.Liquidity[1])
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryGeometricTwapCommand returns the geometric twap of an asset by denom.
+func GetQueryGeometricTwapCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "geometric-twap [poolid] [base denom] [start time] [end time]",
+		Short: "Query geometric twap",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query geometric twap for pool. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+$ %s q twap geometric-twap 1 uosmo 1667088000 24h
+$ %s q twap geometric-twap 1 uosmo 1667088000 1667174400
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			poolId, baseDenom, startTime, endTime, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
 			}
 
-			res, err := queryClient.ArithmeticTwap(cmd.Context(), &v2queryproto.ArithmeticTwapRequest{
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v2queryproto.NewQueryClient(clientCtx)
+			quoteDenom, err := getQuoteDenom(cmd, clientCtx, poolId, baseDenom)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GeometricTwap(cmd.Context(), &v2queryproto.GeometricTwapRequest{
 				PoolId:     poolId,
 				BaseAsset:  baseDenom,
 				QuoteAsset: quoteDenom,
@@ -100,6 +140,25 @@ $ %s q twap 1 uosmo 1667088000 1667174400
 	return cmd
 }
 
+// getQuoteDenom returns the other denom of a two asset pool, given its base denom.
+func getQuoteDenom(cmd *cobra.Command, clientCtx client.Context, poolId uint64, baseDenom string) (string, error) {
+	gammClient := gammtypes.NewQueryClient(clientCtx)
+	liquidity, err := gammClient.TotalPoolLiquidity(cmd.Context(), &gammtypes.QueryTotalPoolLiquidityRequest{PoolId: poolId})
+	if err != nil {
+		return "", err
+	}
+	if len(liquidity.Liquidity) != 2 {
+		return "", fmt.Errorf("pool %d has %d assets of liquidity, CLI support only exists for 2 assets right now.", poolId, len(liquidity.Liquidity))
+	}
+	if liquidity.Liquidity[0].Denom == baseDenom {
+		return liquidity.Liquidity[1].Denom, nil
+	} else if liquidity.Liquidity[1].Denom == baseDenom {
+		return liquidity.Liquidity[0].Denom, nil
+	}
+	return "", fmt.Errorf("pool %d doesn't have provided baseDenom %s, has %s and %s",
+		poolId, baseDenom, liquidity.Liquidity[0], liquidity.Liquidity[1])
+}
+
 // GetQueryTwapCommand returns multiplier of an asset by denom.
 func GetQueryTwapLegacyCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -41,3 +41,22 @@ func (q QuerierV2) ArithmeticTwap(grpcCtx context.Context,
 	return q.Q.ArithmeticTwap(ctx, *req)
 }
 
+func (q QuerierV2) GeometricTwapToNow(grpcCtx context.Context,
+	req *v2queryproto.GeometricTwapToNowRequest,
+) (*v2queryproto.GeometricTwapToNowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.GeometricTwapToNow(ctx, *req)
+}
+
+func (q QuerierV2) GeometricTwap(grpcCtx context.Context,
+	req *v2queryproto.GeometricTwapRequest,
+) (*v2queryproto.GeometricTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.GeometricTwap(ctx, *req)
+}
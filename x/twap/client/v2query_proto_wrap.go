@@ -32,3 +32,22 @@ func (q QuerierV2) ArithmeticTwapToNow(ctx sdk.Context,
 	twap, err := q.K.GetArithmeticTwapToNow(ctx, req.PoolId, req.QuoteAsset, req.BaseAsset, req.StartTime)
 	return &v2queryproto.ArithmeticTwapToNowResponse{ArithmeticTwap: twap}, err
 }
+
+func (q QuerierV2) GeometricTwap(ctx sdk.Context,
+	req v2queryproto.GeometricTwapRequest,
+) (*v2queryproto.GeometricTwapResponse, error) {
+	if (req.EndTime == nil || *req.EndTime == time.Time{}) {
+		endTime := ctx.BlockTime()
+		req.EndTime = &endTime
+	}
+
+	twap, err := q.K.GetGeometricTwap(ctx, req.PoolId, req.QuoteAsset, req.BaseAsset, req.StartTime, *req.EndTime)
+	return &v2queryproto.GeometricTwapResponse{GeometricTwap: twap}, err
+}
+
+func (q QuerierV2) GeometricTwapToNow(ctx sdk.Context,
+	req v2queryproto.GeometricTwapToNowRequest,
+) (*v2queryproto.GeometricTwapToNowResponse, error) {
+	twap, err := q.K.GetGeometricTwapToNow(ctx, req.PoolId, req.QuoteAsset, req.BaseAsset, req.StartTime)
+	return &v2queryproto.GeometricTwapToNowResponse{GeometricTwap: twap}, err
+}
@@ -68,6 +68,18 @@ func ComputeArithmeticTwap(startRecord types.TwapRecord, endRecord types.TwapRec
 	return computeArithmeticTwap(startRecord, endRecord, quoteAsset)
 }
 
+func ComputeGeometricTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) (sdk.Dec, error) {
+	return computeGeometricTwap(startRecord, endRecord, quoteAsset)
+}
+
+func TwapLog(price sdk.Dec) sdk.Dec {
+	return twapLog(price)
+}
+
+func TwapPow(exponent sdk.Dec) sdk.Dec {
+	return twapPow(exponent)
+}
+
 func RecordWithUpdatedAccumulators(record types.TwapRecord, t time.Time) types.TwapRecord {
 	return recordWithUpdatedAccumulators(record, t)
 }
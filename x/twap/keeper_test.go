@@ -44,7 +44,7 @@ func (s *TestSuite) SetupTest() {
 }
 
 var (
-	basicParams = types.NewParams("week", 48*time.Hour)
+	basicParams = types.NewParams("week", 48*time.Hour, nil)
 
 	mostRecentRecordPoolOne = types.TwapRecord{
 		PoolId:                      basePoolId,
@@ -56,6 +56,7 @@ var (
 		P1LastSpotPrice:             sdk.OneDec(),
 		P0ArithmeticTwapAccumulator: sdk.OneDec(),
 		P1ArithmeticTwapAccumulator: sdk.OneDec(),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 
 	basicCustomGenesis = types.NewGenesisState(
@@ -77,6 +78,7 @@ var (
 				P1LastSpotPrice:             sdk.OneDec(),
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
+				GeometricTwapAccumulator:    sdk.ZeroDec(),
 			},
 			{
 				PoolId:                      basePoolId,
@@ -88,6 +90,7 @@ var (
 				P1LastSpotPrice:             sdk.OneDec(),
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
+				GeometricTwapAccumulator:    sdk.ZeroDec(),
 			},
 			mostRecentRecordPoolOne,
 		})
@@ -102,6 +105,7 @@ var (
 		P1LastSpotPrice:             sdk.OneDec(),
 		P0ArithmeticTwapAccumulator: sdk.OneDec(),
 		P1ArithmeticTwapAccumulator: sdk.OneDec(),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 
 	decreasingOrderByTimeRecordsPoolTwo = types.NewGenesisState(
@@ -118,6 +122,7 @@ var (
 				P1LastSpotPrice:             sdk.OneDec(),
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
+				GeometricTwapAccumulator:    sdk.ZeroDec(),
 			},
 			{
 				PoolId:                      basePoolId,
@@ -129,6 +134,7 @@ var (
 				P1LastSpotPrice:             sdk.OneDec(),
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
+				GeometricTwapAccumulator:    sdk.ZeroDec(),
 			},
 		})
 
@@ -202,7 +208,7 @@ func (suite *TestSuite) TestTwapInitGenesis() {
 		},
 		"custom invalid genesis - error": {
 			twapGenesis: types.NewGenesisState(
-				types.NewParams("week", 48*time.Hour),
+				types.NewParams("week", 48*time.Hour, nil),
 				[]types.TwapRecord{
 					{
 						PoolId:                      0, // invalid
@@ -214,6 +220,7 @@ func (suite *TestSuite) TestTwapInitGenesis() {
 						P1LastSpotPrice:             sdk.OneDec(),
 						P0ArithmeticTwapAccumulator: sdk.OneDec(),
 						P1ArithmeticTwapAccumulator: sdk.OneDec(),
+						GeometricTwapAccumulator:    sdk.ZeroDec(),
 					},
 				}),
 
@@ -410,6 +417,7 @@ func newTwoAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accum0, accum1 sdk.
 		P1LastSpotPrice:             sdk.OneDec().Quo(sp0),
 		P0ArithmeticTwapAccumulator: accum0,
 		P1ArithmeticTwapAccumulator: accum1,
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 }
 
@@ -430,6 +438,7 @@ func newThreeAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accumA, accumB, a
 		P1LastSpotPrice:             spB,
 		P0ArithmeticTwapAccumulator: accumA,
 		P1ArithmeticTwapAccumulator: accumB,
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -454,6 +463,7 @@ func newEmptyPriceRecord(poolId uint64, t time.Time, asset0 string, asset1 strin
 		P1LastSpotPrice:             sdk.ZeroDec(),
 		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
 		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 }
 
@@ -468,6 +478,7 @@ func newRecord(poolId uint64, t time.Time, sp0, accum0, accum1 sdk.Dec) types.Tw
 		// make new copies
 		P0ArithmeticTwapAccumulator: accum0.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accum1.Add(sdk.ZeroDec()),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 }
 
@@ -479,6 +490,7 @@ func newExpRecord(accum0, accum1 sdk.Dec) types.TwapRecord {
 		// make new copies
 		P0ArithmeticTwapAccumulator: accum0.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accum1.Add(sdk.ZeroDec()),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 }
 
@@ -496,6 +508,7 @@ func newThreeAssetRecord(poolId uint64, t time.Time, sp0, accumA, accumB, accumC
 		// make new copies
 		P0ArithmeticTwapAccumulator: accumA.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accumB.Add(sdk.ZeroDec()),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -517,6 +530,7 @@ func newThreeAssetExpRecord(poolId uint64, accumA, accumB, accumC sdk.Dec) []typ
 		// make new copies
 		P0ArithmeticTwapAccumulator: accumA.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accumB.Add(sdk.ZeroDec()),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -561,6 +575,11 @@ func recordWithUpdatedAccum(record types.TwapRecord, accum0 sdk.Dec, accum1 sdk.
 	return record
 }
 
+func withGeometricAccum(twap types.TwapRecord, accum sdk.Dec) types.TwapRecord {
+	twap.GeometricTwapAccumulator = accum
+	return twap
+}
+
 func recordWithUpdatedSpotPrice(record types.TwapRecord, sp0 sdk.Dec, sp1 sdk.Dec) types.TwapRecord {
 	record.P0LastSpotPrice = sp0
 	record.P1LastSpotPrice = sp1
@@ -7,9 +7,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v12/osmomath"
 	"github.com/osmosis-labs/osmosis/v12/x/twap/types"
 )
 
+var oneHalf = sdk.MustNewDecFromStr("0.5")
+
 func newTwapRecord(k types.AmmInterface, ctx sdk.Context, poolId uint64, denom0, denom1 string) (types.TwapRecord, error) {
 	denom0, denom1, err := types.LexicographicalOrderDenoms(denom0, denom1)
 	if err != nil {
@@ -27,6 +30,7 @@ func newTwapRecord(k types.AmmInterface, ctx sdk.Context, poolId uint64, denom0,
 		P1LastSpotPrice:             sp1,
 		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
 		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 		LastErrorTime:               lastErrorTime,
 	}, nil
 }
@@ -158,13 +162,15 @@ func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) types.Twa
 // pruneRecords prunes twap records that happened earlier than recordHistoryKeepPeriod
 // before current block time while preserving the most recent record before the threshold.
 // Such record is preserved for each pool.
-// See TWAP keeper's `pruneRecordsBeforeTimeButNewest(...)` for more details about the reasons for
-// keeping this record.
+// Older records are downsampled to the resolutions of the historical retention tiers,
+// rather than pruned, for as long as the tiers keep them.
+// See TWAP keeper's `pruneRecordsBeforeTimeButNewest(...)` and `downsampleRecordsBeforeTime(...)`
+// for more details about the reasons for keeping these records.
 func (k Keeper) pruneRecords(ctx sdk.Context) error {
-	recordHistoryKeepPeriod := k.RecordHistoryKeepPeriod(ctx)
+	params := k.GetParams(ctx)
 
-	lastKeptTime := ctx.BlockTime().Add(-recordHistoryKeepPeriod)
-	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime)
+	lastKeptTime := ctx.BlockTime().Add(-params.RecordHistoryKeepPeriod)
+	return k.downsampleRecordsBeforeTime(ctx, lastKeptTime, params.HistoricalRetentionTiers)
 }
 
 // recordWithUpdatedAccumulators returns a record, with updated accumulator values and time for provided newTime,
@@ -190,6 +196,14 @@ func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) t
 	p1NewAccum := types.SpotPriceMulDuration(record.P1LastSpotPrice, timeDelta)
 	newRecord.P1ArithmeticTwapAccumulator = newRecord.P1ArithmeticTwapAccumulator.Add(p1NewAccum)
 
+	// The logarithm of a zero spot price is undefined, so the geometric accumulator is
+	// left unchanged over errored intervals. Such intervals are already reported
+	// to the caller through LastErrorTime.
+	if record.P0LastSpotPrice.IsPositive() {
+		geomNewAccum := types.SpotPriceMulDuration(twapLog(record.P0LastSpotPrice), timeDelta)
+		newRecord.GeometricTwapAccumulator = newRecord.GeometricTwapAccumulator.Add(geomNewAccum)
+	}
+
 	return newRecord
 }
 
@@ -228,13 +242,7 @@ func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, assetA, asse
 // else returns
 // (endRecord.Accumulator - startRecord.Accumulator) / (endRecord.Time - startRecord.Time)
 func computeArithmeticTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) (sdk.Dec, error) {
-	// see if we need to return an error, due to spot price issues
-	var err error = nil
-	if endRecord.LastErrorTime.After(startRecord.Time) ||
-		endRecord.LastErrorTime.Equal(startRecord.Time) ||
-		startRecord.LastErrorTime.Equal(startRecord.Time) {
-		err = errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
-	}
+	err := checkSpotPriceErrors(startRecord, endRecord)
 	timeDelta := endRecord.Time.Sub(startRecord.Time)
 	// if time difference is 0, then return the last spot price based off of start.
 	if timeDelta == time.Duration(0) {
@@ -251,3 +259,67 @@ func computeArithmeticTwap(startRecord types.TwapRecord, endRecord types.TwapRec
 	}
 	return types.AccumDiffDivDuration(accumDiff, timeDelta), err
 }
+
+// computeGeometricTwap computes and returns a geometric TWAP between
+// two records given the quote asset.
+// precondition: endRecord.Time >= startRecord.Time
+// Errors are reported identically to computeArithmeticTwap.
+// if (endRecord.Time == startRecord.Time) returns endRecord.LastSpotPrice
+// else returns
+// 2^((endRecord.GeometricAccumulator - startRecord.GeometricAccumulator) / (endRecord.Time - startRecord.Time))
+// inverted if the quote asset is asset1.
+func computeGeometricTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) (sdk.Dec, error) {
+	err := checkSpotPriceErrors(startRecord, endRecord)
+	timeDelta := endRecord.Time.Sub(startRecord.Time)
+	// if time difference is 0, then return the last spot price based off of start.
+	if timeDelta == time.Duration(0) {
+		if quoteAsset == startRecord.Asset0Denom {
+			return endRecord.P0LastSpotPrice, err
+		}
+		return endRecord.P1LastSpotPrice, err
+	}
+	accumDiff := endRecord.GeometricTwapAccumulator.Sub(startRecord.GeometricTwapAccumulator)
+	// the geometric accumulator tracks P0, P1's log is its negation.
+	meanLogPrice := types.AccumDiffDivDuration(accumDiff, timeDelta)
+	if quoteAsset != startRecord.Asset0Denom {
+		meanLogPrice = meanLogPrice.Neg()
+	}
+	return twapPow(meanLogPrice), err
+}
+
+// checkSpotPriceErrors returns an error if a spot price error occurred
+// at or after the start record, which means a TWAP over the
+// interval may be faulty.
+func checkSpotPriceErrors(startRecord types.TwapRecord, endRecord types.TwapRecord) error {
+	if endRecord.LastErrorTime.After(startRecord.Time) ||
+		endRecord.LastErrorTime.Equal(startRecord.Time) ||
+		startRecord.LastErrorTime.Equal(startRecord.Time) {
+		return errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
+	}
+	return nil
+}
+
+// twapLog returns log_2 of the given spot price.
+// precondition: price > 0
+func twapLog(price sdk.Dec) sdk.Dec {
+	return osmomath.BigDecFromSDKDec(price).LogBase2().SDKDec()
+}
+
+// twapPow returns 2^exponent, the inverse of twapLog.
+func twapPow(exponent sdk.Dec) sdk.Dec {
+	if exponent.IsNegative() {
+		return sdk.OneDec().Quo(twapPow(exponent.Neg()))
+	}
+
+	integer := exponent.TruncateDec()
+	fractional := exponent.Sub(integer)
+
+	integerPow := sdk.NewDec(2).Power(uint64(integer.TruncateInt64()))
+	if fractional.IsZero() {
+		return integerPow
+	}
+
+	// osmomath.Pow requires a base below two, so 2^f is computed as 1 / 0.5^f.
+	fractionalPow := sdk.OneDec().Quo(osmomath.Pow(oneHalf, fractional))
+	return integerPow.Mul(fractionalPow)
+}
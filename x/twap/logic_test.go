@@ -22,6 +22,8 @@ var (
 	oneDec  = sdk.OneDec()
 	twoDec  = oneDec.Add(oneDec)
 	OneSec  = sdk.MustNewDecFromStr("1000.000000000000000000")
+	// log_2(10), truncated to 18 decimals.
+	logTen = sdk.MustNewDecFromStr("3.321928094887362347")
 )
 
 func (s *TestSuite) TestGetSpotPrices() {
@@ -161,7 +163,7 @@ func (s *TestSuite) TestUpdateRecord() {
 	baseTimeMinusOne := time.Unix(1, 0).UTC()
 
 	zeroAccumNoErrSp10Record := newRecord(poolId, baseTime, sdk.NewDec(10), zeroDec, zeroDec)
-	sp10OneTimeUnitAccumRecord := newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10))
+	sp10OneTimeUnitAccumRecord := withGeometricAccum(newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10)), OneSec.Mul(logTen))
 	// all tests occur with updateTime = base time + time.Unix(1, 0)
 	tests := map[string]struct {
 		record           types.TwapRecord
@@ -245,17 +247,17 @@ func TestRecordWithUpdatedAccumulators(t *testing.T) {
 		"accum with zero value": {
 			record:    newRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), zeroDec, zeroDec),
 			newTime:   time.Unix(2, 0),
-			expRecord: newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10)),
+			expRecord: withGeometricAccum(newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10)), OneSec.Mul(logTen)),
 		},
 		"small starting accumulators": {
 			record:    defaultRecord,
 			newTime:   time.Unix(2, 0),
-			expRecord: newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10))),
+			expRecord: withGeometricAccum(newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10))), OneSec.Mul(logTen)),
 		},
 		"larger time interval": {
 			record:    newRecord(poolId, time.Unix(11, 0), sdk.NewDec(10), oneDec, twoDec),
 			newTime:   time.Unix(55, 0),
-			expRecord: newExpRecord(oneDec.Add(OneSec.MulInt64(44*10)), twoDec.Add(OneSec.MulInt64(44).QuoInt64(10))),
+			expRecord: withGeometricAccum(newExpRecord(oneDec.Add(OneSec.MulInt64(44*10)), twoDec.Add(OneSec.MulInt64(44).QuoInt64(10))), OneSec.MulInt64(44).Mul(logTen)),
 		},
 		"same time, accumulator should not change": {
 			record:    defaultRecord,
@@ -284,21 +286,27 @@ func TestRecordWithUpdatedAccumulators_ThreeAsset(t *testing.T) {
 		record          []types.TwapRecord
 		interpolateTime time.Time
 		expRecord       []types.TwapRecord
+		// geometric accumulators of the AB, AC and BC records.
+		// spot prices of asset0 are 10, 10 and 0.1 respectively.
+		expGeometricAccum []sdk.Dec
 	}{
 		"accum with zero value": {
-			record:          newThreeAssetRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), zeroDec, zeroDec, zeroDec),
-			interpolateTime: time.Unix(2, 0),
-			expRecord:       newThreeAssetExpRecord(poolId, OneSec.MulInt64(10), OneSec.QuoInt64(10), OneSec.MulInt64(20)),
+			record:            newThreeAssetRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), zeroDec, zeroDec, zeroDec),
+			interpolateTime:   time.Unix(2, 0),
+			expRecord:         newThreeAssetExpRecord(poolId, OneSec.MulInt64(10), OneSec.QuoInt64(10), OneSec.MulInt64(20)),
+			expGeometricAccum: []sdk.Dec{OneSec.Mul(logTen), OneSec.Mul(logTen), OneSec.Mul(logTen).Neg()},
 		},
 		"small starting accumulators": {
-			record:          newThreeAssetRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), twoDec, oneDec, twoDec),
-			interpolateTime: time.Unix(2, 0),
-			expRecord:       newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(10)), oneDec.Add(OneSec.QuoInt64(10)), twoDec.Add(OneSec.MulInt64(20))),
+			record:            newThreeAssetRecord(poolId, time.Unix(1, 0), sdk.NewDec(10), twoDec, oneDec, twoDec),
+			interpolateTime:   time.Unix(2, 0),
+			expRecord:         newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(10)), oneDec.Add(OneSec.QuoInt64(10)), twoDec.Add(OneSec.MulInt64(20))),
+			expGeometricAccum: []sdk.Dec{OneSec.Mul(logTen), OneSec.Mul(logTen), OneSec.Mul(logTen).Neg()},
 		},
 		"larger time interval": {
-			record:          newThreeAssetRecord(poolId, time.Unix(11, 0), sdk.NewDec(10), twoDec, oneDec, twoDec),
-			interpolateTime: time.Unix(55, 0),
-			expRecord:       newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(44*10)), oneDec.Add(OneSec.MulInt64(44).QuoInt64(10)), twoDec.Add(OneSec.MulInt64(44*20))),
+			record:            newThreeAssetRecord(poolId, time.Unix(11, 0), sdk.NewDec(10), twoDec, oneDec, twoDec),
+			interpolateTime:   time.Unix(55, 0),
+			expRecord:         newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(44*10)), oneDec.Add(OneSec.MulInt64(44).QuoInt64(10)), twoDec.Add(OneSec.MulInt64(44*20))),
+			expGeometricAccum: []sdk.Dec{OneSec.MulInt64(44).Mul(logTen), OneSec.MulInt64(44).Mul(logTen), OneSec.MulInt64(44).Mul(logTen).Neg()},
 		},
 	}
 
@@ -309,6 +317,7 @@ func TestRecordWithUpdatedAccumulators_ThreeAsset(t *testing.T) {
 				test.expRecord[i].Time = test.interpolateTime
 				test.expRecord[i].P0LastSpotPrice = test.record[i].P0LastSpotPrice
 				test.expRecord[i].P1LastSpotPrice = test.record[i].P1LastSpotPrice
+				test.expRecord[i].GeometricTwapAccumulator = test.expGeometricAccum[i]
 
 				gotRecord := twap.RecordWithUpdatedAccumulators(test.record[i], test.interpolateTime)
 				require.Equal(t, test.expRecord[i], gotRecord)
@@ -612,6 +621,89 @@ func TestComputeArithmeticTwap(t *testing.T) {
 	}
 }
 
+func TestComputeGeometricTwap(t *testing.T) {
+	// records with the given geometric accumulator, and asset0 spot price of 4.
+	newGeometricRecord := func(time time.Time, accum sdk.Dec) types.TwapRecord {
+		record := withGeometricAccum(newOneSidedRecord(time, sdk.ZeroDec(), true), accum)
+		record.P0LastSpotPrice = sdk.NewDec(4)
+		record.P1LastSpotPrice = sdk.NewDecWithPrec(25, 2)
+		return record
+	}
+	tests := map[string]struct {
+		startRecord types.TwapRecord
+		endRecord   types.TwapRecord
+		quoteAsset  string
+		expTwap     sdk.Dec
+	}{
+		"same record: denom0, returns end spot price": {
+			startRecord: newGeometricRecord(baseTime, sdk.ZeroDec()),
+			endRecord:   newGeometricRecord(baseTime, sdk.ZeroDec()),
+			quoteAsset:  denom0,
+			expTwap:     sdk.NewDec(4),
+		},
+		"same record: denom1, returns end spot price": {
+			startRecord: newGeometricRecord(baseTime, sdk.ZeroDec()),
+			endRecord:   newGeometricRecord(baseTime, sdk.ZeroDec()),
+			quoteAsset:  denom1,
+			expTwap:     sdk.NewDecWithPrec(25, 2),
+		},
+		"log price = 2 for one second: denom0": {
+			startRecord: newGeometricRecord(baseTime, sdk.ZeroDec()),
+			endRecord:   newGeometricRecord(tPlusOne, OneSec.MulInt64(2)),
+			quoteAsset:  denom0,
+			expTwap:     sdk.NewDec(4),
+		},
+		"log price = 2 for one second: denom1": {
+			startRecord: newGeometricRecord(baseTime, sdk.ZeroDec()),
+			endRecord:   newGeometricRecord(tPlusOne, OneSec.MulInt64(2)),
+			quoteAsset:  denom1,
+			expTwap:     sdk.NewDecWithPrec(25, 2),
+		},
+		"log price = 3 for 2s, then -1 for 2s: base accum has no impact": {
+			startRecord: newGeometricRecord(baseTime, OneSec.MulInt64(100)),
+			endRecord:   newGeometricRecord(baseTime.Add(4*time.Second), OneSec.MulInt64(100+6-2)),
+			quoteAsset:  denom0,
+			expTwap:     sdk.NewDec(2),
+		},
+		"negative accumulators": {
+			startRecord: newGeometricRecord(baseTime, OneSec.MulInt64(-10)),
+			endRecord:   newGeometricRecord(baseTime.Add(2*time.Second), OneSec.MulInt64(-12)),
+			quoteAsset:  denom0,
+			expTwap:     sdk.NewDecWithPrec(5, 1),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			actualTwap, err := twap.ComputeGeometricTwap(test.startRecord, test.endRecord, test.quoteAsset)
+			require.NoError(t, err)
+			require.Equal(t, test.expTwap, actualTwap)
+		})
+	}
+}
+
+func TestTwapLogPow(t *testing.T) {
+	tests := map[string]struct {
+		price  sdk.Dec
+		expLog sdk.Dec
+	}{
+		"one":         {sdk.OneDec(), sdk.ZeroDec()},
+		"power of 2":  {sdk.NewDec(1024), sdk.NewDec(10)},
+		"ten":         {sdk.NewDec(10), logTen},
+		"one tenth":   {sdk.NewDecWithPrec(1, 1), logTen.Neg()},
+		"fraction":    {sdk.NewDecWithPrec(125, 3), sdk.NewDec(-3)},
+		"large price": {types.MaxSpotPrice, sdk.NewDec(128)},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			log := twap.TwapLog(test.price)
+			osmoassert.DecApproxEq(t, test.expLog, log, sdk.NewDecWithPrec(1, 15))
+
+			// 2^x is approximated with a relative precision of 10^-8.
+			osmoassert.DecApproxEq(t, test.price, twap.TwapPow(log), test.price.Mul(sdk.NewDecWithPrec(1, 7)))
+		})
+	}
+}
+
 func TestComputeArithmeticTwap_ThreeAsset(t *testing.T) {
 	testThreeAssetCaseFromDeltas := func(startAccum, accumDiff sdk.Dec, timeDelta time.Duration, expectedTwap sdk.Dec) computeThreeAssetArithmeticTwapTestCase {
 		return computeThreeAssetArithmeticTwapTestCase{
@@ -759,6 +851,8 @@ func (s *TestSuite) TestPruneRecords() {
 		pool4Plus1Record,
 	}
 	s.SetupTest()
+	// no historical retention tiers, so that everything before the keep period but the newest is pruned.
+	s.twapkeeper.SetParams(s.Ctx, basicParams)
 	s.preSetRecords(recordsToPreSet)
 
 	ctx := s.Ctx
@@ -772,6 +866,107 @@ func (s *TestSuite) TestPruneRecords() {
 	s.validateExpectedRecords(expectedKeptRecords)
 }
 
+// TestPruneRecordsWithRetentionTiers tests that twap records earlier than
+// current block time - RecordHistoryKeepPeriod are downsampled to the resolution
+// of the historical retention tier they belong to, and pruned once older than every tier.
+func (s *TestSuite) TestPruneRecordsWithRetentionTiers() {
+	tiers := []types.HistoricalRetentionTier{
+		{Resolution: time.Hour, KeepPeriod: 7 * 24 * time.Hour},
+		{Resolution: 24 * time.Hour, KeepPeriod: 30 * 24 * time.Hour},
+	}
+	newRecord := func(t time.Time) types.TwapRecord {
+		return newEmptyPriceRecord(basePoolId, t, denom0, denom1)
+	}
+
+	// baseTime is on the hour and on the day.
+	var (
+		keptAboveKeepPeriod   = newRecord(baseTime.Add(-time.Hour))
+		keptNewestBelowPeriod = newRecord(baseTime.Add(-48*time.Hour - time.Minute))
+		prunedSameHour        = newRecord(baseTime.Add(-48*time.Hour - 2*time.Minute))
+		keptPreviousHour      = newRecord(baseTime.Add(-49*time.Hour - time.Minute))
+		prunedPreviousHour    = newRecord(baseTime.Add(-49*time.Hour - 2*time.Minute))
+		keptDailyNewest       = newRecord(baseTime.Add(-10*24*time.Hour - time.Hour))
+		prunedDailySameDay    = newRecord(baseTime.Add(-10*24*time.Hour - 2*time.Hour))
+		keptDailyPreviousDay  = newRecord(baseTime.Add(-11*24*time.Hour - time.Hour))
+		prunedBeyondAllTiers  = newRecord(baseTime.Add(-31 * 24 * time.Hour))
+		otherPoolOnlyRecord   = newEmptyPriceRecord(basePoolId+1, baseTime.Add(-40*24*time.Hour), denom0, denom1)
+	)
+
+	s.SetupTest()
+	s.twapkeeper.SetParams(s.Ctx, types.NewParams("week", 48*time.Hour, tiers))
+	s.preSetRecords([]types.TwapRecord{
+		prunedBeyondAllTiers, keptAboveKeepPeriod, prunedDailySameDay, keptPreviousHour,
+		otherPoolOnlyRecord, prunedSameHour, keptDailyNewest, prunedPreviousHour,
+		keptNewestBelowPeriod, keptDailyPreviousDay,
+	})
+
+	err := s.twapkeeper.PruneRecords(s.Ctx.WithBlockTime(baseTime))
+	s.Require().NoError(err)
+
+	s.validateExpectedRecords([]types.TwapRecord{
+		otherPoolOnlyRecord,
+		keptDailyPreviousDay,
+		keptDailyNewest,
+		keptPreviousHour,
+		keptNewestBelowPeriod,
+		keptAboveKeepPeriod,
+	})
+}
+
+// TestPruneRecordsWithForeverRetentionTier tests that records downsampled by a
+// retention tier that keeps records forever are kept across prunings, and that
+// newly downsampled records are added to them.
+func (s *TestSuite) TestPruneRecordsWithForeverRetentionTier() {
+	tiers := []types.HistoricalRetentionTier{
+		{Resolution: time.Hour, KeepPeriod: 72 * time.Hour},
+		{Resolution: 24 * time.Hour},
+	}
+	newRecord := func(t time.Time) types.TwapRecord {
+		return newEmptyPriceRecord(basePoolId, t, denom0, denom1)
+	}
+
+	s.SetupTest()
+	s.twapkeeper.SetParams(s.Ctx, types.NewParams("week", 48*time.Hour, tiers))
+
+	// a record every 6 hours over the past 10 days.
+	records := []types.TwapRecord{}
+	for t := baseTime.Add(-10 * 24 * time.Hour); t.Before(baseTime); t = t.Add(6 * time.Hour) {
+		records = append(records, newRecord(t))
+	}
+	s.preSetRecords(records)
+
+	err := s.twapkeeper.PruneRecords(s.Ctx.WithBlockTime(baseTime))
+	s.Require().NoError(err)
+
+	// baseTime is at 23:00, so every day older than 72 hours keeps its record at 23:00,
+	// but for the day of baseTime - 72 hours, which keeps its last record older than 72 hours.
+	// Every record from 72 hours on is kept for its own hour.
+	expectedRecords := []types.TwapRecord{}
+	for day := 10; day > 3; day-- {
+		expectedRecords = append(expectedRecords, newRecord(baseTime.Add(-time.Duration(day)*24*time.Hour)))
+	}
+	expectedRecords = append(expectedRecords, newRecord(baseTime.Add(-78*time.Hour)))
+	for _, record := range records {
+		if !record.Time.Before(baseTime.Add(-72 * time.Hour)) {
+			expectedRecords = append(expectedRecords, record)
+		}
+	}
+	s.validateExpectedRecords(expectedRecords)
+
+	// three days later, the records that are now older than 72 hours are downsampled daily as well,
+	// including the day that was only partially downsampled, while the records downsampled before are not pruned.
+	laterTime := baseTime.Add(3 * 24 * time.Hour)
+	err = s.twapkeeper.PruneRecords(s.Ctx.WithBlockTime(laterTime))
+	s.Require().NoError(err)
+
+	expectedRecords = []types.TwapRecord{}
+	for day := 10; day > 0; day-- {
+		expectedRecords = append(expectedRecords, newRecord(baseTime.Add(-time.Duration(day)*24*time.Hour)))
+	}
+	expectedRecords = append(expectedRecords, newRecord(baseTime.Add(-6*time.Hour)))
+	s.validateExpectedRecords(expectedRecords)
+}
+
 // TestUpdateRecords tests that the records are updated correctly.
 // It tests the following:
 // - two-asset pools
@@ -1,7 +1,11 @@
 package twap
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/x/twap/types"
 )
 
 // MigrateExistingPools iterates through all pools and creates state entry for the twap module.
@@ -14,3 +18,45 @@ func (k Keeper) MigrateExistingPools(ctx sdk.Context, latestPoolId uint64) error
 	}
 	return nil
 }
+
+// MigrateExistingGeometricTwapAccumulators populates the geometric twap accumulator
+// of every record stored prior to its introduction.
+// Records only store the last spot price of their block, which is in effect until
+// the next record of the same pair. So for every (pool, asset pair) the accumulator
+// is rebuilt exactly, starting at zero from the oldest record still in state.
+func (k Keeper) MigrateExistingGeometricTwapAccumulators(ctx sdk.Context) error {
+	records, err := k.getAllHistoricalPoolIndexedTWAPs(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Pool indexed records are ordered by (pool id, asset0, asset1, time).
+	var prevRecord *types.TwapRecord
+	for i := range records {
+		record := records[i]
+		record.GeometricTwapAccumulator = sdk.ZeroDec()
+		if prevRecord != nil && isSamePair(*prevRecord, record) {
+			record.GeometricTwapAccumulator = recordWithUpdatedAccumulators(*prevRecord, record.Time).GeometricTwapAccumulator
+		}
+		k.storeHistoricalTWAP(ctx, record)
+
+		// the last record of a pair is its most recent one.
+		if i+1 == len(records) || !isSamePair(record, records[i+1]) {
+			mostRecentRecord, err := k.getMostRecentRecordStoreRepresentation(ctx, record.PoolId, record.Asset0Denom, record.Asset1Denom)
+			if err != nil {
+				return err
+			}
+			if !mostRecentRecord.Time.Equal(record.Time) {
+				return fmt.Errorf("most recent twap record for pool %d (%s, %s) at %s does not match latest historical record at %s",
+					record.PoolId, record.Asset0Denom, record.Asset1Denom, mostRecentRecord.Time, record.Time)
+			}
+			k.storeNewRecord(ctx, record)
+		}
+		prevRecord = &record
+	}
+	return nil
+}
+
+func isSamePair(a, b types.TwapRecord) bool {
+	return a.PoolId == b.PoolId && a.Asset0Denom == b.Asset0Denom && a.Asset1Denom == b.Asset1Denom
+}
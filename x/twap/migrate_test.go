@@ -3,6 +3,9 @@ package twap_test
 import (
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v12/app/apptesting/osmoassert"
 	"github.com/osmosis-labs/osmosis/v12/x/twap/types"
 )
 
@@ -64,3 +67,66 @@ func (s *TestSuite) TestMigrateExistingPoolsError() {
 	err := s.twapkeeper.MigrateExistingPools(s.Ctx, latestPoolIdPlusOne)
 	s.Require().Error(err)
 }
+
+func (s *TestSuite) TestMigrateExistingGeometricTwapAccumulators() {
+	// records of pool 1 prior to the geometric accumulator, i.e. with it unset.
+	// spot price of asset0 is 10 for 10s, then 5 for 10s, then 0 (errored) for 10s, then 2.
+	recordsPoolOne := []types.TwapRecord{
+		newRecord(1, baseTime, sdk.NewDec(10), sdk.ZeroDec(), sdk.ZeroDec()),
+		newRecord(1, baseTime.Add(10*time.Second), sdk.NewDec(5), sdk.ZeroDec(), sdk.ZeroDec()),
+		withSp1(withSp0(newRecord(1, baseTime.Add(20*time.Second), sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.ZeroDec()), sdk.ZeroDec()),
+		newRecord(1, baseTime.Add(30*time.Second), sdk.NewDec(2), sdk.ZeroDec(), sdk.ZeroDec()),
+	}
+	// pool 2 starts at zero again, rather than continuing pool 1's accumulator.
+	recordsPoolTwo := []types.TwapRecord{
+		newRecord(2, baseTime.Add(5*time.Second), sdk.NewDec(2), sdk.ZeroDec(), sdk.ZeroDec()),
+		newRecord(2, baseTime.Add(15*time.Second), sdk.NewDec(2), sdk.ZeroDec(), sdk.ZeroDec()),
+	}
+	for _, record := range append(recordsPoolOne, recordsPoolTwo...) {
+		record.GeometricTwapAccumulator = sdk.Dec{}
+		s.twapkeeper.StoreNewRecord(s.Ctx, record)
+	}
+
+	err := s.twapkeeper.MigrateExistingGeometricTwapAccumulators(s.Ctx)
+	s.Require().NoError(err)
+
+	tenSecLogTen := OneSec.MulInt64(10).Mul(logTen)
+	// log_2(5) = log_2(10) - 1
+	tenSecLogFive := OneSec.MulInt64(10).Mul(logTen.Sub(sdk.OneDec()))
+	expectedAccumsPoolOne := []sdk.Dec{
+		sdk.ZeroDec(),
+		tenSecLogTen,
+		tenSecLogTen.Add(tenSecLogFive),
+		// no accumulation over the errored interval.
+		tenSecLogTen.Add(tenSecLogFive),
+	}
+	expectedAccumsPoolTwo := []sdk.Dec{sdk.ZeroDec(), OneSec.MulInt64(10)}
+
+	for poolId, expectedAccums := range map[uint64][]sdk.Dec{1: expectedAccumsPoolOne, 2: expectedAccumsPoolTwo} {
+		historicalRecords := s.getAllHistoricalRecordsForPool(poolId)
+		s.Require().Len(historicalRecords, len(expectedAccums))
+		for i, record := range historicalRecords {
+			osmoassert.DecApproxEq(s.T(), expectedAccums[i], record.GeometricTwapAccumulator, sdk.NewDecWithPrec(1, 12))
+
+			timeIndexedRecord, err := s.twapkeeper.GetRecordAtOrBeforeTime(s.Ctx, poolId, record.Time, denom0, denom1)
+			s.Require().NoError(err)
+			s.Require().Equal(record, timeIndexedRecord)
+		}
+
+		mostRecentRecord, err := s.twapkeeper.GetMostRecentRecordStoreRepresentation(s.Ctx, poolId, denom0, denom1)
+		s.Require().NoError(err)
+		s.Require().Equal(historicalRecords[len(historicalRecords)-1], mostRecentRecord)
+	}
+
+	allTimeIndexed, err := s.twapkeeper.GetAllHistoricalTimeIndexedTWAPs(s.Ctx)
+	s.Require().NoError(err)
+	allPoolIndexed, err := s.twapkeeper.GetAllHistoricalPoolIndexedTWAPs(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().ElementsMatch(allPoolIndexed, allTimeIndexed)
+
+	// the geometric twap over the migrated records is the geometric mean of 10 and 5.
+	s.Ctx = s.Ctx.WithBlockTime(tPlusOneMin)
+	geometricTwap, err := s.twapkeeper.GetGeometricTwap(s.Ctx, 1, denom1, denom0, baseTime, baseTime.Add(20*time.Second))
+	s.Require().NoError(err)
+	osmoassert.DecApproxEq(s.T(), sdk.MustNewDecFromStr("7.071067811865475244"), geometricTwap, sdk.NewDecWithPrec(1, 6))
+}
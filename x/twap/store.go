@@ -74,13 +74,38 @@ func (k Keeper) storeHistoricalTWAP(ctx sdk.Context, twap types.TwapRecord) {
 // we keep the newest record that is older than the pruning time.
 // This is why we would keep the -50 hour and -1hour twaps despite a 48hr pruning period
 func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time) error {
+	return k.downsampleRecordsBeforeTime(ctx, lastKeptTime, nil)
+}
+
+// downsampleRecordsBeforeTime prunes records before the given time like pruneRecordsBeforeTimeButNewest,
+// except for the records the historical retention tiers keep.
+// Every record before lastKeptTime belongs to the first tier whose keep period is at least the record's age,
+// and for each (pool id, asset 0, asset 1) triplet, only the newest record of every resolution
+// interval of its tier is kept. Records older than every tier are pruned, but for the newest one.
+// For example, with a 48 hour keep period and a single tier of 1 hour resolution and 30 days keep period:
+// - Records younger than 48 hours are all kept.
+// - Of the records between 48 hours and 30 days old, the newest record of every hour is kept.
+// - Records older than 30 days are pruned, unless there is no newer record before the 48 hours.
+//
+// When the last tier keeps its records forever, the records it has already downsampled are not
+// iterated again, so that pruning doesn't get slower as they accumulate.
+func (k Keeper) downsampleRecordsBeforeTime(ctx sdk.Context, lastKeptTime time.Time, tiers []types.HistoricalRetentionTier) error {
 	store := ctx.KVStore(k.storeKey)
+	now := ctx.BlockTime()
+
+	iterStart := []byte(types.HistoricalTWAPTimeIndexPrefix)
+	keepsForever := len(tiers) > 0 && tiers[len(tiers)-1].KeepPeriod == 0
+	if downsampledUntil, found := k.getHistoricalDownsampledUntil(ctx); keepsForever && found {
+		// the resolution interval the marker is in may not have been fully downsampled yet.
+		foreverResolution := tiers[len(tiers)-1].Resolution
+		iterStart = types.FormatHistoricalTimeIndexTWAPKey(downsampledUntil.Truncate(foreverResolution), 0, "", "")
+	}
 
 	// Reverse iterator guarantees that we iterate through the newest per pool first.
 	// Due to how it is indexed, we will only iterate times starting from
 	// lastKeptTime exclusively down to the oldest record.
 	iter := store.ReverseIterator(
-		[]byte(types.HistoricalTWAPTimeIndexPrefix),
+		iterStart,
 		types.FormatHistoricalTimeIndexTWAPKey(lastKeptTime, 0, "", ""))
 	defer iter.Close()
 
@@ -93,6 +118,15 @@ func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime ti
 	}
 	seenPoolAssetTriplets := map[uniqueTriplet]struct{}{}
 
+	// We mark what resolution intervals we've seen a record in, for every triplet.
+	// We prune all records of an interval that we have already seen.
+	type tripletInterval struct {
+		triplet       uniqueTriplet
+		tier          int
+		intervalStart int64
+	}
+	seenIntervals := map[tripletInterval]struct{}{}
+
 	for ; iter.Valid(); iter.Next() {
 		twapToRemove, err := types.ParseTwapFromBz(iter.Value())
 		if err != nil {
@@ -104,17 +138,65 @@ func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime ti
 			asset0: twapToRemove.Asset0Denom,
 			asset1: twapToRemove.Asset1Denom,
 		}
+		tier, intervalStart, inTier := retentionInterval(tiers, now, twapToRemove.Time)
+		intervalKey := tripletInterval{triplet: poolKey, tier: tier, intervalStart: intervalStart.UnixNano()}
+
 		_, hasSeenPoolRecord := seenPoolAssetTriplets[poolKey]
 		if !hasSeenPoolRecord {
 			seenPoolAssetTriplets[poolKey] = struct{}{}
+			seenIntervals[intervalKey] = struct{}{}
+			continue
+		}
+
+		if _, hasSeenInterval := seenIntervals[intervalKey]; inTier && !hasSeenInterval {
+			seenIntervals[intervalKey] = struct{}{}
 			continue
 		}
 
 		k.deleteHistoricalRecord(ctx, twapToRemove)
 	}
+
+	if keepsForever {
+		foreverTierStart := lastKeptTime
+		if len(tiers) > 1 {
+			foreverTierStart = now.Add(-tiers[len(tiers)-2].KeepPeriod)
+		}
+		k.setHistoricalDownsampledUntil(ctx, foreverTierStart)
+	}
 	return nil
 }
 
+// retentionInterval returns the historical retention tier a record of the given time belongs to,
+// and the start of the tier's resolution interval it is in.
+// Returns false if the record is older than every tier.
+func retentionInterval(tiers []types.HistoricalRetentionTier, now time.Time, recordTime time.Time) (int, time.Time, bool) {
+	age := now.Sub(recordTime)
+	for i, tier := range tiers {
+		if tier.KeepPeriod == 0 || age <= tier.KeepPeriod {
+			return i, recordTime.Truncate(tier.Resolution), true
+		}
+	}
+	return 0, time.Time{}, false
+}
+
+// getHistoricalDownsampledUntil returns the time before which records have been downsampled
+// by a historical retention tier that keeps its records forever.
+func (k Keeper) getHistoricalDownsampledUntil(ctx sdk.Context) (time.Time, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.HistoricalTWAPDownsampledUntilKey)
+	if bz == nil {
+		return time.Time{}, false
+	}
+	downsampledUntil, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return downsampledUntil, true
+}
+
+func (k Keeper) setHistoricalDownsampledUntil(ctx sdk.Context, downsampledUntil time.Time) {
+	ctx.KVStore(k.storeKey).Set(types.HistoricalTWAPDownsampledUntilKey, sdk.FormatTimeBytes(downsampledUntil))
+}
+
 func (k Keeper) deleteHistoricalRecord(ctx sdk.Context, twap types.TwapRecord) {
 	store := ctx.KVStore(k.storeKey)
 	key1 := types.FormatHistoricalTimeIndexTWAPKey(twap.Time, twap.PoolId, twap.Asset0Denom, twap.Asset1Denom)
@@ -157,7 +239,6 @@ func (k Keeper) getAllHistoricalTimeIndexedTWAPs(ctx sdk.Context) ([]types.TwapR
 }
 
 // getAllHistoricalPoolIndexedTWAPs returns all historical TWAPs indexed by pool id.
-// nolint: unused
 func (k Keeper) getAllHistoricalPoolIndexedTWAPs(ctx sdk.Context) ([]types.TwapRecord, error) {
 	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), []byte(types.HistoricalTWAPPoolIndexPrefix), types.ParseTwapFromBz)
 }
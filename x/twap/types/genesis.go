@@ -81,5 +81,10 @@ func (t TwapRecord) validate() error {
 	if t.P1ArithmeticTwapAccumulator.IsNil() || t.P1ArithmeticTwapAccumulator.IsNegative() {
 		return fmt.Errorf("twap record p1 accumulator cannot be negative, was (%s)", t.P1ArithmeticTwapAccumulator)
 	}
+
+	// the geometric accumulator sums log_2 of spot prices, which can be negative.
+	if t.GeometricTwapAccumulator.IsNil() {
+		return errors.New("twap record geometric accumulator cannot be nil")
+	}
 	return nil
 }
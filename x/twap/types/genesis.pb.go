@@ -33,6 +33,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	PruneEpochIdentifier    string        `protobuf:"bytes,1,opt,name=prune_epoch_identifier,json=pruneEpochIdentifier,proto3" json:"prune_epoch_identifier,omitempty"`
 	RecordHistoryKeepPeriod time.Duration `protobuf:"bytes,2,opt,name=record_history_keep_period,json=recordHistoryKeepPeriod,proto3,stdduration" json:"record_history_keep_period" yaml:"record_history_keep_period"`
+	// historical_retention_tiers downsample the records older than
+	// record_history_keep_period instead of pruning them, so that TWAPs can be
+	// computed over longer time ranges at a lower resolution.
+	HistoricalRetentionTiers []HistoricalRetentionTier `protobuf:"bytes,3,rep,name=historical_retention_tiers,json=historicalRetentionTiers,proto3" json:"historical_retention_tiers" yaml:"historical_retention_tiers"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -82,6 +86,71 @@ func (m *Params) GetRecordHistoryKeepPeriod() time.Duration {
 	return 0
 }
 
+func (m *Params) GetHistoricalRetentionTiers() []HistoricalRetentionTier {
+	if m != nil {
+		return m.HistoricalRetentionTiers
+	}
+	return nil
+}
+
+// HistoricalRetentionTier keeps at most one record per resolution, for every
+// (pool id, asset 0, asset 1) triplet, among the records older than the keep
+// period of the previous tier and at most as old as its own keep period.
+type HistoricalRetentionTier struct {
+	Resolution time.Duration `protobuf:"bytes,1,opt,name=resolution,proto3,stdduration" json:"resolution" yaml:"resolution"`
+	// keep_period is the age after which records leave this tier. A zero keep
+	// period keeps the tier's records forever, and is only allowed for the last
+	// tier.
+	KeepPeriod time.Duration `protobuf:"bytes,2,opt,name=keep_period,json=keepPeriod,proto3,stdduration" json:"keep_period" yaml:"keep_period"`
+}
+
+func (m *HistoricalRetentionTier) Reset()         { *m = HistoricalRetentionTier{} }
+func (m *HistoricalRetentionTier) String() string { return proto.CompactTextString(m) }
+func (*HistoricalRetentionTier) ProtoMessage()    {}
+func (*HistoricalRetentionTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f4bdf49b69bd63c, []int{1}
+}
+func (m *HistoricalRetentionTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoricalRetentionTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricalRetentionTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoricalRetentionTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalRetentionTier.Merge(m, src)
+}
+func (m *HistoricalRetentionTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoricalRetentionTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalRetentionTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricalRetentionTier proto.InternalMessageInfo
+
+func (m *HistoricalRetentionTier) GetResolution() time.Duration {
+	if m != nil {
+		return m.Resolution
+	}
+	return 0
+}
+
+func (m *HistoricalRetentionTier) GetKeepPeriod() time.Duration {
+	if m != nil {
+		return m.KeepPeriod
+	}
+	return 0
+}
+
 // GenesisState defines the twap module's genesis state.
 type GenesisState struct {
 	// twaps is the collection of all twap records.
@@ -94,7 +163,7 @@ func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f4bdf49b69bd63c, []int{1}
+	return fileDescriptor_3f4bdf49b69bd63c, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -139,6 +208,7 @@ func (m *GenesisState) GetParams() Params {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.twap.v1beta1.Params")
+	proto.RegisterType((*HistoricalRetentionTier)(nil), "osmosis.twap.v1beta1.HistoricalRetentionTier")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.twap.v1beta1.GenesisState")
 }
 
@@ -147,32 +217,38 @@ func init() {
 }
 
 var fileDescriptor_3f4bdf49b69bd63c = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbd, 0x0e, 0xd3, 0x30,
-	0x14, 0x85, 0x63, 0x7e, 0x2a, 0x91, 0x32, 0x45, 0x15, 0xb4, 0x15, 0x4a, 0x43, 0x06, 0xd4, 0xa5,
-	0x36, 0x2d, 0x4c, 0x15, 0x53, 0x05, 0xe2, 0x6f, 0xa9, 0x02, 0x13, 0x4b, 0xe4, 0x24, 0xb7, 0xa9,
-	0x45, 0x13, 0x5b, 0xb6, 0xd3, 0x92, 0x07, 0x40, 0x62, 0x64, 0xe4, 0x89, 0x50, 0xc7, 0x8e, 0x4c,
-	0x05, 0xb5, 0x6f, 0xc0, 0x13, 0xa0, 0xc4, 0x2e, 0x03, 0x2a, 0x5b, 0xae, 0xbe, 0x73, 0x8e, 0x4e,
-	0xee, 0xb5, 0x1b, 0x72, 0x55, 0x70, 0xc5, 0x14, 0xd1, 0x3b, 0x2a, 0xc8, 0x76, 0x9a, 0x80, 0xa6,
-	0x53, 0x92, 0x43, 0x09, 0x8a, 0x29, 0x2c, 0x24, 0xd7, 0xdc, 0xeb, 0x59, 0x0d, 0x6e, 0x34, 0xd8,
-	0x6a, 0x86, 0xbd, 0x9c, 0xe7, 0xbc, 0x15, 0x90, 0xe6, 0xcb, 0x68, 0x87, 0x8f, 0xae, 0xe6, 0x35,
-	0x43, 0x2c, 0x21, 0xe5, 0x32, 0xb3, 0xba, 0x41, 0xce, 0x79, 0xbe, 0x01, 0xd2, 0x4e, 0x49, 0xb5,
-	0x22, 0xb4, 0xac, 0x2f, 0x28, 0x6d, 0x33, 0x62, 0x93, 0x6d, 0x06, 0x8b, 0xfc, 0x7f, 0x5d, 0x59,
-	0x25, 0xa9, 0x66, 0xbc, 0x34, 0x3c, 0xfc, 0x8e, 0xdc, 0xce, 0x92, 0x4a, 0x5a, 0x28, 0xef, 0xa9,
-	0x7b, 0x4f, 0xc8, 0xaa, 0x84, 0x18, 0x04, 0x4f, 0xd7, 0x31, 0xcb, 0xa0, 0xd4, 0x6c, 0xc5, 0x40,
-	0xf6, 0x51, 0x80, 0xc6, 0x77, 0xa2, 0x5e, 0x4b, 0x5f, 0x34, 0xf0, 0xf5, 0x5f, 0xe6, 0x7d, 0x46,
-	0xee, 0xd0, 0xf4, 0x8c, 0xd7, 0x4c, 0x69, 0x2e, 0xeb, 0xf8, 0x23, 0x80, 0x88, 0x05, 0x48, 0xc6,
-	0xb3, 0xfe, 0x8d, 0x00, 0x8d, 0xbb, 0xb3, 0x01, 0x36, 0x35, 0xf0, 0xa5, 0x06, 0x7e, 0x6e, 0x6b,
-	0x2c, 0x26, 0xfb, 0xe3, 0xc8, 0xf9, 0x7d, 0x1c, 0x3d, 0xac, 0x69, 0xb1, 0x99, 0x87, 0xff, 0x8f,
-	0x0a, 0xbf, 0xfd, 0x1c, 0xa1, 0xe8, 0xbe, 0x11, 0xbc, 0x32, 0xfc, 0x2d, 0x80, 0x58, 0x1a, 0xfa,
-	0x05, 0xb9, 0x77, 0x5f, 0x9a, 0x23, 0xbc, 0xd3, 0x54, 0x83, 0xf7, 0xcc, 0xbd, 0xdd, 0x2c, 0x51,
-	0xf5, 0x51, 0x70, 0x73, 0xdc, 0x9d, 0x05, 0xf8, 0xda, 0x4d, 0xf0, 0xfb, 0x1d, 0x15, 0x51, 0x1b,
-	0xb9, 0xb8, 0xd5, 0x34, 0x89, 0x8c, 0xc9, 0x9b, 0xbb, 0x1d, 0xd1, 0xae, 0xc5, 0xfe, 0xc1, 0x83,
-	0xeb, 0x76, 0xb3, 0x3a, 0x6b, 0xb5, 0x8e, 0xc5, 0x9b, 0xfd, 0xc9, 0x47, 0x87, 0x93, 0x8f, 0x7e,
-	0x9d, 0x7c, 0xf4, 0xf5, 0xec, 0x3b, 0x87, 0xb3, 0xef, 0xfc, 0x38, 0xfb, 0xce, 0x87, 0xc7, 0x39,
-	0xd3, 0xeb, 0x2a, 0xc1, 0x29, 0x2f, 0x88, 0xcd, 0x9b, 0x6c, 0x68, 0xa2, 0x2e, 0x03, 0xd9, 0x4e,
-	0x67, 0xe4, 0x93, 0x79, 0x09, 0xba, 0x16, 0xa0, 0x92, 0x4e, 0xbb, 0xb1, 0x27, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x34, 0xde, 0xf1, 0x35, 0x76, 0x02, 0x00, 0x00,
+	// 496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xce, 0xb5, 0x50, 0x89, 0x0b, 0x0b, 0x56, 0x44, 0xdd, 0x08, 0x9c, 0xe0, 0x01, 0x85, 0x21,
+	0x36, 0x09, 0x4c, 0x15, 0x53, 0x04, 0xa2, 0xc0, 0x52, 0x99, 0x0e, 0xa8, 0x8b, 0x75, 0x76, 0xde,
+	0x3a, 0xa7, 0x3a, 0xbe, 0xd3, 0xdd, 0xb9, 0x25, 0x3f, 0x00, 0x89, 0x91, 0x09, 0xf1, 0x93, 0xba,
+	0xd1, 0x91, 0xa9, 0x45, 0xc9, 0x3f, 0xe0, 0x17, 0x20, 0xdf, 0x9d, 0x23, 0x3e, 0x1c, 0x21, 0xb6,
+	0xbc, 0x79, 0x3e, 0xee, 0xd1, 0xf3, 0xbe, 0xc6, 0x3e, 0x93, 0x73, 0x26, 0xa9, 0x0c, 0xd5, 0x39,
+	0xe1, 0xe1, 0xd9, 0x28, 0x01, 0x45, 0x46, 0x61, 0x06, 0x05, 0x48, 0x2a, 0x03, 0x2e, 0x98, 0x62,
+	0x4e, 0xc7, 0x72, 0x82, 0x8a, 0x13, 0x58, 0x4e, 0xb7, 0x93, 0xb1, 0x8c, 0x69, 0x42, 0x58, 0xfd,
+	0x32, 0xdc, 0xee, 0xc3, 0x46, 0xbf, 0x6a, 0x88, 0x05, 0xa4, 0x4c, 0x4c, 0x2d, 0x6f, 0x2f, 0x63,
+	0x2c, 0xcb, 0x21, 0xd4, 0x53, 0x52, 0x9e, 0x84, 0xa4, 0x58, 0xd4, 0x50, 0xaa, 0x3d, 0x62, 0xe3,
+	0x6d, 0x06, 0x0b, 0x79, 0x7f, 0xaa, 0xa6, 0xa5, 0x20, 0x8a, 0xb2, 0xc2, 0xe0, 0xfe, 0xf5, 0x16,
+	0xde, 0x39, 0x24, 0x82, 0xcc, 0xa5, 0xf3, 0x14, 0xdf, 0xe5, 0xa2, 0x2c, 0x20, 0x06, 0xce, 0xd2,
+	0x59, 0x4c, 0xa7, 0x50, 0x28, 0x7a, 0x42, 0x41, 0xb8, 0xa8, 0x8f, 0x06, 0xb7, 0xa2, 0x8e, 0x46,
+	0x5f, 0x54, 0xe0, 0xab, 0x35, 0xe6, 0x7c, 0x40, 0xb8, 0x6b, 0x72, 0xc6, 0x33, 0x2a, 0x15, 0x13,
+	0x8b, 0xf8, 0x14, 0x80, 0xc7, 0x1c, 0x04, 0x65, 0x53, 0x77, 0xab, 0x8f, 0x06, 0xed, 0xf1, 0x5e,
+	0x60, 0x62, 0x04, 0x75, 0x8c, 0xe0, 0xb9, 0x8d, 0x31, 0x19, 0x5e, 0x5c, 0xf5, 0x5a, 0x3f, 0xae,
+	0x7a, 0x0f, 0x16, 0x64, 0x9e, 0xef, 0xfb, 0x9b, 0xad, 0xfc, 0x2f, 0xd7, 0x3d, 0x14, 0xed, 0x1a,
+	0xc2, 0x81, 0xc1, 0xdf, 0x00, 0xf0, 0x43, 0x8d, 0x3a, 0x9f, 0x11, 0xee, 0x1a, 0x15, 0x4d, 0x49,
+	0x1e, 0x0b, 0x50, 0x55, 0x44, 0x56, 0xc4, 0x8a, 0x82, 0x90, 0xee, 0x76, 0x7f, 0x7b, 0xd0, 0x1e,
+	0x0f, 0x83, 0xa6, 0xc5, 0x04, 0x07, 0x6b, 0x5d, 0x54, 0xcb, 0x8e, 0x28, 0x88, 0xc9, 0xa3, 0xdf,
+	0xb3, 0x6d, 0xb6, 0xf7, 0x23, 0x77, 0xd6, 0xec, 0x21, 0xfd, 0xaf, 0x08, 0xef, 0x6e, 0x78, 0xc0,
+	0x79, 0x87, 0xb1, 0x00, 0xc9, 0xf2, 0xb2, 0xfa, 0xc7, 0x45, 0xff, 0xea, 0xea, 0xbe, 0xcd, 0x73,
+	0xa7, 0xee, 0xaa, 0x96, 0x9a, 0x6e, 0x7e, 0xf1, 0x72, 0x8e, 0x71, 0xfb, 0xbf, 0xd6, 0xe0, 0x59,
+	0x6b, 0xc7, 0x58, 0xff, 0xd5, 0x3b, 0x3e, 0x5d, 0x57, 0xed, 0x7f, 0x44, 0xf8, 0xf6, 0x4b, 0x73,
+	0xef, 0x6f, 0x15, 0x51, 0xe0, 0x3c, 0xc3, 0x37, 0xab, 0x3e, 0xa5, 0x8b, 0x74, 0xcb, 0xfd, 0xe6,
+	0x96, 0x8f, 0xce, 0x09, 0x8f, 0xf4, 0xf6, 0x26, 0x37, 0xaa, 0xd7, 0x22, 0x23, 0x72, 0xf6, 0xf1,
+	0x0e, 0xd7, 0x17, 0x68, 0x53, 0xde, 0x6b, 0x96, 0x9b, 0x2b, 0xb5, 0x52, 0xab, 0x98, 0xbc, 0xbe,
+	0x58, 0x7a, 0xe8, 0x72, 0xe9, 0xa1, 0xef, 0x4b, 0x0f, 0x7d, 0x5a, 0x79, 0xad, 0xcb, 0x95, 0xd7,
+	0xfa, 0xb6, 0xf2, 0x5a, 0xc7, 0x8f, 0x33, 0xaa, 0x66, 0x65, 0x12, 0xa4, 0x6c, 0x1e, 0x5a, 0xbf,
+	0x61, 0x4e, 0x12, 0x59, 0x0f, 0xe1, 0xd9, 0x68, 0x1c, 0xbe, 0x37, 0x1f, 0x9d, 0x5a, 0x70, 0x90,
+	0xc9, 0x8e, 0x6e, 0xe5, 0xc9, 0xcf, 0x01, 0x00, 0xfd, 0xbd, 0x4e, 0xcd, 0xe1, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -195,6 +271,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HistoricalRetentionTiers) > 0 {
+		for iNdEx := len(m.HistoricalRetentionTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoricalRetentionTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RecordHistoryKeepPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod):])
 	if err1 != nil {
 		return 0, err1
@@ -213,6 +303,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HistoricalRetentionTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalRetentionTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalRetentionTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.KeepPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.KeepPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Resolution, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Resolution):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -283,6 +412,25 @@ func (m *Params) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod)
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.HistoricalRetentionTiers) > 0 {
+		for _, e := range m.HistoricalRetentionTiers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HistoricalRetentionTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Resolution)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.KeepPeriod)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -403,6 +551,156 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalRetentionTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoricalRetentionTiers = append(m.HistoricalRetentionTiers, HistoricalRetentionTier{})
+			if err := m.HistoricalRetentionTiers[len(m.HistoricalRetentionTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricalRetentionTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalRetentionTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalRetentionTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Resolution, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.KeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -28,12 +28,13 @@ var (
 		P1LastSpotPrice:             sdk.OneDec(),
 		P0ArithmeticTwapAccumulator: sdk.OneDec(),
 		P1ArithmeticTwapAccumulator: sdk.OneDec(),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
 	}
 )
 
 func TestGenesisState_Validate(t *testing.T) {
 	var (
-		basicParams = NewParams("week", 48*time.Hour)
+		basicParams = NewParams("week", 48*time.Hour, nil)
 
 		basicCustomGenesis = NewGenesisState(
 			basicParams,
@@ -55,6 +56,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					P1LastSpotPrice:             sdk.OneDec(),
 					P0ArithmeticTwapAccumulator: sdk.OneDec(),
 					P1ArithmeticTwapAccumulator: sdk.OneDec(),
+					GeometricTwapAccumulator:    sdk.ZeroDec(),
 				},
 				{
 					PoolId:                      basePoolId,
@@ -66,6 +68,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					P1LastSpotPrice:             sdk.OneDec(),
 					P0ArithmeticTwapAccumulator: sdk.OneDec(),
 					P1ArithmeticTwapAccumulator: sdk.OneDec(),
+					GeometricTwapAccumulator:    sdk.ZeroDec(),
 				},
 			})
 	)
@@ -89,7 +92,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		"invalid genesis - pool ID doesn't exist": {
 			twapGenesis: NewGenesisState(
-				NewParams("week", 48*time.Hour),
+				NewParams("week", 48*time.Hour, nil),
 				[]TwapRecord{
 					{
 						PoolId:                      0, // invalid
@@ -101,6 +104,7 @@ func TestGenesisState_Validate(t *testing.T) {
 						P1LastSpotPrice:             sdk.OneDec(),
 						P0ArithmeticTwapAccumulator: sdk.OneDec(),
 						P1ArithmeticTwapAccumulator: sdk.OneDec(),
+						GeometricTwapAccumulator:    sdk.ZeroDec(),
 					},
 				}),
 
@@ -108,7 +112,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		"invalid pruneEpochIdentifier - error": {
 			twapGenesis: NewGenesisState(
-				NewParams("", 48*time.Hour), // invalid empty string
+				NewParams("", 48*time.Hour, nil), // invalid empty string
 				[]TwapRecord{
 					baseRecord,
 				}),
@@ -117,7 +121,75 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		"invalid recordHistoryKeepPeriod - error": {
 			twapGenesis: NewGenesisState(
-				NewParams("week", -1*time.Hour), // invalid duration
+				NewParams("week", -1*time.Hour, nil), // invalid duration
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
+		"valid historicalRetentionTiers": {
+			twapGenesis: NewGenesisState(
+				NewParams("week", 48*time.Hour, []HistoricalRetentionTier{
+					{Resolution: time.Hour, KeepPeriod: 30 * 24 * time.Hour},
+					{Resolution: 24 * time.Hour, KeepPeriod: 0}, // kept forever
+				}),
+				[]TwapRecord{
+					baseRecord,
+				}),
+		},
+		"invalid historicalRetentionTiers, zero resolution - error": {
+			twapGenesis: NewGenesisState(
+				NewParams("week", 48*time.Hour, []HistoricalRetentionTier{
+					{Resolution: 0, KeepPeriod: 30 * 24 * time.Hour},
+				}),
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
+		"invalid historicalRetentionTiers, keep forever before last tier - error": {
+			twapGenesis: NewGenesisState(
+				NewParams("week", 48*time.Hour, []HistoricalRetentionTier{
+					{Resolution: time.Hour, KeepPeriod: 0},
+					{Resolution: 24 * time.Hour, KeepPeriod: 0},
+				}),
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
+		"invalid historicalRetentionTiers, decreasing keep period - error": {
+			twapGenesis: NewGenesisState(
+				NewParams("week", 48*time.Hour, []HistoricalRetentionTier{
+					{Resolution: time.Hour, KeepPeriod: 30 * 24 * time.Hour},
+					{Resolution: 24 * time.Hour, KeepPeriod: 7 * 24 * time.Hour},
+				}),
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
+		"invalid historicalRetentionTiers, decreasing resolution - error": {
+			twapGenesis: NewGenesisState(
+				NewParams("week", 48*time.Hour, []HistoricalRetentionTier{
+					{Resolution: 24 * time.Hour, KeepPeriod: 30 * 24 * time.Hour},
+					{Resolution: time.Hour, KeepPeriod: 0},
+				}),
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
+		"invalid historicalRetentionTiers, keep period within recordHistoryKeepPeriod - error": {
+			twapGenesis: NewGenesisState(
+				NewParams("week", 48*time.Hour, []HistoricalRetentionTier{
+					{Resolution: time.Hour, KeepPeriod: 24 * time.Hour},
+				}),
 				[]TwapRecord{
 					baseRecord,
 				}),
@@ -229,6 +301,7 @@ func TestTWAPRecord_Validate(t *testing.T) {
 					P1LastSpotPrice:             sdk.OneDec(),
 					P0ArithmeticTwapAccumulator: sdk.OneDec(),
 					P1ArithmeticTwapAccumulator: sdk.OneDec(),
+					GeometricTwapAccumulator:    sdk.ZeroDec(),
 				}
 				return r
 			}(),
@@ -246,6 +319,7 @@ func TestTWAPRecord_Validate(t *testing.T) {
 					P0LastSpotPrice:             sdk.OneDec(),
 					P0ArithmeticTwapAccumulator: sdk.OneDec(),
 					P1ArithmeticTwapAccumulator: sdk.OneDec(),
+					GeometricTwapAccumulator:    sdk.ZeroDec(),
 				}
 				return r
 			}(),
@@ -272,6 +346,7 @@ func TestTWAPRecord_Validate(t *testing.T) {
 					P0LastSpotPrice:             sdk.OneDec(),
 					P1LastSpotPrice:             sdk.OneDec(),
 					P1ArithmeticTwapAccumulator: sdk.OneDec(),
+					GeometricTwapAccumulator:    sdk.ZeroDec(),
 				}
 				return r
 			}(),
@@ -42,6 +42,9 @@ var (
 	// format is pool id | denom1 | denom2 | time
 	// made for efficiently getting records given (pool id, denom1, denom2) and time bounds
 	HistoricalTWAPPoolIndexPrefix = historicalTWAPPoolIndexNoSeparator + KeySeparator
+	// time before which historical records have already been downsampled to the
+	// resolution of the last historical retention tier, if it keeps records forever.
+	HistoricalTWAPDownsampledUntilKey = []byte("historical_downsampled_until")
 )
 
 // TODO: make utility command to automatically interlace separators
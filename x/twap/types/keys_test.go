@@ -74,6 +74,7 @@ func TestParseTwapFromBz(t *testing.T) {
 			P1LastSpotPrice:             sdk.NewDecWithPrec(2, 5), // inconsistent value
 			P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
 			P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
+			GeometricTwapAccumulator:    sdk.ZeroDec(),
 		}},
 	}
 	for name, tt := range tests {
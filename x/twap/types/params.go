@@ -11,8 +11,9 @@ import (
 
 // Parameter store keys.
 var (
-	KeyPruneEpochIdentifier    = []byte("PruneEpochIdentifier")
-	KeyRecordHistoryKeepPeriod = []byte("RecordHistoryKeepPeriod")
+	KeyPruneEpochIdentifier     = []byte("PruneEpochIdentifier")
+	KeyRecordHistoryKeepPeriod  = []byte("RecordHistoryKeepPeriod")
+	KeyHistoricalRetentionTiers = []byte("HistoricalRetentionTiers")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -22,26 +23,40 @@ const (
 	defaultRecordHistoryKeepPeriod = 48 * time.Hour
 )
 
+// By default, records older than the record history keep period are kept
+// hourly for 30 days, and daily forever.
+var defaultHistoricalRetentionTiers = []HistoricalRetentionTier{
+	{Resolution: time.Hour, KeepPeriod: 30 * 24 * time.Hour},
+	{Resolution: 24 * time.Hour, KeepPeriod: 0},
+}
+
 // ParamTable for twap module.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(pruneEpochIdentifier string, recordHistoryKeepPeriod time.Duration) Params {
+func NewParams(pruneEpochIdentifier string, recordHistoryKeepPeriod time.Duration, historicalRetentionTiers []HistoricalRetentionTier) Params {
 	return Params{
-		PruneEpochIdentifier:    pruneEpochIdentifier,
-		RecordHistoryKeepPeriod: recordHistoryKeepPeriod,
+		PruneEpochIdentifier:     pruneEpochIdentifier,
+		RecordHistoryKeepPeriod:  recordHistoryKeepPeriod,
+		HistoricalRetentionTiers: historicalRetentionTiers,
 	}
 }
 
 // default twap module parameters.
 func DefaultParams() Params {
 	return Params{
-		PruneEpochIdentifier:    defaultPruneEpochIdentifier,
-		RecordHistoryKeepPeriod: defaultRecordHistoryKeepPeriod,
+		PruneEpochIdentifier:     defaultPruneEpochIdentifier,
+		RecordHistoryKeepPeriod:  defaultRecordHistoryKeepPeriod,
+		HistoricalRetentionTiers: DefaultHistoricalRetentionTiers(),
 	}
 }
 
+// DefaultHistoricalRetentionTiers returns the default historical retention tiers.
+func DefaultHistoricalRetentionTiers() []HistoricalRetentionTier {
+	return append([]HistoricalRetentionTier{}, defaultHistoricalRetentionTiers...)
+}
+
 // validate params.
 func (p Params) Validate() error {
 	if err := epochtypes.ValidateEpochIdentifierString(p.PruneEpochIdentifier); err != nil {
@@ -52,6 +67,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateHistoricalRetentionTiers(p.HistoricalRetentionTiers); err != nil {
+		return err
+	}
+
+	if len(p.HistoricalRetentionTiers) > 0 {
+		firstKeepPeriod := p.HistoricalRetentionTiers[0].KeepPeriod
+		if firstKeepPeriod != 0 && firstKeepPeriod <= p.RecordHistoryKeepPeriod {
+			return fmt.Errorf("first historical retention tier keep period %s must be greater than the record history keep period %s",
+				firstKeepPeriod, p.RecordHistoryKeepPeriod)
+		}
+	}
+
 	return nil
 }
 
@@ -60,6 +87,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPruneEpochIdentifier, &p.PruneEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
 		paramtypes.NewParamSetPair(KeyRecordHistoryKeepPeriod, &p.RecordHistoryKeepPeriod, validatePeriod),
+		paramtypes.NewParamSetPair(KeyHistoricalRetentionTiers, &p.HistoricalRetentionTiers, validateHistoricalRetentionTiers),
 	}
 }
 
@@ -75,3 +103,43 @@ func validatePeriod(i interface{}) error {
 
 	return nil
 }
+
+// validateHistoricalRetentionTiers checks that tiers have positive resolutions,
+// and strictly increasing keep periods, with only the last tier allowed to keep
+// its records forever.
+func validateHistoricalRetentionTiers(i interface{}) error {
+	tiers, ok := i.([]HistoricalRetentionTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for i, tier := range tiers {
+		if err := validatePeriod(tier.Resolution); err != nil {
+			return fmt.Errorf("invalid resolution of historical retention tier %d: %w", i, err)
+		}
+
+		if tier.KeepPeriod < 0 {
+			return fmt.Errorf("keep period of historical retention tier %d must not be negative: %s", i, tier.KeepPeriod)
+		}
+		if tier.KeepPeriod == 0 && i != len(tiers)-1 {
+			return fmt.Errorf("only the last historical retention tier can keep its records forever, got tier %d", i)
+		}
+		if tier.KeepPeriod != 0 && tier.KeepPeriod < tier.Resolution {
+			return fmt.Errorf("keep period %s of historical retention tier %d must be at least its resolution %s", tier.KeepPeriod, i, tier.Resolution)
+		}
+
+		if i > 0 {
+			previous := tiers[i-1]
+			if tier.KeepPeriod != 0 && tier.KeepPeriod <= previous.KeepPeriod {
+				return fmt.Errorf("keep periods of historical retention tiers must be strictly increasing, got %s after %s",
+					tier.KeepPeriod, previous.KeepPeriod)
+			}
+			if tier.Resolution < previous.Resolution {
+				return fmt.Errorf("resolutions of historical retention tiers must not decrease, got %s after %s",
+					tier.Resolution, previous.Resolution)
+			}
+		}
+	}
+
+	return nil
+}
@@ -55,6 +55,10 @@ type TwapRecord struct {
 	P1LastSpotPrice             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=p1_last_spot_price,json=p1LastSpotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p1_last_spot_price"`
 	P0ArithmeticTwapAccumulator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=p0_arithmetic_twap_accumulator,json=p0ArithmeticTwapAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p0_arithmetic_twap_accumulator"`
 	P1ArithmeticTwapAccumulator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=p1_arithmetic_twap_accumulator,json=p1ArithmeticTwapAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p1_arithmetic_twap_accumulator"`
+	// Accumulator of log_2 of the asset0 spot price (P0) over time, used to
+	// compute geometric TWAPs. As log_2(P1) = -log_2(P0), a single accumulator
+	// serves both directions of the pair.
+	GeometricTwapAccumulator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=geometric_twap_accumulator,json=geometricTwapAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"geometric_twap_accumulator"`
 	// This field contains the time in which the last spot price error occured.
 	// It is used to alert the caller if they are getting a potentially erroneous
 	// TWAP, due to an unforeseen underlying error.
@@ -145,40 +149,41 @@ func init() {
 }
 
 var fileDescriptor_dbf5c78678e601aa = []byte{
-	// 523 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x63, 0x1a, 0x5c, 0xba, 0xa1, 0xaa, 0x64, 0x45, 0x60, 0x82, 0x64, 0x07, 0x1f, 0xaa,
-	0x70, 0xa8, 0x3f, 0xca, 0x8d, 0x5b, 0xa2, 0x72, 0x00, 0x21, 0x84, 0x4c, 0x4f, 0x70, 0x58, 0xad,
-	0xed, 0xad, 0x63, 0x61, 0x67, 0x57, 0xde, 0x4d, 0x4b, 0x5e, 0x81, 0x53, 0x1f, 0xab, 0xc7, 0x1e,
-	0x11, 0x07, 0x83, 0x92, 0x1b, 0xc7, 0x3e, 0x01, 0xda, 0x8f, 0x84, 0x26, 0x08, 0x2a, 0xe5, 0x64,
-	0xcf, 0xcc, 0x7f, 0x7e, 0x33, 0xb3, 0xb3, 0x36, 0x38, 0x24, 0xac, 0x22, 0xac, 0x60, 0x01, 0xbf,
-	0x40, 0x34, 0x38, 0x8f, 0x12, 0xcc, 0x51, 0x24, 0x0d, 0x58, 0xe3, 0x94, 0xd4, 0x99, 0x4f, 0x6b,
-	0xc2, 0x89, 0xd5, 0xd5, 0x3a, 0x5f, 0x84, 0x7c, 0xad, 0xeb, 0x75, 0x73, 0x92, 0x13, 0x29, 0x08,
-	0xc4, 0x9b, 0xd2, 0xf6, 0x9e, 0xe4, 0x84, 0xe4, 0x25, 0x0e, 0xa4, 0x95, 0x4c, 0xcf, 0x02, 0x34,
-	0x99, 0x2d, 0x43, 0xa9, 0xe4, 0x40, 0x95, 0xa3, 0x0c, 0x1d, 0x72, 0x94, 0x15, 0x24, 0x88, 0xe1,
-	0x55, 0x23, 0x29, 0x29, 0x26, 0x3a, 0xee, 0x6e, 0x52, 0x79, 0x51, 0x61, 0xc6, 0x51, 0x45, 0x95,
-	0xc0, 0xfb, 0x6a, 0x02, 0x70, 0x7a, 0x81, 0x68, 0x2c, 0xfb, 0xb6, 0x1e, 0x83, 0x5d, 0x4a, 0x48,
-	0x09, 0x8b, 0xcc, 0x36, 0xfa, 0xc6, 0xa0, 0x1d, 0x9b, 0xc2, 0x7c, 0x9d, 0x59, 0xcf, 0xc0, 0x43,
-	0xc4, 0x18, 0xe6, 0x21, 0xcc, 0xf0, 0x84, 0x54, 0xf6, 0xbd, 0xbe, 0x31, 0xd8, 0x8b, 0x3b, 0xca,
-	0x77, 0x22, 0x5c, 0x2b, 0x49, 0xa4, 0x25, 0x3b, 0xb7, 0x24, 0x91, 0x92, 0x0c, 0x81, 0x39, 0xc6,
-	0x45, 0x3e, 0xe6, 0x76, 0xbb, 0x6f, 0x0c, 0x76, 0x46, 0xcf, 0x7f, 0x35, 0xee, 0xbe, 0x3a, 0x32,
-	0xa8, 0x02, 0x37, 0x8d, 0xdb, 0x9d, 0xa1, 0xaa, 0x7c, 0xe9, 0xad, 0xb9, 0xbd, 0x58, 0x27, 0x5a,
-	0xef, 0x40, 0x5b, 0xcc, 0x60, 0xdf, 0xef, 0x1b, 0x83, 0xce, 0x71, 0xcf, 0x57, 0x03, 0xfa, 0xcb,
-	0x01, 0xfd, 0xd3, 0xe5, 0x80, 0x23, 0xe7, 0xaa, 0x71, 0x5b, 0x37, 0x8d, 0x6b, 0xad, 0xf1, 0x44,
-	0xb2, 0x77, 0xf9, 0xc3, 0x35, 0x62, 0xc9, 0xb1, 0x3e, 0x01, 0x8b, 0x86, 0xb0, 0x44, 0x8c, 0x43,
-	0x46, 0x09, 0x87, 0xb4, 0x2e, 0x52, 0x6c, 0x9b, 0xa2, 0xf7, 0x91, 0x2f, 0x08, 0xdf, 0x1b, 0xf7,
-	0x30, 0x2f, 0xf8, 0x78, 0x9a, 0xf8, 0x29, 0xa9, 0xf4, 0xf1, 0xeb, 0xc7, 0x11, 0xcb, 0x3e, 0x07,
-	0x7c, 0x46, 0x31, 0xf3, 0x4f, 0x70, 0x1a, 0x1f, 0xd0, 0xf0, 0x2d, 0x62, 0xfc, 0x03, 0x25, 0xfc,
-	0xbd, 0xc0, 0x48, 0x78, 0xf4, 0x17, 0x7c, 0x77, 0x4b, 0x78, 0xb4, 0x0e, 0x67, 0xc0, 0xa1, 0x21,
-	0x44, 0x75, 0xc1, 0xc7, 0x15, 0xe6, 0x45, 0x0a, 0xe5, 0x05, 0x44, 0x69, 0x3a, 0xad, 0xa6, 0x25,
-	0xe2, 0xa4, 0xb6, 0x1f, 0x6c, 0x55, 0xe8, 0x29, 0x0d, 0x87, 0x2b, 0xa8, 0xb8, 0x1b, 0xc3, 0x3f,
-	0x48, 0x59, 0x34, 0xfa, 0x6f, 0xd1, 0xbd, 0x2d, 0x8b, 0x46, 0xff, 0x2e, 0x7a, 0x06, 0x0e, 0xe4,
-	0x19, 0xe2, 0xba, 0x26, 0xb5, 0xdc, 0xa0, 0xdd, 0xb9, 0x73, 0xfd, 0x9e, 0x5e, 0xff, 0x23, 0xb5,
-	0xfe, 0x0d, 0x80, 0xba, 0x02, 0xfb, 0xc2, 0xfb, 0x4a, 0x38, 0x45, 0xde, 0xe8, 0xcd, 0xd5, 0xdc,
-	0x31, 0xae, 0xe7, 0x8e, 0xf1, 0x73, 0xee, 0x18, 0x97, 0x0b, 0xa7, 0x75, 0xbd, 0x70, 0x5a, 0xdf,
-	0x16, 0x4e, 0xeb, 0x63, 0x78, 0x6b, 0x0c, 0xfd, 0x51, 0x1f, 0x95, 0x28, 0x61, 0x4b, 0x23, 0x38,
-	0x8f, 0x8e, 0x83, 0x2f, 0xea, 0x7f, 0x20, 0x87, 0x4a, 0x4c, 0xd9, 0xd2, 0x8b, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xdf, 0xff, 0x6c, 0x30, 0x2c, 0x04, 0x00, 0x00,
+	// 536 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x63, 0x1a, 0x52, 0x7a, 0xa1, 0xaa, 0x64, 0x45, 0x60, 0x82, 0x64, 0x87, 0x0c, 0x55,
+	0x18, 0xea, 0x1f, 0x65, 0x63, 0x4b, 0x54, 0x06, 0x10, 0x42, 0xc8, 0x74, 0x82, 0xe1, 0x74, 0xb6,
+	0xaf, 0x8e, 0x85, 0x9d, 0x3b, 0xdd, 0x5d, 0x5a, 0xf2, 0x5f, 0xf4, 0xcf, 0xea, 0xd8, 0x11, 0x31,
+	0x18, 0x94, 0x6c, 0x8c, 0x9d, 0x18, 0xd1, 0xfd, 0x48, 0x68, 0xc2, 0x2f, 0x29, 0x53, 0xf2, 0xde,
+	0xfb, 0xbe, 0xcf, 0xf7, 0x3d, 0xfb, 0xc9, 0xe0, 0x90, 0xf0, 0x8a, 0xf0, 0x82, 0x07, 0xe2, 0x02,
+	0xd1, 0xe0, 0x3c, 0x4a, 0xb0, 0x40, 0x91, 0x0a, 0x20, 0xc3, 0x29, 0x61, 0x99, 0x4f, 0x19, 0x11,
+	0xc4, 0xee, 0x18, 0x9d, 0x2f, 0x4b, 0xbe, 0xd1, 0x75, 0x3b, 0x39, 0xc9, 0x89, 0x12, 0x04, 0xf2,
+	0x9f, 0xd6, 0x76, 0x1f, 0xe5, 0x84, 0xe4, 0x25, 0x0e, 0x54, 0x94, 0x4c, 0xcf, 0x02, 0x34, 0x99,
+	0x2d, 0x4b, 0xa9, 0xe2, 0x40, 0xdd, 0xa3, 0x03, 0x53, 0x72, 0x75, 0x14, 0x24, 0x88, 0xe3, 0xd5,
+	0x20, 0x29, 0x29, 0x26, 0xa6, 0xee, 0x6d, 0x52, 0x45, 0x51, 0x61, 0x2e, 0x50, 0x45, 0xb5, 0xa0,
+	0xff, 0xa3, 0x05, 0xc0, 0xe9, 0x05, 0xa2, 0xb1, 0x9a, 0xdb, 0x7e, 0x08, 0x76, 0x29, 0x21, 0x25,
+	0x2c, 0x32, 0xc7, 0xea, 0x59, 0x83, 0x66, 0xdc, 0x92, 0xe1, 0xcb, 0xcc, 0x7e, 0x02, 0xee, 0x23,
+	0xce, 0xb1, 0x08, 0x61, 0x86, 0x27, 0xa4, 0x72, 0xee, 0xf4, 0xac, 0xc1, 0x5e, 0xdc, 0xd6, 0xb9,
+	0x13, 0x99, 0x5a, 0x49, 0x22, 0x23, 0xd9, 0xb9, 0x25, 0x89, 0xb4, 0x64, 0x08, 0x5a, 0x63, 0x5c,
+	0xe4, 0x63, 0xe1, 0x34, 0x7b, 0xd6, 0x60, 0x67, 0xf4, 0xf4, 0x7b, 0xed, 0xed, 0xeb, 0x47, 0x06,
+	0x75, 0xe1, 0xa6, 0xf6, 0x3a, 0x33, 0x54, 0x95, 0xcf, 0xfb, 0x6b, 0xe9, 0x7e, 0x6c, 0x1a, 0xed,
+	0x37, 0xa0, 0x29, 0x77, 0x70, 0xee, 0xf6, 0xac, 0x41, 0xfb, 0xb8, 0xeb, 0xeb, 0x05, 0xfd, 0xe5,
+	0x82, 0xfe, 0xe9, 0x72, 0xc1, 0x91, 0x7b, 0x55, 0x7b, 0x8d, 0x9b, 0xda, 0xb3, 0xd7, 0x78, 0xb2,
+	0xb9, 0x7f, 0xf9, 0xd5, 0xb3, 0x62, 0xc5, 0xb1, 0x3f, 0x00, 0x9b, 0x86, 0xb0, 0x44, 0x5c, 0x40,
+	0x4e, 0x89, 0x80, 0x94, 0x15, 0x29, 0x76, 0x5a, 0x72, 0xf6, 0x91, 0x2f, 0x09, 0x5f, 0x6a, 0xef,
+	0x30, 0x2f, 0xc4, 0x78, 0x9a, 0xf8, 0x29, 0xa9, 0xcc, 0xe3, 0x37, 0x3f, 0x47, 0x3c, 0xfb, 0x18,
+	0x88, 0x19, 0xc5, 0xdc, 0x3f, 0xc1, 0x69, 0x7c, 0x40, 0xc3, 0xd7, 0x88, 0x8b, 0x77, 0x94, 0x88,
+	0xb7, 0x12, 0xa3, 0xe0, 0xd1, 0x6f, 0xf0, 0xdd, 0x2d, 0xe1, 0xd1, 0x3a, 0x9c, 0x03, 0x97, 0x86,
+	0x10, 0xb1, 0x42, 0x8c, 0x2b, 0x2c, 0x8a, 0x14, 0xaa, 0x03, 0x44, 0x69, 0x3a, 0xad, 0xa6, 0x25,
+	0x12, 0x84, 0x39, 0xf7, 0xb6, 0x32, 0x7a, 0x4c, 0xc3, 0xe1, 0x0a, 0x2a, 0x6f, 0x63, 0xf8, 0x0b,
+	0xa9, 0x4c, 0xa3, 0x7f, 0x9a, 0xee, 0x6d, 0x69, 0x1a, 0xfd, 0xdd, 0xb4, 0x04, 0xdd, 0x1c, 0x93,
+	0x0a, 0x0b, 0xf6, 0x27, 0x43, 0xb0, 0x95, 0xa1, 0xb3, 0x22, 0x6e, 0xba, 0x9d, 0x81, 0x03, 0xf5,
+	0xc6, 0x30, 0x63, 0x84, 0xa9, 0x7b, 0x71, 0xda, 0xff, 0x3d, 0xb6, 0xbe, 0x39, 0xb6, 0x07, 0xfa,
+	0xd8, 0x36, 0x00, 0xfa, 0xe0, 0xf6, 0x65, 0xf6, 0x85, 0x4c, 0xca, 0xbe, 0xd1, 0xab, 0xab, 0xb9,
+	0x6b, 0x5d, 0xcf, 0x5d, 0xeb, 0xdb, 0xdc, 0xb5, 0x2e, 0x17, 0x6e, 0xe3, 0x7a, 0xe1, 0x36, 0x3e,
+	0x2f, 0xdc, 0xc6, 0xfb, 0xf0, 0xd6, 0x0e, 0xe6, 0x13, 0x72, 0x54, 0xa2, 0x84, 0x2f, 0x83, 0xe0,
+	0x3c, 0x3a, 0x0e, 0x3e, 0xe9, 0xaf, 0x8f, 0xda, 0x28, 0x69, 0xa9, 0x91, 0x9e, 0xfd, 0x1c, 0x00,
+	0x64, 0x77, 0xcc, 0xd8, 0x9a, 0x04, 0x00, 0x00,
 }
 
 func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
@@ -209,6 +214,16 @@ func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i = encodeVarintTwapRecord(dAtA, i, uint64(n1))
 	i--
 	dAtA[i] = 0x5a
+	{
+		size := m.GeometricTwapAccumulator.Size()
+		i -= size
+		if _, err := m.GeometricTwapAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	{
 		size := m.P1ArithmeticTwapAccumulator.Size()
 		i -= size
@@ -325,6 +340,8 @@ func (m *TwapRecord) Size() (n int) {
 	n += 1 + l + sovTwapRecord(uint64(l))
 	l = m.P1ArithmeticTwapAccumulator.Size()
 	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.GeometricTwapAccumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastErrorTime)
 	n += 1 + l + sovTwapRecord(uint64(l))
 	return n
@@ -636,6 +653,40 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwapAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GeometricTwapAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LastErrorTime", wireType)
@@ -24,12 +24,23 @@ func (k Keeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin
 		return sdk.Coin{}, err
 	}
 
-	spotPrice, err := k.CalcFeeSpotPrice(ctx, feeToken.Denom)
+	price, err := k.CalcFeePrice(ctx, feeToken.Denom)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
 
-	return sdk.NewCoin(baseDenom, spotPrice.MulInt(inputFee.Amount).RoundInt()), nil
+	return sdk.NewCoin(baseDenom, price.MulInt(inputFee.Amount).RoundInt()), nil
+}
+
+// CalcFeePrice returns the price of the provided fee token in the base denomination,
+// at which tx fees in the fee token are valued.
+// This is the fee token's TWAP price if the UseTwapFeeTokenPricing param is set,
+// and its spot price otherwise.
+func (k Keeper) CalcFeePrice(ctx sdk.Context, inputDenom string) (sdk.Dec, error) {
+	if k.GetParams(ctx).UseTwapFeeTokenPricing {
+		return k.CalcFeeTwapPrice(ctx, inputDenom)
+	}
+	return k.CalcFeeSpotPrice(ctx, inputDenom)
 }
 
 // CalcFeeSpotPrice converts the provided tx fees into their equivalent value in the base denomination.
@@ -54,6 +65,29 @@ func (k Keeper) CalcFeeSpotPrice(ctx sdk.Context, inputDenom string) (sdk.Dec, e
 	return spotPrice, nil
 }
 
+// CalcFeeTwapPrice converts the provided tx fees into their equivalent value in the base denomination,
+// at the arithmetic TWAP of the fee token's pool over the FeeTokenPricingTwapWindow param.
+// Unlike the spot price, the TWAP price can't be moved within a single block,
+// but it can't be computed until the pool is older than the window.
+func (k Keeper) CalcFeeTwapPrice(ctx sdk.Context, inputDenom string) (sdk.Dec, error) {
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	feeToken, err := k.GetFeeToken(ctx, inputDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	startTime := ctx.BlockTime().Add(-k.GetParams(ctx).FeeTokenPricingTwapWindow)
+	twapPrice, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, feeToken.PoolID, feeToken.Denom, baseDenom, startTime)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return twapPrice, nil
+}
+
 // GetFeeToken returns the fee token record for a specific denom,
 // In our case the baseDenom is uosmo.
 func (k Keeper) GetBaseDenom(ctx sdk.Context) (denom string, err error) {
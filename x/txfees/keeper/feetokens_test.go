@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v12/x/txfees/keeper"
 	"github.com/osmosis-labs/osmosis/v12/x/txfees/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -222,3 +225,72 @@ func (suite *KeeperTestSuite) TestFeeTokenConversions() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestFeeTokenConversionsTwap() {
+	twapWindow := types.DefaultParams().FeeTokenPricingTwapWindow
+
+	tests := map[string]struct {
+		useTwapFeeTokenPricing bool
+		blockTimeAfterCreation time.Duration
+		expectedConvertable    bool
+		// expected converted fee, after the pool price was manipulated within the block
+		expectedOutput sdk.Int
+	}{
+		"twap pricing: manipulation within the block does not change the converted fee": {
+			useTwapFeeTokenPricing: true,
+			blockTimeAfterCreation: twapWindow,
+			expectedConvertable:    true,
+			expectedOutput:         sdk.NewInt(5),
+		},
+		"twap pricing: pool younger than the twap window": {
+			useTwapFeeTokenPricing: true,
+			blockTimeAfterCreation: twapWindow - time.Second,
+			expectedConvertable:    false,
+		},
+		"spot pricing: manipulation within the block changes the converted fee": {
+			useTwapFeeTokenPricing: false,
+			blockTimeAfterCreation: twapWindow,
+			expectedConvertable:    true,
+			// 10 foo are worth 0.25 as much base denom after doubling the foo in the pool
+			expectedOutput: sdk.NewInt(1),
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest(false)
+			baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+			params := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+			params.UseTwapFeeTokenPricing = tc.useTwapFeeTokenPricing
+			suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+
+			poolId := suite.PrepareBalancerPoolWithCoins(
+				sdk.NewInt64Coin(baseDenom, 1_000_000),
+				sdk.NewInt64Coin("foo", 2_000_000),
+			)
+			suite.ExecuteUpgradeFeeTokenProposal("foo", poolId)
+
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(tc.blockTimeAfterCreation))
+			// doubles the foo in the pool, dividing its spot price by 4.
+			_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId,
+				sdk.NewInt64Coin("foo", 2_000_000), baseDenom, sdk.OneInt())
+			suite.Require().NoError(err)
+
+			converted, err := suite.App.TxFeesKeeper.ConvertToBaseToken(suite.Ctx, sdk.NewInt64Coin("foo", 10))
+			if !tc.expectedConvertable {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewCoin(baseDenom, tc.expectedOutput), converted)
+
+			querier := keeper.NewQuerier(*suite.App.TxFeesKeeper)
+			res, err := querier.DenomSpotPrice(sdk.WrapSDKContext(suite.Ctx), &types.QueryDenomSpotPriceRequest{Denom: "foo"})
+			suite.Require().NoError(err)
+			suite.Require().Equal(poolId, res.PoolID)
+			suite.Require().Equal(tc.useTwapFeeTokenPricing, res.UsesTwapPrice)
+			suite.Require().Equal(sdk.NewDecWithPrec(5, 1), res.TwapPrice)
+			suite.Require().True(res.SpotPrice.LT(sdk.NewDecWithPrec(13, 2)))
+		})
+	}
+}
@@ -50,7 +50,18 @@ func (q Querier) DenomSpotPrice(ctx context.Context, req *types.QueryDenomSpotPr
 		return nil, err
 	}
 
-	return &types.QueryDenomSpotPriceResponse{PoolID: feeToken.PoolID, SpotPrice: spotPrice}, nil
+	// The TWAP can't be computed for pools younger than the window, in which case it is reported as zero.
+	twapPrice, err := q.CalcFeeTwapPrice(sdkCtx, req.Denom)
+	if err != nil {
+		twapPrice = sdk.ZeroDec()
+	}
+
+	return &types.QueryDenomSpotPriceResponse{
+		PoolID:        feeToken.PoolID,
+		SpotPrice:     spotPrice,
+		TwapPrice:     twapPrice,
+		UsesTwapPrice: q.GetParams(sdkCtx).UseTwapFeeTokenPricing,
+	}, nil
 }
 
 func (q Querier) DenomPoolId(ctx context.Context, req *types.QueryDenomPoolIdRequest) (*types.QueryDenomPoolIdResponse, error) {
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v12/osmoutils"
@@ -12,11 +14,14 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochN
 	return nil
 }
 
-// at the end of each epoch, swap all non-OSMO fees into OSMO and transfer to fee module account
+// at the end of each epoch, swap all non-OSMO fees into OSMO and transfer to fee module account.
+// Fee tokens whose swap would return too little OSMO relative to the TWAP of their pool
+// are not swapped, and carried over to the next epoch.
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	nonNativeFeeAddr := k.accountKeeper.GetModuleAddress(txfeestypes.NonNativeFeeCollectorName)
 	baseDenom, _ := k.GetBaseDenom(ctx)
 	feeTokens := k.GetFeeTokens(ctx)
+	params := k.GetParams(ctx)
 
 	for _, feetoken := range feeTokens {
 		if feetoken.Denom == baseDenom {
@@ -28,15 +33,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		}
 
 		// Do the swap of this fee token denom to base denom.
-		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
-			// We allow full slippage. Theres not really an effective way to bound slippage until TWAP's land,
-			// but even then the point is a bit moot.
-			// The only thing that could be done is a costly griefing attack to reduce the amount of osmo given as tx fees.
-			// However the idea of the txfees FeeToken gating is that the pool is sufficiently liquid for that base token.
-			minAmountOut := sdk.ZeroInt()
-			_, err := k.gammKeeper.SwapExactAmountIn(cacheCtx, nonNativeFeeAddr, feetoken.PoolID, coinBalance, baseDenom, minAmountOut)
-			return err
-		})
+		k.swapNonNativeFeeToken(ctx, nonNativeFeeAddr, feetoken.PoolID, coinBalance, baseDenom, params)
 	}
 
 	// Get all of the txfee payout denom in the module account
@@ -50,6 +47,64 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	return nil
 }
 
+// swapNonNativeFeeToken swaps the given fee tokens into the base denom, unless the swap would
+// return less than the tokens' value at the TWAP of their pool, minus the max epoch swap TWAP deviation.
+// This prevents the swap from being sandwiched, or executed against a pool whose price is otherwise manipulated.
+// The fee tokens that are not swapped remain in the non native fee collector, to be swapped at the next epoch.
+func (k Keeper) swapNonNativeFeeToken(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokenIn sdk.Coin, baseDenom string, params txfeestypes.Params) {
+	minAmountOut, err := k.epochSwapMinAmountOut(ctx, poolId, tokenIn, baseDenom, params)
+	if err != nil {
+		emitFeeTokenSwapDeferredEvent(ctx, poolId, tokenIn, sdk.ZeroInt(), err)
+		return
+	}
+
+	var tokenOutAmount sdk.Int
+	err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		tokenOutAmount, err = k.gammKeeper.SwapExactAmountIn(cacheCtx, sender, poolId, tokenIn, baseDenom, minAmountOut)
+		return err
+	})
+	if err != nil {
+		emitFeeTokenSwapDeferredEvent(ctx, poolId, tokenIn, minAmountOut, err)
+		return
+	}
+
+	emitFeeTokenSwappedEvent(ctx, poolId, tokenIn, sdk.NewCoin(baseDenom, tokenOutAmount), minAmountOut)
+}
+
+// epochSwapMinAmountOut returns the minimum amount of base denom that swapping tokenIn at the end of an epoch must return.
+// That is the value of tokenIn at the arithmetic TWAP of the pool over the epoch swap TWAP window,
+// minus the max epoch swap TWAP deviation.
+func (k Keeper) epochSwapMinAmountOut(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, baseDenom string, params txfeestypes.Params) (sdk.Int, error) {
+	startTime := ctx.BlockTime().Add(-params.EpochSwapTwapWindow)
+	twap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, poolId, tokenIn.Denom, baseDenom, startTime)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return twap.MulInt(tokenIn.Amount).Mul(sdk.OneDec().Sub(params.MaxEpochSwapTwapDeviation)).TruncateInt(), nil
+}
+
+func emitFeeTokenSwappedEvent(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, tokenOut sdk.Coin, minAmountOut sdk.Int) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		txfeestypes.TypeEvtFeeTokenSwapped,
+		sdk.NewAttribute(sdk.AttributeKeyModule, txfeestypes.AttributeValueCategory),
+		sdk.NewAttribute(txfeestypes.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(txfeestypes.AttributeKeyTokensIn, tokenIn.String()),
+		sdk.NewAttribute(txfeestypes.AttributeKeyTokensOut, tokenOut.String()),
+		sdk.NewAttribute(txfeestypes.AttributeKeyMinTokensOut, minAmountOut.String()),
+	))
+}
+
+func emitFeeTokenSwapDeferredEvent(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, minAmountOut sdk.Int, reason error) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		txfeestypes.TypeEvtFeeTokenSwapDeferred,
+		sdk.NewAttribute(sdk.AttributeKeyModule, txfeestypes.AttributeValueCategory),
+		sdk.NewAttribute(txfeestypes.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(txfeestypes.AttributeKeyTokensIn, tokenIn.String()),
+		sdk.NewAttribute(txfeestypes.AttributeKeyMinTokensOut, minAmountOut.String()),
+		sdk.NewAttribute(txfeestypes.AttributeKeyDeferredReason, reason.Error()),
+	))
+}
+
 // Hooks wrapper struct for incentives keeper
 type Hooks struct {
 	k Keeper
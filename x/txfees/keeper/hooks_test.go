@@ -28,6 +28,10 @@ func (suite *KeeperTestSuite) preparePool(denom string) (poolID uint64, pool gam
 func (suite *KeeperTestSuite) TestTxFeesAfterEpochEnd() {
 	suite.SetupTest(false)
 	baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+	// the test swaps are large relative to the pools, so allow their price impact.
+	params := types.DefaultParams()
+	params.MaxEpochSwapTwapDeviation = sdk.NewDecWithPrec(1, 1)
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
 
 	// create pools for three separate fee tokens
 	uion := "uion"
@@ -93,9 +97,9 @@ func (suite *KeeperTestSuite) TestTxFeesAfterEpochEnd() {
 			suite.Equal(suite.App.BankKeeper.GetAllBalances(suite.Ctx, moduleAddrNonNativeFee), tc.coins)
 
 			// End of epoch, so all the non-osmo fee amount should be swapped to osmo and transfer to fee module account
-			params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
-			futureCtx := suite.Ctx.WithBlockTime(time.Now().Add(time.Minute))
-			suite.App.TxFeesKeeper.AfterEpochEnd(futureCtx, params.DistrEpochIdentifier, int64(1))
+			incentivesParams := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
+			futureCtx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(params.EpochSwapTwapWindow + time.Minute))
+			suite.App.TxFeesKeeper.AfterEpochEnd(futureCtx, incentivesParams.DistrEpochIdentifier, int64(1))
 
 			// check the balance of the native-basedenom in module
 			moduleAddrFee := suite.App.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
@@ -108,3 +112,71 @@ func (suite *KeeperTestSuite) TestTxFeesAfterEpochEnd() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestTxFeesAfterEpochEndTwapBound() {
+	uion := "uion"
+	feeCoin := sdk.NewInt64Coin(uion, 10)
+	twapWindow := types.DefaultParams().EpochSwapTwapWindow
+
+	tests := map[string]struct {
+		blockTime func(poolCreationTime time.Time) time.Time
+		// amount of uion swapped into the pool right before the epoch end
+		manipulationAmount int64
+		expectSwapped      bool
+	}{
+		"pool price within max deviation of the twap: swapped": {
+			blockTime:     func(t time.Time) time.Time { return t.Add(twapWindow) },
+			expectSwapped: true,
+		},
+		"pool younger than the twap window: deferred": {
+			blockTime:     func(t time.Time) time.Time { return t.Add(twapWindow - time.Minute) },
+			expectSwapped: false,
+		},
+		"pool price manipulated below the twap: deferred": {
+			blockTime:          func(t time.Time) time.Time { return t.Add(twapWindow) },
+			manipulationAmount: 1_000_000,
+			expectSwapped:      false,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest(false)
+			baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+			poolId := suite.PrepareBalancerPoolWithCoins(
+				sdk.NewInt64Coin(baseDenom, 10_000_000),
+				sdk.NewInt64Coin(uion, 10_000_000),
+			)
+			suite.ExecuteUpgradeFeeTokenProposal(uion, poolId)
+
+			suite.FundModuleAcc(types.NonNativeFeeCollectorName, sdk.NewCoins(feeCoin))
+			nonNativeFeeAddr := suite.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+			feeCollectorAddr := suite.App.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+			feeCollectorBalanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, feeCollectorAddr, baseDenom)
+
+			ctx := suite.Ctx.WithBlockTime(tc.blockTime(suite.Ctx.BlockTime())).WithEventManager(sdk.NewEventManager())
+			if tc.manipulationAmount > 0 {
+				_, err := suite.App.GAMMKeeper.SwapExactAmountIn(ctx, suite.TestAccs[0], poolId,
+					sdk.NewInt64Coin(uion, tc.manipulationAmount), baseDenom, sdk.OneInt())
+				suite.Require().NoError(err)
+			}
+
+			err := suite.App.TxFeesKeeper.AfterEpochEnd(ctx, "day", 1)
+			suite.Require().NoError(err)
+
+			feeCollectorBalance := suite.App.BankKeeper.GetBalance(ctx, feeCollectorAddr, baseDenom)
+			if tc.expectSwapped {
+				suite.Require().True(suite.App.BankKeeper.GetAllBalances(ctx, nonNativeFeeAddr).IsZero())
+				suite.Require().True(feeCollectorBalance.Amount.GT(feeCollectorBalanceBefore.Amount))
+				suite.AssertEventEmitted(ctx, types.TypeEvtFeeTokenSwapped, 1)
+				suite.AssertEventEmitted(ctx, types.TypeEvtFeeTokenSwapDeferred, 0)
+			} else {
+				// the fee tokens are carried over to the next epoch.
+				suite.Require().Equal(sdk.NewCoins(feeCoin), suite.App.BankKeeper.GetAllBalances(ctx, nonNativeFeeAddr))
+				suite.Require().Equal(feeCollectorBalanceBefore, feeCollectorBalance)
+				suite.AssertEventEmitted(ctx, types.TypeEvtFeeTokenSwapped, 0)
+				suite.AssertEventEmitted(ctx, types.TypeEvtFeeTokenSwapDeferred, 1)
+			}
+		})
+	}
+}
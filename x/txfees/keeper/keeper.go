@@ -7,16 +7,19 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/osmosis-labs/osmosis/v12/x/txfees/types"
 )
 
 type Keeper struct {
-	storeKey sdk.StoreKey
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
 
 	accountKeeper             types.AccountKeeper
 	bankKeeper                types.BankKeeper
 	gammKeeper                types.GammKeeper
+	twapKeeper                types.TwapKeeper
 	spotPriceCalculator       types.SpotPriceCalculator
 	feeCollectorName          string
 	nonNativeFeeCollectorName string
@@ -28,16 +31,25 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
 	gammKeeper types.GammKeeper,
+	twapKeeper types.TwapKeeper,
 	spotPriceCalculator types.SpotPriceCalculator,
 	feeCollectorName string,
 	nonNativeFeeCollectorName string,
 ) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		accountKeeper:             accountKeeper,
 		bankKeeper:                bankKeeper,
 		storeKey:                  storeKey,
+		paramSpace:                paramSpace,
 		gammKeeper:                gammKeeper,
+		twapKeeper:                twapKeeper,
 		spotPriceCalculator:       spotPriceCalculator,
 		feeCollectorName:          feeCollectorName,
 		nonNativeFeeCollectorName: nonNativeFeeCollectorName,
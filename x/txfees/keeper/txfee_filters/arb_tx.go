@@ -2,6 +2,7 @@ package txfee_filters
 
 import (
 	gammtypes "github.com/osmosis-labs/osmosis/v12/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v12/x/swaprouter/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -16,7 +17,7 @@ import (
 // 4) Contains both JoinPool and ExitPool messages in one tx.
 //   - Has some false positives, but they seem relatively contrived.
 //
-// TODO: Move the first component to a future router module.
+// Split route swaps from x/swaprouter are checked for (1) and (2) as well.
 func IsArbTxLoose(tx sdk.Tx) bool {
 	msgs := tx.GetMsgs()
 
@@ -33,6 +34,34 @@ func IsArbTxLoose(tx sdk.Tx) bool {
 			}
 		}
 
+		// (1) and (2) for split route swaps, which have a single denom in and out.
+		if splitMsg, isSplitMsg := m.(*swaproutertypes.MsgSplitRouteSwapExactAmountIn); isSplitMsg {
+			for _, route := range splitMsg.Routes {
+				if len(route.Pools) > 0 && route.TokenOutDenom() == splitMsg.TokenInDenom {
+					return true
+				}
+			}
+			if swapInDenom != "" && splitMsg.TokenInDenom != swapInDenom {
+				return true
+			}
+			swapInDenom = splitMsg.TokenInDenom
+			continue
+		}
+		if splitMsg, isSplitMsg := m.(*swaproutertypes.MsgSplitRouteSwapExactAmountOut); isSplitMsg {
+			if len(splitMsg.Routes) == 0 || len(splitMsg.Routes[0].Pools) == 0 {
+				continue
+			}
+			tokenInDenom := splitMsg.Routes[0].TokenInDenom()
+			if tokenInDenom == splitMsg.TokenOut.Denom {
+				return true
+			}
+			if swapInDenom != "" && tokenInDenom != swapInDenom {
+				return true
+			}
+			swapInDenom = tokenInDenom
+			continue
+		}
+
 		swapMsg, isSwapMsg := m.(gammtypes.SwapMsgRoute)
 		if !isSwapMsg {
 			continue
@@ -0,0 +1,13 @@
+package types
+
+// event types.
+const (
+	TypeEvtFeeTokenSwapped      = "fee_token_swapped"
+	TypeEvtFeeTokenSwapDeferred = "fee_token_swap_deferred"
+	AttributeValueCategory      = ModuleName
+	AttributeKeyPoolId          = "pool_id"
+	AttributeKeyTokensIn        = "tokens_in"
+	AttributeKeyTokensOut       = "tokens_out"
+	AttributeKeyMinTokensOut    = "min_tokens_out"
+	AttributeKeyDeferredReason  = "reason"
+)
@@ -7,6 +7,7 @@ func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Basedenom: sdk.DefaultBondDenom,
 		Feetokens: []FeeToken{},
+		Params:    DefaultParams(),
 	}
 }
 
@@ -19,6 +20,10 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	for _, feeToken := range gs.Feetokens {
 		err := sdk.ValidateDenom(feeToken.Denom)
 		if err != nil {
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+var (
+	KeyMaxEpochSwapTwapDeviation = []byte("MaxEpochSwapTwapDeviation")
+	KeyEpochSwapTwapWindow       = []byte("EpochSwapTwapWindow")
+	KeyUseTwapFeeTokenPricing    = []byte("UseTwapFeeTokenPricing")
+	KeyFeeTokenPricingTwapWindow = []byte("FeeTokenPricingTwapWindow")
+
+	_ paramtypes.ParamSet = &Params{}
+)
+
+var (
+	defaultMaxEpochSwapTwapDeviation = sdk.NewDecWithPrec(5, 2) // 5%
+	defaultEpochSwapTwapWindow       = time.Hour
+	defaultUseTwapFeeTokenPricing    = false
+	defaultFeeTokenPricingTwapWindow = 5 * time.Minute
+)
+
+// ParamTable for txfees module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(maxEpochSwapTwapDeviation sdk.Dec, epochSwapTwapWindow time.Duration, useTwapFeeTokenPricing bool, feeTokenPricingTwapWindow time.Duration) Params {
+	return Params{
+		MaxEpochSwapTwapDeviation: maxEpochSwapTwapDeviation,
+		EpochSwapTwapWindow:       epochSwapTwapWindow,
+		UseTwapFeeTokenPricing:    useTwapFeeTokenPricing,
+		FeeTokenPricingTwapWindow: feeTokenPricingTwapWindow,
+	}
+}
+
+// default txfees module parameters.
+func DefaultParams() Params {
+	return Params{
+		MaxEpochSwapTwapDeviation: defaultMaxEpochSwapTwapDeviation,
+		EpochSwapTwapWindow:       defaultEpochSwapTwapWindow,
+		UseTwapFeeTokenPricing:    defaultUseTwapFeeTokenPricing,
+		FeeTokenPricingTwapWindow: defaultFeeTokenPricingTwapWindow,
+	}
+}
+
+// validate params.
+func (p Params) Validate() error {
+	if err := validateMaxEpochSwapTwapDeviation(p.MaxEpochSwapTwapDeviation); err != nil {
+		return err
+	}
+
+	if err := validateEpochSwapTwapWindow(p.EpochSwapTwapWindow); err != nil {
+		return err
+	}
+
+	if err := validateUseTwapFeeTokenPricing(p.UseTwapFeeTokenPricing); err != nil {
+		return err
+	}
+
+	if err := validateFeeTokenPricingTwapWindow(p.FeeTokenPricingTwapWindow); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxEpochSwapTwapDeviation, &p.MaxEpochSwapTwapDeviation, validateMaxEpochSwapTwapDeviation),
+		paramtypes.NewParamSetPair(KeyEpochSwapTwapWindow, &p.EpochSwapTwapWindow, validateEpochSwapTwapWindow),
+		paramtypes.NewParamSetPair(KeyUseTwapFeeTokenPricing, &p.UseTwapFeeTokenPricing, validateUseTwapFeeTokenPricing),
+		paramtypes.NewParamSetPair(KeyFeeTokenPricingTwapWindow, &p.FeeTokenPricingTwapWindow, validateFeeTokenPricingTwapWindow),
+	}
+}
+
+func validateMaxEpochSwapTwapDeviation(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max epoch swap twap deviation must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateEpochSwapTwapWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("epoch swap twap window must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateUseTwapFeeTokenPricing(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateFeeTokenPricingTwapWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("fee token pricing twap window must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,491 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/txfees/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the txfees module.
+type Params struct {
+	// max_epoch_swap_twap_deviation is the maximum fraction by which the base
+	// denom received when swapping a fee token at the end of an epoch may fall
+	// short of the fee tokens' value at the TWAP of their pool. Fee tokens whose
+	// swap would fall short by more are carried over to the next epoch.
+	MaxEpochSwapTwapDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_epoch_swap_twap_deviation,json=maxEpochSwapTwapDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_epoch_swap_twap_deviation" yaml:"max_epoch_swap_twap_deviation"`
+	// epoch_swap_twap_window is the window, ending at the current block time,
+	// of the TWAP the epoch fee token swaps are bounded by.
+	EpochSwapTwapWindow time.Duration `protobuf:"bytes,2,opt,name=epoch_swap_twap_window,json=epochSwapTwapWindow,proto3,stdduration" json:"epoch_swap_twap_window" yaml:"epoch_swap_twap_window"`
+	// use_twap_fee_token_pricing values non-native fee tokens at the arithmetic
+	// TWAP of their pool over fee_token_pricing_twap_window, rather than at
+	// their pool's spot price, when checking the sufficiency of tx fees.
+	UseTwapFeeTokenPricing bool `protobuf:"varint,3,opt,name=use_twap_fee_token_pricing,json=useTwapFeeTokenPricing,proto3" json:"use_twap_fee_token_pricing,omitempty" yaml:"use_twap_fee_token_pricing"`
+	// fee_token_pricing_twap_window is the window, ending at the current block
+	// time, of the TWAP fee tokens are valued at if use_twap_fee_token_pricing
+	// is set.
+	FeeTokenPricingTwapWindow time.Duration `protobuf:"bytes,4,opt,name=fee_token_pricing_twap_window,json=feeTokenPricingTwapWindow,proto3,stdduration" json:"fee_token_pricing_twap_window" yaml:"fee_token_pricing_twap_window"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcbfbe8e37bb08e6, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEpochSwapTwapWindow() time.Duration {
+	if m != nil {
+		return m.EpochSwapTwapWindow
+	}
+	return 0
+}
+
+func (m *Params) GetUseTwapFeeTokenPricing() bool {
+	if m != nil {
+		return m.UseTwapFeeTokenPricing
+	}
+	return false
+}
+
+func (m *Params) GetFeeTokenPricingTwapWindow() time.Duration {
+	if m != nil {
+		return m.FeeTokenPricingTwapWindow
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "osmosis.txfees.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/txfees/v1beta1/params.proto", fileDescriptor_fcbfbe8e37bb08e6)
+}
+
+var fileDescriptor_fcbfbe8e37bb08e6 = []byte{
+	// 418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x8f, 0x93, 0x40,
+	0x18, 0xc6, 0x3b, 0xba, 0xd9, 0x28, 0xde, 0xd0, 0x34, 0x6d, 0x93, 0x42, 0xc5, 0x3f, 0xa9, 0x87,
+	0x9d, 0xb1, 0xf5, 0xe6, 0x91, 0x54, 0x4f, 0x1e, 0x36, 0x75, 0xa3, 0x89, 0x17, 0x32, 0xc0, 0x0b,
+	0x4b, 0xb6, 0x30, 0x13, 0x66, 0x28, 0xf4, 0x43, 0x98, 0xe8, 0x6d, 0x3f, 0xd2, 0x1e, 0xf7, 0x68,
+	0x3c, 0xa0, 0x69, 0xbf, 0x41, 0x3f, 0x81, 0x61, 0x06, 0xe2, 0xae, 0x7f, 0x76, 0x2f, 0xc0, 0xe4,
+	0x79, 0xde, 0x87, 0xe7, 0x07, 0xaf, 0xf1, 0x84, 0x89, 0x94, 0x89, 0x44, 0x10, 0x59, 0x45, 0x00,
+	0x82, 0xac, 0x67, 0x3e, 0x48, 0x3a, 0x23, 0x9c, 0xe6, 0x34, 0x15, 0x98, 0xe7, 0x4c, 0x32, 0xb3,
+	0xdf, 0x9a, 0xb0, 0x36, 0xe1, 0xd6, 0x34, 0x7a, 0x14, 0xb3, 0x98, 0x29, 0x0b, 0x69, 0x9e, 0xb4,
+	0x7b, 0x64, 0xc5, 0x8c, 0xc5, 0x2b, 0x20, 0xea, 0xe4, 0x17, 0x11, 0x09, 0x8b, 0x9c, 0xca, 0x84,
+	0x65, 0x5a, 0x77, 0xbe, 0x1e, 0x18, 0x87, 0xc7, 0x2a, 0xde, 0x3c, 0x47, 0xc6, 0x38, 0xa5, 0x95,
+	0x07, 0x9c, 0x05, 0xa7, 0x9e, 0x28, 0x29, 0xf7, 0x64, 0x73, 0x09, 0x61, 0x9d, 0xa8, 0x91, 0x01,
+	0x9a, 0xa0, 0xe9, 0x7d, 0xf7, 0xc3, 0x45, 0x6d, 0xf7, 0xbe, 0xd7, 0xf6, 0xf3, 0x38, 0x91, 0xa7,
+	0x85, 0x8f, 0x03, 0x96, 0x92, 0x40, 0x95, 0x6a, 0x6f, 0x47, 0x22, 0x3c, 0x23, 0x72, 0xc3, 0x41,
+	0xe0, 0x05, 0x04, 0xfb, 0xda, 0x7e, 0xba, 0xa1, 0xe9, 0xea, 0xb5, 0x73, 0x63, 0xb8, 0xb3, 0x1c,
+	0xa6, 0xb4, 0x7a, 0xd3, 0xc8, 0xef, 0x4b, 0xca, 0x4f, 0x4a, 0xca, 0x17, 0x9d, 0x66, 0x6e, 0x8c,
+	0xfe, 0x9f, 0x83, 0x65, 0x92, 0x85, 0xac, 0x1c, 0xdc, 0x99, 0xa0, 0xe9, 0x83, 0xf9, 0x10, 0x6b,
+	0x4c, 0xdc, 0x61, 0xe2, 0x45, 0x8b, 0xe9, 0xbe, 0x68, 0xda, 0xee, 0x6b, 0x7b, 0xac, 0x3b, 0xfc,
+	0x3b, 0xc6, 0x39, 0xff, 0x61, 0xa3, 0xe5, 0x43, 0xb8, 0xfa, 0xf6, 0x8f, 0x4a, 0x31, 0xa9, 0x31,
+	0x2a, 0x04, 0x68, 0x73, 0x04, 0xe0, 0x49, 0x76, 0x06, 0x99, 0xc7, 0xf3, 0x24, 0x48, 0xb2, 0x78,
+	0x70, 0x77, 0x82, 0xa6, 0xf7, 0xdc, 0x67, 0xfb, 0xda, 0x7e, 0xac, 0xf3, 0xff, 0xef, 0x75, 0x96,
+	0xfd, 0x42, 0x40, 0x93, 0xfc, 0x16, 0xe0, 0xa4, 0x51, 0x8e, 0xb5, 0x60, 0x7e, 0x46, 0xc6, 0xf8,
+	0x2f, 0xfb, 0x35, 0xca, 0x83, 0xdb, 0x28, 0x5f, 0xb6, 0x94, 0xed, 0x97, 0xbe, 0x31, 0x4d, 0xc3,
+	0x0e, 0xa3, 0xeb, 0x2d, 0x7e, 0x23, 0xbb, 0xef, 0x2e, 0xb6, 0x16, 0xba, 0xdc, 0x5a, 0xe8, 0xe7,
+	0xd6, 0x42, 0x5f, 0x76, 0x56, 0xef, 0x72, 0x67, 0xf5, 0xbe, 0xed, 0xac, 0xde, 0xa7, 0xf9, 0x95,
+	0x5f, 0xde, 0xae, 0xe1, 0xd1, 0x8a, 0xfa, 0xa2, 0x3b, 0x90, 0xf5, 0x6c, 0x4e, 0xaa, 0x6e, 0x7d,
+	0xd5, 0x0a, 0xf8, 0x87, 0xaa, 0xed, 0xab, 0x5f, 0x03, 0x00, 0xb8, 0x46, 0x13, 0x65, 0xdd, 0x02,
+	0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.FeeTokenPricingTwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.FeeTokenPricingTwapWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.UseTwapFeeTokenPricing {
+		i--
+		if m.UseTwapFeeTokenPricing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.EpochSwapTwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochSwapTwapWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxEpochSwapTwapDeviation.Size()
+		i -= size
+		if _, err := m.MaxEpochSwapTwapDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxEpochSwapTwapDeviation.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochSwapTwapWindow)
+	n += 1 + l + sovParams(uint64(l))
+	if m.UseTwapFeeTokenPricing {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.FeeTokenPricingTwapWindow)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEpochSwapTwapDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxEpochSwapTwapDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochSwapTwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.EpochSwapTwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseTwapFeeTokenPricing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseTwapFeeTokenPricing = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokenPricingTwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.FeeTokenPricingTwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -162,6 +162,13 @@ func (m *QueryDenomSpotPriceRequest) GetDenom() string {
 type QueryDenomSpotPriceResponse struct {
 	PoolID    uint64                                 `protobuf:"varint,1,opt,name=poolID,proto3" json:"poolID,omitempty" yaml:"pool_id"`
 	SpotPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=spot_price,json=spotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spot_price" yaml:"spot_price"`
+	// twap_price is the arithmetic TWAP of the fee token's pool over the fee
+	// token pricing TWAP window. It is zero if the TWAP can't be computed, e.g.
+	// if the pool is younger than the window.
+	TwapPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=twap_price,json=twapPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap_price" yaml:"twap_price"`
+	// uses_twap_price is true if tx fees in the fee token are valued at
+	// twap_price, rather than spot_price.
+	UsesTwapPrice bool `protobuf:"varint,4,opt,name=uses_twap_price,json=usesTwapPrice,proto3" json:"uses_twap_price,omitempty" yaml:"uses_twap_price"`
 }
 
 func (m *QueryDenomSpotPriceResponse) Reset()         { *m = QueryDenomSpotPriceResponse{} }
@@ -204,6 +211,13 @@ func (m *QueryDenomSpotPriceResponse) GetPoolID() uint64 {
 	return 0
 }
 
+func (m *QueryDenomSpotPriceResponse) GetUsesTwapPrice() bool {
+	if m != nil {
+		return m.UsesTwapPrice
+	}
+	return false
+}
+
 type QueryDenomPoolIdRequest struct {
 	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 }
@@ -388,46 +402,49 @@ func init() {
 }
 
 var fileDescriptor_6cbc1b48c44dfdd6 = []byte{
-	// 610 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xc1, 0x6e, 0x12, 0x41,
-	0x18, 0xc7, 0x99, 0x2a, 0x4d, 0x98, 0x9a, 0x46, 0x27, 0xb6, 0xc5, 0xd5, 0x2c, 0x64, 0xa2, 0x4d,
-	0x53, 0xc3, 0x8e, 0x80, 0x5e, 0xbc, 0x89, 0xa4, 0x89, 0x89, 0x31, 0x75, 0xf5, 0xd4, 0xcb, 0x66,
-	0x17, 0x3e, 0x70, 0x53, 0x60, 0xb6, 0xcc, 0xd0, 0x94, 0x18, 0x2f, 0x3e, 0x81, 0x89, 0xc6, 0x57,
-	0xf0, 0xa4, 0xcf, 0xd1, 0x63, 0x13, 0x2f, 0xc6, 0x03, 0x31, 0xe0, 0x13, 0xf0, 0x04, 0x66, 0x67,
-	0x67, 0x59, 0xda, 0x82, 0x94, 0x13, 0xec, 0x7c, 0xff, 0xf9, 0x7f, 0xff, 0x6f, 0xe7, 0x37, 0x8b,
-	0x29, 0x17, 0x6d, 0x2e, 0x7c, 0xc1, 0xe4, 0x49, 0x03, 0x40, 0xb0, 0xe3, 0xa2, 0x07, 0xd2, 0x2d,
-	0xb2, 0xa3, 0x1e, 0x74, 0xfb, 0x56, 0xd0, 0xe5, 0x92, 0x93, 0x4d, 0xad, 0xb1, 0x22, 0x8d, 0xa5,
-	0x35, 0xc6, 0xed, 0x26, 0x6f, 0x72, 0x25, 0x61, 0xe1, 0xbf, 0x48, 0x6d, 0xdc, 0x6b, 0x72, 0xde,
-	0x6c, 0x01, 0x73, 0x03, 0x9f, 0xb9, 0x9d, 0x0e, 0x97, 0xae, 0xf4, 0x79, 0x47, 0xe8, 0xaa, 0xa9,
-	0xab, 0xea, 0xc9, 0xeb, 0x35, 0x58, 0xbd, 0xd7, 0x55, 0x02, 0x5d, 0x7f, 0x30, 0x27, 0x4f, 0x03,
-	0x40, 0xf2, 0x43, 0xd0, 0x32, 0xba, 0x85, 0x37, 0x5e, 0x87, 0x09, 0xf7, 0x00, 0xde, 0x86, 0xcb,
-	0xc2, 0x86, 0xa3, 0x1e, 0x08, 0x49, 0x25, 0xde, 0xbc, 0x58, 0x10, 0x01, 0xef, 0x08, 0x20, 0x07,
-	0x18, 0x37, 0x00, 0x1c, 0xe5, 0x22, 0xb2, 0x28, 0x7f, 0x6d, 0x67, 0xad, 0x94, 0xb7, 0x66, 0x8f,
-	0x66, 0xc5, 0xdb, 0x2b, 0x77, 0x4e, 0x07, 0xb9, 0xd4, 0x78, 0x90, 0xbb, 0xd5, 0x77, 0xdb, 0xad,
-	0xa7, 0x34, 0x71, 0xa0, 0x76, 0xa6, 0x11, 0xf7, 0xa0, 0x55, 0x6c, 0xa8, 0xae, 0x55, 0xe8, 0xf0,
-	0xf6, 0x9b, 0x80, 0xcb, 0xfd, 0xae, 0x5f, 0x03, 0x9d, 0x89, 0x6c, 0xe3, 0x74, 0x3d, 0x2c, 0x64,
-	0x51, 0x1e, 0xed, 0x64, 0x2a, 0x37, 0xc7, 0x83, 0xdc, 0x8d, 0xc8, 0x4e, 0x2d, 0x53, 0x3b, 0x2a,
-	0xd3, 0xef, 0x08, 0xdf, 0x9d, 0x69, 0xa3, 0x27, 0xd8, 0xc5, 0xab, 0x01, 0xe7, 0xad, 0x17, 0x55,
-	0x65, 0x74, 0xbd, 0x42, 0xc6, 0x83, 0xdc, 0x7a, 0x64, 0x14, 0xae, 0x3b, 0x7e, 0x9d, 0xda, 0x5a,
-	0x41, 0x3c, 0x8c, 0x45, 0xc0, 0xa5, 0x13, 0x84, 0x0e, 0xd9, 0x15, 0xd5, 0xf8, 0x79, 0x38, 0xcb,
-	0xef, 0x41, 0x6e, 0xbb, 0xe9, 0xcb, 0x77, 0x3d, 0xcf, 0xaa, 0xf1, 0x36, 0xab, 0xa9, 0x17, 0xa0,
-	0x7f, 0x0a, 0xa2, 0x7e, 0xc8, 0x64, 0x3f, 0x00, 0x61, 0x55, 0xa1, 0x96, 0x4c, 0x9d, 0x38, 0x51,
-	0x3b, 0x23, 0xe2, 0x5c, 0xf4, 0x19, 0xde, 0x4a, 0xe2, 0xee, 0x87, 0x7d, 0xeb, 0xcb, 0x8e, 0xbc,
-	0x87, 0xb3, 0x97, 0x2d, 0x96, 0x1f, 0x77, 0xc2, 0x43, 0xc5, 0x15, 0xa0, 0xbc, 0x62, 0x1e, 0x5e,
-	0x69, 0x1e, 0xa6, 0x0a, 0xda, 0xfe, 0x31, 0xc6, 0x9e, 0x2b, 0xc0, 0x99, 0xce, 0xb9, 0x91, 0xcc,
-	0x9c, 0xd4, 0xa8, 0x9d, 0xf1, 0xe2, 0xdd, 0xa5, 0x2f, 0x69, 0x9c, 0x56, 0x86, 0xe4, 0x2b, 0xc2,
-	0x99, 0x09, 0x65, 0xa4, 0x30, 0x8f, 0xa4, 0x99, 0x98, 0x1a, 0xd6, 0x55, 0xe5, 0x51, 0x58, 0xba,
-	0xfb, 0xf1, 0xe7, 0xdf, 0xcf, 0x2b, 0xf7, 0x09, 0x65, 0xf3, 0xef, 0x87, 0x06, 0x93, 0xfc, 0x40,
-	0x78, 0xfd, 0x3c, 0x41, 0xa4, 0xf4, 0xdf, 0x76, 0x33, 0xa9, 0x35, 0xca, 0x4b, 0xed, 0xd1, 0x39,
-	0xcb, 0x2a, 0x67, 0x81, 0x3c, 0x9c, 0x97, 0x33, 0x41, 0xc9, 0xf1, 0xfa, 0xd1, 0xfb, 0x25, 0xdf,
-	0x10, 0x5e, 0x9b, 0x02, 0x80, 0xb0, 0xc5, 0x9d, 0xcf, 0xd1, 0x66, 0x3c, 0xba, 0xfa, 0x06, 0x9d,
-	0xf3, 0x89, 0xca, 0xc9, 0x48, 0x61, 0x5e, 0x4e, 0x95, 0xcc, 0xd1, 0x9c, 0xb1, 0xf7, 0xea, 0xf1,
-	0x83, 0x3a, 0xf3, 0x09, 0x49, 0x0b, 0xce, 0xfc, 0x22, 0x8a, 0x0b, 0xce, 0xfc, 0x12, 0xa0, 0x8b,
-	0xcf, 0x3c, 0x41, 0xb4, 0xf2, 0xf2, 0x74, 0x68, 0xa2, 0xb3, 0xa1, 0x89, 0xfe, 0x0c, 0x4d, 0xf4,
-	0x69, 0x64, 0xa6, 0xce, 0x46, 0x66, 0xea, 0xd7, 0xc8, 0x4c, 0x1d, 0x94, 0xa6, 0x2e, 0xbb, 0xf6,
-	0x29, 0xb4, 0x5c, 0x4f, 0x4c, 0x4c, 0x8f, 0x8b, 0x25, 0x76, 0x12, 0x5b, 0xab, 0xcb, 0xef, 0xad,
-	0xaa, 0x8f, 0x6c, 0xf9, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x1d, 0x4e, 0x22, 0x1d, 0x06,
-	0x00, 0x00,
+	// 657 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xdf, 0x6e, 0xd3, 0x3e,
+	0x18, 0x6d, 0xf6, 0x4f, 0xbf, 0x78, 0x3f, 0x06, 0x58, 0xac, 0x2b, 0x01, 0x25, 0x95, 0x05, 0xd3,
+	0x34, 0xd4, 0x98, 0x75, 0x70, 0xc3, 0x1d, 0xa1, 0x9a, 0x84, 0x84, 0xd0, 0x08, 0xbb, 0xda, 0x4d,
+	0x94, 0xb4, 0x6e, 0x89, 0xd6, 0xd6, 0x59, 0xed, 0x8c, 0x55, 0x88, 0x1b, 0x9e, 0x00, 0x09, 0xc4,
+	0x2b, 0x70, 0xc7, 0x73, 0xec, 0x72, 0x12, 0x42, 0x42, 0x5c, 0x44, 0xa8, 0xe5, 0x09, 0xfa, 0x04,
+	0x28, 0x8e, 0xd3, 0x74, 0xa5, 0x65, 0xad, 0xb8, 0xda, 0xec, 0xef, 0xf8, 0x9c, 0x63, 0x7f, 0xe7,
+	0x4b, 0x01, 0xa2, 0xac, 0x45, 0x99, 0xcf, 0x30, 0x3f, 0xad, 0x13, 0xc2, 0xf0, 0xc9, 0x8e, 0x47,
+	0xb8, 0xbb, 0x83, 0x8f, 0x43, 0xd2, 0xe9, 0x9a, 0x41, 0x87, 0x72, 0x0a, 0xf3, 0x12, 0x63, 0x26,
+	0x18, 0x53, 0x62, 0xb4, 0x1b, 0x0d, 0xda, 0xa0, 0x02, 0x82, 0xe3, 0xff, 0x12, 0xb4, 0x76, 0xbb,
+	0x41, 0x69, 0xa3, 0x49, 0xb0, 0x1b, 0xf8, 0xd8, 0x6d, 0xb7, 0x29, 0x77, 0xb9, 0x4f, 0xdb, 0x4c,
+	0x56, 0x75, 0x59, 0x15, 0x2b, 0x2f, 0xac, 0xe3, 0x5a, 0xd8, 0x11, 0x00, 0x59, 0xbf, 0x3b, 0xc5,
+	0x4f, 0x9d, 0x10, 0x4e, 0x8f, 0x88, 0x84, 0xa1, 0x0d, 0xb0, 0xfe, 0x22, 0x76, 0xb8, 0x47, 0xc8,
+	0x41, 0xbc, 0xcd, 0x6c, 0x72, 0x1c, 0x12, 0xc6, 0x11, 0x07, 0xf9, 0xf1, 0x02, 0x0b, 0x68, 0x9b,
+	0x11, 0x78, 0x08, 0x40, 0x9d, 0x10, 0x47, 0xb0, 0xb0, 0x82, 0x52, 0x5c, 0xdc, 0x5a, 0x2d, 0x17,
+	0xcd, 0xc9, 0x57, 0x33, 0xd3, 0xe3, 0xd6, 0xcd, 0xb3, 0xc8, 0xc8, 0x0d, 0x22, 0xe3, 0x7a, 0xd7,
+	0x6d, 0x35, 0x1f, 0xa1, 0x8c, 0x01, 0xd9, 0x6a, 0x3d, 0xd5, 0x40, 0x15, 0xa0, 0x09, 0xd5, 0x0a,
+	0x69, 0xd3, 0xd6, 0xcb, 0x80, 0xf2, 0xfd, 0x8e, 0x5f, 0x25, 0xd2, 0x13, 0xdc, 0x04, 0xcb, 0xb5,
+	0xb8, 0x50, 0x50, 0x8a, 0xca, 0x96, 0x6a, 0x5d, 0x1b, 0x44, 0xc6, 0xff, 0x09, 0x9d, 0xd8, 0x46,
+	0x76, 0x52, 0x46, 0xdf, 0x16, 0xc0, 0xad, 0x89, 0x34, 0xf2, 0x06, 0xdb, 0x60, 0x25, 0xa0, 0xb4,
+	0xf9, 0xb4, 0x22, 0x88, 0x96, 0x2c, 0x38, 0x88, 0x8c, 0xb5, 0x84, 0x28, 0xde, 0x77, 0xfc, 0x1a,
+	0xb2, 0x25, 0x02, 0x7a, 0x00, 0xb0, 0x80, 0x72, 0x27, 0x88, 0x19, 0x0a, 0x0b, 0x42, 0xf8, 0x49,
+	0x7c, 0x97, 0x1f, 0x91, 0xb1, 0xd9, 0xf0, 0xf9, 0xab, 0xd0, 0x33, 0xab, 0xb4, 0x85, 0xab, 0xe2,
+	0x01, 0xe4, 0x9f, 0x12, 0xab, 0x1d, 0x61, 0xde, 0x0d, 0x08, 0x33, 0x2b, 0xa4, 0x9a, 0xdd, 0x3a,
+	0x63, 0x42, 0xb6, 0xca, 0x52, 0x5f, 0xb1, 0x06, 0x7f, 0xed, 0x06, 0x52, 0x63, 0xf1, 0xdf, 0x34,
+	0x32, 0x26, 0x64, 0xab, 0xf1, 0x22, 0xd1, 0xb0, 0xc0, 0xd5, 0x90, 0x11, 0xe6, 0x8c, 0x08, 0x2d,
+	0x15, 0x95, 0xad, 0xff, 0x2c, 0x6d, 0x10, 0x19, 0xf9, 0xe4, 0xe8, 0x18, 0x00, 0xd9, 0x57, 0xe2,
+	0x9d, 0x83, 0x94, 0x03, 0x3d, 0x06, 0x1b, 0xd9, 0xb3, 0xee, 0xc7, 0xef, 0x53, 0x9b, 0xb7, 0x35,
+	0x7b, 0xa0, 0xf0, 0x27, 0xc5, 0xfc, 0x6d, 0x19, 0xe6, 0xd6, 0x72, 0x19, 0x11, 0x5c, 0x69, 0x6e,
+	0x9f, 0x83, 0xfc, 0x78, 0x41, 0xd2, 0x3f, 0x00, 0xc0, 0x73, 0x19, 0x71, 0x46, 0x7d, 0xae, 0x67,
+	0xef, 0x96, 0xd5, 0x90, 0xad, 0x7a, 0xe9, 0xe9, 0xf2, 0xc7, 0x65, 0xb0, 0x2c, 0x08, 0xe1, 0x27,
+	0x05, 0xa8, 0xc3, 0x69, 0x80, 0xa5, 0x69, 0x89, 0x9f, 0x38, 0x4e, 0x9a, 0x39, 0x2b, 0x3c, 0x31,
+	0x8b, 0xb6, 0xdf, 0x7d, 0xfd, 0xf5, 0x61, 0xe1, 0x0e, 0x44, 0x78, 0xfa, 0x1c, 0xcb, 0x01, 0x82,
+	0x5f, 0x14, 0xb0, 0x76, 0x31, 0xe9, 0xb0, 0xfc, 0x57, 0xb9, 0x89, 0xd3, 0xa5, 0xed, 0xce, 0x75,
+	0x46, 0xfa, 0xdc, 0x15, 0x3e, 0x4b, 0xf0, 0xde, 0x34, 0x9f, 0x59, 0xe4, 0x1d, 0xaf, 0x9b, 0xbc,
+	0x2f, 0xfc, 0xac, 0x80, 0xd5, 0x91, 0x00, 0x40, 0x7c, 0xb9, 0xf2, 0x85, 0xb4, 0x69, 0xf7, 0x67,
+	0x3f, 0x20, 0x7d, 0x3e, 0x14, 0x3e, 0x31, 0x2c, 0x4d, 0xf3, 0x29, 0x9c, 0x39, 0x32, 0x67, 0xf8,
+	0x8d, 0x58, 0xbe, 0x15, 0x3d, 0x1f, 0x26, 0xe9, 0x92, 0x9e, 0x8f, 0x47, 0x51, 0x33, 0x67, 0x85,
+	0xcf, 0xda, 0xf3, 0x2c, 0xa2, 0xd6, 0xb3, 0xb3, 0x9e, 0xae, 0x9c, 0xf7, 0x74, 0xe5, 0x67, 0x4f,
+	0x57, 0xde, 0xf7, 0xf5, 0xdc, 0x79, 0x5f, 0xcf, 0x7d, 0xef, 0xeb, 0xb9, 0xc3, 0xf2, 0xc8, 0x07,
+	0x43, 0xf2, 0x94, 0x9a, 0xae, 0xc7, 0x86, 0xa4, 0x27, 0x3b, 0x65, 0x7c, 0x9a, 0x52, 0x8b, 0x0f,
+	0x88, 0xb7, 0x22, 0x7e, 0x0c, 0x76, 0x7f, 0x0f, 0x00, 0xc6, 0x41, 0xd5, 0x0c, 0xc5, 0x06, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -740,6 +757,26 @@ func (m *QueryDenomSpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.UsesTwapPrice {
+		i--
+		if m.UsesTwapPrice {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TwapPrice.Size()
+		i -= size
+		if _, err := m.TwapPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.SpotPrice.Size()
 		i -= size
@@ -928,6 +965,11 @@ func (m *QueryDenomSpotPriceResponse) Size() (n int) {
 	}
 	l = m.SpotPrice.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = m.TwapPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.UsesTwapPrice {
+		n += 2
+	}
 	return n
 }
 
@@ -1282,6 +1324,60 @@ func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TwapPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsesTwapPrice", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UsesTwapPrice = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
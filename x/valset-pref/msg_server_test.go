@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	valPref "github.com/osmosis-labs/osmosis/v12/x/valset-pref"
 	"github.com/osmosis-labs/osmosis/v12/x/valset-pref/types"
 )
@@ -124,3 +125,294 @@ func (suite *KeeperTestSuite) TestSetValidatorSetPreference() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestDelegateToValidatorSet() {
+	tests := []struct {
+		name           string
+		delegator      sdk.AccAddress
+		coin           sdk.Coin
+		setValSet      bool
+		expectedShares []sdk.Dec // expected shares for each validator in the set
+		expectPass     bool
+	}{
+		{
+			name:           "delegate to valid validator set",
+			delegator:      sdk.AccAddress([]byte("addr1---------------")),
+			coin:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+			setValSet:      true,
+			expectedShares: []sdk.Dec{sdk.NewDec(500), sdk.NewDec(300), sdk.NewDec(200)},
+			expectPass:     true,
+		},
+		{
+			name:           "delegate an amount that doesn't split evenly, dust goes to the highest weight",
+			delegator:      sdk.AccAddress([]byte("addr2---------------")),
+			coin:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1007)),
+			setValSet:      true,
+			expectedShares: []sdk.Dec{sdk.NewDec(504), sdk.NewDec(302), sdk.NewDec(201)},
+			expectPass:     true,
+		},
+		{
+			name:       "delegate a denom other than the bond denom",
+			delegator:  sdk.AccAddress([]byte("addr3---------------")),
+			coin:       sdk.NewCoin("uatom", sdk.NewInt(1000)),
+			setValSet:  true,
+			expectPass: false,
+		},
+		{
+			name:       "delegate more than the delegator's balance",
+			delegator:  sdk.AccAddress([]byte("addr4---------------")),
+			coin:       sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100_000)),
+			setValSet:  true,
+			expectPass: false,
+		},
+		{
+			name:       "delegate without a validator set or existing delegations",
+			delegator:  sdk.AccAddress([]byte("addr5---------------")),
+			coin:       sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+			setValSet:  false,
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			suite.FundAcc(test.delegator, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10_000)), sdk.NewCoin("uatom", sdk.NewInt(10_000))))
+
+			msgServer := valPref.NewMsgServerImpl(suite.App.ValidatorSetPreferenceKeeper)
+			c := sdk.WrapSDKContext(suite.Ctx)
+
+			valPreferences := suite.PrepareDelegateToValidatorSet()
+			if test.setValSet {
+				_, err := msgServer.SetValidatorSetPreference(c, types.NewMsgSetValidatorSetPreference(test.delegator, valPreferences))
+				suite.Require().NoError(err)
+			}
+
+			_, err := msgServer.DelegateToValidatorSet(c, types.NewMsgMsgStakeToValidatorSet(test.delegator, test.coin))
+			if !test.expectPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			for i, val := range valPreferences {
+				valAddr, err := sdk.ValAddressFromBech32(val.ValOperAddress)
+				suite.Require().NoError(err)
+
+				del, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, test.delegator, valAddr)
+				suite.Require().True(found)
+				suite.Require().Equal(test.expectedShares[i], del.Shares)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUndelegateFromValidatorSet() {
+	tests := []struct {
+		name            string
+		delegator       sdk.AccAddress
+		coinToStake     sdk.Coin
+		coinToUnStake   sdk.Coin
+		extraDelegation bool // delegate 400 outside of the validator set to the first validator
+		expectedShares  []sdk.Dec
+		expectPass      bool
+	}{
+		{
+			name:           "undelegate part of the delegation",
+			delegator:      sdk.AccAddress([]byte("addr1---------------")),
+			coinToStake:    sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+			coinToUnStake:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(400)),
+			expectedShares: []sdk.Dec{sdk.NewDec(300), sdk.NewDec(180), sdk.NewDec(120)},
+			expectPass:     true,
+		},
+		{
+			name:           "undelegate the full delegation",
+			delegator:      sdk.AccAddress([]byte("addr2---------------")),
+			coinToStake:    sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+			coinToUnStake:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+			expectedShares: []sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()},
+			expectPass:     true,
+		},
+		{
+			name:           "undelegate an amount that doesn't split evenly",
+			delegator:      sdk.AccAddress([]byte("addr3---------------")),
+			coinToStake:    sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+			coinToUnStake:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(7)),
+			expectedShares: []sdk.Dec{sdk.NewDec(496), sdk.NewDec(298), sdk.NewDec(199)},
+			expectPass:     true,
+		},
+		{
+			name:            "delegation is not proportional to weights, shortfall is taken from the other validators",
+			delegator:       sdk.AccAddress([]byte("addr4---------------")),
+			coinToStake:     sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+			coinToUnStake:   sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1200)),
+			extraDelegation: true,
+			// requested split is 600/360/240, validators 2 and 3 only hold 300/200
+			// so the 100 shortfall is undelegated from validator 1
+			expectedShares: []sdk.Dec{sdk.NewDec(200), sdk.ZeroDec(), sdk.ZeroDec()},
+			expectPass:     true,
+		},
+		{
+			name:          "undelegate more than delegated",
+			delegator:     sdk.AccAddress([]byte("addr5---------------")),
+			coinToStake:   sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+			coinToUnStake: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1001)),
+			expectPass:    false,
+		},
+		{
+			name:          "undelegate a denom other than the bond denom",
+			delegator:     sdk.AccAddress([]byte("addr6---------------")),
+			coinToStake:   sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+			coinToUnStake: sdk.NewCoin("uatom", sdk.NewInt(100)),
+			expectPass:    false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			suite.FundAcc(test.delegator, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10_000))))
+
+			msgServer := valPref.NewMsgServerImpl(suite.App.ValidatorSetPreferenceKeeper)
+			c := sdk.WrapSDKContext(suite.Ctx)
+
+			valPreferences := suite.PrepareDelegateToValidatorSet()
+			_, err := msgServer.SetValidatorSetPreference(c, types.NewMsgSetValidatorSetPreference(test.delegator, valPreferences))
+			suite.Require().NoError(err)
+
+			_, err = msgServer.DelegateToValidatorSet(c, types.NewMsgMsgStakeToValidatorSet(test.delegator, test.coinToStake))
+			suite.Require().NoError(err)
+
+			if test.extraDelegation {
+				valAddr, err := sdk.ValAddressFromBech32(valPreferences[0].ValOperAddress)
+				suite.Require().NoError(err)
+				validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+				suite.Require().True(found)
+				_, err = suite.App.StakingKeeper.Delegate(suite.Ctx, test.delegator, sdk.NewInt(400), stakingtypes.Unbonded, validator, true)
+				suite.Require().NoError(err)
+			}
+
+			_, err = msgServer.UndelegateFromValidatorSet(c, types.NewMsgUndelegateFromValidatorSet(test.delegator, test.coinToUnStake))
+			if !test.expectPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			for i, val := range valPreferences {
+				valAddr, err := sdk.ValAddressFromBech32(val.ValOperAddress)
+				suite.Require().NoError(err)
+
+				del, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, test.delegator, valAddr)
+				if test.expectedShares[i].IsZero() {
+					suite.Require().False(found)
+					continue
+				}
+				suite.Require().True(found)
+				suite.Require().Equal(test.expectedShares[i], del.Shares)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestWithdrawDelegationRewards() {
+	tests := []struct {
+		name       string
+		delegator  sdk.AccAddress
+		setValSet  bool
+		delegate   bool
+		expectPass bool
+	}{
+		{
+			name:       "withdraw rewards from the validator set",
+			delegator:  sdk.AccAddress([]byte("addr1---------------")),
+			setValSet:  true,
+			delegate:   true,
+			expectPass: true,
+		},
+		{
+			name:       "withdraw rewards from existing delegations without a validator set",
+			delegator:  sdk.AccAddress([]byte("addr2---------------")),
+			setValSet:  false,
+			delegate:   true,
+			expectPass: true,
+		},
+		{
+			name:       "validator set without delegations has no rewards to withdraw",
+			delegator:  sdk.AccAddress([]byte("addr3---------------")),
+			setValSet:  true,
+			delegate:   false,
+			expectPass: true,
+		},
+		{
+			name:       "no validator set and no delegations",
+			delegator:  sdk.AccAddress([]byte("addr4---------------")),
+			setValSet:  false,
+			delegate:   false,
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			suite.FundAcc(test.delegator, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10_000))))
+
+			msgServer := valPref.NewMsgServerImpl(suite.App.ValidatorSetPreferenceKeeper)
+			c := sdk.WrapSDKContext(suite.Ctx)
+
+			valPreferences := suite.PrepareDelegateToValidatorSet()
+			if test.setValSet {
+				_, err := msgServer.SetValidatorSetPreference(c, types.NewMsgSetValidatorSetPreference(test.delegator, valPreferences))
+				suite.Require().NoError(err)
+			}
+
+			if test.delegate {
+				// delegate directly through staking so that both code paths have delegations
+				for _, val := range valPreferences {
+					valAddr, err := sdk.ValAddressFromBech32(val.ValOperAddress)
+					suite.Require().NoError(err)
+					validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+					suite.Require().True(found)
+					_, err = suite.App.StakingKeeper.Delegate(suite.Ctx, test.delegator, sdk.NewInt(1000), stakingtypes.Unbonded, validator, true)
+					suite.Require().NoError(err)
+				}
+
+				for _, val := range valPreferences {
+					valAddr, err := sdk.ValAddressFromBech32(val.ValOperAddress)
+					suite.Require().NoError(err)
+					suite.AllocateRewardsToValidator(valAddr, sdk.NewInt(20000))
+				}
+			}
+
+			balanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, test.delegator, sdk.DefaultBondDenom)
+
+			_, err := msgServer.WithdrawDelegationRewards(sdk.WrapSDKContext(suite.Ctx), types.NewMsgWithdrawDelegationRewards(test.delegator))
+			if !test.expectPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			balanceAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, test.delegator, sdk.DefaultBondDenom)
+			if test.delegate {
+				suite.Require().True(balanceAfter.Amount.GT(balanceBefore.Amount))
+
+				// all the rewards from the set have been withdrawn
+				for _, val := range valPreferences {
+					valAddr, err := sdk.ValAddressFromBech32(val.ValOperAddress)
+					suite.Require().NoError(err)
+					validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+					suite.Require().True(found)
+					endingPeriod := suite.App.DistrKeeper.IncrementValidatorPeriod(suite.Ctx, validator)
+					del, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, test.delegator, valAddr)
+					suite.Require().True(found)
+					rewards := suite.App.DistrKeeper.CalculateDelegationRewards(suite.Ctx, validator, del, endingPeriod)
+					suite.Require().True(rewards.IsZero())
+				}
+			} else {
+				suite.Require().Equal(balanceBefore, balanceAfter)
+			}
+		})
+	}
+}
@@ -29,6 +29,253 @@ func (k Keeper) SetupValidatorSetPreference(ctx sdk.Context, delegator string, p
 	return nil
 }
 
+// GetDelegationPreferences returns the validator-set preference of the delegator.
+// If the delegator has not set a preference, it defaults to their existing delegations,
+// weighted by the amount of tokens delegated to each validator.
+func (k Keeper) GetDelegationPreferences(ctx sdk.Context, delegator string) (types.ValidatorSetPreferences, error) {
+	valSet, found := k.GetValidatorSetPreference(ctx, delegator)
+	if found {
+		return valSet, nil
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return types.ValidatorSetPreferences{}, err
+	}
+
+	existingDelegations := k.stakingKeeper.GetAllDelegatorDelegations(ctx, delAddr)
+	if len(existingDelegations) == 0 {
+		return types.ValidatorSetPreferences{}, fmt.Errorf("No Existing delegation to unbond from")
+	}
+
+	totalTokens := sdk.ZeroDec()
+	delegatedTokens := make([]sdk.Dec, len(existingDelegations))
+	for i, delegation := range existingDelegations {
+		_, validator, err := k.getValAddrAndVal(ctx, delegation.ValidatorAddress)
+		if err != nil {
+			return types.ValidatorSetPreferences{}, err
+		}
+		delegatedTokens[i] = validator.TokensFromShares(delegation.Shares)
+		totalTokens = totalTokens.Add(delegatedTokens[i])
+	}
+
+	if !totalTokens.IsPositive() {
+		return types.ValidatorSetPreferences{}, fmt.Errorf("No Existing delegation to unbond from")
+	}
+
+	preferences := make([]types.ValidatorPreference, len(existingDelegations))
+	for i, delegation := range existingDelegations {
+		preferences[i] = types.ValidatorPreference{
+			ValOperAddress: delegation.ValidatorAddress,
+			Weight:         delegatedTokens[i].Quo(totalTokens),
+		}
+	}
+
+	return types.ValidatorSetPreferences{Preferences: preferences}, nil
+}
+
+// DelegateToValidatorSet delegates to the delegator's validator-set.
+// The amount is split by weight, and any dust left over by truncation is delegated
+// to the validator with the highest weight, so that the full amount is always delegated.
+func (k Keeper) DelegateToValidatorSet(ctx sdk.Context, delegatorAddr string, coin sdk.Coin) error {
+	existingSet, err := k.GetDelegationPreferences(ctx, delegatorAddr)
+	if err != nil {
+		return fmt.Errorf("user %s doesn't have validator set", delegatorAddr)
+	}
+
+	delegator, err := sdk.AccAddressFromBech32(delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	if bondDenom := k.stakingKeeper.BondDenom(ctx); coin.Denom != bondDenom {
+		return fmt.Errorf("invalid coin denom %s, expected %s", coin.Denom, bondDenom)
+	}
+
+	tokenAmts := splitByWeight(coin.Amount, existingSet.Preferences)
+	for i, val := range existingSet.Preferences {
+		if tokenAmts[i].IsZero() {
+			continue
+		}
+
+		_, validator, err := k.getValAddrAndVal(ctx, val.ValOperAddress)
+		if err != nil {
+			return err
+		}
+
+		// Delegate the unbonded tokens from the delegator's account
+		_, err = k.stakingKeeper.Delegate(ctx, delegator, tokenAmts[i], stakingtypes.Unbonded, validator, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UndelegateFromValidatorSet undelegates the given amount from the delegator's validator-set.
+// The amount is split by weight. If a validator doesn't hold enough of the delegation for its share
+// (e.g. because of rounding, slashing or delegations made outside of the set), the shortfall is
+// undelegated from the remaining validators in the set in order of weight.
+func (k Keeper) UndelegateFromValidatorSet(ctx sdk.Context, delegatorAddr string, coin sdk.Coin) error {
+	existingSet, err := k.GetDelegationPreferences(ctx, delegatorAddr)
+	if err != nil {
+		return fmt.Errorf("user %s doesn't have validator set", delegatorAddr)
+	}
+
+	delegator, err := sdk.AccAddressFromBech32(delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	if bondDenom := k.stakingKeeper.BondDenom(ctx); coin.Denom != bondDenom {
+		return fmt.Errorf("invalid coin denom %s, expected %s", coin.Denom, bondDenom)
+	}
+
+	// get the amount of tokens the delegator has with each validator in the set
+	delegatedAmts := make([]sdk.Int, len(existingSet.Preferences))
+	totalDelegated := sdk.ZeroInt()
+	for i, val := range existingSet.Preferences {
+		delegatedAmts[i] = sdk.ZeroInt()
+		valAddr, err := sdk.ValAddressFromBech32(val.ValOperAddress)
+		if err != nil {
+			return err
+		}
+
+		// validators that have been removed from state no longer hold any delegation
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		delegation, found := k.stakingKeeper.GetDelegation(ctx, delegator, valAddr)
+		if !found {
+			continue
+		}
+
+		delegatedAmts[i] = validator.TokensFromShares(delegation.Shares).TruncateInt()
+		totalDelegated = totalDelegated.Add(delegatedAmts[i])
+	}
+
+	if totalDelegated.LT(coin.Amount) {
+		return fmt.Errorf("total delegation %s is less than the requested undelegation %s", totalDelegated, coin.Amount)
+	}
+
+	tokenAmts := capToDelegations(splitByWeight(coin.Amount, existingSet.Preferences), delegatedAmts, existingSet.Preferences)
+	for i, val := range existingSet.Preferences {
+		if tokenAmts[i].IsZero() {
+			continue
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(val.ValOperAddress)
+		if err != nil {
+			return err
+		}
+
+		sharesAmt, err := k.stakingKeeper.ValidateUnbondAmount(ctx, delegator, valAddr, tokenAmts[i])
+		if err != nil {
+			return err
+		}
+
+		_, err = k.stakingKeeper.Undelegate(ctx, delegator, valAddr, sharesAmt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WithdrawDelegationRewards withdraws the staking rewards from every validator in the
+// delegator's validator-set that the delegator has a delegation with.
+func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delegatorAddr string) error {
+	existingSet, err := k.GetDelegationPreferences(ctx, delegatorAddr)
+	if err != nil {
+		return fmt.Errorf("user %s doesn't have validator set or existing delegations", delegatorAddr)
+	}
+
+	delegator, err := sdk.AccAddressFromBech32(delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	for _, val := range existingSet.Preferences {
+		valAddr, err := sdk.ValAddressFromBech32(val.ValOperAddress)
+		if err != nil {
+			return err
+		}
+
+		// validators in the set that the delegator hasn't delegated to have no rewards
+		if _, found := k.stakingKeeper.GetDelegation(ctx, delegator, valAddr); !found {
+			continue
+		}
+
+		_, err = k.distributionKeeper.WithdrawDelegationRewards(ctx, delegator, valAddr)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// splitByWeight splits amount across the preferences proportionally to their weights.
+// Each share is truncated, and the remaining dust is assigned to the highest weighted preference.
+func splitByWeight(amount sdk.Int, preferences []types.ValidatorPreference) []sdk.Int {
+	amounts := make([]sdk.Int, len(preferences))
+	total := sdk.ZeroInt()
+	maxIdx := 0
+	for i, val := range preferences {
+		amounts[i] = val.Weight.MulInt(amount).TruncateInt()
+		total = total.Add(amounts[i])
+		if val.Weight.GT(preferences[maxIdx].Weight) {
+			maxIdx = i
+		}
+	}
+
+	if len(preferences) > 0 {
+		amounts[maxIdx] = amounts[maxIdx].Add(amount.Sub(total))
+	}
+
+	return amounts
+}
+
+// capToDelegations caps each amount to what is delegated to the corresponding validator, and
+// reassigns the excess to validators with remaining delegation, starting from the highest weight.
+// The caller must ensure the sum of delegated is at least the sum of amounts.
+func capToDelegations(amounts, delegated []sdk.Int, preferences []types.ValidatorPreference) []sdk.Int {
+	excess := sdk.ZeroInt()
+	for i := range amounts {
+		if amounts[i].GT(delegated[i]) {
+			excess = excess.Add(amounts[i].Sub(delegated[i]))
+			amounts[i] = delegated[i]
+		}
+	}
+
+	order := make([]int, len(preferences))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return preferences[order[i]].Weight.GT(preferences[order[j]].Weight)
+	})
+
+	for _, i := range order {
+		if excess.IsZero() {
+			break
+		}
+		remaining := delegated[i].Sub(amounts[i])
+		if remaining.IsZero() {
+			continue
+		}
+		take := sdk.MinInt(remaining, excess)
+		amounts[i] = amounts[i].Add(take)
+		excess = excess.Sub(take)
+	}
+
+	return amounts
+}
+
 // GetValAddrAndVal checks if the validator address is valid and the validator provided exists on chain.
 func (k Keeper) getValAddrAndVal(ctx sdk.Context, valOperAddress string) (sdk.ValAddress, stakingtypes.Validator, error) {
 	valAddr, err := sdk.ValAddressFromBech32(valOperAddress)